@@ -0,0 +1,148 @@
+package pango
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PaloAltoNetworks/pango/pnrm/accessdomain"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// ScopeError is returned when a request is outside of the access domain that
+// the client is restricted to.
+type ScopeError struct {
+	Domain string
+	Kind   string
+	Name   string
+	Action string
+}
+
+// Error returns the error message.
+func (e ScopeError) Error() string {
+	if e.Name == "" {
+		return fmt.Sprintf("Access domain %q does not allow %s access to %s", e.Domain, e.Action, e.Kind)
+	}
+
+	return fmt.Sprintf("Access domain %q does not include %s %q", e.Domain, e.Kind, e.Name)
+}
+
+// UseAccessDomain restricts this client to the given access domain.
+//
+// The access domain's definition is retrieved from Panorama.  Admins that
+// cannot read the access domain config can instead use RestrictTo with an
+// access domain they have defined themselves.
+func (c *Panorama) UseAccessDomain(name string) error {
+	ad, err := c.Panorama.AccessDomain.Get(name)
+	if err != nil {
+		return err
+	}
+
+	c.RestrictTo(&ad)
+	return nil
+}
+
+// RestrictTo restricts this client's config requests to the given access
+// domain.  Pass in nil to remove the restriction.
+//
+// Requests for config outside of the access domain are not sent to
+// Panorama, instead returning a ScopeError.  This includes requests for the
+// device context of a managed device not in the access domain's Devices,
+// either as the client's Target or by xpath.
+func (c *Panorama) RestrictTo(ad *accessdomain.Entry) {
+	if ad == nil {
+		c.accessDomain = nil
+		c.scope = nil
+		return
+	}
+
+	d := *ad
+	c.accessDomain = &d
+	c.scope = func(action, xpath string) error {
+		return checkAccess(d, c.Target, action, xpath)
+	}
+}
+
+// RestrictedTo returns a copy of the access domain that this client is
+// restricted to, or nil if it is not restricted.
+func (c *Panorama) RestrictedTo() *accessdomain.Entry {
+	if c.accessDomain == nil {
+		return nil
+	}
+
+	d := *c.accessDomain
+	return &d
+}
+
+/** Internal functions **/
+
+// checkAccess verifies that the given config action on xpath is allowed by
+// the access domain, with target being the serial number of the managed
+// device the request is sent to, if any.
+func checkAccess(ad accessdomain.Entry, target, action, xpath string) error {
+	if target != "" && !containsString(ad.Devices, target) {
+		return ScopeError{Domain: ad.Name, Kind: "device", Name: target, Action: action}
+	}
+
+	prefix := "/config/devices/" + util.AsEntryXpath([]string{"localhost.localdomain"}) + "/"
+	mgt := "/config/mgt-config/devices/"
+
+	switch {
+	case strings.HasPrefix(xpath, "/config/shared"):
+		switch ad.SharedAccess {
+		case accessdomain.SharedAccessWrite:
+			return nil
+		case accessdomain.SharedAccessRead:
+			if action == "get" || action == "show" {
+				return nil
+			}
+		}
+		return ScopeError{Domain: ad.Name, Kind: "shared", Action: action}
+	case strings.HasPrefix(xpath, mgt):
+		name, ok := entryName(xpath[len(mgt):])
+		if !ok || containsString(ad.Devices, name) {
+			return nil
+		}
+		return ScopeError{Domain: ad.Name, Kind: "device", Name: name, Action: action}
+	case !strings.HasPrefix(xpath, prefix):
+		return nil
+	}
+
+	rest := xpath[len(prefix):]
+	for _, v := range []struct {
+		tag  string
+		kind string
+		list []string
+	}{
+		{"device-group/", "device group", ad.DeviceGroups},
+		{"template/", "template", ad.Templates},
+		{"template-stack/", "template stack", ad.Templates},
+	} {
+		if !strings.HasPrefix(rest, v.tag) {
+			continue
+		}
+		name, ok := entryName(rest[len(v.tag):])
+		if !ok || containsString(v.list, name) {
+			return nil
+		}
+		return ScopeError{Domain: ad.Name, Kind: v.kind, Name: name, Action: action}
+	}
+
+	return nil
+}
+
+// entryName returns the name from an xpath starting with a single
+// entry[@name='...'] step.
+func entryName(s string) (string, bool) {
+	start := "entry[@name='"
+	if !strings.HasPrefix(s, start) {
+		return "", false
+	}
+	s = s[len(start):]
+
+	end := strings.Index(s, "']")
+	if end == -1 {
+		return "", false
+	}
+
+	return s[:end], true
+}
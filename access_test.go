@@ -0,0 +1,83 @@
+package pango
+
+import (
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/pnrm/accessdomain"
+)
+
+func TestRestrictTo(t *testing.T) {
+	pano := &Panorama{Client: Client{
+		rb: [][]byte{
+			[]byte(`<response status="success" code="20"><msg>command succeeded</msg></response>`),
+			[]byte(`<response status="success"><result><address /></result></response>`),
+			[]byte(`<response status="success" code="20"><msg>command succeeded</msg></response>`),
+			[]byte(`<response status="success" code="20"><msg>command succeeded</msg></response>`),
+		},
+	}}
+	if err := pano.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	pano.RestrictTo(&accessdomain.Entry{
+		Name:         "branch",
+		SharedAccess: accessdomain.SharedAccessRead,
+		DeviceGroups: []string{"dg1"},
+		Templates:    []string{"t1"},
+		Devices:      []string{"0001"},
+	})
+	if ad := pano.RestrictedTo(); ad == nil || ad.Name != "branch" {
+		t.Fatalf("Bad access domain: %#v", ad)
+	}
+
+	var shared struct{}
+	prefix := "/config/devices/entry[@name='localhost.localdomain']"
+	testCases := []struct {
+		desc  string
+		fn    func() error
+		allow bool
+	}{
+		{"dg in domain", func() error { return pano.SetXpath(prefix+"/device-group/entry[@name='dg1']/address", nil) }, true},
+		{"dg outside domain", func() error { return pano.SetXpath(prefix+"/device-group/entry[@name='dg2']/address", nil) }, false},
+		{"template stack outside domain", func() error { return pano.DeleteXpath(prefix + "/template-stack/entry[@name='ts1']") }, false},
+		{"read shared", func() error { return pano.GetXpath("/config/shared/address", &shared) }, true},
+		{"write shared", func() error { return pano.DeleteXpath("/config/shared/address") }, false},
+		{"device in domain", func() error { return pano.SetXpath("/config/mgt-config/devices/entry[@name='0001']", nil) }, true},
+		{"device outside domain", func() error { return pano.SetXpath("/config/mgt-config/devices/entry[@name='0002']", nil) }, false},
+		{"target in domain", func() error {
+			pano.Target = "0001"
+			defer func() { pano.Target = "" }()
+			return pano.SetXpath(prefix+"/vsys/entry[@name='vsys1']/address", nil)
+		}, true},
+		{"target outside domain", func() error {
+			pano.Target = "0002"
+			defer func() { pano.Target = "" }()
+			return pano.SetXpath(prefix+"/vsys/entry[@name='vsys1']/address", nil)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.fn()
+			if tc.allow && err != nil {
+				t.Errorf("Unexpected error: %s", err)
+			} else if !tc.allow {
+				if _, ok := err.(ScopeError); !ok {
+					t.Errorf("Expected ScopeError, got %#v", err)
+				}
+			}
+		})
+	}
+
+	if len(pano.rp) != 4 {
+		t.Errorf("Expected 4 requests sent, got %d", len(pano.rp))
+	}
+
+	pano.RestrictTo(nil)
+	if ad := pano.RestrictedTo(); ad != nil {
+		t.Errorf("Access domain not removed: %#v", ad)
+	}
+	if err := pano.DeleteXpath("/config/shared/address"); err != nil {
+		t.Errorf("Unrestricted client returned error: %s", err)
+	}
+}
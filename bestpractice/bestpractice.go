@@ -0,0 +1,197 @@
+package bestpractice
+
+import (
+	"github.com/PaloAltoNetworks/pango/objs/profile/security/spyware"
+	"github.com/PaloAltoNetworks/pango/objs/profile/security/urlfilter"
+	"github.com/PaloAltoNetworks/pango/objs/profile/security/virus"
+	"github.com/PaloAltoNetworks/pango/objs/profile/security/vulnerability"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+// Description is the description given to all generated profiles.
+const Description = "Best practice profile generated by pango"
+
+// BlockedCategories are the URL categories that best practices say should
+// always be blocked.
+var BlockedCategories = []string{
+	"command-and-control",
+	"copyright-infringement",
+	"dynamic-dns",
+	"extremism",
+	"malware",
+	"parked",
+	"phishing",
+	"proxy-avoidance-and-anonymizers",
+	"unknown",
+}
+
+// Profiles is a full set of best practice security profiles.
+type Profiles struct {
+	Antivirus     virus.Entry
+	AntiSpyware   spyware.Entry
+	Vulnerability vulnerability.Entry
+	UrlFiltering  urlfilter.Entry
+}
+
+// New returns a full set of best practice security profiles for the given
+// PAN-OS version, each named with the given prefix.
+//
+// The categories param is all URL categories known to the device; refer to
+// UrlFiltering for details.
+func New(prefix string, v version.Number, categories []string) Profiles {
+	return Profiles{
+		Antivirus:     Antivirus(prefix+"-AV", v),
+		AntiSpyware:   AntiSpyware(prefix + "-AS"),
+		Vulnerability: Vulnerability(prefix + "-VP"),
+		UrlFiltering:  UrlFiltering(prefix+"-URL", categories),
+	}
+}
+
+// Antivirus returns a best practice antivirus profile.
+//
+// Every decoder is set to reset both the client and the server for both
+// antivirus and WildFire signatures.
+func Antivirus(name string, v version.Number) virus.Entry {
+	names := []string{"ftp", "http", "imap", "pop3", "smb", "smtp"}
+	if v.Gte(version.Number{9, 0, 0, ""}) {
+		names = append(names, "http2")
+	}
+
+	list := make([]virus.Decoder, 0, len(names))
+	for _, n := range names {
+		list = append(list, virus.Decoder{
+			Name:           n,
+			Action:         virus.ActionResetBoth,
+			WildfireAction: virus.ActionResetBoth,
+		})
+	}
+
+	return virus.Entry{
+		Name:        name,
+		Description: Description,
+		Decoders:    list,
+	}
+}
+
+// AntiSpyware returns a best practice anti-spyware profile.
+//
+// Critical, high, and medium severity threats are reset with a packet capture,
+// while low and informational threats take the default action.  DNS queries
+// for known malicious domains are sinkholed so that infected hosts can be
+// identified from the traffic logs.
+func AntiSpyware(name string) spyware.Entry {
+	return spyware.Entry{
+		Name:                name,
+		Description:         Description,
+		SinkholeIpv4Address: spyware.SinkholeDefaultIpv4,
+		SinkholeIpv6Address: spyware.SinkholeDefaultIpv6,
+		DnsLists: []spyware.DnsList{{
+			Name:          "default-paloalto-dns",
+			Action:        spyware.DnsActionSinkhole,
+			PacketCapture: spyware.PacketCaptureSinglePacket,
+		}},
+		Rules: []spyware.Rule{
+			{
+				Name:       "Block-Critical-High-Medium",
+				ThreatName: "any",
+				Category:   "any",
+				Severities: []string{
+					spyware.SeverityCritical,
+					spyware.SeverityHigh,
+					spyware.SeverityMedium,
+				},
+				PacketCapture: spyware.PacketCaptureSinglePacket,
+				Action:        spyware.ActionResetBoth,
+			},
+			{
+				Name:       "Default-Low-Info",
+				ThreatName: "any",
+				Category:   "any",
+				Severities: []string{
+					spyware.SeverityLow,
+					spyware.SeverityInformational,
+				},
+				PacketCapture: spyware.PacketCaptureDisable,
+				Action:        spyware.ActionDefault,
+			},
+		},
+	}
+}
+
+// Vulnerability returns a best practice vulnerability protection profile.
+//
+// Critical, high, and medium severity threats are reset with a packet capture,
+// while low and informational threats take the default action.
+func Vulnerability(name string) vulnerability.Entry {
+	return vulnerability.Entry{
+		Name:        name,
+		Description: Description,
+		Rules: []vulnerability.Rule{
+			{
+				Name:       "Block-Critical-High-Medium",
+				ThreatName: "any",
+				Cves:       []string{"any"},
+				Host:       vulnerability.HostAny,
+				VendorIds:  []string{"any"},
+				Severities: []string{
+					vulnerability.SeverityCritical,
+					vulnerability.SeverityHigh,
+					vulnerability.SeverityMedium,
+				},
+				Category:      "any",
+				PacketCapture: vulnerability.PacketCaptureSinglePacket,
+				Action:        vulnerability.ActionResetBoth,
+			},
+			{
+				Name:       "Default-Low-Info",
+				ThreatName: "any",
+				Cves:       []string{"any"},
+				Host:       vulnerability.HostAny,
+				VendorIds:  []string{"any"},
+				Severities: []string{
+					vulnerability.SeverityLow,
+					vulnerability.SeverityInformational,
+				},
+				Category:      "any",
+				PacketCapture: vulnerability.PacketCaptureDisable,
+				Action:        vulnerability.ActionDefault,
+			},
+		},
+	}
+}
+
+// UrlFiltering returns a best practice URL filtering profile.
+//
+// The BlockedCategories are blocked, and all other given categories are set
+// to alert so that they are logged.  Container page tracking and the HTTP
+// header logging options are enabled for better visibility.
+func UrlFiltering(name string, categories []string) urlfilter.Entry {
+	block := make([]string, len(BlockedCategories))
+	copy(block, BlockedCategories)
+
+	var alert []string
+	for _, c := range categories {
+		found := false
+		for _, b := range block {
+			if c == b {
+				found = true
+				break
+			}
+		}
+		if !found {
+			alert = append(alert, c)
+		}
+	}
+
+	return urlfilter.Entry{
+		Name:                   name,
+		Description:            Description,
+		AlertCategories:        alert,
+		BlockCategories:        block,
+		TrackContainerPage:     true,
+		LogContainerPageOnly:   true,
+		LogHttpHeaderXff:       true,
+		LogHttpHeaderUserAgent: true,
+		LogHttpHeaderReferer:   true,
+	}
+}
@@ -0,0 +1,46 @@
+package bestpractice
+
+import (
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+func TestNew(t *testing.T) {
+	p := New("BP", version.Number{9, 0, 0, ""}, []string{"news", "malware", "shopping"})
+
+	if p.Antivirus.Name != "BP-AV" || p.AntiSpyware.Name != "BP-AS" || p.Vulnerability.Name != "BP-VP" || p.UrlFiltering.Name != "BP-URL" {
+		t.Errorf("Unexpected names: %q %q %q %q", p.Antivirus.Name, p.AntiSpyware.Name, p.Vulnerability.Name, p.UrlFiltering.Name)
+	}
+
+	if len(p.Antivirus.Decoders) != 7 {
+		t.Errorf("Expected 7 decoders for 9.0, got %d", len(p.Antivirus.Decoders))
+	}
+
+	if len(p.UrlFiltering.AlertCategories) != 2 {
+		t.Errorf("Expected 2 alert categories, got %#v", p.UrlFiltering.AlertCategories)
+	}
+	for _, c := range p.UrlFiltering.AlertCategories {
+		if c == "malware" {
+			t.Errorf("Blocked category is also alerted")
+		}
+	}
+}
+
+func TestAntivirusVersioning(t *testing.T) {
+	e := Antivirus("av", version.Number{8, 1, 0, ""})
+	for _, d := range e.Decoders {
+		if d.Name == "http2" {
+			t.Errorf("http2 decoder present for PAN-OS 8.1")
+		}
+	}
+}
+
+func TestProfilesAreIndependent(t *testing.T) {
+	a := UrlFiltering("a", nil)
+	a.BlockCategories[0] = "changed"
+
+	if BlockedCategories[0] == "changed" {
+		t.Errorf("Modifying a profile modified BlockedCategories")
+	}
+}
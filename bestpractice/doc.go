@@ -0,0 +1,19 @@
+/*
+Package bestpractice generates security profiles that follow Palo Alto Networks
+best practice guidance.
+
+The profiles returned are normal pango entries, so they can be customized as
+needed before being sent to PAN-OS:
+
+	p := bestpractice.New("BP", fw.Versioning(), nil)
+	p.UrlFiltering.BlockCategories = append(p.UrlFiltering.BlockCategories, "gambling")
+	if err := fw.Objects.AntivirusProfile.Set("vsys1", p.Antivirus); err != nil {
+	    return err
+	}
+
+The URL filtering profile alerts on every category that it does not block, so
+that all web traffic is logged.  Pass in the URL categories known to the
+device (such as from the Predefined namespace) for this to happen; otherwise
+only the blocked categories are configured.
+*/
+package bestpractice
@@ -0,0 +1,111 @@
+package bootstrap
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestInitCfgStatic(t *testing.T) {
+	o := InitCfg{
+		Type:           TypeStatic,
+		IpAddress:      "10.1.1.5",
+		Netmask:        "255.255.255.0",
+		DefaultGateway: "10.1.1.1",
+		Hostname:       "fw1",
+		VmAuthKey:      "1234",
+		PanoramaServer: "10.2.2.2",
+		TemplateStack:  "stack",
+		DeviceGroup:    "dg",
+		OpCommandModes: []string{"multi-vsys", "jumbo-frame"},
+	}
+
+	expected := `type=static
+ip-address=10.1.1.5
+default-gateway=10.1.1.1
+netmask=255.255.255.0
+hostname=fw1
+vm-auth-key=1234
+panorama-server=10.2.2.2
+tplname=stack
+dgname=dg
+op-command-modes=multi-vsys,jumbo-frame
+`
+
+	b, err := o.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes failed: %s", err)
+	}
+	if string(b) != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, b)
+	}
+}
+
+func TestInitCfgValidate(t *testing.T) {
+	testCases := []struct {
+		desc string
+		conf InitCfg
+	}{
+		{"no type", InitCfg{}},
+		{"static without ip", InitCfg{Type: TypeStatic}},
+		{"panorama without key", InitCfg{Type: TypeDhcpClient, PanoramaServer: "10.2.2.2"}},
+		{"dg without panorama", InitCfg{Type: TypeDhcpClient, DeviceGroup: "dg"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if err := tc.conf.Validate(); err == nil {
+				t.Errorf("No error")
+			}
+		})
+	}
+}
+
+func TestBundleWrite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "bootstrap")
+	if err != nil {
+		t.Fatalf("TempDir failed: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	b := Bundle{
+		InitCfg: InitCfg{
+			Type:             TypeDhcpClient,
+			DhcpSendHostname: true,
+		},
+		AuthCodes: []string{"I1234"},
+	}
+	if err = b.Write(dir); err != nil {
+		t.Fatalf("Write failed: %s", err)
+	}
+
+	for _, d := range Directories {
+		if fi, err := os.Stat(filepath.Join(dir, d)); err != nil || !fi.IsDir() {
+			t.Errorf("Directory %q not created", d)
+		}
+	}
+
+	cfg, err := ioutil.ReadFile(filepath.Join(dir, "config", "init-cfg.txt"))
+	if err != nil {
+		t.Fatalf("init-cfg.txt not written: %s", err)
+	}
+	expected := `type=dhcp-client
+dhcp-send-hostname=yes
+dhcp-send-client-id=no
+dhcp-accept-server-hostname=no
+dhcp-accept-server-domain=no
+`
+	if string(cfg) != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, cfg)
+	}
+
+	codes, err := ioutil.ReadFile(filepath.Join(dir, "license", "authcodes"))
+	if err != nil || string(codes) != "I1234\n" {
+		t.Errorf("Bad authcodes: %q %v", codes, err)
+	}
+
+	if _, err = os.Stat(filepath.Join(dir, "config", "bootstrap.xml")); !os.IsNotExist(err) {
+		t.Errorf("bootstrap.xml written without content")
+	}
+}
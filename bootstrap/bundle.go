@@ -0,0 +1,60 @@
+package bootstrap
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Directories is the directory layout of a bootstrap package.
+var Directories = []string{
+	"config",
+	"content",
+	"license",
+	"software",
+	"plugins",
+}
+
+// Bundle is a full bootstrap package.
+//
+// AuthCodes are written to license/authcodes, while BootstrapXml (if given)
+// is written to config/bootstrap.xml.
+type Bundle struct {
+	InitCfg      InitCfg
+	AuthCodes    []string
+	BootstrapXml []byte
+}
+
+// Write creates the bootstrap directory layout under dir.
+func (o Bundle) Write(dir string) error {
+	cfg, err := o.InitCfg.Bytes()
+	if err != nil {
+		return err
+	}
+
+	for _, d := range Directories {
+		if err = os.MkdirAll(filepath.Join(dir, d), 0755); err != nil {
+			return err
+		}
+	}
+
+	if err = ioutil.WriteFile(filepath.Join(dir, "config", "init-cfg.txt"), cfg, 0644); err != nil {
+		return err
+	}
+
+	if len(o.BootstrapXml) > 0 {
+		if err = ioutil.WriteFile(filepath.Join(dir, "config", "bootstrap.xml"), o.BootstrapXml, 0644); err != nil {
+			return err
+		}
+	}
+
+	if len(o.AuthCodes) > 0 {
+		codes := strings.Join(o.AuthCodes, "\n") + "\n"
+		if err = ioutil.WriteFile(filepath.Join(dir, "license", "authcodes"), []byte(codes), 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+/*
+Package bootstrap builds bootstrap packages for VM-Series firewalls.
+
+The init-cfg.txt file tells a newly launched firewall how to configure its
+management interface and which Panorama (and device group / template stack)
+it should register with.  Combined with a VM auth key from
+Panorama.CreateVmAuthKey(), bringing up a new firewall is:
+
+	key, err := pano.CreateVmAuthKey(24)
+	if err != nil {
+		return err
+	}
+	b := bootstrap.Bundle{
+		InitCfg: bootstrap.InitCfg{
+			Type:           bootstrap.TypeDhcpClient,
+			VmAuthKey:      key.AuthKey,
+			PanoramaServer: "10.1.1.1",
+			TemplateStack:  "branch-stack",
+			DeviceGroup:    "branch",
+		},
+	}
+	err = b.Write("/tmp/bootstrap")
+*/
+package bootstrap
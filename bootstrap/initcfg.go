@@ -0,0 +1,127 @@
+package bootstrap
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Valid values for InitCfg.Type.
+const (
+	TypeDhcpClient = "dhcp-client"
+	TypeStatic     = "static"
+)
+
+// InitCfg is the contents of an init-cfg.txt file.
+//
+// The IP address, netmask, and default gateway fields are only used (and are
+// required) for the static type, while the Dhcp fields are only used for the
+// dhcp-client type.
+//
+// TemplateStack is the template stack the firewall is added to (which is
+// "tplname" in the init-cfg.txt file).
+type InitCfg struct {
+	Type                     string
+	IpAddress                string
+	Netmask                  string
+	DefaultGateway           string
+	Ipv6Address              string
+	Ipv6DefaultGateway       string
+	Hostname                 string
+	VmAuthKey                string
+	PanoramaServer           string
+	PanoramaServer2          string
+	TemplateStack            string
+	DeviceGroup              string
+	DnsPrimary               string
+	DnsSecondary             string
+	OpCommandModes           []string
+	DhcpSendHostname         bool
+	DhcpSendClientId         bool
+	DhcpAcceptServerHostname bool
+	DhcpAcceptServerDomain   bool
+}
+
+// Validate checks that the init-cfg.txt has all required fields.
+func (o InitCfg) Validate() error {
+	switch o.Type {
+	case TypeDhcpClient:
+	case TypeStatic:
+		if o.IpAddress == "" || o.Netmask == "" || o.DefaultGateway == "" {
+			return fmt.Errorf("Static mgmt config requires the IP address, netmask, and default gateway")
+		}
+	default:
+		return fmt.Errorf("Invalid type: %q", o.Type)
+	}
+
+	if o.PanoramaServer == "" {
+		if o.PanoramaServer2 != "" || o.TemplateStack != "" || o.DeviceGroup != "" || o.VmAuthKey != "" {
+			return fmt.Errorf("Panorama settings given without a Panorama server")
+		}
+	} else if o.VmAuthKey == "" {
+		return fmt.Errorf("A VM auth key is required to register with Panorama")
+	}
+
+	return nil
+}
+
+// Bytes returns the contents of init-cfg.txt.
+func (o InitCfg) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+
+	if _, err := o.WriteTo(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// WriteTo validates and writes the contents of init-cfg.txt to w.
+func (o InitCfg) WriteTo(w io.Writer) (int64, error) {
+	if err := o.Validate(); err != nil {
+		return 0, err
+	}
+
+	var buf bytes.Buffer
+	add := func(key, value string) {
+		if value != "" {
+			fmt.Fprintf(&buf, "%s=%s\n", key, value)
+		}
+	}
+
+	add("type", o.Type)
+	if o.Type == TypeStatic {
+		add("ip-address", o.IpAddress)
+		add("default-gateway", o.DefaultGateway)
+		add("netmask", o.Netmask)
+	}
+	add("ipv6-address", o.Ipv6Address)
+	add("ipv6-default-gateway", o.Ipv6DefaultGateway)
+	add("hostname", o.Hostname)
+	add("vm-auth-key", o.VmAuthKey)
+	add("panorama-server", o.PanoramaServer)
+	add("panorama-server-2", o.PanoramaServer2)
+	add("tplname", o.TemplateStack)
+	add("dgname", o.DeviceGroup)
+	add("dns-primary", o.DnsPrimary)
+	add("dns-secondary", o.DnsSecondary)
+	add("op-command-modes", strings.Join(o.OpCommandModes, ","))
+	if o.Type == TypeDhcpClient {
+		add("dhcp-send-hostname", yesNo(o.DhcpSendHostname))
+		add("dhcp-send-client-id", yesNo(o.DhcpSendClientId))
+		add("dhcp-accept-server-hostname", yesNo(o.DhcpAcceptServerHostname))
+		add("dhcp-accept-server-domain", yesNo(o.DhcpAcceptServerDomain))
+	}
+
+	n, err := w.Write(buf.Bytes())
+	return int64(n), err
+}
+
+func yesNo(v bool) string {
+	if v {
+		return "yes"
+	}
+
+	return "no"
+}
@@ -0,0 +1,172 @@
+package cfgtree
+
+import (
+	"sort"
+)
+
+// Valid values for Difference.Type.
+const (
+	Added    = "added"
+	Removed  = "removed"
+	Modified = "modified"
+	Moved    = "moved"
+)
+
+// OrderedContainers are the tag names of the containers whose entries are
+// evaluated in order, such as the rules of a rulebase.  Changes to the order
+// of the entries of these containers are reported as Moved differences.
+var OrderedContainers = map[string]bool{
+	"rules": true,
+}
+
+// Difference is a single change between two config trees.
+//
+// For added nodes, Before is nil.  For removed nodes, After is nil.  For
+// modified nodes, both Before and After are set; modified nodes are always
+// leaves on at least one side.
+//
+// For moved nodes, both Before and After are set, and Previous is the key of
+// the node that the moved node now follows, or an empty string if it is now
+// first.  A moved node that was also changed is reported separately as such.
+type Difference struct {
+	Path     string
+	Type     string
+	Before   *Node
+	After    *Node
+	Previous string
+}
+
+// Diff returns the differences between the two given trees.
+//
+// Paths are absolute xpaths starting with the key of the root nodes.  If the
+// roots themselves differ, a single Modified difference is returned.
+func Diff(before, after *Node) []Difference {
+	if before.Key() != after.Key() {
+		return []Difference{{
+			Path:   "/" + before.Key(),
+			Type:   Modified,
+			Before: before,
+			After:  after,
+		}}
+	}
+
+	return diff("/"+before.Key(), before, after)
+}
+
+// DiffChildren returns the differences between the children of the two
+// given nodes, where path is the xpath of the given nodes.
+//
+// This is useful when before and after are wrappers around config that was
+// retrieved from a specific xpath.
+func DiffChildren(path string, before, after *Node) []Difference {
+	return diffChildren(path, before, after)
+}
+
+func diff(path string, before, after *Node) []Difference {
+	if before.IsLeaf() || after.IsLeaf() {
+		if before.IsLeaf() && after.IsLeaf() && before.Text == after.Text {
+			return nil
+		}
+		return []Difference{{
+			Path:   path,
+			Type:   Modified,
+			Before: before,
+			After:  after,
+		}}
+	}
+
+	return diffChildren(path, before, after)
+}
+
+func diffChildren(path string, before, after *Node) []Difference {
+	var ans []Difference
+
+	for _, b := range before.Nodes {
+		cp := path + "/" + b.Key()
+		if a := after.Child(b.Key()); a == nil {
+			ans = append(ans, Difference{Path: cp, Type: Removed, Before: b})
+		} else {
+			ans = append(ans, diff(cp, b, a)...)
+		}
+	}
+
+	if OrderedContainers[before.Name()] {
+		ans = append(ans, diffOrder(path, before, after)...)
+	}
+
+	for _, a := range after.Nodes {
+		if before.Child(a.Key()) == nil {
+			ans = append(ans, Difference{
+				Path:  path + "/" + a.Key(),
+				Type:  Added,
+				After: a,
+			})
+		}
+	}
+
+	return ans
+}
+
+// diffOrder returns the nodes present on both sides that changed position
+// relative to each other, in their after order.
+//
+// The nodes that keep their relative order are the longest increasing
+// subsequence of before positions taken in after order, so only the nodes
+// outside of it are reported as moved.
+func diffOrder(path string, before, after *Node) []Difference {
+	pos := make(map[string]int, len(before.Nodes))
+	for i, b := range before.Nodes {
+		pos[b.Key()] = i
+	}
+
+	var common []*Node
+	var idx []int
+	for _, a := range after.Nodes {
+		if i, ok := pos[a.Key()]; ok {
+			common = append(common, a)
+			idx = append(idx, i)
+		}
+	}
+
+	// Patience sorting, tracking predecessors to rebuild the subsequence.
+	var tails []int
+	prev := make([]int, len(idx))
+	for i, v := range idx {
+		j := sort.Search(len(tails), func(k int) bool { return idx[tails[k]] >= v })
+		if j > 0 {
+			prev[i] = tails[j-1]
+		} else {
+			prev[i] = -1
+		}
+		if j == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[j] = i
+		}
+	}
+	kept := make([]bool, len(idx))
+	if len(tails) > 0 {
+		for i := tails[len(tails)-1]; i != -1; i = prev[i] {
+			kept[i] = true
+		}
+	}
+
+	var ans []Difference
+	for i, a := range common {
+		if kept[i] {
+			continue
+		}
+		d := Difference{
+			Path:   path + "/" + a.Key(),
+			Type:   Moved,
+			Before: before.Nodes[idx[i]],
+			After:  a,
+		}
+		if i > 0 {
+			d.Previous = common[i-1].Key()
+		}
+		ans = append(ans, d)
+	}
+
+	return ans
+}
@@ -0,0 +1,18 @@
+/*
+Package cfgtree is a generic, navigable representation of PAN-OS XML config.
+
+Typed namespaces only cover part of what PAN-OS can configure.  The Node struct
+in this package can hold any part of the config, allowing for it to be walked,
+merged, and serialized back into XML for sending to PAN-OS.
+
+The full running or candidate config can be retrieved as a Node using the
+RunningConfig() and CandidateConfig() functions of the client, then addressed
+with Find() / FindAll() using a subset of xpath:
+
+    n, err := fw.RunningConfig()
+    if err != nil {
+        return err
+    }
+    list := n.FindAll("/config/devices/entry/vsys/entry[@name='vsys1']/address/*")
+*/
+package cfgtree
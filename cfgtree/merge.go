@@ -0,0 +1,74 @@
+package cfgtree
+
+import (
+	"fmt"
+)
+
+// Conflict is a leaf value that differs between the two sides of a merge.
+//
+// A node that has a value on one side but children on the other is also a
+// conflict, with the side that has children given as its inner XML.
+type Conflict struct {
+	Path     string
+	Existing string
+	Incoming string
+}
+
+// String returns a human readable representation of this conflict.
+func (o Conflict) String() string {
+	return fmt.Sprintf("%s: %q != %q", o.Path, o.Existing, o.Incoming)
+}
+
+// Merge merges src into this node.
+//
+// Children of src not present in this node are copied over.  Leaf nodes
+// present in both but with different values, as well as nodes with a value on
+// one side and children on the other, are returned as conflicts; if overwrite
+// is true, the node from src is taken, otherwise the existing node is kept.
+func (o *Node) Merge(src *Node, overwrite bool) []Conflict {
+	return o.merge(src, overwrite, "/"+o.Key())
+}
+
+func (o *Node) merge(src *Node, overwrite bool, path string) []Conflict {
+	var ans []Conflict
+
+	if o.IsLeaf() && src.IsLeaf() {
+		if o.Text != src.Text {
+			ans = append(ans, Conflict{
+				Path:     path,
+				Existing: o.Text,
+				Incoming: src.Text,
+			})
+			if overwrite {
+				o.Text = src.Text
+			}
+		}
+		return ans
+	}
+
+	if (o.IsLeaf() && o.Text != "") || (src.IsLeaf() && src.Text != "") {
+		ans = append(ans, Conflict{
+			Path:     path,
+			Existing: o.InnerXml(),
+			Incoming: src.InnerXml(),
+		})
+		if overwrite {
+			n := src.Copy()
+			o.Text = n.Text
+			o.Nodes = n.Nodes
+		}
+		return ans
+	}
+
+	for _, sc := range src.Nodes {
+		dc := o.Child(sc.Key())
+		if dc == nil {
+			o.Nodes = append(o.Nodes, sc.Copy())
+			o.Text = ""
+			continue
+		}
+		ans = append(ans, dc.merge(sc, overwrite, path+"/"+sc.Key())...)
+	}
+
+	return ans
+}
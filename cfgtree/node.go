@@ -0,0 +1,168 @@
+package cfgtree
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// Node is a single XML element of PAN-OS config.
+type Node struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr `xml:",any,attr"`
+	Text    string     `xml:",chardata"`
+	Nodes   []*Node    `xml:",any"`
+}
+
+// Parse parses the given XML into a Node.
+//
+// Whitespace surrounding text is removed, as are the admin / dirtyId / time
+// attributes that PAN-OS adds to candidate config.
+func Parse(b []byte) (*Node, error) {
+	ans := &Node{}
+	if err := xml.Unmarshal(b, ans); err != nil {
+		return nil, err
+	}
+	ans.clean()
+
+	return ans, nil
+}
+
+// ParseInner parses XML that may contain multiple sibling elements (such as
+// the inner XML of a util.RawXml) as the children of a new node named tag.
+func ParseInner(tag string, b []byte) (*Node, error) {
+	var buf bytes.Buffer
+	buf.Grow(len(b) + 2*len(tag) + 5)
+	fmt.Fprintf(&buf, "<%s>", tag)
+	buf.Write(b)
+	fmt.Fprintf(&buf, "</%s>", tag)
+
+	return Parse(buf.Bytes())
+}
+
+// Name returns the tag name of this node.
+func (o *Node) Name() string {
+	return o.XMLName.Local
+}
+
+// Attr returns the value of the given attribute, or an empty string if it
+// is not present.
+func (o *Node) Attr(name string) string {
+	for _, a := range o.Attrs {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+
+	return ""
+}
+
+// Key returns the identity of this node amongst its siblings.
+//
+// For "entry" nodes this includes the name attribute, for "member" nodes this
+// includes the text, and for all others this is just the tag name.
+func (o *Node) Key() string {
+	switch o.XMLName.Local {
+	case "entry":
+		return fmt.Sprintf("entry[@name='%s']", o.Attr("name"))
+	case "member":
+		return fmt.Sprintf("member[text()='%s']", o.Text)
+	}
+
+	return o.XMLName.Local
+}
+
+// Child returns the child node with the given key, or nil.
+func (o *Node) Child(key string) *Node {
+	for _, c := range o.Nodes {
+		if c.Key() == key {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// IsLeaf returns if this node has no children.
+func (o *Node) IsLeaf() bool {
+	return len(o.Nodes) == 0
+}
+
+// Copy returns a deep copy of this node.
+func (o *Node) Copy() *Node {
+	ans := &Node{
+		XMLName: o.XMLName,
+		Text:    o.Text,
+	}
+
+	if len(o.Attrs) > 0 {
+		ans.Attrs = make([]xml.Attr, len(o.Attrs))
+		copy(ans.Attrs, o.Attrs)
+	}
+
+	if len(o.Nodes) > 0 {
+		ans.Nodes = make([]*Node, 0, len(o.Nodes))
+		for _, c := range o.Nodes {
+			ans.Nodes = append(ans.Nodes, c.Copy())
+		}
+	}
+
+	return ans
+}
+
+// Bytes returns this node as XML.
+func (o *Node) Bytes() []byte {
+	b, _ := xml.Marshal(o)
+	return b
+}
+
+// String returns this node as XML.
+func (o *Node) String() string {
+	return string(o.Bytes())
+}
+
+// InnerXml returns the XML of this node's children (or text), without
+// this node's tag.
+func (o *Node) InnerXml() string {
+	if o.IsLeaf() {
+		var buf bytes.Buffer
+		xml.EscapeText(&buf, []byte(o.Text))
+		return buf.String()
+	}
+
+	var buf bytes.Buffer
+	for _, c := range o.Nodes {
+		buf.Write(c.Bytes())
+	}
+
+	return buf.String()
+}
+
+/** Internal functions. **/
+
+func (o *Node) clean() {
+	if len(o.Attrs) > 0 {
+		attrs := make([]xml.Attr, 0, len(o.Attrs))
+		for _, a := range o.Attrs {
+			switch a.Name.Local {
+			case "admin", "dirtyId", "time":
+			default:
+				attrs = append(attrs, a)
+			}
+		}
+		if len(attrs) == 0 {
+			attrs = nil
+		}
+		o.Attrs = attrs
+	}
+
+	if len(o.Nodes) > 0 {
+		o.Text = ""
+		for _, c := range o.Nodes {
+			c.clean()
+		}
+	} else {
+		o.Text = strings.TrimSpace(o.Text)
+	}
+}
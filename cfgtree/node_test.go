@@ -0,0 +1,132 @@
+package cfgtree
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseCleansMetadata(t *testing.T) {
+	n, err := Parse([]byte(`<entry name="a" admin="me" dirtyId="3" time="2019/01/01 00:00:00">
+    <ip-netmask>10.1.1.1</ip-netmask>
+</entry>`))
+	if err != nil {
+		t.Fatalf("Parse failed: %s", err)
+	}
+
+	want := `<entry name="a"><ip-netmask>10.1.1.1</ip-netmask></entry>`
+	if got := n.String(); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestKeys(t *testing.T) {
+	n, err := ParseInner("x", []byte(`<entry name="a"/><member>b</member><desc>c</desc>`))
+	if err != nil {
+		t.Fatalf("Parse failed: %s", err)
+	}
+
+	for _, key := range []string{"entry[@name='a']", "member[text()='b']", "desc"} {
+		if n.Child(key) == nil {
+			t.Errorf("Child %q not found", key)
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	dst, _ := ParseInner("config", []byte(`<a><b>1</b><c>2</c></a><entry name="x"><v>1</v></entry>`))
+	src, _ := ParseInner("config", []byte(`<a><b>9</b><d>3</d></a><entry name="y"><v>2</v></entry>`))
+
+	conflicts := dst.Merge(src, false)
+	if len(conflicts) != 1 {
+		t.Fatalf("Expected 1 conflict, got %#v", conflicts)
+	} else if conflicts[0].Path != "/config/a/b" {
+		t.Errorf("Bad conflict path: %s", conflicts[0].Path)
+	}
+
+	want := `<config><a><b>1</b><c>2</c><d>3</d></a><entry name="x"><v>1</v></entry><entry name="y"><v>2</v></entry></config>`
+	if got := dst.String(); got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	dst.Merge(src, true)
+	if dst.Child("a").Child("b").Text != "9" {
+		t.Errorf("Overwrite did not take the incoming value")
+	}
+}
+
+func TestMergeShapeMismatch(t *testing.T) {
+	dst, _ := ParseInner("config", []byte(`<a>text</a><b><c>1</c></b><e/>`))
+	src, _ := ParseInner("config", []byte(`<a><c>1</c></a><b>text</b><e><f>1</f></e>`))
+
+	conflicts := dst.Merge(src, false)
+	want := []Conflict{
+		{"/config/a", "text", "<c>1</c>"},
+		{"/config/b", "<c>1</c>", "text"},
+	}
+	if !reflect.DeepEqual(conflicts, want) {
+		t.Fatalf("Expected %#v, got %#v", want, conflicts)
+	}
+	if got := dst.String(); got != `<config><a>text</a><b><c>1</c></b><e><f>1</f></e></config>` {
+		t.Errorf("Bad merge without overwrite: %s", got)
+	}
+
+	dst.Merge(src, true)
+	if got := dst.String(); got != `<config><a><c>1</c></a><b>text</b><e><f>1</f></e></config>` {
+		t.Errorf("Bad merge with overwrite: %s", got)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	before, _ := ParseInner("config", []byte(`<a><b>1</b><c>2</c></a><m><member>x</member></m>`))
+	after, _ := ParseInner("config", []byte(`<a><b>9</b></a><m><member>x</member><member>y</member></m>`))
+
+	want := map[string]string{
+		"/config/a/b":                  Modified,
+		"/config/a/c":                  Removed,
+		"/config/m/member[text()='y']": Added,
+	}
+
+	diffs := Diff(before, after)
+	if len(diffs) != len(want) {
+		t.Fatalf("Expected %d diffs, got %#v", len(want), diffs)
+	}
+	for _, d := range diffs {
+		if want[d.Path] != d.Type {
+			t.Errorf("Unexpected diff %s %s", d.Type, d.Path)
+		}
+	}
+
+	if diffs = Diff(before, before.Copy()); len(diffs) != 0 {
+		t.Errorf("Copy differs: %#v", diffs)
+	}
+}
+
+func TestDiffOrder(t *testing.T) {
+	before, _ := ParseInner("security", []byte(`<rules><entry name="a"/><entry name="b"/><entry name="c"/><entry name="d"><action>deny</action></entry></rules><x><member>1</member><member>2</member></x>`))
+	after, _ := ParseInner("security", []byte(`<rules><entry name="d"><action>allow</action></entry><entry name="a"/><entry name="c"/><entry name="b"/></rules><x><member>2</member><member>1</member></x>`))
+
+	diffs := Diff(before, after)
+	want := []struct {
+		path, typ, prev string
+	}{
+		{"/security/rules/entry[@name='d']/action", Modified, ""},
+		{"/security/rules/entry[@name='d']", Moved, ""},
+		{"/security/rules/entry[@name='c']", Moved, "entry[@name='a']"},
+	}
+	if len(diffs) != len(want) {
+		t.Fatalf("Expected %d diffs, got %#v", len(want), diffs)
+	}
+	for i, w := range want {
+		if d := diffs[i]; d.Path != w.path || d.Type != w.typ || d.Previous != w.prev {
+			t.Errorf("Diff %d: got %s %s after %q", i, d.Type, d.Path, d.Previous)
+		}
+	}
+
+	expected := `set security rules d action allow
+move security rules d top
+move security rules c after a
+`
+	if s := SetCommands(diffs); s != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, s)
+	}
+}
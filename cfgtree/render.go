@@ -0,0 +1,213 @@
+package cfgtree
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// Valid values for the format param of Render.
+const (
+	FormatSetCommands = "set"
+	FormatUnifiedDiff = "diff"
+	FormatSummary     = "summary"
+)
+
+// Render renders the given differences as text in the given format, suitable
+// for posting into a change ticket.
+func Render(diffs []Difference, format string) (string, error) {
+	switch format {
+	case FormatSetCommands:
+		return SetCommands(diffs), nil
+	case FormatUnifiedDiff:
+		return UnifiedDiff(diffs), nil
+	case FormatSummary:
+		return Summarize(diffs).String(), nil
+	}
+
+	return "", fmt.Errorf("Unknown render format: %q", format)
+}
+
+// SetCommands renders the given differences as the PAN-OS CLI set, delete,
+// and move commands that turn the before config into the after config.
+//
+// The leading "/config" and "devices localhost.localdomain" portions of the
+// xpath are not part of CLI commands, so they are omitted.
+func SetCommands(diffs []Difference) string {
+	var buf bytes.Buffer
+
+	for _, d := range diffs {
+		words := cliWords(d.Path)
+		switch d.Type {
+		case Removed:
+			writeCommand(&buf, "delete", words)
+		case Added:
+			writeSetCommands(&buf, words, d.After)
+		case Modified:
+			if !d.After.IsLeaf() || !d.Before.IsLeaf() {
+				writeCommand(&buf, "delete", words)
+			}
+			writeSetCommands(&buf, words, d.After)
+		case Moved:
+			if d.Previous == "" {
+				writeCommand(&buf, "move", append(words, "top"))
+			} else {
+				writeCommand(&buf, "move", append(append(words, "after"), cliWords(d.Previous)...))
+			}
+		}
+	}
+
+	return buf.String()
+}
+
+// UnifiedDiff renders the given differences as unified diff text, with one
+// hunk per difference.  The xpath of each difference is given as the section
+// heading of its hunk.
+//
+// The before and after files that the hunk ranges refer to are the rendered
+// XML of each difference's Before and After nodes respectively, one after
+// the other, as the diff has no unchanged context lines.
+func UnifiedDiff(diffs []Difference) string {
+	var buf bytes.Buffer
+	var bn, an int
+
+	buf.WriteString("--- before\n+++ after\n")
+	for _, d := range diffs {
+		bl := xmlLines(d.Before)
+		al := xmlLines(d.After)
+		fmt.Fprintf(&buf, "@@ -%s +%s @@ %s\n", hunkRange(bn, len(bl)), hunkRange(an, len(al)), d.Path)
+		writeLines(&buf, "-", bl)
+		writeLines(&buf, "+", al)
+		bn += len(bl)
+		an += len(al)
+	}
+
+	return buf.String()
+}
+
+/** Internal functions. **/
+
+// cliWords converts an xpath into the words of a CLI command.
+func cliWords(path string) []string {
+	var ans []string
+
+	for _, v := range splitXpath(strings.TrimPrefix(path, "/")) {
+		s, err := parseStep(v)
+		if err != nil {
+			ans = append(ans, v)
+			continue
+		}
+		switch {
+		case s.text:
+			ans = append(ans, s.value)
+		case s.pred:
+			ans = append(ans, s.value)
+		default:
+			ans = append(ans, s.tag)
+		}
+	}
+
+	if len(ans) > 0 && ans[0] == "config" {
+		ans = ans[1:]
+	}
+	if len(ans) > 1 && ans[0] == "devices" && ans[1] == "localhost.localdomain" {
+		ans = ans[2:]
+	}
+
+	return ans
+}
+
+func writeSetCommands(buf *bytes.Buffer, words []string, n *Node) {
+	switch {
+	case n.IsLeaf():
+		if n.Name() == "member" || n.Name() == "entry" || n.Text == "" {
+			writeCommand(buf, "set", words)
+		} else {
+			writeCommand(buf, "set", append(words, n.Text))
+		}
+		return
+	case allMembers(n):
+		list := make([]string, 0, len(n.Nodes)+2)
+		if len(n.Nodes) > 1 {
+			list = append(list, "[")
+		}
+		for _, c := range n.Nodes {
+			list = append(list, c.Text)
+		}
+		if len(n.Nodes) > 1 {
+			list = append(list, "]")
+		}
+		writeCommand(buf, "set", append(words, list...))
+		return
+	}
+
+	for _, c := range n.Nodes {
+		cw := make([]string, len(words), len(words)+1)
+		copy(cw, words)
+		switch c.Name() {
+		case "entry":
+			cw = append(cw, c.Attr("name"))
+		case "member":
+			cw = append(cw, c.Text)
+		default:
+			cw = append(cw, c.Name())
+		}
+		writeSetCommands(buf, cw, c)
+	}
+}
+
+func allMembers(n *Node) bool {
+	for _, c := range n.Nodes {
+		if c.Name() != "member" || !c.IsLeaf() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func writeCommand(buf *bytes.Buffer, cmd string, words []string) {
+	buf.WriteString(cmd)
+	for _, w := range words {
+		buf.WriteString(" ")
+		if w == "" || strings.ContainsAny(w, " \t\"") {
+			buf.WriteString(fmt.Sprintf("%q", w))
+		} else {
+			buf.WriteString(w)
+		}
+	}
+	buf.WriteString("\n")
+}
+
+// xmlLines returns the indented XML of the given node as lines.
+func xmlLines(n *Node) []string {
+	if n == nil {
+		return nil
+	}
+
+	b, err := xml.MarshalIndent(n, "", "  ")
+	if err != nil {
+		return nil
+	}
+
+	return strings.Split(string(b), "\n")
+}
+
+// hunkRange returns the range of a unified diff hunk that has count lines
+// following the first prev lines of the file.
+func hunkRange(prev, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", prev)
+	}
+
+	return fmt.Sprintf("%d,%d", prev+1, count)
+}
+
+func writeLines(buf *bytes.Buffer, prefix string, lines []string) {
+	for _, line := range lines {
+		buf.WriteString(prefix)
+		buf.WriteString(line)
+		buf.WriteString("\n")
+	}
+}
@@ -0,0 +1,63 @@
+package cfgtree
+
+import (
+	"testing"
+)
+
+func renderDiffs(t *testing.T) []Difference {
+	before, err := Parse([]byte(`<config><devices><entry name="localhost.localdomain"><vsys><entry name="vsys1"><address><entry name="a"><ip-netmask>10.1.1.1</ip-netmask></entry><entry name="b"><fqdn>b.example.com</fqdn></entry></address></entry></vsys></entry></devices></config>`))
+	if err != nil {
+		t.Fatalf("Failed to parse before: %s", err)
+	}
+	after, err := Parse([]byte(`<config><devices><entry name="localhost.localdomain"><vsys><entry name="vsys1"><address><entry name="a"><ip-netmask>10.1.1.2</ip-netmask></entry><entry name="c"><ip-netmask>10.3.3.3</ip-netmask><description>new host</description><tag><member>one</member><member>two</member></tag></entry></address></entry></vsys></entry></devices></config>`))
+	if err != nil {
+		t.Fatalf("Failed to parse after: %s", err)
+	}
+
+	return Diff(before, after)
+}
+
+func TestSetCommands(t *testing.T) {
+	expected := `set vsys vsys1 address a ip-netmask 10.1.1.2
+delete vsys vsys1 address b
+set vsys vsys1 address c ip-netmask 10.3.3.3
+set vsys vsys1 address c description "new host"
+set vsys vsys1 address c tag [ one two ]
+`
+
+	if s := SetCommands(renderDiffs(t)); s != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, s)
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	expected := `--- before
++++ after
+@@ -1,1 +1,1 @@ /config/devices/entry[@name='localhost.localdomain']/vsys/entry[@name='vsys1']/address/entry[@name='a']/ip-netmask
+-<ip-netmask>10.1.1.1</ip-netmask>
++<ip-netmask>10.1.1.2</ip-netmask>
+@@ -2,3 +1,0 @@ /config/devices/entry[@name='localhost.localdomain']/vsys/entry[@name='vsys1']/address/entry[@name='b']
+-<entry name="b">
+-  <fqdn>b.example.com</fqdn>
+-</entry>
+@@ -4,0 +2,8 @@ /config/devices/entry[@name='localhost.localdomain']/vsys/entry[@name='vsys1']/address/entry[@name='c']
++<entry name="c">
++  <ip-netmask>10.3.3.3</ip-netmask>
++  <description>new host</description>
++  <tag>
++    <member>one</member>
++    <member>two</member>
++  </tag>
++</entry>
+`
+
+	if s := UnifiedDiff(renderDiffs(t)); s != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, s)
+	}
+}
+
+func TestRenderUnknownFormat(t *testing.T) {
+	if _, err := Render(nil, "html"); err == nil {
+		t.Errorf("No error for unknown format")
+	}
+}
@@ -0,0 +1,177 @@
+package cfgtree
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Valid values for ObjectChange.Type.
+const (
+	Created = "created"
+	Updated = "updated"
+	Deleted = "deleted"
+)
+
+// ObjectChange is a change to a single config object, such as an address
+// object or a security rule.
+//
+// Scope is where the object lives, such as "shared", "vsys vsys1", or
+// "template t1, vsys vsys1".  Kind is the object's location within the scope,
+// such as "address" or "rulebase security rules".  Name is empty for config
+// that is not an entry (such as "deviceconfig").
+type ObjectChange struct {
+	Scope string
+	Kind  string
+	Name  string
+	Type  string
+}
+
+// Summary is a human readable summary of a set of differences, meant for
+// approval before the changes are applied.
+type Summary struct {
+	Changes []ObjectChange
+}
+
+// Summarize collapses the given differences into one change per object.
+//
+// An object with multiple differences within it is reported once as updated.
+func Summarize(diffs []Difference) Summary {
+	var ans Summary
+	idx := make(map[string]int)
+
+	for _, d := range diffs {
+		oc, whole := objectChange(d.Path)
+		switch {
+		case !whole:
+			oc.Type = Updated
+		case d.Type == Added:
+			oc.Type = Created
+		case d.Type == Removed:
+			oc.Type = Deleted
+		default:
+			oc.Type = Updated
+		}
+
+		key := oc.Scope + "\x00" + oc.Kind + "\x00" + oc.Name
+		if i, ok := idx[key]; ok {
+			if ans.Changes[i].Type != oc.Type {
+				ans.Changes[i].Type = Updated
+			}
+			continue
+		}
+		idx[key] = len(ans.Changes)
+		ans.Changes = append(ans.Changes, oc)
+	}
+
+	return ans
+}
+
+// String renders the summary, grouped by scope:
+//
+//	vsys vsys1: 1 created, 1 updated, 0 deleted
+//	  + address "c"
+//	  ~ rulebase security rules "allow-web"
+func (o Summary) String() string {
+	var buf bytes.Buffer
+	var scopes []string
+	byScope := make(map[string][]ObjectChange)
+
+	for _, c := range o.Changes {
+		if _, ok := byScope[c.Scope]; !ok {
+			scopes = append(scopes, c.Scope)
+		}
+		byScope[c.Scope] = append(byScope[c.Scope], c)
+	}
+
+	for _, s := range scopes {
+		counts := make(map[string]int)
+		for _, c := range byScope[s] {
+			counts[c.Type]++
+		}
+		fmt.Fprintf(&buf, "%s: %d created, %d updated, %d deleted\n", s, counts[Created], counts[Updated], counts[Deleted])
+
+		for _, c := range byScope[s] {
+			var sym string
+			switch c.Type {
+			case Created:
+				sym = "+"
+			case Deleted:
+				sym = "-"
+			default:
+				sym = "~"
+			}
+			if c.Name == "" {
+				fmt.Fprintf(&buf, "  %s %s\n", sym, c.Kind)
+			} else {
+				fmt.Fprintf(&buf, "  %s %s %q\n", sym, c.Kind, c.Name)
+			}
+		}
+	}
+
+	return buf.String()
+}
+
+/** Internal functions. **/
+
+// objectChange returns the object that the given xpath is within, and if the
+// xpath is the object itself.
+func objectChange(path string) (ObjectChange, bool) {
+	var steps []step
+	for _, v := range splitXpath(strings.TrimPrefix(path, "/")) {
+		s, err := parseStep(v)
+		if err != nil {
+			s = step{tag: v}
+		}
+		steps = append(steps, s)
+	}
+
+	var scope []string
+	i := 0
+	if i < len(steps) && steps[i].tag == "config" {
+		i++
+	}
+
+loop:
+	for i < len(steps) {
+		tag := steps[i].tag
+		named := i+1 < len(steps) && steps[i+1].pred
+		switch {
+		case tag == "shared":
+			scope = append(scope, "shared")
+			i++
+		case tag == "devices" && named:
+			i += 2
+		case named && (tag == "vsys" || tag == "device-group" || tag == "template" || tag == "template-stack"):
+			scope = append(scope, tag+" "+steps[i+1].value)
+			i += 2
+			if i < len(steps) && steps[i].tag == "config" {
+				i++
+			}
+		default:
+			break loop
+		}
+	}
+
+	ans := ObjectChange{Scope: strings.Join(scope, ", ")}
+	if ans.Scope == "" {
+		ans.Scope = "device"
+	}
+
+	var kind []string
+	for j := i; j < len(steps); j++ {
+		if steps[j].pred {
+			ans.Kind = strings.Join(kind, " ")
+			ans.Name = steps[j].value
+			return ans, j == len(steps)-1
+		}
+		kind = append(kind, steps[j].tag)
+	}
+
+	// No entry, so the object is the first step after the scope.
+	if i < len(steps) {
+		ans.Kind = steps[i].tag
+	}
+
+	return ans, i >= len(steps)-1
+}
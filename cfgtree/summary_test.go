@@ -0,0 +1,52 @@
+package cfgtree
+
+import (
+	"testing"
+)
+
+func TestSummarize(t *testing.T) {
+	prefix := "/config/devices/entry[@name='localhost.localdomain']"
+	diffs := []Difference{
+		{Path: prefix + "/vsys/entry[@name='vsys1']/address/entry[@name='c']", Type: Added},
+		{Path: prefix + "/vsys/entry[@name='vsys1']/address/entry[@name='a']/ip-netmask", Type: Modified},
+		{Path: prefix + "/vsys/entry[@name='vsys1']/address/entry[@name='a']/description", Type: Added},
+		{Path: prefix + "/vsys/entry[@name='vsys1']/rulebase/security/rules/entry[@name='old']", Type: Removed},
+		{Path: "/config/shared/tag/entry[@name='prod']", Type: Added},
+		{Path: prefix + "/template/entry[@name='t1']/config/devices/entry[@name='localhost.localdomain']/vsys/entry[@name='vsys1']/zone/entry[@name='trust']/network", Type: Modified},
+		{Path: prefix + "/deviceconfig/system/hostname", Type: Modified},
+	}
+
+	sum := Summarize(diffs)
+	expected := []ObjectChange{
+		{"vsys vsys1", "address", "c", Created},
+		{"vsys vsys1", "address", "a", Updated},
+		{"vsys vsys1", "rulebase security rules", "old", Deleted},
+		{"shared", "tag", "prod", Created},
+		{"template t1, vsys vsys1", "zone", "trust", Updated},
+		{"device", "deviceconfig", "", Updated},
+	}
+
+	if len(sum.Changes) != len(expected) {
+		t.Fatalf("Expected %d changes, got %#v", len(expected), sum.Changes)
+	}
+	for i := range expected {
+		if sum.Changes[i] != expected[i] {
+			t.Errorf("Change %d: expected %#v, got %#v", i, expected[i], sum.Changes[i])
+		}
+	}
+
+	text := `vsys vsys1: 1 created, 1 updated, 1 deleted
+  + address "c"
+  ~ address "a"
+  - rulebase security rules "old"
+shared: 1 created, 0 updated, 0 deleted
+  + tag "prod"
+template t1, vsys vsys1: 0 created, 1 updated, 0 deleted
+  ~ zone "trust"
+device: 0 created, 1 updated, 0 deleted
+  ~ deviceconfig
+`
+	if s := sum.String(); s != text {
+		t.Errorf("Expected:\n%s\nGot:\n%s", text, s)
+	}
+}
@@ -0,0 +1,247 @@
+package cfgtree
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Match is a node found by Search, along with its xpath.
+type Match struct {
+	Path string
+	Node *Node
+}
+
+// WalkFunc is invoked for each node visited by Walk.
+//
+// Returning a non-nil error stops the walk, and that error is returned from
+// Walk.
+type WalkFunc func(path string, n *Node) error
+
+// Find returns the first node matching the given xpath, or nil.
+//
+// An absolute xpath (one starting with "/") starts at this node, so the first
+// step must match this node.  A relative xpath starts at this node's children.
+//
+// Steps may be a tag name, "*", or a tag name with a predicate of either the
+// form [@attr='value'] or [text()='value'].  A predicate may match multiple
+// values of the same attribute or text, such as [@name='a' or @name='b'].
+func (o *Node) Find(xpath string) *Node {
+	list := o.FindAll(xpath)
+	if len(list) == 0 {
+		return nil
+	}
+
+	return list[0]
+}
+
+// FindAll returns all nodes matching the given xpath.
+//
+// Refer to Find for the supported xpath syntax.
+func (o *Node) FindAll(xpath string) []*Node {
+	steps, err := parseXpath(xpath)
+	if err != nil || len(steps) == 0 {
+		return nil
+	}
+
+	if strings.HasPrefix(xpath, "/") {
+		if !steps[0].matches(o) {
+			return nil
+		}
+		steps = steps[1:]
+	}
+
+	cur := []*Node{o}
+
+	for _, s := range steps {
+		var next []*Node
+		for _, n := range cur {
+			for _, c := range n.Nodes {
+				if s.matches(c) {
+					next = append(next, c)
+				}
+			}
+		}
+		if len(next) == 0 {
+			return nil
+		}
+		cur = next
+	}
+
+	return cur
+}
+
+// Walk visits this node and all of its descendants depth first, invoking fn
+// with each node's absolute xpath.
+func (o *Node) Walk(fn WalkFunc) error {
+	return o.walk("/"+o.Key(), fn)
+}
+
+// Search returns all nodes (including this one) for which fn returns true.
+func (o *Node) Search(fn func(n *Node) bool) []Match {
+	var ans []Match
+
+	_ = o.Walk(func(path string, n *Node) error {
+		if fn(n) {
+			ans = append(ans, Match{Path: path, Node: n})
+		}
+		return nil
+	})
+
+	return ans
+}
+
+// SearchName returns all entries whose name attribute is the given name.
+func (o *Node) SearchName(name string) []Match {
+	return o.Search(func(n *Node) bool {
+		return n.Name() == "entry" && n.Attr("name") == name
+	})
+}
+
+// SearchText returns all leaf nodes whose text is the given value.
+func (o *Node) SearchText(value string) []Match {
+	return o.Search(func(n *Node) bool {
+		return n.IsLeaf() && n.Text == value
+	})
+}
+
+/** Internal functions. **/
+
+func (o *Node) walk(path string, fn WalkFunc) error {
+	if err := fn(path, o); err != nil {
+		return err
+	}
+
+	for _, c := range o.Nodes {
+		if err := c.walk(path+"/"+c.Key(), fn); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type step struct {
+	tag   string
+	pred  bool
+	text  bool
+	attr  string
+	value string
+	alts  []string
+}
+
+func (s step) matches(n *Node) bool {
+	if s.tag != "*" && s.tag != n.Name() {
+		return false
+	}
+
+	if !s.pred {
+		return true
+	}
+
+	v := n.Text
+	if !s.text {
+		v = n.Attr(s.attr)
+	}
+	if v == s.value {
+		return true
+	}
+	for _, x := range s.alts {
+		if v == x {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseXpath(xpath string) ([]step, error) {
+	var ans []step
+
+	for _, v := range splitXpath(strings.TrimPrefix(xpath, "/")) {
+		s, err := parseStep(v)
+		if err != nil {
+			return nil, err
+		}
+		ans = append(ans, s)
+	}
+
+	return ans, nil
+}
+
+// splitXpath splits on "/", ignoring any slashes inside of quotes.
+func splitXpath(xpath string) []string {
+	var ans []string
+	var quote rune
+	start := 0
+
+	for i, r := range xpath {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == '/':
+			ans = append(ans, xpath[start:i])
+			start = i + 1
+		}
+	}
+	ans = append(ans, xpath[start:])
+
+	return ans
+}
+
+func parseStep(v string) (step, error) {
+	idx := strings.Index(v, "[")
+	if idx == -1 {
+		if v == "" {
+			return step{}, fmt.Errorf("Empty xpath step")
+		}
+		return step{tag: v}, nil
+	}
+
+	if !strings.HasSuffix(v, "]") {
+		return step{}, fmt.Errorf("Malformed xpath step: %s", v)
+	}
+
+	ans := step{tag: v[:idx], pred: true}
+	pred := v[idx+1 : len(v)-1]
+
+	for i, part := range strings.Split(pred, " or ") {
+		eq := strings.Index(part, "=")
+		if eq == -1 {
+			return step{}, fmt.Errorf("Unsupported xpath predicate: %s", pred)
+		}
+
+		lhs := strings.TrimSpace(part[:eq])
+		rhs := strings.TrimSpace(part[eq+1:])
+		if len(rhs) < 2 || (rhs[0] != '\'' && rhs[0] != '"') || rhs[len(rhs)-1] != rhs[0] {
+			return step{}, fmt.Errorf("Unquoted xpath predicate value: %s", pred)
+		}
+		if i == 0 {
+			ans.value = rhs[1 : len(rhs)-1]
+		} else {
+			ans.alts = append(ans.alts, rhs[1:len(rhs)-1])
+		}
+
+		var text bool
+		var attr string
+		switch {
+		case lhs == "text()":
+			text = true
+		case strings.HasPrefix(lhs, "@"):
+			attr = lhs[1:]
+		default:
+			return step{}, fmt.Errorf("Unsupported xpath predicate: %s", pred)
+		}
+
+		if i == 0 {
+			ans.text, ans.attr = text, attr
+		} else if text != ans.text || attr != ans.attr {
+			return step{}, fmt.Errorf("Mixed xpath predicate: %s", pred)
+		}
+	}
+
+	return ans, nil
+}
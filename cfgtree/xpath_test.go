@@ -0,0 +1,62 @@
+package cfgtree
+
+import (
+	"testing"
+)
+
+const xpathConfig = `<config><devices><entry name="localhost.localdomain"><vsys><entry name="vsys1"><address><entry name="a/1"><ip-netmask>10.1.1.1</ip-netmask></entry><entry name="b"><fqdn>example.com</fqdn></entry></address><zone><entry name="L3-trust"><network><layer3><member>ethernet1/1</member></layer3></network></entry></zone></entry></vsys></entry></devices></config>`
+
+func TestFind(t *testing.T) {
+	n, err := Parse([]byte(xpathConfig))
+	if err != nil {
+		t.Fatalf("Parse failed: %s", err)
+	}
+
+	vsys := "/config/devices/entry[@name='localhost.localdomain']/vsys/entry[@name='vsys1']"
+	if v := n.Find(vsys + "/address/entry[@name='a/1']/ip-netmask"); v == nil || v.Text != "10.1.1.1" {
+		t.Errorf("Absolute find failed: %v", v)
+	}
+
+	if v := n.Find(vsys + "/zone/entry/network/layer3/member[text()='ethernet1/1']"); v == nil {
+		t.Errorf("Member find failed")
+	}
+
+	if v := n.Find("devices/entry/vsys/entry/address/entry[@name='b']/fqdn"); v == nil || v.Text != "example.com" {
+		t.Errorf("Relative find failed: %v", v)
+	}
+
+	if list := n.FindAll(vsys + "/address/*"); len(list) != 2 {
+		t.Errorf("Expected 2 addresses, got %d", len(list))
+	}
+
+	if list := n.FindAll(vsys + "/address/entry[@name='a/1' or @name='b' or @name='c']"); len(list) != 2 {
+		t.Errorf("Expected 2 addresses from or predicate, got %d", len(list))
+	}
+
+	if v := n.Find(vsys + "/address/entry[@name='c']"); v != nil {
+		t.Errorf("Found nonexistent entry: %s", v)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	n, err := Parse([]byte(xpathConfig))
+	if err != nil {
+		t.Fatalf("Parse failed: %s", err)
+	}
+
+	list := n.SearchName("b")
+	if len(list) != 1 {
+		t.Fatalf("Expected 1 match, got %#v", list)
+	}
+
+	path := "/config/devices/entry[@name='localhost.localdomain']/vsys/entry[@name='vsys1']/address/entry[@name='b']"
+	if list[0].Path != path {
+		t.Errorf("Path is %q, not %q", list[0].Path, path)
+	} else if n.Find(list[0].Path) != list[0].Node {
+		t.Errorf("Find of search result path returned a different node")
+	}
+
+	if list = n.SearchText("ethernet1/1"); len(list) != 1 {
+		t.Errorf("Expected 1 text match, got %#v", list)
+	}
+}
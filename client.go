@@ -13,10 +13,13 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/PaloAltoNetworks/pango/cfgtree"
+	"github.com/PaloAltoNetworks/pango/jobs"
 	"github.com/PaloAltoNetworks/pango/util"
 	"github.com/PaloAltoNetworks/pango/version"
 )
@@ -63,6 +66,11 @@ type Client struct {
 	Timeout  int    `json:"timeout"`
 	Target   string `json:"target"`
 
+	// The hostname of the HA peer.  If set, config changes, commits, and op
+	// commands are sent to whichever of Hostname / HaPeer is the active HA
+	// member.
+	HaPeer string `json:"ha_peer"`
+
 	// Set to true if you want to check environment variables
 	// for auth and connection properties.
 	CheckEnvironment bool `json:"-"`
@@ -72,9 +80,19 @@ type Client struct {
 	VerifyCertificate bool            `json:"verify_certificate"`
 	Transport         *http.Transport `json:"-"`
 
+	// Response limits, protecting against enormous or deeply nested
+	// responses.  A value of zero means no limit.
+	MaxResponseSize  int64 `json:"-"`
+	MaxResponseDepth int   `json:"-"`
+
+	// If set, this function is invoked with the parsed response envelope of
+	// every API call made, whether or not the call succeeded.
+	OnResponse func(Response) `json:"-"`
+
 	// Variables determined at runtime.
 	Version        version.Number      `json:"-"`
 	SystemInfo     map[string]string   `json:"-"`
+	Info           SystemInfo          `json:"-"`
 	Plugin         []map[string]string `json:"-"`
 	MultiConfigure *MultiConfigure     `json:"-"`
 
@@ -83,9 +101,11 @@ type Client struct {
 	LoggingFromInitialize []string `json:"logging"`
 
 	// Internal variables.
-	credsFile string
-	con       *http.Client
-	api_url   string
+	credsFile  string
+	con        *http.Client
+	api_url    string
+	active_url string
+	scope      func(string, string) error
 
 	// Variables for testing, response bytes and response index.
 	rp              []url.Values
@@ -131,7 +151,7 @@ func (c *Client) Plugins() []map[string]string {
 // Initialize does some initial setup of the Client connection, retrieves
 // the API key if it was not already present, then performs "show system
 // info" to get the PAN-OS version.  The full results are saved into the
-// client's SystemInfo map.
+// client's SystemInfo map, and the typed form into the client's Info.
 //
 // If not specified, the following is assumed:
 //  * Protocol: https
@@ -322,6 +342,19 @@ func (c *Client) RevertToRunningConfig() error {
 	return err
 }
 
+// RunningConfig retrieves the entire running config as a navigable tree.
+//
+// This is useful for analyzing parts of the config that do not yet have a
+// typed namespace.
+func (c *Client) RunningConfig() (*cfgtree.Node, error) {
+	return c.retrieveConfig("running")
+}
+
+// CandidateConfig retrieves the entire candidate config as a navigable tree.
+func (c *Client) CandidateConfig() (*cfgtree.Node, error) {
+	return c.retrieveConfig("candidate")
+}
+
 // ConfigLocks returns any config locks that are currently in place.
 //
 // If vsys is an empty string, then the vsys will default to "shared".
@@ -435,6 +468,58 @@ func (c *Client) UnlockCommits(vsys, admin string) error {
 	return err
 }
 
+// CommitJobs returns all commit jobs that have not yet finished, both those
+// in progress and those still waiting in the commit queue.
+func (c *Client) CommitJobs() ([]util.Job, error) {
+	c.LogOp("(op) getting unfinished commit jobs")
+	list, err := c.showJobs("<show><jobs><all /></jobs></show>")
+	if err != nil {
+		return nil, err
+	}
+
+	var ans []util.Job
+	for _, j := range list {
+		if j.IsCommit() && !j.IsFinished() {
+			ans = append(ans, j)
+		}
+	}
+
+	return ans, nil
+}
+
+// CommitQueue returns the commit jobs waiting in the commit queue, in the order
+// that they will be processed.
+func (c *Client) CommitQueue() ([]util.Job, error) {
+	c.LogOp("(op) getting the commit queue")
+	list, err := c.showJobs("<show><jobs><pending /></jobs></show>")
+	if err != nil {
+		return nil, err
+	}
+
+	var ans []util.Job
+	for _, j := range list {
+		if j.IsCommit() {
+			ans = append(ans, j)
+		}
+	}
+	sort.SliceStable(ans, func(i, j int) bool {
+		return ans[i].PositionInQueue < ans[j].PositionInQueue
+	})
+
+	return ans, nil
+}
+
+// CancelJob cancels the given job, whether it is queued or already running.
+//
+// Not every job can be stopped once it is running; refer to the Stoppable
+// field of the job to know ahead of time.
+func (c *Client) CancelJob(id uint) error {
+	ns := &jobs.Jobs{}
+	ns.Initialize(c)
+
+	return ns.Cancel(id)
+}
+
 // WaitForJob polls the device, waiting for the specified job to finish.
 //
 // The sleep param is the length of time to wait between polling for job
@@ -657,7 +742,7 @@ func (c *Client) CommunicateFile(content, filename, fp string, data url.Values,
 	}
 
 	defer res.Body.Close()
-	body, err := ioutil.ReadAll(res.Body)
+	body, err := c.readBody(res.Body)
 	if err != nil {
 		return nil, err
 	}
@@ -846,6 +931,80 @@ func (c *Client) Rename(path interface{}, newname string, extras, ans interface{
 	return c.typeConfig("rename", data, nil, extras, ans)
 }
 
+// GetXpath performs a GET at the given xpath, unmarshaling the candidate config
+// found there into ans.
+//
+// This is for working with PAN-OS features that do not yet have a namespace.
+// The ans param should be a pointer to a struct representing the XML element
+// found at the xpath (the response / result packaging has already been
+// removed).  If the xpath matches multiple elements, only the first is
+// unmarshaled.
+//
+// The path param should be either a string or a slice of strings.
+func (c *Client) GetXpath(path, ans interface{}) error {
+	c.LogQuery("(get) xpath %s", util.AsXpath(path))
+	b, err := c.Get(path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return unpackageResult(b, ans)
+}
+
+// ShowXpath performs a SHOW at the given xpath, unmarshaling the running
+// config found there into ans.
+//
+// Refer to GetXpath for information on the params.
+func (c *Client) ShowXpath(path, ans interface{}) error {
+	c.LogQuery("(show) xpath %s", util.AsXpath(path))
+	b, err := c.Show(path, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	return unpackageResult(b, ans)
+}
+
+// SetXpath performs a SET, creating / merging the element at the given
+// xpath.
+//
+// The path param is the xpath of the parent of the element.
+//
+// The element param should be either a string of properly formatted XML or a
+// struct that can be marshaled into XML.
+func (c *Client) SetXpath(path, element interface{}) error {
+	c.LogAction("(set) xpath %s", util.AsXpath(path))
+	_, err := c.Set(path, element, nil, nil)
+	return err
+}
+
+// EditXpath performs an EDIT, replacing the element at the given xpath.
+//
+// The path param is the xpath of the element itself.
+//
+// The element param should be either a string of properly formatted XML or a
+// struct that can be marshaled into XML.
+func (c *Client) EditXpath(path, element interface{}) error {
+	c.LogAction("(edit) xpath %s", util.AsXpath(path))
+	_, err := c.Edit(path, element, nil, nil)
+	return err
+}
+
+// DeleteXpath performs a DELETE, removing the element at the given xpath.
+//
+// Deleting an xpath that does not exist is not an error.
+func (c *Client) DeleteXpath(path interface{}) error {
+	c.LogAction("(delete) xpath %s", util.AsXpath(path))
+	_, err := c.Delete(path, nil, nil)
+	if err != nil {
+		e2, ok := err.(PanosError)
+		if ok && e2.ObjectNotFound() {
+			return nil
+		}
+	}
+	return err
+}
+
 // MultiConfig does a "multi-config" type command.
 //
 // Param strict should be true if you want strict transactional support.
@@ -924,7 +1083,7 @@ func (c *Client) Import(cat, content, filename, fp string, extras map[string]str
 // Commit performs PAN-OS commits.
 //
 // The cmd param can be a properly formatted XML string, a struct that can
-// be marshalled into XML, or one of the commit types.
+// be marshalled into XML, one of the commit types, or CommitOptions.
 //
 // The action param is the commit action to be taken (e.g. - "all").  If the
 // cmd param is one of the commit types, and the action passed in to this function
@@ -937,6 +1096,10 @@ func (c *Client) Import(cat, content, filename, fp string, extras map[string]str
 // the commit action was successfully submitted, the response from the server,
 // and if an error was encountered or not are all returned from this function.
 func (c *Client) Commit(cmd interface{}, action string, extras interface{}) (uint, []byte, error) {
+	if o, ok := cmd.(CommitOptions); ok {
+		return c.commitOptions(o, action, extras)
+	}
+
 	var err error
 	data := url.Values{}
 	data.Set("type", "commit")
@@ -1085,6 +1248,15 @@ func (c *Client) initCon() error {
 		}
 	}
 
+	// HA peer.
+	if c.HaPeer == "" {
+		if val := os.Getenv("PANOS_HA_PEER"); c.CheckEnvironment && val != "" {
+			c.HaPeer = val
+		} else {
+			c.HaPeer = json_client.HaPeer
+		}
+	}
+
 	// Verify cert.
 	if !c.VerifyCertificate {
 		if val := os.Getenv("PANOS_VERIFY_CERTIFICATE"); c.CheckEnvironment && val != "" {
@@ -1159,11 +1331,8 @@ func (c *Client) initCon() error {
 	}
 
 	// Configure the api url
-	if c.Port == 0 {
-		c.api_url = fmt.Sprintf("%s://%s/api", c.Protocol, c.Hostname)
-	} else {
-		c.api_url = fmt.Sprintf("%s://%s:%d/api", c.Protocol, c.Hostname, c.Port)
-	}
+	c.api_url = c.apiUrl(c.Hostname)
+	c.active_url = ""
 
 	return nil
 }
@@ -1191,9 +1360,15 @@ func (c *Client) initSystemInfo() error {
 		Value   string `xml:",chardata"`
 	}
 
+	type pluginVersion struct {
+		Name    string `xml:"name,attr"`
+		Version string `xml:"version,attr"`
+	}
+
 	type sysTag struct {
-		XMLName xml.Name `xml:"system"`
-		Tag     []tagVal `xml:",any"`
+		XMLName xml.Name        `xml:"system"`
+		Plugins []pluginVersion `xml:"plugin_versions>entry"`
+		Tag     []tagVal        `xml:",any"`
 	}
 
 	type system_info_ans struct {
@@ -1219,52 +1394,31 @@ func (c *Client) initSystemInfo() error {
 		}
 	}
 
+	plugins := make(map[string]string, len(ans.System.Plugins))
+	for _, p := range ans.System.Plugins {
+		plugins[p.Name] = p.Version
+	}
+	c.Info = newSystemInfo(c.SystemInfo, plugins)
+
 	return nil
 }
 
 func (c *Client) initPlugins() {
 	c.LogOp("(op) getting plugin info")
 
-	type plugin_req struct {
-		XMLName xml.Name `xml:"show"`
-		Cmd     string   `xml:"plugins>packages"`
-	}
-
-	type relNote struct {
-		ReleaseNoteUrl string `xml:",cdata"`
-	}
-
-	type pkgInfo struct {
-		Name        string  `xml:"name"`
-		Version     string  `xml:"version"`
-		ReleaseDate string  `xml:"release-date"`
-		RelNote     relNote `xml:"release-note-url"`
-		PackageFile string  `xml:"pkg-file"`
-		Size        string  `xml:"size"`
-		Platform    string  `xml:"platform"`
-		Installed   string  `xml:"installed"`
-		Downloaded  string  `xml:"downloaded"`
-	}
-
-	type pluginResp struct {
-		Answer []pkgInfo `xml:"result>plugins>entry"`
-	}
-
-	req := plugin_req{}
-	ans := pluginResp{}
-
-	if _, err := c.Op(req, "", nil, &ans); err != nil {
+	list, err := c.pluginPackages()
+	if err != nil {
 		c.LogAction("WARNING: Failed to get plugin info: %s", err)
 		return
 	}
 
-	c.Plugin = make([]map[string]string, 0, len(ans.Answer))
-	for _, data := range ans.Answer {
+	c.Plugin = make([]map[string]string, 0, len(list))
+	for _, data := range list {
 		c.Plugin = append(c.Plugin, map[string]string{
 			"name":             data.Name,
 			"version":          data.Version,
 			"release-date":     data.ReleaseDate,
-			"release-note-url": data.RelNote.ReleaseNoteUrl,
+			"release-note-url": data.ReleaseNoteUrl,
 			"package-file":     data.PackageFile,
 			"size":             data.Size,
 			"platform":         data.Platform,
@@ -1277,6 +1431,12 @@ func (c *Client) initPlugins() {
 func (c *Client) typeConfig(action string, data url.Values, element, extras, ans interface{}) ([]byte, error) {
 	var err error
 
+	if c.scope != nil && data.Get("xpath") != "" {
+		if err = c.scope(action, data.Get("xpath")); err != nil {
+			return nil, err
+		}
+	}
+
 	if c.MultiConfigure != nil && (action == "set" ||
 		action == "edit" ||
 		action == "delete") {
@@ -1403,13 +1563,10 @@ func (c *Client) xpathImport(tmpl, ts, vsys string) []string {
 
 func (c *Client) post(data url.Values) ([]byte, error) {
 	if len(c.rb) == 0 {
-		r, err := c.con.PostForm(c.api_url, data)
-		if err != nil {
-			return nil, err
+		if c.HaPeer != "" && isHaActive(data) {
+			return c.postActive(data)
 		}
-
-		defer r.Body.Close()
-		return ioutil.ReadAll(r.Body)
+		return c.postTo(c.api_url, data)
 	} else {
 		if c.ri < len(c.rb) {
 			c.rp = append(c.rp, data)
@@ -1427,6 +1584,14 @@ func (c *Client) endCommunication(body []byte, ans interface{}) ([]byte, error)
 		log.Printf("Response = %s", body)
 	}
 
+	if err = c.checkLimits(body); err != nil {
+		return nil, err
+	}
+
+	if c.OnResponse != nil {
+		c.OnResponse(ParseResponse(body))
+	}
+
 	// Check for errors first
 	errType1 := &panosErrorResponseWithoutLine{}
 	err = xml.Unmarshal(body, errType1)
@@ -1617,6 +1782,58 @@ func addToData(key string, i interface{}, attemptMarshal bool, data *url.Values)
 	return nil
 }
 
+// showJobs runs the given show jobs op command, returning the jobs listed.
+func (c *Client) showJobs(cmd string) ([]util.Job, error) {
+	ans := util.JobsResponse{}
+	if _, err := c.Op(cmd, "", nil, &ans); err != nil {
+		return nil, err
+	}
+
+	return ans.Jobs, nil
+}
+
+// retrieveConfig returns the given config ("running" or "candidate") as a
+// config tree.
+func (c *Client) retrieveConfig(which string) (*cfgtree.Node, error) {
+	type result struct {
+		Result util.RawXml `xml:"result"`
+	}
+
+	cmd := fmt.Sprintf("<show><config><%s/></config></show>", which)
+	ans := result{}
+
+	c.LogOp("(op) retrieving %s config", which)
+	if _, err := c.Op(cmd, "", nil, &ans); err != nil {
+		return nil, err
+	}
+
+	text := strings.TrimSpace(ans.Result.Text)
+	if text == "" {
+		return nil, fmt.Errorf("No %s config returned", which)
+	}
+
+	return cfgtree.Parse([]byte(text))
+}
+
+// unpackageResult unmarshals the contents of the response's result into ans.
+func unpackageResult(b []byte, ans interface{}) error {
+	type result struct {
+		Result util.RawXml `xml:"result"`
+	}
+
+	var resp result
+	if err := xml.Unmarshal(b, &resp); err != nil {
+		return err
+	}
+
+	text := strings.TrimSpace(resp.Result.Text)
+	if text == "" {
+		return PanosError{"Object not found", 7}
+	}
+
+	return xml.Unmarshal([]byte(text), ans)
+}
+
 func asString(i interface{}, attemptMarshal bool) (string, error) {
 	if a, ok := i.(fmt.Stringer); ok {
 		return a.String(), nil
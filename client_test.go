@@ -229,3 +229,97 @@ func TestAsStringWithNil(t *testing.T) {
 		t.Errorf("asString() returned no error on nil input")
 	}
 }
+
+func TestGetXpath(t *testing.T) {
+	type address struct {
+		Name  string `xml:"name,attr"`
+		Value string `xml:"ip-netmask"`
+	}
+
+	c := &Client{}
+	c.rb = [][]byte{
+		[]byte(`<response status="success"><result total-count="1" count="1"><entry name="one"><ip-netmask>10.1.1.1</ip-netmask></entry></result></response>`),
+	}
+	if err := c.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	ans := address{}
+	if err := c.GetXpath("/config/shared/address/entry[@name='one']", &ans); err != nil {
+		t.Fatalf("GetXpath failed: %s", err)
+	}
+
+	if ans.Name != "one" || ans.Value != "10.1.1.1" {
+		t.Errorf("Unexpected result: %#v", ans)
+	} else if c.rp[0].Get("action") != "get" {
+		t.Errorf("Expected action get, got %q", c.rp[0].Get("action"))
+	}
+}
+
+func TestGetXpathEmptyResult(t *testing.T) {
+	c := &Client{}
+	c.rb = [][]byte{
+		[]byte(`<response status="success"><result/></response>`),
+	}
+	if err := c.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	var ans struct{}
+	err := c.GetXpath("/config/shared/address", &ans)
+	if e2, ok := err.(PanosError); !ok || !e2.ObjectNotFound() {
+		t.Errorf("Expected object not found, got: %v", err)
+	}
+}
+
+func TestRunningConfig(t *testing.T) {
+	c := &Client{}
+	c.rb = [][]byte{
+		[]byte(`<response status="success"><result><config version="9.0.0"><shared><address><entry name="one"><ip-netmask>10.1.1.1</ip-netmask></entry></address></shared></config></result></response>`),
+	}
+	if err := c.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	n, err := c.RunningConfig()
+	if err != nil {
+		t.Fatalf("RunningConfig failed: %s", err)
+	}
+
+	if v := n.Find("/config/shared/address/entry[@name='one']/ip-netmask"); v == nil || v.Text != "10.1.1.1" {
+		t.Errorf("Address not found in config: %s", n)
+	} else if cmd := c.rp[0].Get("cmd"); !strings.Contains(cmd, "<running") {
+		t.Errorf("Unexpected cmd: %s", cmd)
+	}
+}
+
+func TestCommitQueue(t *testing.T) {
+	c := &Client{}
+	c.rb = [][]byte{
+		[]byte(`<response status="success"><result>
+<job><id>12</id><type>Commit</type><user>bob</user><status>PEND</status><queued>YES</queued><positionInQ>2</positionInQ></job>
+<job><id>11</id><type>Commit</type><user>alice</user><status>PEND</status><queued>YES</queued><positionInQ>1</positionInQ></job>
+<job><id>10</id><type>Downld</type><status>PEND</status><queued>YES</queued><positionInQ>0</positionInQ></job>
+</result></response>`),
+		[]byte(`<response status="success"><result>Job 11 cleared</result></response>`),
+	}
+	if err := c.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	list, err := c.CommitQueue()
+	if err != nil {
+		t.Fatalf("CommitQueue failed: %s", err)
+	}
+	if len(list) != 2 {
+		t.Fatalf("Expected 2 commit jobs, got %#v", list)
+	} else if list[0].Id != 11 || list[1].Id != 12 {
+		t.Errorf("Queue out of order: %d, %d", list[0].Id, list[1].Id)
+	}
+
+	if err = c.CancelJob(11); err != nil {
+		t.Fatalf("CancelJob failed: %s", err)
+	} else if cmd := c.rp[1].Get("cmd"); cmd != "<clear><job><id>11</id></job></clear>" {
+		t.Errorf("Unexpected cancel cmd: %s", cmd)
+	}
+}
@@ -0,0 +1,194 @@
+package pango
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/commit"
+	"github.com/PaloAltoNetworks/pango/pnrm/managed"
+)
+
+// CommitOptions are the options for a commit, shared by both the firewall
+// and Panorama.  Pass them as the cmd param of Commit():
+//
+//	id, _, err := c.Commit(pango.CommitOptions{Sync: true}, "", nil)
+//
+// Not every option applies to both device types.  Options that do not apply
+// to the device being committed result in an error rather than being silently
+// ignored, as doing so would commit a different set of changes than intended.
+//
+// Target is the serial number of the firewall to commit, overriding the
+// client's Target.  Vsys limits a firewall commit to the changes in the
+// given virtual systems.
+//
+// If Sync is true, then the commit blocks until the commit job finishes,
+// polling every SyncSleep.  When committing on Panorama, this does not
+// include any pushes to the managed devices.
+type CommitOptions struct {
+	Description             string
+	Admins                  []string
+	ExcludeDeviceAndNetwork bool
+	ExcludeSharedObjects    bool
+	Force                   bool
+	Sync                    bool
+	SyncSleep               time.Duration
+
+	// Firewall only.
+	ExcludePolicyAndObjects bool
+	Target                  string
+	Vsys                    []string
+
+	// Panorama only.
+	DeviceGroups       []string
+	Templates          []string
+	TemplateStacks     []string
+	WildfireAppliances []string
+	WildfireClusters   []string
+	LogCollectors      []string
+	LogCollectorGroups []string
+}
+
+// FirewallCommit returns the firewall commit for these options.
+func (o CommitOptions) FirewallCommit() (commit.FirewallCommit, error) {
+	if o.panoramaOnly() {
+		return commit.FirewallCommit{}, fmt.Errorf("Panorama only commit options specified for a firewall commit")
+	}
+
+	return commit.FirewallCommit{
+		Description:             o.Description,
+		Admins:                  o.Admins,
+		Vsys:                    o.Vsys,
+		ExcludeDeviceAndNetwork: o.ExcludeDeviceAndNetwork,
+		ExcludeSharedObjects:    o.ExcludeSharedObjects,
+		ExcludePolicyAndObjects: o.ExcludePolicyAndObjects,
+		Force:                   o.Force,
+	}, nil
+}
+
+// PanoramaCommit returns the Panorama commit for these options.
+func (o CommitOptions) PanoramaCommit() (commit.PanoramaCommit, error) {
+	if o.firewallOnly() {
+		return commit.PanoramaCommit{}, fmt.Errorf("Firewall only commit options specified for a Panorama commit")
+	}
+
+	return commit.PanoramaCommit{
+		Description:             o.Description,
+		Admins:                  o.Admins,
+		DeviceGroups:            o.DeviceGroups,
+		Templates:               o.Templates,
+		TemplateStacks:          o.TemplateStacks,
+		WildfireAppliances:      o.WildfireAppliances,
+		WildfireClusters:        o.WildfireClusters,
+		LogCollectors:           o.LogCollectors,
+		LogCollectorGroups:      o.LogCollectorGroups,
+		ExcludeDeviceAndNetwork: o.ExcludeDeviceAndNetwork,
+		ExcludeSharedObjects:    o.ExcludeSharedObjects,
+		Force:                   o.Force,
+	}, nil
+}
+
+func (o CommitOptions) firewallOnly() bool {
+	return o.ExcludePolicyAndObjects || o.Target != "" || len(o.Vsys) > 0
+}
+
+func (o CommitOptions) panoramaOnly() bool {
+	return len(o.DeviceGroups) > 0 || len(o.Templates) > 0 || len(o.TemplateStacks) > 0 ||
+		len(o.WildfireAppliances) > 0 || len(o.WildfireClusters) > 0 ||
+		len(o.LogCollectors) > 0 || len(o.LogCollectorGroups) > 0
+}
+
+// CommitAllMatching performs a commit-all, pushing to the managed devices
+// selected by the given filter, returning the job ID.
+//
+// The Devices param of the commit is replaced by the serial numbers of the
+// devices that both match the filter and are members of the device group,
+// template, or template stack being pushed.  An error is returned if no
+// devices are selected, or if the commit type does not push to devices.
+func (c *Panorama) CommitAllMatching(cmd commit.PanoramaCommitAll, f managed.Filter) (uint, error) {
+	var members map[string][]string
+
+	switch cmd.Type {
+	case commit.TypeDeviceGroup:
+		e, err := c.Panorama.DeviceGroup.Get(cmd.Name)
+		if err != nil {
+			return 0, err
+		}
+		members = e.Devices
+	case commit.TypeTemplate:
+		e, err := c.Panorama.Template.Get(cmd.Name)
+		if err != nil {
+			return 0, err
+		}
+		members = e.Devices
+	case commit.TypeTemplateStack:
+		e, err := c.Panorama.TemplateStack.Get(cmd.Name)
+		if err != nil {
+			return 0, err
+		}
+		members = make(map[string][]string, len(e.Devices))
+		for _, serial := range e.Devices {
+			members[serial] = nil
+		}
+	default:
+		return 0, fmt.Errorf("Device filters are not supported for commit type %q", cmd.Type)
+	}
+
+	list, err := c.Panorama.ManagedDevice.Select(f)
+	if err != nil {
+		return 0, err
+	}
+
+	cmd.Devices = nil
+	for _, serial := range list {
+		if _, ok := members[serial]; ok {
+			cmd.Devices = append(cmd.Devices, serial)
+		}
+	}
+
+	if len(cmd.Devices) == 0 {
+		return 0, fmt.Errorf("No devices in %q match the given filter", cmd.Name)
+	}
+
+	c.LogAction("(commit) push to %s %q: %v", cmd.Type, cmd.Name, cmd.Devices)
+	id, _, err := c.Commit(cmd, "", nil)
+	return id, err
+}
+
+/** Internal functions **/
+
+// commitOptions performs the commit for Commit() when given CommitOptions.
+//
+// The options common to both device types marshal identically, so the
+// commit type is chosen by which device specific options are present.
+func (c *Client) commitOptions(o CommitOptions, action string, extras interface{}) (uint, []byte, error) {
+	var (
+		cmd interface{}
+		err error
+	)
+
+	if o.panoramaOnly() {
+		cmd, err = o.PanoramaCommit()
+	} else {
+		cmd, err = o.FirewallCommit()
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if o.Target != "" {
+		data := url.Values{}
+		if err = mergeUrlValues(&data, extras); err != nil {
+			return 0, nil, err
+		}
+		data.Set("target", o.Target)
+		extras = data
+	}
+
+	id, b, err := c.Commit(cmd, action, extras)
+	if err != nil || id == 0 || !o.Sync {
+		return id, b, err
+	}
+
+	return id, b, c.WaitForJob(id, o.SyncSleep, nil)
+}
@@ -11,9 +11,12 @@ import (
 // This is a commit type, designed to be passed in to Client.Commit().
 //
 // Admins is the list of admins whose changes should be committed.
+//
+// Vsys is the list of virtual systems whose changes should be committed.
 type FirewallCommit struct {
 	Description             string
 	Admins                  []string
+	Vsys                    []string
 	ExcludeDeviceAndNetwork bool
 	ExcludeSharedObjects    bool
 	ExcludePolicyAndObjects bool
@@ -30,10 +33,11 @@ func (o FirewallCommit) Element() interface{} {
 	}
 
 	var p *fwPartialCommit
-	if len(o.Admins) > 0 ||
+	if len(o.Admins) > 0 || len(o.Vsys) > 0 ||
 		o.ExcludeDeviceAndNetwork || o.ExcludeSharedObjects || o.ExcludePolicyAndObjects {
 		p = &fwPartialCommit{
 			Admins: util.StrToMem(o.Admins),
+			Vsys:   util.StrToMem(o.Vsys),
 		}
 
 		if o.ExcludeDeviceAndNetwork {
@@ -73,6 +77,7 @@ type fwForce struct {
 
 type fwPartialCommit struct {
 	Admins                  *util.MemberType `xml:"admin"`
+	Vsys                    *util.MemberType `xml:"vsys"`
 	ExcludeDeviceAndNetwork string           `xml:"device-and-network,omitempty"`
 	ExcludeSharedObjects    string           `xml:"shared-object,omitempty"`
 	ExcludePolicyAndObjects string           `xml:"policy-and-objects,omitempty"`
@@ -43,6 +43,30 @@ func TestPartialCommitWithAdmins(t *testing.T) {
 	}
 }
 
+func TestPartialCommitWithVsys(t *testing.T) {
+	s := []string{
+		"<commit>",
+		"<description>example</description>",
+		"<partial><vsys>",
+		"<member>vsys1</member>",
+		"<member>vsys2</member>",
+		"</vsys></partial>",
+		"</commit>",
+	}
+
+	expected := strings.Join(s, "")
+
+	c := FirewallCommit{
+		Description: "example",
+		Vsys:        []string{"vsys1", "vsys2"},
+	}
+
+	b, _ := xml.Marshal(c.Element())
+	if expected != string(b) {
+		t.Errorf("Expected(%s) got(%s)", expected, b)
+	}
+}
+
 func TestPartialExcludeDeviceAndNetwork(t *testing.T) {
 	s := []string{
 		"<commit>",
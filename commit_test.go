@@ -0,0 +1,135 @@
+package pango
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/commit"
+	"github.com/PaloAltoNetworks/pango/pnrm/managed"
+)
+
+func TestFirewallCommitOptions(t *testing.T) {
+	fw := &Firewall{Client: Client{
+		rb: [][]byte{
+			[]byte(`<response status="success"><result><job>7</job></result></response>`),
+			[]byte(`<response status="success"><result><job><id>7</id><type>Commit</type><status>FIN</status><result>OK</result><progress>100</progress></job></result></response>`),
+		},
+	}}
+	if err := fw.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	id, _, err := fw.Commit(CommitOptions{
+		Description: "hello",
+		Admins:      []string{"admin"},
+		Vsys:        []string{"vsys2"},
+		Sync:        true,
+		Target:      "0123456789",
+	}, "", nil)
+	if err != nil {
+		t.Fatalf("Commit failed: %s", err)
+	}
+	if id != 7 {
+		t.Errorf("Expected job 7, got %d", id)
+	}
+
+	if len(fw.rp) != 2 {
+		t.Fatalf("Expected 2 requests, got %d", len(fw.rp))
+	}
+	req := fw.rp[0]
+	if req.Get("target") != "0123456789" {
+		t.Errorf("Target not set: %v", req)
+	}
+	cmd := req.Get("cmd")
+	for _, s := range []string{"<description>hello</description>", "<admin><member>admin</member></admin>", "<vsys><member>vsys2</member></vsys>"} {
+		if !strings.Contains(cmd, s) {
+			t.Errorf("%q not in cmd: %s", s, cmd)
+		}
+	}
+}
+
+func TestPanoramaCommitOptionsNothingToCommit(t *testing.T) {
+	pano := &Panorama{Client: Client{
+		rb: [][]byte{
+			[]byte(`<response status="success" code="19"><msg>There are no changes to commit.</msg></response>`),
+		},
+	}}
+	if err := pano.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	id, _, err := pano.Commit(CommitOptions{
+		DeviceGroups: []string{"dg1"},
+		Sync:         true,
+	}, "", nil)
+	if err != nil {
+		t.Fatalf("Commit failed: %s", err)
+	}
+	if id != 0 {
+		t.Errorf("Expected job 0, got %d", id)
+	}
+	if len(pano.rp) != 1 {
+		t.Errorf("Expected 1 request, got %d", len(pano.rp))
+	}
+	if !strings.Contains(pano.rp[0].Get("cmd"), "<device-group><member>dg1</member></device-group>") {
+		t.Errorf("Device group not in cmd: %s", pano.rp[0].Get("cmd"))
+	}
+}
+
+func TestCommitOptionsNotApplicable(t *testing.T) {
+	if _, err := (CommitOptions{DeviceGroups: []string{"dg1"}}).FirewallCommit(); err == nil {
+		t.Errorf("Firewall commit allowed device groups")
+	}
+	if _, err := (CommitOptions{ExcludePolicyAndObjects: true}).PanoramaCommit(); err == nil {
+		t.Errorf("Panorama commit allowed excluding policy and objects")
+	}
+	if _, err := (CommitOptions{Vsys: []string{"vsys1"}}).PanoramaCommit(); err == nil {
+		t.Errorf("Panorama commit allowed a vsys")
+	}
+
+	c := &Client{}
+	if _, _, err := c.Commit(CommitOptions{Target: "0001", DeviceGroups: []string{"dg1"}}, "", nil); err == nil {
+		t.Errorf("Commit allowed mixing firewall and Panorama options")
+	}
+	if len(c.rp) != 0 {
+		t.Errorf("Request sent for invalid options: %v", c.rp)
+	}
+}
+
+func TestPanoramaCommitAllMatching(t *testing.T) {
+	pano := &Panorama{Client: Client{
+		rb: [][]byte{
+			[]byte(`<response status="success"><result><entry name="dg1"><devices><entry name="0001"/><entry name="0002"/></devices></entry></result></response>`),
+			[]byte(`<response status="success"><result><devices>
+<entry name="0001"><serial>0001</serial><model>PA-VM</model><tags><member>east</member></tags></entry>
+<entry name="0002"><serial>0002</serial><model>PA-220</model><tags><member>east</member></tags></entry>
+<entry name="0003"><serial>0003</serial><model>PA-VM</model><tags><member>east</member></tags></entry>
+</devices></result></response>`),
+			[]byte(`<response status="success"><result><job>9</job></result></response>`),
+		},
+	}}
+	if err := pano.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	cmd := commit.PanoramaCommitAll{Type: commit.TypeDeviceGroup, Name: "dg1"}
+	id, err := pano.CommitAllMatching(cmd, managed.Filter{Tags: []string{"east"}, Models: []string{"PA-VM"}})
+	if err != nil {
+		t.Fatalf("CommitAllMatching failed: %s", err)
+	}
+	if id != 9 {
+		t.Errorf("Expected job 9, got %d", id)
+	}
+
+	if len(pano.rp) != 3 {
+		t.Fatalf("Expected 3 requests, got %d", len(pano.rp))
+	}
+	push := pano.rp[2].Get("cmd")
+	if !strings.Contains(push, `<entry name="0001"></entry>`) || strings.Contains(push, "0002") || strings.Contains(push, "0003") {
+		t.Errorf("Bad devices in push: %s", push)
+	}
+
+	if _, err = pano.CommitAllMatching(commit.PanoramaCommitAll{Type: commit.TypeLogCollectorGroup}, managed.Filter{}); err == nil {
+		t.Errorf("No error for log collector group push")
+	}
+}
@@ -17,7 +17,7 @@ func Connect(c Client) (interface{}, error) {
 		return nil, err
 	}
 
-	model := c.SystemInfo["model"]
+	model := c.Info.Model
 	if model == "Panorama" || model[:2] == "M-" {
 		pano := &Panorama{Client: c}
 		pano.Logging = logg
@@ -0,0 +1,19 @@
+package admin
+
+// Valid values for Role.
+const (
+	RoleSuperuser     = "superuser"
+	RoleSuperreader   = "superreader"
+	RoleDeviceAdmin   = "deviceadmin"
+	RoleDeviceReader  = "devicereader"
+	RoleVsysAdmin     = "vsysadmin"
+	RoleVsysReader    = "vsysreader"
+	RolePanoramaAdmin = "panorama-admin"
+	RoleCustom        = "custom"
+	RoleAccessDomain  = "access-domain"
+)
+
+const (
+	singular = "administrator"
+	plural   = "administrators"
+)
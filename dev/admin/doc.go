@@ -0,0 +1,10 @@
+/*
+Package admin is the client.Device.Administrator namespace.
+
+This namespace manages the local administrators of the firewall or Panorama
+itself.  The password of an administrator is given as a hash, which can be
+generated with the client's RequestPasswordHash().
+
+Normalized object:  Entry
+*/
+package admin
@@ -0,0 +1,201 @@
+package admin
+
+import (
+	"encoding/xml"
+	"sort"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a local
+// administrator.
+//
+// Role is one of the Role* constants.  The Vsys param is used with the vsys
+// admin / reader roles, and Profile is the admin role profile for the custom
+// role.  RolePanoramaAdmin and RoleAccessDomain are Panorama only, and for
+// RoleAccessDomain the AccessDomains param is a map where the key is the
+// access domain and the value is the admin role profile to use in it.
+type Entry struct {
+	Name                  string
+	PasswordHash          string
+	AuthenticationProfile string
+	PasswordProfile       string
+	ClientCertificateOnly bool
+	PublicKey             string
+	Role                  string
+	Vsys                  []string
+	Profile               string
+	AccessDomains         map[string]string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.PasswordHash = s.PasswordHash
+	o.AuthenticationProfile = s.AuthenticationProfile
+	o.PasswordProfile = s.PasswordProfile
+	o.ClientCertificateOnly = s.ClientCertificateOnly
+	o.PublicKey = s.PublicKey
+	o.Role = s.Role
+	o.Vsys = s.Vsys
+	o.Profile = s.Profile
+	o.AccessDomains = s.AccessDomains
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:                  o.Answer.Name,
+		PasswordHash:          o.Answer.PasswordHash,
+		AuthenticationProfile: o.Answer.AuthenticationProfile,
+		PasswordProfile:       o.Answer.PasswordProfile,
+		ClientCertificateOnly: util.AsBool(o.Answer.ClientCertificateOnly),
+		PublicKey:             o.Answer.PublicKey,
+	}
+
+	if o.Answer.Role == nil {
+		return ans
+	}
+
+	r := o.Answer.Role
+	switch {
+	case r.Superuser == util.YesNo(true):
+		ans.Role = RoleSuperuser
+	case r.Superreader == util.YesNo(true):
+		ans.Role = RoleSuperreader
+	case r.PanoramaAdmin == util.YesNo(true):
+		ans.Role = RolePanoramaAdmin
+	case r.DeviceAdmin != nil:
+		ans.Role = RoleDeviceAdmin
+	case r.DeviceReader != nil:
+		ans.Role = RoleDeviceReader
+	case r.VsysAdmin != nil:
+		ans.Role = RoleVsysAdmin
+		ans.Vsys = util.MemToStr(r.VsysAdmin.Vsys)
+	case r.VsysReader != nil:
+		ans.Role = RoleVsysReader
+		ans.Vsys = util.MemToStr(r.VsysReader.Vsys)
+	case r.Custom != nil:
+		ans.Role = RoleCustom
+		ans.Profile = r.Custom.Profile
+	case r.AccessDomains != nil:
+		ans.Role = RoleAccessDomain
+		ans.AccessDomains = make(map[string]string, len(r.AccessDomains.Entries))
+		for _, v := range r.AccessDomains.Entries {
+			ans.AccessDomains[v.Name] = v.Profile
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName               xml.Name `xml:"entry"`
+	Name                  string   `xml:"name,attr"`
+	PasswordHash          string   `xml:"phash,omitempty"`
+	AuthenticationProfile string   `xml:"authentication-profile,omitempty"`
+	PasswordProfile       string   `xml:"password-profile,omitempty"`
+	ClientCertificateOnly string   `xml:"client-certificate-only,omitempty"`
+	PublicKey             string   `xml:"public-key,omitempty"`
+	Role                  *role    `xml:"permissions>role-based"`
+}
+
+type role struct {
+	Superuser     string           `xml:"superuser,omitempty"`
+	Superreader   string           `xml:"superreader,omitempty"`
+	PanoramaAdmin string           `xml:"panorama-admin,omitempty"`
+	DeviceAdmin   *util.MemberType `xml:"deviceadmin"`
+	DeviceReader  *util.MemberType `xml:"devicereader"`
+	VsysAdmin     *vsysRole        `xml:"vsysadmin>entry"`
+	VsysReader    *vsysRole        `xml:"vsysreader>entry"`
+	Custom        *custom          `xml:"custom"`
+	AccessDomains *accessDomains   `xml:"dg-template-profiles"`
+}
+
+type vsysRole struct {
+	Name string           `xml:"name,attr"`
+	Vsys *util.MemberType `xml:"vsys"`
+}
+
+type custom struct {
+	Profile string `xml:"profile"`
+}
+
+type accessDomains struct {
+	Entries []accessDomain `xml:"entry"`
+}
+
+type accessDomain struct {
+	Name    string `xml:"name,attr"`
+	Profile string `xml:"profile"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:                  e.Name,
+		PasswordHash:          e.PasswordHash,
+		AuthenticationProfile: e.AuthenticationProfile,
+		PasswordProfile:       e.PasswordProfile,
+		PublicKey:             e.PublicKey,
+	}
+
+	if e.ClientCertificateOnly {
+		ans.ClientCertificateOnly = util.YesNo(true)
+	}
+
+	device := util.StrToMem([]string{"localhost.localdomain"})
+	switch e.Role {
+	case RoleSuperuser:
+		ans.Role = &role{Superuser: util.YesNo(true)}
+	case RoleSuperreader:
+		ans.Role = &role{Superreader: util.YesNo(true)}
+	case RolePanoramaAdmin:
+		ans.Role = &role{PanoramaAdmin: util.YesNo(true)}
+	case RoleDeviceAdmin:
+		ans.Role = &role{DeviceAdmin: device}
+	case RoleDeviceReader:
+		ans.Role = &role{DeviceReader: device}
+	case RoleVsysAdmin:
+		ans.Role = &role{VsysAdmin: &vsysRole{
+			Name: "localhost.localdomain",
+			Vsys: util.StrToMem(e.Vsys),
+		}}
+	case RoleVsysReader:
+		ans.Role = &role{VsysReader: &vsysRole{
+			Name: "localhost.localdomain",
+			Vsys: util.StrToMem(e.Vsys),
+		}}
+	case RoleCustom:
+		ans.Role = &role{Custom: &custom{Profile: e.Profile}}
+	case RoleAccessDomain:
+		list := make([]accessDomain, 0, len(e.AccessDomains))
+		for _, name := range sortedKeys(e.AccessDomains) {
+			list = append(list, accessDomain{
+				Name:    name,
+				Profile: e.AccessDomains[name],
+			})
+		}
+		ans.Role = &role{AccessDomains: &accessDomains{Entries: list}}
+	}
+
+	return ans
+}
+
+func sortedKeys(m map[string]string) []string {
+	ans := make([]string, 0, len(m))
+	for key := range m {
+		ans = append(ans, key)
+	}
+	sort.Strings(ans)
+
+	return ans
+}
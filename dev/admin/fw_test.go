@@ -0,0 +1,50 @@
+package admin
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestFwNormalization(t *testing.T) {
+	testCases := getTests()
+
+	mc := &testdata.MockClient{}
+	ns := &FwAdmin{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("")
+			err := ns.Set(tc.conf)
+			if err != nil {
+				t.Errorf("Error in set: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get(tc.conf.Name)
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				}
+				if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
+
+func TestFwSetPassword(t *testing.T) {
+	mc := &testdata.MockClient{PasswordHash: "$1$hashed"}
+	ns := &FwAdmin{}
+	ns.Initialize(mc)
+
+	mc.AddResp("")
+	if err := ns.SetPassword("admin", "secret"); err != nil {
+		t.Fatalf("Error in set password: %s", err)
+	}
+	if mc.Function != "edit" || mc.Path != "/config/mgt-config/users/entry[@name='admin']/phash" || mc.Elm != "<phash>$1$hashed</phash>" {
+		t.Errorf("Bad set password: %s %s %s", mc.Function, mc.Path, mc.Elm)
+	}
+}
@@ -0,0 +1,63 @@
+package admin
+
+type tc struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"superuser", Entry{
+			Name:                  "one",
+			PasswordHash:          "$1$hash",
+			ClientCertificateOnly: true,
+			Role:                  RoleSuperuser,
+		}},
+		{"superreader", Entry{
+			Name:                  "two",
+			AuthenticationProfile: "radius",
+			Role:                  RoleSuperreader,
+		}},
+		{"device admin", Entry{
+			Name:            "three",
+			PasswordProfile: "pp",
+			PublicKey:       "c3NoLXJzYQ==",
+			Role:            RoleDeviceAdmin,
+		}},
+		{"device reader", Entry{
+			Name: "four",
+			Role: RoleDeviceReader,
+		}},
+		{"vsys admin", Entry{
+			Name: "five",
+			Role: RoleVsysAdmin,
+			Vsys: []string{"vsys1", "vsys2"},
+		}},
+		{"vsys reader", Entry{
+			Name: "six",
+			Role: RoleVsysReader,
+			Vsys: []string{"vsys3"},
+		}},
+		{"custom", Entry{
+			Name:    "seven",
+			Role:    RoleCustom,
+			Profile: "auditor",
+		}},
+		{"panorama admin", Entry{
+			Name: "eight",
+			Role: RolePanoramaAdmin,
+		}},
+		{"access domains", Entry{
+			Name: "nine",
+			Role: RoleAccessDomain,
+			AccessDomains: map[string]string{
+				"east": "dg-admin",
+				"west": "dg-reader",
+			},
+		}},
+		{"no role", Entry{
+			Name:         "ten",
+			PasswordHash: "$1$other",
+		}},
+	}
+}
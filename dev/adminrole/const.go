@@ -0,0 +1,22 @@
+package adminrole
+
+// Valid values for Scope.  ScopePanorama and ScopeDeviceGroupTemplate are
+// Panorama only, while ScopeDevice and ScopeVsys are firewall only.
+const (
+	ScopeDevice              = "device"
+	ScopeVsys                = "vsys"
+	ScopePanorama            = "panorama"
+	ScopeDeviceGroupTemplate = "device-group-and-template"
+)
+
+// Valid permission values.
+const (
+	PermissionEnable   = "enable"
+	PermissionReadOnly = "read-only"
+	PermissionDisable  = "disable"
+)
+
+const (
+	singular = "admin role"
+	plural   = "admin roles"
+)
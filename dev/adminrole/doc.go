@@ -0,0 +1,12 @@
+/*
+Package adminrole is the client.Device.AdminRole namespace.
+
+Admin role profiles define the web UI, XML API, and CLI permissions of
+administrators that use a custom role.  Web UI and XML API permissions are
+given as maps, where the key is the path to the permission (such as
+"monitor/logs/traffic" or "commit") and the value is one of the Permission*
+constants.
+
+Normalized object:  Entry
+*/
+package adminrole
@@ -0,0 +1,169 @@
+package adminrole
+
+import (
+	"encoding/xml"
+	"sort"
+	"strings"
+
+	"github.com/PaloAltoNetworks/pango/cfgtree"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an admin
+// role profile.
+//
+// Scope is one of the Scope* constants.  Cli is the CLI role, such as
+// "superuser" or "devicereader", and is left empty for no CLI access.
+type Entry struct {
+	Name        string
+	Description string
+	Scope       string
+	WebUi       map[string]string
+	XmlApi      map[string]string
+	Cli         string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Description = s.Description
+	o.Scope = s.Scope
+	o.WebUi = s.WebUi
+	o.XmlApi = s.XmlApi
+	o.Cli = s.Cli
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:        o.Answer.Name,
+		Description: o.Answer.Description,
+	}
+
+	var p *perms
+	switch {
+	case o.Answer.Role.Device != nil:
+		ans.Scope, p = ScopeDevice, o.Answer.Role.Device
+	case o.Answer.Role.Vsys != nil:
+		ans.Scope, p = ScopeVsys, o.Answer.Role.Vsys
+	case o.Answer.Role.Panorama != nil:
+		ans.Scope, p = ScopePanorama, o.Answer.Role.Panorama
+	case o.Answer.Role.DeviceGroupTemplate != nil:
+		ans.Scope, p = ScopeDeviceGroupTemplate, o.Answer.Role.DeviceGroupTemplate
+	}
+
+	if p != nil {
+		ans.WebUi = flatten("webui", p.WebUi)
+		ans.XmlApi = flatten("xmlapi", p.XmlApi)
+		ans.Cli = p.Cli
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName     xml.Name `xml:"entry"`
+	Name        string   `xml:"name,attr"`
+	Description string   `xml:"description,omitempty"`
+	Role        role     `xml:"role"`
+}
+
+type role struct {
+	Device              *perms `xml:"device"`
+	Vsys                *perms `xml:"vsys"`
+	Panorama            *perms `xml:"panorama"`
+	DeviceGroupTemplate *perms `xml:"device-group-and-template"`
+}
+
+type perms struct {
+	WebUi  *util.RawXml `xml:"webui"`
+	XmlApi *util.RawXml `xml:"xmlapi"`
+	Cli    string       `xml:"cli,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:        e.Name,
+		Description: e.Description,
+	}
+
+	p := &perms{
+		WebUi:  unflatten(e.WebUi),
+		XmlApi: unflatten(e.XmlApi),
+		Cli:    e.Cli,
+	}
+
+	switch e.Scope {
+	case ScopeDevice:
+		ans.Role.Device = p
+	case ScopeVsys:
+		ans.Role.Vsys = p
+	case ScopePanorama:
+		ans.Role.Panorama = p
+	case ScopeDeviceGroupTemplate:
+		ans.Role.DeviceGroupTemplate = p
+	}
+
+	return ans
+}
+
+// flatten converts nested permissions into a map of path to permission.
+func flatten(tag string, v *util.RawXml) map[string]string {
+	if v == nil {
+		return nil
+	}
+
+	root, err := cfgtree.ParseInner(tag, []byte(v.Text))
+	if err != nil || root.IsLeaf() {
+		return nil
+	}
+
+	ans := make(map[string]string)
+	prefix := "/" + tag + "/"
+	_ = root.Walk(func(path string, n *cfgtree.Node) error {
+		if n != root && n.IsLeaf() {
+			ans[strings.TrimPrefix(path, prefix)] = n.Text
+		}
+		return nil
+	})
+
+	return ans
+}
+
+// unflatten converts a map of path to permission into nested permissions.
+func unflatten(m map[string]string) *util.RawXml {
+	if len(m) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	root := &cfgtree.Node{}
+	for _, key := range keys {
+		cur := root
+		for _, tag := range strings.Split(key, "/") {
+			next := cur.Child(tag)
+			if next == nil {
+				next = &cfgtree.Node{XMLName: xml.Name{Local: tag}}
+				cur.Nodes = append(cur.Nodes, next)
+			}
+			cur = next
+		}
+		cur.Text = m[key]
+	}
+
+	return &util.RawXml{Text: root.InnerXml()}
+}
@@ -0,0 +1,47 @@
+package adminrole
+
+type tc struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"device", Entry{
+			Name:        "one",
+			Description: "auditors",
+			Scope:       ScopeDevice,
+			WebUi: map[string]string{
+				"dashboard":            PermissionEnable,
+				"monitor/logs/traffic": PermissionReadOnly,
+				"monitor/logs/threat":  PermissionReadOnly,
+				"policies/security":    PermissionDisable,
+			},
+			XmlApi: map[string]string{
+				"report": PermissionEnable,
+				"log":    PermissionEnable,
+				"commit": PermissionDisable,
+			},
+			Cli: "devicereader",
+		}},
+		{"vsys", Entry{
+			Name:  "two",
+			Scope: ScopeVsys,
+			WebUi: map[string]string{
+				"objects/addresses": PermissionEnable,
+			},
+		}},
+		{"panorama", Entry{
+			Name:  "three",
+			Scope: ScopePanorama,
+			Cli:   "superreader",
+		}},
+		{"device group and template", Entry{
+			Name:  "four",
+			Scope: ScopeDeviceGroupTemplate,
+			XmlApi: map[string]string{
+				"config": PermissionEnable,
+			},
+		}},
+	}
+}
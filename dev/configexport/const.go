@@ -0,0 +1,12 @@
+package configexport
+
+// Valid values for Protocol.
+const (
+	ProtocolFtp = "ftp"
+	ProtocolScp = "scp"
+)
+
+const (
+	singular = "scheduled config export"
+	plural   = "scheduled config exports"
+)
@@ -0,0 +1,11 @@
+/*
+Package configexport is the panorama.Device.ScheduledConfigExport namespace.
+
+Scheduled config exports copy a bundle of the running configuration of
+Panorama and its managed devices to an FTP or SCP server once a day, for
+off-box backups.  PAN-OS firewalls do not have a scheduled config export of
+their own; they are covered by the export of the Panorama managing them.
+
+Normalized object:  Entry
+*/
+package configexport
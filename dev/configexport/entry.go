@@ -0,0 +1,131 @@
+package configexport
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a scheduled
+// config export.
+//
+// StartTime is the daily start time, as "hh:mm".  Path is the directory on
+// the server to upload to.  PassiveMode only applies to ProtocolFtp.
+type Entry struct {
+	Name        string
+	Description string
+	Enable      bool
+	StartTime   string
+	Protocol    string
+	Hostname    string
+	Port        int
+	Path        string
+	Username    string
+	Password    string // encrypted
+	PassiveMode bool
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Description = s.Description
+	o.Enable = s.Enable
+	o.StartTime = s.StartTime
+	o.Protocol = s.Protocol
+	o.Hostname = s.Hostname
+	o.Port = s.Port
+	o.Path = s.Path
+	o.Username = s.Username
+	o.Password = s.Password
+	o.PassiveMode = s.PassiveMode
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:        o.Answer.Name,
+		Description: o.Answer.Description,
+		Enable:      util.AsBool(o.Answer.Enable),
+		StartTime:   o.Answer.StartTime,
+	}
+
+	var d *destination
+	switch {
+	case o.Answer.Protocol.Ftp != nil:
+		ans.Protocol = ProtocolFtp
+		d = o.Answer.Protocol.Ftp
+		ans.PassiveMode = util.AsBool(d.PassiveMode)
+	case o.Answer.Protocol.Scp != nil:
+		ans.Protocol = ProtocolScp
+		d = o.Answer.Protocol.Scp
+	}
+
+	if d != nil {
+		ans.Hostname = d.Hostname
+		ans.Port = d.Port
+		ans.Path = d.Path
+		ans.Username = d.Username
+		ans.Password = d.Password
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName     xml.Name `xml:"entry"`
+	Name        string   `xml:"name,attr"`
+	Description string   `xml:"description,omitempty"`
+	Enable      string   `xml:"enable"`
+	StartTime   string   `xml:"start-time,omitempty"`
+	Protocol    protocol `xml:"protocol"`
+}
+
+type protocol struct {
+	Ftp *destination `xml:"ftp"`
+	Scp *destination `xml:"scp"`
+}
+
+type destination struct {
+	Hostname    string `xml:"hostname,omitempty"`
+	Port        int    `xml:"port,omitempty"`
+	Path        string `xml:"path,omitempty"`
+	Username    string `xml:"username,omitempty"`
+	Password    string `xml:"password,omitempty"`
+	PassiveMode string `xml:"passive-mode,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:        e.Name,
+		Description: e.Description,
+		Enable:      util.YesNo(e.Enable),
+		StartTime:   e.StartTime,
+	}
+
+	d := &destination{
+		Hostname: e.Hostname,
+		Port:     e.Port,
+		Path:     e.Path,
+		Username: e.Username,
+		Password: e.Password,
+	}
+
+	switch e.Protocol {
+	case ProtocolFtp:
+		d.PassiveMode = util.YesNo(e.PassiveMode)
+		ans.Protocol.Ftp = d
+	case ProtocolScp:
+		ans.Protocol.Scp = d
+	}
+
+	return ans
+}
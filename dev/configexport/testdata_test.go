@@ -0,0 +1,37 @@
+package configexport
+
+type tc struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"scp", Entry{
+			Name:        "nightly",
+			Description: "nightly backup",
+			Enable:      true,
+			StartTime:   "02:30",
+			Protocol:    ProtocolScp,
+			Hostname:    "backup.example.com",
+			Port:        22,
+			Path:        "/backups/panorama",
+			Username:    "backup",
+			Password:    "secret",
+		}},
+		{"ftp passive", Entry{
+			Name:        "ftp",
+			StartTime:   "04:00",
+			Protocol:    ProtocolFtp,
+			Hostname:    "10.1.1.1",
+			Port:        21,
+			Username:    "anonymous",
+			PassiveMode: true,
+		}},
+		{"ftp active", Entry{
+			Name:     "ftp2",
+			Protocol: ProtocolFtp,
+			Hostname: "10.1.1.2",
+		}},
+	}
+}
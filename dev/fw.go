@@ -3,7 +3,10 @@ package dev
 import (
 	"github.com/PaloAltoNetworks/pango/util"
 
+	"github.com/PaloAltoNetworks/pango/dev/admin"
+	"github.com/PaloAltoNetworks/pango/dev/adminrole"
 	"github.com/PaloAltoNetworks/pango/dev/general"
+	"github.com/PaloAltoNetworks/pango/dev/logging"
 	"github.com/PaloAltoNetworks/pango/dev/profile/email"
 	emailsrv "github.com/PaloAltoNetworks/pango/dev/profile/email/server"
 	"github.com/PaloAltoNetworks/pango/dev/profile/http"
@@ -15,11 +18,15 @@ import (
 	"github.com/PaloAltoNetworks/pango/dev/profile/snmp/v3"
 	"github.com/PaloAltoNetworks/pango/dev/profile/syslog"
 	syslogsrv "github.com/PaloAltoNetworks/pango/dev/profile/syslog/server"
+	"github.com/PaloAltoNetworks/pango/dev/session"
 	"github.com/PaloAltoNetworks/pango/dev/telemetry"
+	"github.com/PaloAltoNetworks/pango/dev/updateschedule"
 )
 
 // FwDev is the client.Device namespace.
 type FwDev struct {
+	AdminRole           *adminrole.FwAdminRole
+	Administrator       *admin.FwAdmin
 	EmailServer         *emailsrv.FwServer
 	EmailServerProfile  *email.FwEmail
 	GeneralSettings     *general.FwGeneral
@@ -27,16 +34,25 @@ type FwDev struct {
 	HttpParam           *param.FwParam
 	HttpServer          *httpsrv.FwServer
 	HttpServerProfile   *http.FwHttp
+	Logging             *logging.FwLogging
+	SessionSettings     *session.FwSession
 	SnmpServerProfile   *snmp.FwSnmp
 	SnmpV2cServer       *v2c.FwV2c
 	SnmpV3Server        *v3.FwV3
 	SyslogServer        *syslogsrv.FwServer
 	SyslogServerProfile *syslog.FwSyslog
 	Telemetry           *telemetry.FwTelemetry
+	UpdateSchedule      *updateschedule.FwUpdateSchedule
 }
 
 // Initialize is invoked on client.Initialize().
 func (c *FwDev) Initialize(i util.XapiClient) {
+	c.AdminRole = &adminrole.FwAdminRole{}
+	c.AdminRole.Initialize(i)
+
+	c.Administrator = &admin.FwAdmin{}
+	c.Administrator.Initialize(i)
+
 	c.EmailServer = &emailsrv.FwServer{}
 	c.EmailServer.Initialize(i)
 
@@ -58,6 +74,12 @@ func (c *FwDev) Initialize(i util.XapiClient) {
 	c.HttpServerProfile = &http.FwHttp{}
 	c.HttpServerProfile.Initialize(i)
 
+	c.Logging = &logging.FwLogging{}
+	c.Logging.Initialize(i)
+
+	c.SessionSettings = &session.FwSession{}
+	c.SessionSettings.Initialize(i)
+
 	c.SnmpServerProfile = &snmp.FwSnmp{}
 	c.SnmpServerProfile.Initialize(i)
 
@@ -75,4 +97,7 @@ func (c *FwDev) Initialize(i util.XapiClient) {
 
 	c.Telemetry = &telemetry.FwTelemetry{}
 	c.Telemetry.Initialize(i)
+
+	c.UpdateSchedule = &updateschedule.FwUpdateSchedule{}
+	c.UpdateSchedule.Initialize(i)
 }
@@ -0,0 +1,16 @@
+package logging
+
+// Valid values for the keys of Settings.DiskQuotas.
+const (
+	LogTypeTraffic       = "traffic"
+	LogTypeThreat        = "threat"
+	LogTypeConfig        = "config"
+	LogTypeSystem        = "system"
+	LogTypeAlarm         = "alarm"
+	LogTypeHipMatch      = "hipmatch"
+	LogTypeUserId        = "userid"
+	LogTypeGtp           = "gtp"
+	LogTypeAuth          = "auth"
+	LogTypeDecryption    = "decryption"
+	LogTypeGlobalProtect = "globalprotect"
+)
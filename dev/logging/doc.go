@@ -0,0 +1,13 @@
+/*
+Package logging is the firewall.Device.Logging and panorama.Device.Logging
+namespace.
+
+This namespace handles how a device forwards its logs to Panorama or to log
+collectors: forwarding under high dataplane load, high speed log forwarding,
+buffered log forwarding, and per-log-type disk quotas.  The Panorama variant
+configures these settings in a template or template stack, and additionally
+manages the per-device collector preference lists of a collector group.
+
+Normalized object: Settings
+*/
+package logging
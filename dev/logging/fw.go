@@ -0,0 +1,113 @@
+package logging
+
+import (
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwLogging is a namespace struct, included as part of pango.Firewall.
+type FwLogging struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *FwLogging) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Show performs SHOW to retrieve the log forwarding settings.
+func (c *FwLogging) Show() (Settings, error) {
+	c.con.LogQuery("(show) log forwarding settings")
+	return c.details(c.con.Show)
+}
+
+// Get performs GET to retrieve the log forwarding settings.
+func (c *FwLogging) Get() (Settings, error) {
+	c.con.LogQuery("(get) log forwarding settings")
+	return c.details(c.con.Get)
+}
+
+// Set performs SET to update the log forwarding settings.
+func (c *FwLogging) Set(e Settings) error {
+	var err error
+	_, fn := c.versioning()
+	c.con.LogAction("(set) log forwarding settings")
+
+	path := c.xpath()
+	path = path[:len(path)-1]
+
+	_, err = c.con.Set(path, fn(e), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to update the log forwarding settings.
+//
+// Only the log forwarding settings are replaced; the other settings in the
+// management settings container are left as-is.
+func (c *FwLogging) Edit(e Settings) error {
+	c.con.LogAction("(edit) log forwarding settings")
+	base := c.xpath()
+
+	for _, v := range editNodes(e) {
+		path := make([]string, 0, len(base)+len(v.path))
+		path = append(path, base...)
+		path = append(path, v.path...)
+
+		var err error
+		if v.elm == nil {
+			_, err = c.con.Delete(path, nil, nil)
+		} else {
+			_, err = c.con.Edit(path, v.elm, nil, nil)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Delete removes the log forwarding settings, reverting them to their
+// defaults.
+func (c *FwLogging) Delete() error {
+	c.con.LogAction("(delete) log forwarding settings")
+	base := c.xpath()
+
+	for _, v := range deleteNodes {
+		path := make([]string, 0, len(base)+len(v))
+		path = append(path, base...)
+		path = append(path, v...)
+		if _, err := c.con.Delete(path, nil, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/** Internal functions for the FwLogging struct **/
+
+func (c *FwLogging) versioning() (normalizer, func(Settings) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwLogging) details(fn util.Retriever) (Settings, error) {
+	path := c.xpath()
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Settings{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *FwLogging) xpath() []string {
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"deviceconfig",
+		"setting",
+		"management",
+	}
+}
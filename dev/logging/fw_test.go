@@ -0,0 +1,92 @@
+package logging
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestFwNormalization(t *testing.T) {
+	testCases := []struct {
+		desc string
+		conf Settings
+	}{
+		{"all no", Settings{}},
+		{"all yes", Settings{
+			EnableLogHighDpLoad:          true,
+			EnableHighSpeedLogForwarding: true,
+			BufferedLogForwarding:        true,
+		}},
+		{"with quotas", Settings{
+			BufferedLogForwarding: true,
+			DiskQuotas: map[string]int{
+				LogTypeTraffic: 32,
+				LogTypeThreat:  16,
+				LogTypeConfig:  4,
+			},
+		}},
+	}
+
+	mc := &testdata.MockClient{}
+	ns := &FwLogging{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("")
+			err := ns.Set(tc.conf)
+			if err != nil {
+				t.Errorf("Error in set: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get()
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				} else if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
+
+func TestFwEditOnlyReplacesLogForwardingNodes(t *testing.T) {
+	base := "/config/devices/entry[@name='localhost.localdomain']/deviceconfig/setting/management"
+	testCases := []struct {
+		desc string
+		conf Settings
+		fn   string
+		elm  string
+	}{
+		{"without quotas", Settings{BufferedLogForwarding: true}, "delete", ""},
+		{"with quotas", Settings{DiskQuotas: map[string]int{LogTypeTraffic: 32, LogTypeConfig: 4}}, "edit",
+			"<disk-quota><config>4</config><traffic>32</traffic></disk-quota>"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc := &testdata.MockClient{}
+			mc.AddResp("")
+			ns := &FwLogging{}
+			ns.Initialize(mc)
+
+			if err := ns.Edit(tc.conf); err != nil {
+				t.Fatalf("Error in edit: %s", err)
+			}
+			if mc.Called != 4 {
+				t.Errorf("Expected 4 calls, got %d", mc.Called)
+			}
+			if mc.Function != tc.fn {
+				t.Errorf("Last call is %s, not %s", mc.Function, tc.fn)
+			}
+			if mc.Path != base+"/quota-settings/disk-quota" {
+				t.Errorf("Last path is %s", mc.Path)
+			}
+			if tc.elm != "" && mc.Elm != tc.elm {
+				t.Errorf("Last element is %s, not %s", mc.Elm, tc.elm)
+			}
+		})
+	}
+}
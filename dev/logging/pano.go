@@ -0,0 +1,186 @@
+package logging
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PanoLogging is a namespace struct, included as part of pango.Panorama.
+type PanoLogging struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *PanoLogging) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Show performs SHOW to retrieve the log forwarding settings.
+func (c *PanoLogging) Show(tmpl, ts string) (Settings, error) {
+	c.con.LogQuery("(show) log forwarding settings")
+	return c.details(c.con.Show, tmpl, ts)
+}
+
+// Get performs GET to retrieve the log forwarding settings.
+func (c *PanoLogging) Get(tmpl, ts string) (Settings, error) {
+	c.con.LogQuery("(get) log forwarding settings")
+	return c.details(c.con.Get, tmpl, ts)
+}
+
+// Set performs SET to update the log forwarding settings.
+func (c *PanoLogging) Set(tmpl, ts string, e Settings) error {
+	var err error
+	_, fn := c.versioning()
+	c.con.LogAction("(set) log forwarding settings")
+
+	path := c.xpath(tmpl, ts)
+	path = path[:len(path)-1]
+
+	_, err = c.con.Set(path, fn(e), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to update the log forwarding settings.
+//
+// Only the log forwarding settings are replaced; the other settings in the
+// management settings container are left as-is.
+func (c *PanoLogging) Edit(tmpl, ts string, e Settings) error {
+	c.con.LogAction("(edit) log forwarding settings")
+	base := c.xpath(tmpl, ts)
+
+	for _, v := range editNodes(e) {
+		path := make([]string, 0, len(base)+len(v.path))
+		path = append(path, base...)
+		path = append(path, v.path...)
+
+		var err error
+		if v.elm == nil {
+			_, err = c.con.Delete(path, nil, nil)
+		} else {
+			_, err = c.con.Edit(path, v.elm, nil, nil)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Delete removes the log forwarding settings, reverting them to their
+// defaults.
+func (c *PanoLogging) Delete(tmpl, ts string) error {
+	c.con.LogAction("(delete) log forwarding settings")
+	base := c.xpath(tmpl, ts)
+
+	for _, v := range deleteNodes {
+		path := make([]string, 0, len(base)+len(v))
+		path = append(path, base...)
+		path = append(path, v...)
+		if _, err := c.con.Delete(path, nil, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetCollectorPreference returns the ordered list of log collectors that
+// the given managed device forwards its logs to within collector group cg.
+func (c *PanoLogging) GetCollectorPreference(cg, serial string) ([]string, error) {
+	c.con.LogQuery("(get) collector preference for %q in collector group %q", serial, cg)
+
+	type prefResp struct {
+		Collectors *util.MemberType `xml:"result>collectors"`
+	}
+
+	path := c.preferenceXpath(cg, serial)
+	path = append(path, "collectors")
+
+	ans := prefResp{}
+	if _, err := c.con.Get(path, nil, &ans); err != nil {
+		return nil, err
+	}
+
+	return util.MemToStr(ans.Collectors), nil
+}
+
+// EditCollectorPreference sets the ordered list of log collectors (by
+// serial number) that the given managed device forwards its logs to within
+// collector group cg.  The first collector is the most preferred.
+func (c *PanoLogging) EditCollectorPreference(cg, serial string, collectors []string) error {
+	c.con.LogAction("(edit) collector preference for %q in collector group %q: %v", serial, cg, collectors)
+
+	type prefReq struct {
+		XMLName    xml.Name         `xml:"entry"`
+		Name       string           `xml:"name,attr"`
+		Collectors *util.MemberType `xml:"collectors"`
+	}
+
+	d := prefReq{
+		Name:       serial,
+		Collectors: util.StrToMem(collectors),
+	}
+
+	path := c.preferenceXpath(cg, serial)
+
+	_, err := c.con.Edit(path, d, nil, nil)
+	return err
+}
+
+// DeleteCollectorPreference removes the given managed device from the log
+// forwarding preference list of collector group cg.
+func (c *PanoLogging) DeleteCollectorPreference(cg, serial string) error {
+	c.con.LogAction("(delete) collector preference for %q in collector group %q", serial, cg)
+
+	path := c.preferenceXpath(cg, serial)
+
+	_, err := c.con.Delete(path, nil, nil)
+	return err
+}
+
+/** Internal functions for the PanoLogging struct **/
+
+func (c *PanoLogging) versioning() (normalizer, func(Settings) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *PanoLogging) details(fn util.Retriever, tmpl, ts string) (Settings, error) {
+	path := c.xpath(tmpl, ts)
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Settings{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *PanoLogging) xpath(tmpl, ts string) []string {
+	ans := make([]string, 0, 11)
+	ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"deviceconfig",
+		"setting",
+		"management",
+	)
+
+	return ans
+}
+
+func (c *PanoLogging) preferenceXpath(cg, serial string) []string {
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"log-collector-group",
+		util.AsEntryXpath([]string{cg}),
+		"logfwd-setting",
+		"devices",
+		util.AsEntryXpath([]string{serial}),
+	}
+}
@@ -0,0 +1,63 @@
+package logging
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestPanoNormalization(t *testing.T) {
+	conf := Settings{
+		EnableLogHighDpLoad: true,
+		DiskQuotas: map[string]int{
+			LogTypeTraffic: 40,
+		},
+	}
+
+	mc := &testdata.MockClient{}
+	ns := &PanoLogging{}
+	ns.Initialize(mc)
+
+	mc.AddResp("")
+	if err := ns.Set("t1", "", conf); err != nil {
+		t.Fatalf("Error in set: %s", err)
+	}
+	path := mc.Path
+	if !strings.Contains(path, "template/entry[@name='t1']/config/devices") {
+		t.Errorf("Not a template path: %s", path)
+	}
+
+	mc.AddResp(mc.Elm)
+	r, err := ns.Get("t1", "")
+	if err != nil {
+		t.Fatalf("Error in get: %s", err)
+	} else if !reflect.DeepEqual(conf, r) {
+		t.Errorf("%#v != %#v", conf, r)
+	}
+}
+
+func TestPanoCollectorPreference(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &PanoLogging{}
+	ns.Initialize(mc)
+
+	mc.AddResp("")
+	if err := ns.EditCollectorPreference("cg1", "0001", []string{"c1", "c2"}); err != nil {
+		t.Fatalf("Error in edit: %s", err)
+	}
+	path := mc.Path
+	if !strings.HasSuffix(path, "log-collector-group/entry[@name='cg1']/logfwd-setting/devices/entry[@name='0001']") {
+		t.Errorf("Wrong path: %s", path)
+	}
+
+	mc.Reset()
+	mc.AddResp("<collectors><member>c1</member><member>c2</member></collectors>")
+	list, err := ns.GetCollectorPreference("cg1", "0001")
+	if err != nil {
+		t.Fatalf("Error in get: %s", err)
+	} else if !reflect.DeepEqual(list, []string{"c1", "c2"}) {
+		t.Errorf("Wrong list: %v", list)
+	}
+}
@@ -0,0 +1,158 @@
+package logging
+
+import (
+	"encoding/xml"
+	"sort"
+	"strconv"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Settings is a normalized, version independent representation of a
+// device's log forwarding settings.
+//
+// DiskQuotas maps a log type (see the LogType constants) to the percentage
+// of the log disk reserved for that log type.
+type Settings struct {
+	EnableLogHighDpLoad          bool
+	EnableHighSpeedLogForwarding bool
+	BufferedLogForwarding        bool
+	DiskQuotas                   map[string]int
+}
+
+// Copy copies the information from source Settings `s` to this object.
+func (o *Settings) Copy(s Settings) {
+	o.EnableLogHighDpLoad = s.EnableLogHighDpLoad
+	o.EnableHighSpeedLogForwarding = s.EnableHighSpeedLogForwarding
+	o.BufferedLogForwarding = s.BufferedLogForwarding
+	if s.DiskQuotas == nil {
+		o.DiskQuotas = nil
+	} else {
+		o.DiskQuotas = make(map[string]int, len(s.DiskQuotas))
+		for k, v := range s.DiskQuotas {
+			o.DiskQuotas[k] = v
+		}
+	}
+}
+
+/** Structs / functions for normalization. **/
+
+type normalizer interface {
+	Normalize() Settings
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>management"`
+}
+
+func (o *container_v1) Normalize() Settings {
+	ans := Settings{
+		EnableLogHighDpLoad:          util.AsBool(o.Answer.EnableLogHighDpLoad),
+		EnableHighSpeedLogForwarding: util.AsBool(o.Answer.EnableHighSpeedLogForwarding),
+		BufferedLogForwarding:        util.AsBool(o.Answer.BufferedLogForwarding),
+	}
+
+	if o.Answer.Quota != nil && len(o.Answer.Quota.Entries) > 0 {
+		ans.DiskQuotas = make(map[string]int, len(o.Answer.Quota.Entries))
+		for _, q := range o.Answer.Quota.Entries {
+			ans.DiskQuotas[q.XMLName.Local], _ = strconv.Atoi(q.Value)
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName                      xml.Name `xml:"management"`
+	EnableLogHighDpLoad          string   `xml:"enable-log-high-dp-load"`
+	EnableHighSpeedLogForwarding string   `xml:"enable-high-speed-log-forwarding"`
+	BufferedLogForwarding        string   `xml:"enable-buffered-log-forwarding"`
+	Quota                        *quota   `xml:"quota-settings>disk-quota"`
+}
+
+type quota struct {
+	XMLName xml.Name     `xml:"disk-quota"`
+	Entries []quotaEntry `xml:",any"`
+}
+
+type quotaEntry struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
+func specify_v1(e Settings) interface{} {
+	ans := entry_v1{
+		EnableLogHighDpLoad:          util.YesNo(e.EnableLogHighDpLoad),
+		EnableHighSpeedLogForwarding: util.YesNo(e.EnableHighSpeedLogForwarding),
+		BufferedLogForwarding:        util.YesNo(e.BufferedLogForwarding),
+	}
+
+	if len(e.DiskQuotas) > 0 {
+		keys := make([]string, 0, len(e.DiskQuotas))
+		for k := range e.DiskQuotas {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		ans.Quota = &quota{Entries: make([]quotaEntry, 0, len(keys))}
+		for _, k := range keys {
+			ans.Quota.Entries = append(ans.Quota.Entries, quotaEntry{
+				XMLName: xml.Name{Local: k},
+				Value:   strconv.Itoa(e.DiskQuotas[k]),
+			})
+		}
+	}
+
+	return ans
+}
+
+// leaf is a single element with a text value.
+type leaf struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
+// editNode is a node under the management container and the element to EDIT
+// it with.  If elm is nil, then the node is deleted instead.
+type editNode struct {
+	path []string
+	elm  interface{}
+}
+
+// editNodes returns the nodes under the management container that Edit
+// replaces, as the container itself holds unrelated settings.
+func editNodes(e Settings) []editNode {
+	spec := specify_v1(e).(entry_v1)
+
+	ans := make([]editNode, 0, len(deleteNodes))
+	for _, v := range []struct {
+		name  string
+		value string
+	}{
+		{"enable-log-high-dp-load", spec.EnableLogHighDpLoad},
+		{"enable-high-speed-log-forwarding", spec.EnableHighSpeedLogForwarding},
+		{"enable-buffered-log-forwarding", spec.BufferedLogForwarding},
+	} {
+		ans = append(ans, editNode{
+			path: []string{v.name},
+			elm:  leaf{XMLName: xml.Name{Local: v.name}, Value: v.value},
+		})
+	}
+
+	q := editNode{path: []string{"quota-settings", "disk-quota"}}
+	if spec.Quota != nil {
+		q.elm = spec.Quota
+	}
+	ans = append(ans, q)
+
+	return ans
+}
+
+// deleteNodes are the nodes under the management container that Delete
+// removes, as the container itself holds unrelated settings.
+var deleteNodes = [][]string{
+	{"enable-log-high-dp-load"},
+	{"enable-high-speed-log-forwarding"},
+	{"enable-buffered-log-forwarding"},
+	{"quota-settings", "disk-quota"},
+}
@@ -3,6 +3,10 @@ package dev
 import (
 	"github.com/PaloAltoNetworks/pango/util"
 
+	"github.com/PaloAltoNetworks/pango/dev/admin"
+	"github.com/PaloAltoNetworks/pango/dev/adminrole"
+	"github.com/PaloAltoNetworks/pango/dev/configexport"
+	"github.com/PaloAltoNetworks/pango/dev/logging"
 	"github.com/PaloAltoNetworks/pango/dev/profile/email"
 	emailsrv "github.com/PaloAltoNetworks/pango/dev/profile/email/server"
 	"github.com/PaloAltoNetworks/pango/dev/profile/http"
@@ -14,25 +18,39 @@ import (
 	"github.com/PaloAltoNetworks/pango/dev/profile/snmp/v3"
 	"github.com/PaloAltoNetworks/pango/dev/profile/syslog"
 	syslogsrv "github.com/PaloAltoNetworks/pango/dev/profile/syslog/server"
+	"github.com/PaloAltoNetworks/pango/dev/session"
+	"github.com/PaloAltoNetworks/pango/dev/updateschedule"
 )
 
 // PanoDev is the client.Device namespace.
 type PanoDev struct {
-	EmailServer         *emailsrv.PanoServer
-	EmailServerProfile  *email.PanoEmail
-	HttpHeader          *header.PanoHeader
-	HttpParam           *param.PanoParam
-	HttpServer          *httpsrv.PanoServer
-	HttpServerProfile   *http.PanoHttp
-	SnmpServerProfile   *snmp.PanoSnmp
-	SnmpV2cServer       *v2c.PanoV2c
-	SnmpV3Server        *v3.PanoV3
-	SyslogServer        *syslogsrv.PanoServer
-	SyslogServerProfile *syslog.PanoSyslog
+	AdminRole             *adminrole.PanoAdminRole
+	Administrator         *admin.PanoAdmin
+	EmailServer           *emailsrv.PanoServer
+	EmailServerProfile    *email.PanoEmail
+	HttpHeader            *header.PanoHeader
+	HttpParam             *param.PanoParam
+	HttpServer            *httpsrv.PanoServer
+	HttpServerProfile     *http.PanoHttp
+	Logging               *logging.PanoLogging
+	ScheduledConfigExport *configexport.PanoConfigExport
+	SessionSettings       *session.PanoSession
+	SnmpServerProfile     *snmp.PanoSnmp
+	SnmpV2cServer         *v2c.PanoV2c
+	SnmpV3Server          *v3.PanoV3
+	SyslogServer          *syslogsrv.PanoServer
+	SyslogServerProfile   *syslog.PanoSyslog
+	UpdateSchedule        *updateschedule.PanoUpdateSchedule
 }
 
 // Initialize is invoked on client.Initialize().
 func (c *PanoDev) Initialize(i util.XapiClient) {
+	c.AdminRole = &adminrole.PanoAdminRole{}
+	c.AdminRole.Initialize(i)
+
+	c.Administrator = &admin.PanoAdmin{}
+	c.Administrator.Initialize(i)
+
 	c.EmailServer = &emailsrv.PanoServer{}
 	c.EmailServer.Initialize(i)
 
@@ -51,6 +69,15 @@ func (c *PanoDev) Initialize(i util.XapiClient) {
 	c.HttpServerProfile = &http.PanoHttp{}
 	c.HttpServerProfile.Initialize(i)
 
+	c.Logging = &logging.PanoLogging{}
+	c.Logging.Initialize(i)
+
+	c.ScheduledConfigExport = &configexport.PanoConfigExport{}
+	c.ScheduledConfigExport.Initialize(i)
+
+	c.SessionSettings = &session.PanoSession{}
+	c.SessionSettings.Initialize(i)
+
 	c.SnmpServerProfile = &snmp.PanoSnmp{}
 	c.SnmpServerProfile.Initialize(i)
 
@@ -65,4 +92,7 @@ func (c *PanoDev) Initialize(i util.XapiClient) {
 
 	c.SyslogServerProfile = &syslog.PanoSyslog{}
 	c.SyslogServerProfile.Initialize(i)
+
+	c.UpdateSchedule = &updateschedule.PanoUpdateSchedule{}
+	c.UpdateSchedule.Initialize(i)
 }
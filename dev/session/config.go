@@ -0,0 +1,266 @@
+package session
+
+import (
+	"encoding/xml"
+	"sort"
+	"strings"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Config is a normalized, version independent representation of a device's
+// session settings.
+//
+// Timeouts are in seconds.  Any timeout left as 0 is not sent to PAN-OS, so
+// the PAN-OS default is used.
+//
+// Set merges this config into the device settings, so all other device
+// settings are left as-is.  Edit replaces only the session, config, and (if
+// JumboFrameMtu is set) jumbo-frame settings nodes, leaving the other device
+// settings as-is.  The settings under those nodes that this namespace does
+// not manage are preserved by Edit only if this Config was retrieved with
+// Get or Show first.
+type Config struct {
+	TimeoutDefault                int
+	TimeoutDiscardDefault         int
+	TimeoutDiscardTcp             int
+	TimeoutDiscardUdp             int
+	TimeoutIcmp                   int
+	TimeoutScan                   int
+	TimeoutTcp                    int
+	TimeoutTcpHandshake           int // XML: timeout-tcphandshake
+	TimeoutTcpInit                int // XML: timeout-tcpinit
+	TimeoutTcpHalfClosed          int
+	TimeoutTcpTimeWait            int
+	TimeoutTcpUnverifiedRst       int
+	TimeoutUdp                    int
+	TimeoutCaptivePortal          int
+	AcceleratedAging              bool // XML: accelerated-aging-enable
+	AcceleratedAgingThreshold     int
+	AcceleratedAgingScalingFactor int
+	Ipv6Firewalling               bool
+	Rematch                       bool // XML: config/rematch
+	JumboFrameMtu                 int  // XML: jumbo-frame/mtu
+
+	raw map[string]string
+}
+
+// Copy copies the information from source Config `s` to this object.
+func (o *Config) Copy(s Config) {
+	o.TimeoutDefault = s.TimeoutDefault
+	o.TimeoutDiscardDefault = s.TimeoutDiscardDefault
+	o.TimeoutDiscardTcp = s.TimeoutDiscardTcp
+	o.TimeoutDiscardUdp = s.TimeoutDiscardUdp
+	o.TimeoutIcmp = s.TimeoutIcmp
+	o.TimeoutScan = s.TimeoutScan
+	o.TimeoutTcp = s.TimeoutTcp
+	o.TimeoutTcpHandshake = s.TimeoutTcpHandshake
+	o.TimeoutTcpInit = s.TimeoutTcpInit
+	o.TimeoutTcpHalfClosed = s.TimeoutTcpHalfClosed
+	o.TimeoutTcpTimeWait = s.TimeoutTcpTimeWait
+	o.TimeoutTcpUnverifiedRst = s.TimeoutTcpUnverifiedRst
+	o.TimeoutUdp = s.TimeoutUdp
+	o.TimeoutCaptivePortal = s.TimeoutCaptivePortal
+	o.AcceleratedAging = s.AcceleratedAging
+	o.AcceleratedAgingThreshold = s.AcceleratedAgingThreshold
+	o.AcceleratedAgingScalingFactor = s.AcceleratedAgingScalingFactor
+	o.Ipv6Firewalling = s.Ipv6Firewalling
+	o.Rematch = s.Rematch
+	o.JumboFrameMtu = s.JumboFrameMtu
+}
+
+/** Structs / functions for normalization. **/
+
+type normalizer interface {
+	Normalize() Config
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>setting"`
+}
+
+func (o *container_v1) Normalize() Config {
+	ans := Config{}
+	raw := make(map[string]string)
+
+	if o.Answer.Session != nil {
+		s := o.Answer.Session
+		ans.TimeoutDefault = s.TimeoutDefault
+		ans.TimeoutDiscardDefault = s.TimeoutDiscardDefault
+		ans.TimeoutDiscardTcp = s.TimeoutDiscardTcp
+		ans.TimeoutDiscardUdp = s.TimeoutDiscardUdp
+		ans.TimeoutIcmp = s.TimeoutIcmp
+		ans.TimeoutScan = s.TimeoutScan
+		ans.TimeoutTcp = s.TimeoutTcp
+		ans.TimeoutTcpHandshake = s.TimeoutTcpHandshake
+		ans.TimeoutTcpInit = s.TimeoutTcpInit
+		ans.TimeoutTcpHalfClosed = s.TimeoutTcpHalfClosed
+		ans.TimeoutTcpTimeWait = s.TimeoutTcpTimeWait
+		ans.TimeoutTcpUnverifiedRst = s.TimeoutTcpUnverifiedRst
+		ans.TimeoutUdp = s.TimeoutUdp
+		ans.TimeoutCaptivePortal = s.TimeoutCaptivePortal
+		ans.AcceleratedAging = util.AsBool(s.AcceleratedAging)
+		ans.AcceleratedAgingThreshold = s.AcceleratedAgingThreshold
+		ans.AcceleratedAgingScalingFactor = s.AcceleratedAgingScalingFactor
+		ans.Ipv6Firewalling = util.AsBool(s.Ipv6Firewalling)
+		normalizeOther("session/", s.Other, raw)
+	}
+
+	if o.Answer.Config != nil {
+		ans.Rematch = util.AsBool(o.Answer.Config.Rematch)
+		normalizeOther("config/", o.Answer.Config.Other, raw)
+	}
+
+	if o.Answer.JumboFrame != nil {
+		ans.JumboFrameMtu = o.Answer.JumboFrame.Mtu
+		normalizeOther("jumbo-frame/", o.Answer.JumboFrame.Other, raw)
+	}
+
+	normalizeOther("", o.Answer.Other, raw)
+
+	if len(raw) != 0 {
+		ans.raw = raw
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName    xml.Name    `xml:"setting"`
+	Session    *session    `xml:"session"`
+	Config     *cfg        `xml:"config"`
+	JumboFrame *jumboFrame `xml:"jumbo-frame"`
+	Other      []other     `xml:",any"`
+}
+
+type session struct {
+	XMLName                       xml.Name `xml:"session"`
+	TimeoutDefault                int      `xml:"timeout-default,omitempty"`
+	TimeoutDiscardDefault         int      `xml:"timeout-discard-default,omitempty"`
+	TimeoutDiscardTcp             int      `xml:"timeout-discard-tcp,omitempty"`
+	TimeoutDiscardUdp             int      `xml:"timeout-discard-udp,omitempty"`
+	TimeoutIcmp                   int      `xml:"timeout-icmp,omitempty"`
+	TimeoutScan                   int      `xml:"timeout-scan,omitempty"`
+	TimeoutTcp                    int      `xml:"timeout-tcp,omitempty"`
+	TimeoutTcpHandshake           int      `xml:"timeout-tcphandshake,omitempty"`
+	TimeoutTcpInit                int      `xml:"timeout-tcpinit,omitempty"`
+	TimeoutTcpHalfClosed          int      `xml:"timeout-tcp-half-closed,omitempty"`
+	TimeoutTcpTimeWait            int      `xml:"timeout-tcp-time-wait,omitempty"`
+	TimeoutTcpUnverifiedRst       int      `xml:"timeout-tcp-unverified-rst,omitempty"`
+	TimeoutUdp                    int      `xml:"timeout-udp,omitempty"`
+	TimeoutCaptivePortal          int      `xml:"timeout-captive-portal,omitempty"`
+	AcceleratedAging              string   `xml:"accelerated-aging-enable"`
+	AcceleratedAgingThreshold     int      `xml:"accelerated-aging-threshold,omitempty"`
+	AcceleratedAgingScalingFactor int      `xml:"accelerated-aging-scaling-factor,omitempty"`
+	Ipv6Firewalling               string   `xml:"ipv6-firewalling"`
+	Other                         []other  `xml:",any"`
+}
+
+type cfg struct {
+	XMLName xml.Name `xml:"config"`
+	Rematch string   `xml:"rematch"`
+	Other   []other  `xml:",any"`
+}
+
+type jumboFrame struct {
+	XMLName xml.Name `xml:"jumbo-frame"`
+	Mtu     int      `xml:"mtu,omitempty"`
+	Other   []other  `xml:",any"`
+}
+
+// other is any element that this namespace does not otherwise manage.
+type other struct {
+	XMLName xml.Name
+	Text    string `xml:",innerxml"`
+}
+
+func normalizeOther(prefix string, list []other, raw map[string]string) {
+	for _, x := range list {
+		raw[prefix+x.XMLName.Local] = util.CleanRawXml(x.Text)
+	}
+}
+
+func specifyOther(prefix string, raw map[string]string) []other {
+	keys := make([]string, 0, len(raw))
+	for key := range raw {
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		name := strings.TrimPrefix(key, prefix)
+		if name == "" || strings.Contains(name, "/") {
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return nil
+	}
+
+	sort.Strings(keys)
+	ans := make([]other, 0, len(keys))
+	for _, key := range keys {
+		ans = append(ans, other{
+			XMLName: xml.Name{Local: strings.TrimPrefix(key, prefix)},
+			Text:    raw[key],
+		})
+	}
+
+	return ans
+}
+
+func specify_v1(e Config) interface{} {
+	ans := entry_v1{
+		Session: &session{
+			TimeoutDefault:                e.TimeoutDefault,
+			TimeoutDiscardDefault:         e.TimeoutDiscardDefault,
+			TimeoutDiscardTcp:             e.TimeoutDiscardTcp,
+			TimeoutDiscardUdp:             e.TimeoutDiscardUdp,
+			TimeoutIcmp:                   e.TimeoutIcmp,
+			TimeoutScan:                   e.TimeoutScan,
+			TimeoutTcp:                    e.TimeoutTcp,
+			TimeoutTcpHandshake:           e.TimeoutTcpHandshake,
+			TimeoutTcpInit:                e.TimeoutTcpInit,
+			TimeoutTcpHalfClosed:          e.TimeoutTcpHalfClosed,
+			TimeoutTcpTimeWait:            e.TimeoutTcpTimeWait,
+			TimeoutTcpUnverifiedRst:       e.TimeoutTcpUnverifiedRst,
+			TimeoutUdp:                    e.TimeoutUdp,
+			TimeoutCaptivePortal:          e.TimeoutCaptivePortal,
+			AcceleratedAging:              util.YesNo(e.AcceleratedAging),
+			AcceleratedAgingThreshold:     e.AcceleratedAgingThreshold,
+			AcceleratedAgingScalingFactor: e.AcceleratedAgingScalingFactor,
+			Ipv6Firewalling:               util.YesNo(e.Ipv6Firewalling),
+			Other:                         specifyOther("session/", e.raw),
+		},
+		Config: &cfg{
+			Rematch: util.YesNo(e.Rematch),
+			Other:   specifyOther("config/", e.raw),
+		},
+		Other: specifyOther("", e.raw),
+	}
+
+	jf := specifyOther("jumbo-frame/", e.raw)
+	if e.JumboFrameMtu != 0 || len(jf) != 0 {
+		ans.JumboFrame = &jumboFrame{
+			Mtu:   e.JumboFrameMtu,
+			Other: jf,
+		}
+	}
+
+	return ans
+}
+
+// editNodes returns the names and specs of the setting nodes that Edit
+// replaces.
+func editNodes(spec interface{}) ([]string, []interface{}) {
+	e := spec.(entry_v1)
+	names := []string{"session", "config"}
+	elms := []interface{}{e.Session, e.Config}
+
+	if e.JumboFrame != nil {
+		names = append(names, "jumbo-frame")
+		elms = append(elms, e.JumboFrame)
+	}
+
+	return names, elms
+}
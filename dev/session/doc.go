@@ -0,0 +1,11 @@
+/*
+Package session is the client.Device.SessionSettings namespace.
+
+Session settings are dataplane wide, covering session timeouts, accelerated
+aging, IPv6 firewalling, rematching sessions on policy change, and the jumbo
+frame MTU.  On Panorama, these settings are configured in a template or
+template stack.
+
+Normalized object:  Config
+*/
+package session
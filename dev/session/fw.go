@@ -0,0 +1,85 @@
+package session
+
+import (
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwSession is a namespace struct, included as part of pango.Firewall.
+type FwSession struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *FwSession) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Show performs SHOW to retrieve session settings.
+func (c *FwSession) Show() (Config, error) {
+	c.con.LogQuery("(show) session settings")
+	return c.details(c.con.Show)
+}
+
+// Get performs GET to retrieve session settings.
+func (c *FwSession) Get() (Config, error) {
+	c.con.LogQuery("(get) session settings")
+	return c.details(c.con.Get)
+}
+
+// Set performs SET to update session settings.
+func (c *FwSession) Set(e Config) error {
+	var err error
+	_, fn := c.versioning()
+	c.con.LogAction("(set) session settings")
+
+	path := c.xpath()
+	path = path[:len(path)-1]
+
+	_, err = c.con.Set(path, fn(e), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to update session settings.
+//
+// Only the session, config, and jumbo-frame settings nodes are replaced.
+func (c *FwSession) Edit(e Config) error {
+	_, fn := c.versioning()
+	c.con.LogAction("(edit) session settings")
+
+	names, elms := editNodes(fn(e))
+	for i := range names {
+		path := append(c.xpath(), names[i])
+		if _, err := c.con.Edit(path, elms[i], nil, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/** Internal functions for the FwSession struct **/
+
+func (c *FwSession) versioning() (normalizer, func(Config) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwSession) details(fn util.Retriever) (Config, error) {
+	path := c.xpath()
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Config{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *FwSession) xpath() []string {
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"deviceconfig",
+		"setting",
+	}
+}
@@ -0,0 +1,72 @@
+package session
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestFwNormalization(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &FwSession{}
+	ns.Initialize(mc)
+
+	for _, tc := range getTests() {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("")
+			err := ns.Set(tc.conf)
+			if err != nil {
+				t.Errorf("Error in set: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get()
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				} else if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
+
+func TestFwEditOnlyReplacesManagedNodes(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		conf  Config
+		calls int
+		path  string
+		elm   string
+	}{
+		{"without jumbo frame", Config{Rematch: true}, 2,
+			"/config/devices/entry[@name='localhost.localdomain']/deviceconfig/setting/config",
+			"<config><rematch>yes</rematch></config>"},
+		{"with jumbo frame", Config{JumboFrameMtu: 9000}, 3,
+			"/config/devices/entry[@name='localhost.localdomain']/deviceconfig/setting/jumbo-frame",
+			"<jumbo-frame><mtu>9000</mtu></jumbo-frame>"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc := &testdata.MockClient{}
+			mc.AddResp("")
+			ns := &FwSession{}
+			ns.Initialize(mc)
+
+			if err := ns.Edit(tc.conf); err != nil {
+				t.Fatalf("Error in edit: %s", err)
+			}
+			if mc.Called != tc.calls {
+				t.Errorf("Expected %d edits, got %d", tc.calls, mc.Called)
+			}
+			if mc.Path != tc.path {
+				t.Errorf("Last edit path is %s, not %s", mc.Path, tc.path)
+			}
+			if mc.Elm != tc.elm {
+				t.Errorf("Last edit element is %s, not %s", mc.Elm, tc.elm)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package session
+
+import (
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PanoSession is a namespace struct, included as part of pango.Panorama.
+type PanoSession struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *PanoSession) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Show performs SHOW to retrieve session settings.
+func (c *PanoSession) Show(tmpl, ts string) (Config, error) {
+	c.con.LogQuery("(show) session settings")
+	return c.details(c.con.Show, tmpl, ts)
+}
+
+// Get performs GET to retrieve session settings.
+func (c *PanoSession) Get(tmpl, ts string) (Config, error) {
+	c.con.LogQuery("(get) session settings")
+	return c.details(c.con.Get, tmpl, ts)
+}
+
+// Set performs SET to update session settings.
+func (c *PanoSession) Set(tmpl, ts string, e Config) error {
+	var err error
+	_, fn := c.versioning()
+	c.con.LogAction("(set) session settings")
+
+	path := c.xpath(tmpl, ts)
+	path = path[:len(path)-1]
+
+	_, err = c.con.Set(path, fn(e), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to update session settings.
+//
+// Only the session, config, and jumbo-frame settings nodes are replaced.
+func (c *PanoSession) Edit(tmpl, ts string, e Config) error {
+	_, fn := c.versioning()
+	c.con.LogAction("(edit) session settings")
+
+	names, elms := editNodes(fn(e))
+	for i := range names {
+		path := append(c.xpath(tmpl, ts), names[i])
+		if _, err := c.con.Edit(path, elms[i], nil, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/** Internal functions for the PanoSession struct **/
+
+func (c *PanoSession) versioning() (normalizer, func(Config) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *PanoSession) details(fn util.Retriever, tmpl, ts string) (Config, error) {
+	path := c.xpath(tmpl, ts)
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Config{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *PanoSession) xpath(tmpl, ts string) []string {
+	ans := make([]string, 0, 10)
+	ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"deviceconfig",
+		"setting",
+	)
+
+	return ans
+}
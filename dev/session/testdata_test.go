@@ -0,0 +1,49 @@
+package session
+
+func getTests() []testCase {
+	return []testCase{
+		{"all defaults", Config{}},
+		{"timeouts", Config{
+			TimeoutDefault:          30,
+			TimeoutDiscardDefault:   60,
+			TimeoutDiscardTcp:       90,
+			TimeoutDiscardUdp:       60,
+			TimeoutIcmp:             6,
+			TimeoutScan:             10,
+			TimeoutTcp:              3600,
+			TimeoutTcpHandshake:     10,
+			TimeoutTcpInit:          5,
+			TimeoutTcpHalfClosed:    120,
+			TimeoutTcpTimeWait:      15,
+			TimeoutTcpUnverifiedRst: 30,
+			TimeoutUdp:              30,
+			TimeoutCaptivePortal:    30,
+			Rematch:                 true,
+		}},
+		{"accelerated aging and ipv6", Config{
+			AcceleratedAging:              true,
+			AcceleratedAgingThreshold:     80,
+			AcceleratedAgingScalingFactor: 2,
+			Ipv6Firewalling:               true,
+		}},
+		{"jumbo frame", Config{
+			Rematch:       true,
+			JumboFrameMtu: 9192,
+		}},
+		{"with raw", Config{
+			TimeoutTcp:    3600,
+			JumboFrameMtu: 9000,
+			raw: map[string]string{
+				"session/icmp-unreachable-rate": "200",
+				"config/other-setting":          "<member>x</member>",
+				"tcp":                           "<bypass-exceed-oo-queue>no</bypass-exceed-oo-queue>",
+				"ctd":                           "<skip-block-http-range>yes</skip-block-http-range>",
+			},
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Config
+}
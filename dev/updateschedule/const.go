@@ -0,0 +1,31 @@
+package updateschedule
+
+// Valid values for the kind of dynamic update.  Application content is
+// part of KindThreats.
+const (
+	KindThreats   = "threats"
+	KindAntivirus = "anti-virus"
+	KindWildfire  = "wildfire"
+)
+
+// Valid values for Schedule.Recurrence.
+//
+// Every30Mins is only valid for KindThreats, while EveryMin, Every15Mins,
+// EveryHour, and RealTime are only valid for KindWildfire.
+const (
+	RecurrenceNone = "none"
+	EveryMin       = "every-min"
+	Every15Mins    = "every-15-mins"
+	Every30Mins    = "every-30-mins"
+	EveryHour      = "every-hour"
+	Hourly         = "hourly"
+	Daily          = "daily"
+	Weekly         = "weekly"
+	RealTime       = "real-time"
+)
+
+// Valid values for Schedule.Action.
+const (
+	ActionDownloadOnly       = "download-only"
+	ActionDownloadAndInstall = "download-and-install"
+)
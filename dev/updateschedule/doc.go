@@ -0,0 +1,13 @@
+/*
+Package updateschedule is the firewall.Device.UpdateSchedule and
+panorama.Device.UpdateSchedule namespace.
+
+This namespace configures when a device checks for, downloads, and installs
+dynamic updates.  Each kind of update (applications and threats, antivirus,
+and WildFire) has its own schedule, so the kind is given to each function.
+The Panorama variant configures the schedules in a template or template
+stack.
+
+Normalized object: Schedule
+*/
+package updateschedule
@@ -0,0 +1,118 @@
+package updateschedule
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Schedule is a normalized, version independent representation of the
+// schedule of a single kind of dynamic update.
+//
+// At is the minute past the hour for Every30Mins and Hourly, and "hh:mm"
+// for Daily and Weekly.  Threshold is the number of hours an update must have
+// been released before it is installed, while NewAppThreshold is the same
+// for updates that contain new applications (KindThreats only).
+type Schedule struct {
+	Recurrence      string
+	At              string
+	DayOfWeek       string
+	Action          string
+	Threshold       int
+	NewAppThreshold int
+	SyncToPeer      bool
+}
+
+// Copy copies the information from source Schedule `s` to this object.
+func (o *Schedule) Copy(s Schedule) {
+	o.Recurrence = s.Recurrence
+	o.At = s.At
+	o.DayOfWeek = s.DayOfWeek
+	o.Action = s.Action
+	o.Threshold = s.Threshold
+	o.NewAppThreshold = s.NewAppThreshold
+	o.SyncToPeer = s.SyncToPeer
+}
+
+/** Structs / functions for normalization. **/
+
+type normalizer interface {
+	Normalize() Schedule
+}
+
+type container_v1 struct {
+	Answer answer_v1 `xml:"result"`
+}
+
+type answer_v1 struct {
+	Entry entry_v1 `xml:",any"`
+}
+
+func (o *container_v1) Normalize() Schedule {
+	r := o.Answer.Entry.Recurring
+	ans := Schedule{
+		Threshold:       r.Threshold,
+		NewAppThreshold: r.NewAppThreshold,
+		SyncToPeer:      util.AsBool(r.SyncToPeer),
+	}
+
+	if r.Interval != nil {
+		ans.Recurrence = r.Interval.XMLName.Local
+		ans.At = r.Interval.At
+		ans.DayOfWeek = r.Interval.DayOfWeek
+		ans.Action = r.Interval.Action
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName   xml.Name
+	Recurring recurring `xml:"recurring"`
+}
+
+type recurring struct {
+	SyncToPeer      string    `xml:"sync-to-peer,omitempty"`
+	Threshold       int       `xml:"threshold,omitempty"`
+	NewAppThreshold int       `xml:"new-app-threshold,omitempty"`
+	Interval        *interval `xml:",any"`
+}
+
+type interval struct {
+	XMLName   xml.Name
+	DayOfWeek string `xml:"day-of-week,omitempty"`
+	At        string `xml:"at,omitempty"`
+	Action    string `xml:"action,omitempty"`
+}
+
+func specify_v1(kind string, e Schedule) interface{} {
+	ans := entry_v1{
+		XMLName: xml.Name{Local: kind},
+		Recurring: recurring{
+			SyncToPeer:      util.YesNo(e.SyncToPeer),
+			Threshold:       e.Threshold,
+			NewAppThreshold: e.NewAppThreshold,
+		},
+	}
+
+	if e.Recurrence != "" {
+		ans.Recurring.Interval = &interval{
+			XMLName:   xml.Name{Local: e.Recurrence},
+			DayOfWeek: e.DayOfWeek,
+			At:        e.At,
+			Action:    e.Action,
+		}
+	}
+
+	return ans
+}
+
+func validKind(kind string) error {
+	switch kind {
+	case KindThreats, KindAntivirus, KindWildfire:
+		return nil
+	}
+
+	return fmt.Errorf("Invalid update kind: %q", kind)
+}
@@ -0,0 +1,108 @@
+package updateschedule
+
+import (
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwUpdateSchedule is a namespace struct, included as part of pango.Firewall.
+type FwUpdateSchedule struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *FwUpdateSchedule) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Show performs SHOW to retrieve the schedule of the given kind of update.
+func (c *FwUpdateSchedule) Show(kind string) (Schedule, error) {
+	c.con.LogQuery("(show) %s update schedule", kind)
+	return c.details(c.con.Show, kind)
+}
+
+// Get performs GET to retrieve the schedule of the given kind of update.
+func (c *FwUpdateSchedule) Get(kind string) (Schedule, error) {
+	c.con.LogQuery("(get) %s update schedule", kind)
+	return c.details(c.con.Get, kind)
+}
+
+// Set performs SET to update the schedule of the given kind of update.
+func (c *FwUpdateSchedule) Set(kind string, e Schedule) error {
+	var err error
+
+	if err = validKind(kind); err != nil {
+		return err
+	}
+
+	_, fn := c.versioning()
+	c.con.LogAction("(set) %s update schedule", kind)
+
+	path := c.xpath(kind)
+	path = path[:len(path)-1]
+
+	_, err = c.con.Set(path, fn(kind, e), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to update the schedule of the given kind of update.
+func (c *FwUpdateSchedule) Edit(kind string, e Schedule) error {
+	var err error
+
+	if err = validKind(kind); err != nil {
+		return err
+	}
+
+	_, fn := c.versioning()
+	c.con.LogAction("(edit) %s update schedule", kind)
+
+	path := c.xpath(kind)
+
+	_, err = c.con.Edit(path, fn(kind, e), nil, nil)
+	return err
+}
+
+// Delete removes the schedule of the given kind of update.
+func (c *FwUpdateSchedule) Delete(kind string) error {
+	if err := validKind(kind); err != nil {
+		return err
+	}
+
+	c.con.LogAction("(delete) %s update schedule", kind)
+	path := c.xpath(kind)
+
+	_, err := c.con.Delete(path, nil, nil)
+	return err
+}
+
+/** Internal functions for the FwUpdateSchedule struct **/
+
+func (c *FwUpdateSchedule) versioning() (normalizer, func(string, Schedule) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwUpdateSchedule) details(fn util.Retriever, kind string) (Schedule, error) {
+	if err := validKind(kind); err != nil {
+		return Schedule{}, err
+	}
+
+	path := c.xpath(kind)
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Schedule{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *FwUpdateSchedule) xpath(kind string) []string {
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"deviceconfig",
+		"system",
+		"update-schedule",
+		kind,
+	}
+}
@@ -0,0 +1,77 @@
+package updateschedule
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestFwNormalization(t *testing.T) {
+	testCases := []struct {
+		desc string
+		kind string
+		conf Schedule
+	}{
+		{"threats weekly", KindThreats, Schedule{
+			Recurrence:      Weekly,
+			DayOfWeek:       "sunday",
+			At:              "02:30",
+			Action:          ActionDownloadAndInstall,
+			Threshold:       48,
+			NewAppThreshold: 72,
+			SyncToPeer:      true,
+		}},
+		{"threats every 30 mins", KindThreats, Schedule{
+			Recurrence: Every30Mins,
+			At:         "5",
+			Action:     ActionDownloadOnly,
+		}},
+		{"antivirus hourly", KindAntivirus, Schedule{
+			Recurrence: Hourly,
+			At:         "15",
+			Action:     ActionDownloadAndInstall,
+			Threshold:  6,
+		}},
+		{"wildfire every min", KindWildfire, Schedule{
+			Recurrence: EveryMin,
+			Action:     ActionDownloadAndInstall,
+		}},
+		{"wildfire none", KindWildfire, Schedule{
+			Recurrence: RecurrenceNone,
+		}},
+	}
+
+	mc := &testdata.MockClient{}
+	ns := &FwUpdateSchedule{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("")
+			err := ns.Set(tc.kind, tc.conf)
+			if err != nil {
+				t.Errorf("Error in set: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get(tc.kind)
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				} else if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
+
+func TestFwInvalidKind(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &FwUpdateSchedule{}
+	ns.Initialize(mc)
+
+	if err := ns.Set("bogus", Schedule{}); err == nil {
+		t.Errorf("No error for invalid kind")
+	}
+}
@@ -0,0 +1,112 @@
+package updateschedule
+
+import (
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PanoUpdateSchedule is a namespace struct, included as part of pango.Panorama.
+type PanoUpdateSchedule struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *PanoUpdateSchedule) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Show performs SHOW to retrieve the schedule of the given kind of update.
+func (c *PanoUpdateSchedule) Show(tmpl, ts, kind string) (Schedule, error) {
+	c.con.LogQuery("(show) %s update schedule", kind)
+	return c.details(c.con.Show, tmpl, ts, kind)
+}
+
+// Get performs GET to retrieve the schedule of the given kind of update.
+func (c *PanoUpdateSchedule) Get(tmpl, ts, kind string) (Schedule, error) {
+	c.con.LogQuery("(get) %s update schedule", kind)
+	return c.details(c.con.Get, tmpl, ts, kind)
+}
+
+// Set performs SET to update the schedule of the given kind of update.
+func (c *PanoUpdateSchedule) Set(tmpl, ts, kind string, e Schedule) error {
+	var err error
+
+	if err = validKind(kind); err != nil {
+		return err
+	}
+
+	_, fn := c.versioning()
+	c.con.LogAction("(set) %s update schedule", kind)
+
+	path := c.xpath(tmpl, ts, kind)
+	path = path[:len(path)-1]
+
+	_, err = c.con.Set(path, fn(kind, e), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to update the schedule of the given kind of update.
+func (c *PanoUpdateSchedule) Edit(tmpl, ts, kind string, e Schedule) error {
+	var err error
+
+	if err = validKind(kind); err != nil {
+		return err
+	}
+
+	_, fn := c.versioning()
+	c.con.LogAction("(edit) %s update schedule", kind)
+
+	path := c.xpath(tmpl, ts, kind)
+
+	_, err = c.con.Edit(path, fn(kind, e), nil, nil)
+	return err
+}
+
+// Delete removes the schedule of the given kind of update.
+func (c *PanoUpdateSchedule) Delete(tmpl, ts, kind string) error {
+	if err := validKind(kind); err != nil {
+		return err
+	}
+
+	c.con.LogAction("(delete) %s update schedule", kind)
+	path := c.xpath(tmpl, ts, kind)
+
+	_, err := c.con.Delete(path, nil, nil)
+	return err
+}
+
+/** Internal functions for the PanoUpdateSchedule struct **/
+
+func (c *PanoUpdateSchedule) versioning() (normalizer, func(string, Schedule) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *PanoUpdateSchedule) details(fn util.Retriever, tmpl, ts, kind string) (Schedule, error) {
+	if err := validKind(kind); err != nil {
+		return Schedule{}, err
+	}
+
+	path := c.xpath(tmpl, ts, kind)
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Schedule{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *PanoUpdateSchedule) xpath(tmpl, ts, kind string) []string {
+	ans := make([]string, 0, 12)
+	ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"deviceconfig",
+		"system",
+		"update-schedule",
+		kind,
+	)
+
+	return ans
+}
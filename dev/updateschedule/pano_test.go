@@ -0,0 +1,41 @@
+package updateschedule
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestPanoNormalization(t *testing.T) {
+	conf := Schedule{
+		Recurrence: Daily,
+		At:         "01:00",
+		Action:     ActionDownloadAndInstall,
+		Threshold:  24,
+	}
+
+	mc := &testdata.MockClient{}
+	ns := &PanoUpdateSchedule{}
+	ns.Initialize(mc)
+
+	mc.AddResp("")
+	if err := ns.Set("", "ts1", KindAntivirus, conf); err != nil {
+		t.Fatalf("Error in set: %s", err)
+	}
+	if !strings.HasPrefix(mc.Path, "/config/devices/entry[@name='localhost.localdomain']/template-stack/entry[@name='ts1']/config/") {
+		t.Errorf("Not a template stack path: %s", mc.Path)
+	}
+	if !strings.HasSuffix(mc.Path, "/update-schedule") {
+		t.Errorf("Wrong set path: %s", mc.Path)
+	}
+
+	mc.AddResp(mc.Elm)
+	r, err := ns.Get("", "ts1", KindAntivirus)
+	if err != nil {
+		t.Fatalf("Error in get: %s", err)
+	} else if !reflect.DeepEqual(conf, r) {
+		t.Errorf("%#v != %#v", conf, r)
+	}
+}
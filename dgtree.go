@@ -0,0 +1,156 @@
+package pango
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// DeviceGroupNode is a single device group in a DeviceGroupTree.
+//
+// Top level device groups have a nil Parent and a Depth of 0.  Devices is the
+// number of devices directly assigned to this device group.
+type DeviceGroupNode struct {
+	Name     string
+	Parent   *DeviceGroupNode
+	Children []*DeviceGroupNode
+	Depth    int
+	Devices  int
+}
+
+// DeviceGroupTree is the device group hierarchy as a tree.
+type DeviceGroupTree struct {
+	Roots []*DeviceGroupNode
+
+	nodes map[string]*DeviceGroupNode
+}
+
+// Get returns the given device group, or nil if it is not present.
+func (o *DeviceGroupTree) Get(name string) *DeviceGroupNode {
+	return o.nodes[name]
+}
+
+// Len returns the total number of device groups in the tree.
+func (o *DeviceGroupTree) Len() int {
+	return len(o.nodes)
+}
+
+// AncestorsOf returns the ancestors of the given device group, starting with
+// its parent and ending with a top level device group.
+func (o *DeviceGroupTree) AncestorsOf(dg string) ([]string, error) {
+	n := o.nodes[dg]
+	if n == nil {
+		return nil, fmt.Errorf("Device group %q not found", dg)
+	}
+
+	var ans []string
+	for p := n.Parent; p != nil; p = p.Parent {
+		ans = append(ans, p.Name)
+	}
+
+	return ans, nil
+}
+
+// DescendantsOf returns all descendants of the given device group, depth
+// first.
+func (o *DeviceGroupTree) DescendantsOf(dg string) ([]string, error) {
+	n := o.nodes[dg]
+	if n == nil {
+		return nil, fmt.Errorf("Device group %q not found", dg)
+	}
+
+	var ans []string
+	var walk func(*DeviceGroupNode)
+	walk = func(v *DeviceGroupNode) {
+		for _, c := range v.Children {
+			ans = append(ans, c.Name)
+			walk(c)
+		}
+	}
+	walk(n)
+
+	return ans, nil
+}
+
+// DeviceGroupTree returns the device group hierarchy as a tree, including
+// the number of devices in each device group.
+//
+// Device counts come from listing the device entry names of each device
+// group, so the rest of the device group config is not retrieved.
+//
+// Use DeviceGroupHierarchy if only the child to parent mapping is needed.
+func (c *Panorama) DeviceGroupTree() (*DeviceGroupTree, error) {
+	type dghReq struct {
+		XMLName xml.Name `xml:"show"`
+		Cmd     string   `xml:"dg-hierarchy"`
+	}
+
+	hier := dghResp{}
+
+	c.LogOp("(op) retrieving device group hierarchy")
+	if _, err := c.Op(dghReq{}, "", nil, &hier); err != nil {
+		return nil, err
+	}
+
+	ans := &DeviceGroupTree{nodes: make(map[string]*DeviceGroupNode)}
+	if hier.Result != nil {
+		for _, v := range hier.Result.Info {
+			ans.Roots = append(ans.Roots, ans.add(v, nil))
+		}
+	}
+
+	var err error
+	var walk func(*DeviceGroupNode)
+	walk = func(n *DeviceGroupNode) {
+		if err != nil {
+			return
+		}
+
+		path := []string{
+			"config",
+			"devices",
+			util.AsEntryXpath([]string{"localhost.localdomain"}),
+			"device-group",
+			util.AsEntryXpath([]string{n.Name}),
+			"devices",
+		}
+		var list []string
+		c.LogQuery("(get) list of devices in device group %q", n.Name)
+		if list, err = c.EntryListUsing(c.Get, path); err != nil {
+			return
+		}
+		n.Devices = len(list)
+
+		for _, v := range n.Children {
+			walk(v)
+		}
+	}
+	for _, v := range ans.Roots {
+		walk(v)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return ans, nil
+}
+
+/** Internal functions **/
+
+func (o *DeviceGroupTree) add(v dghInfo, parent *DeviceGroupNode) *DeviceGroupNode {
+	n := &DeviceGroupNode{
+		Name:   v.Name,
+		Parent: parent,
+	}
+	if parent != nil {
+		n.Depth = parent.Depth + 1
+	}
+	o.nodes[n.Name] = n
+
+	for _, c := range v.Children {
+		n.Children = append(n.Children, o.add(c, n))
+	}
+
+	return n
+}
@@ -0,0 +1,61 @@
+package pango
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDeviceGroupTree(t *testing.T) {
+	pano := &Panorama{Client: Client{
+		rb: [][]byte{
+			[]byte(`<response status="success"><result><dg-hierarchy>
+<dg name="corp" dg_id="11"><dg name="east" dg_id="12"><dg name="nyc" dg_id="14" /></dg><dg name="west" dg_id="13" /></dg>
+<dg name="lab" dg_id="15" />
+</dg-hierarchy></result></response>`),
+			[]byte(`<response status="success"><result></result></response>`),
+			[]byte(`<response status="success"><result></result></response>`),
+			[]byte(`<response status="success"><result><entry name="0001" /><entry name="0002" /></result></response>`),
+			[]byte(`<response status="success"><result></result></response>`),
+			[]byte(`<response status="success"><result><entry name="0003" /></result></response>`),
+		},
+	}}
+	if err := pano.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	tree, err := pano.DeviceGroupTree()
+	if err != nil {
+		t.Fatalf("DeviceGroupTree failed: %s", err)
+	}
+
+	if len(pano.rp) != 6 {
+		t.Fatalf("Expected 6 requests sent, got %d", len(pano.rp))
+	}
+	if xp := pano.rp[5].Get("xpath"); xp != "/config/devices/entry[@name='localhost.localdomain']/device-group/entry[@name='lab']/devices/entry/@name" {
+		t.Errorf("Bad xpath for lab devices: %s", xp)
+	}
+	if len(tree.Roots) != 2 || tree.Len() != 5 {
+		t.Fatalf("Expected 2 roots and 5 device groups, got %d and %d", len(tree.Roots), tree.Len())
+	}
+
+	nyc := tree.Get("nyc")
+	if nyc == nil || nyc.Depth != 2 || nyc.Devices != 2 || nyc.Parent.Name != "east" {
+		t.Errorf("Bad nyc: %#v", nyc)
+	}
+	if tree.Get("corp").Devices != 0 || len(tree.Get("corp").Children) != 2 {
+		t.Errorf("Bad corp: %#v", tree.Get("corp"))
+	}
+
+	if list, err := tree.AncestorsOf("nyc"); err != nil || !reflect.DeepEqual(list, []string{"east", "corp"}) {
+		t.Errorf("Bad ancestors of nyc: %v %v", list, err)
+	}
+	if list, err := tree.AncestorsOf("lab"); err != nil || len(list) != 0 {
+		t.Errorf("Bad ancestors of lab: %v %v", list, err)
+	}
+	if list, err := tree.DescendantsOf("corp"); err != nil || !reflect.DeepEqual(list, []string{"east", "nyc", "west"}) {
+		t.Errorf("Bad descendants of corp: %v %v", list, err)
+	}
+	if _, err := tree.DescendantsOf("missing"); err == nil {
+		t.Errorf("No error for missing device group")
+	}
+}
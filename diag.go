@@ -0,0 +1,158 @@
+package pango
+
+import (
+	"bufio"
+	"encoding/xml"
+	"strings"
+)
+
+// Valid values for ConnectivityResult.Target.
+const (
+	TargetPanorama       = "panorama"
+	TargetLoggingService = "logging-service"
+	TargetUpdateServer   = "update-server"
+	TargetWildFire       = "wildfire"
+)
+
+// PanoramaStatus is the firewall's view of its connection to a Panorama.
+type PanoramaStatus struct {
+	Server    string
+	Connected bool
+	HaState   string
+}
+
+// ConnectivityResult is the outcome of checking connectivity to a single
+// service from the firewall.
+//
+// Message contains the output from PAN-OS (or the error encountered), which
+// is usually enough to figure out why a check failed.
+type ConnectivityResult struct {
+	Target  string
+	Ok      bool
+	Message string
+}
+
+// PanoramaStatus returns the status of the connection to each Panorama that
+// this firewall is configured to use.
+func (c *Firewall) PanoramaStatus() ([]PanoramaStatus, error) {
+	type req struct {
+		XMLName xml.Name `xml:"show"`
+		Cmd     string   `xml:"panorama-status"`
+	}
+
+	ans := opText{}
+
+	c.LogOp("(op) show panorama-status")
+	if _, err := c.Op(req{}, "", nil, &ans); err != nil {
+		return nil, err
+	}
+
+	return parsePanoramaStatus(ans.Result), nil
+}
+
+// CheckConnectivity checks if Panorama, the logging service, the update
+// server, and the WildFire cloud are reachable from the firewall.
+//
+// Failing checks do not stop the remaining checks from being performed, so
+// a result is always returned for each target.
+func (c *Firewall) CheckConnectivity() []ConnectivityResult {
+	ans := make([]ConnectivityResult, 0, 4)
+
+	// Panorama.
+	pr := ConnectivityResult{Target: TargetPanorama}
+	if list, err := c.PanoramaStatus(); err != nil {
+		pr.Message = err.Error()
+	} else if len(list) == 0 {
+		pr.Message = "No Panorama servers configured"
+	} else {
+		pr.Ok = true
+		msgs := make([]string, 0, len(list))
+		for _, s := range list {
+			if s.Connected {
+				msgs = append(msgs, s.Server+" connected")
+			} else {
+				pr.Ok = false
+				msgs = append(msgs, s.Server+" not connected")
+			}
+		}
+		pr.Message = strings.Join(msgs, ", ")
+	}
+	ans = append(ans, pr)
+
+	ans = append(ans, c.connectivityCheck(
+		TargetLoggingService,
+		"<request><logging-service-forwarding><status/></logging-service-forwarding></request>",
+		nil,
+	))
+
+	ans = append(ans, c.connectivityCheck(
+		TargetUpdateServer,
+		"<request><content><upgrade><check/></upgrade></content></request>",
+		nil,
+	))
+
+	ans = append(ans, c.connectivityCheck(
+		TargetWildFire,
+		"<test><wildfire><registration/></wildfire></test>",
+		func(s string) bool {
+			return strings.Contains(strings.ToLower(s), "successful")
+		},
+	))
+
+	return ans
+}
+
+/** Internal functions **/
+
+type opText struct {
+	Result string `xml:"result"`
+}
+
+// connectivityCheck runs the given op command.  The check is ok if the
+// command succeeds and, if given, ok returns true for the output.
+func (c *Firewall) connectivityCheck(target, cmd string, ok func(string) bool) ConnectivityResult {
+	ans := ConnectivityResult{Target: target}
+	out := opText{}
+
+	c.LogOp("(op) checking %s connectivity", target)
+	if _, err := c.Op(cmd, "", nil, &out); err != nil {
+		ans.Message = err.Error()
+		return ans
+	}
+
+	ans.Message = strings.TrimSpace(out.Result)
+	ans.Ok = ok == nil || ok(ans.Message)
+
+	return ans
+}
+
+// parsePanoramaStatus parses the text output of "show panorama-status":
+//
+//  Panorama Server 1 : 10.1.1.1
+//      Connected     : yes
+//      HA state      : disconnected
+func parsePanoramaStatus(s string) []PanoramaStatus {
+	var ans []PanoramaStatus
+
+	scanner := bufio.NewScanner(strings.NewReader(s))
+	for scanner.Scan() {
+		tokens := strings.SplitN(scanner.Text(), ":", 2)
+		if len(tokens) != 2 {
+			continue
+		}
+		key := strings.TrimSpace(tokens[0])
+		val := strings.TrimSpace(tokens[1])
+
+		switch {
+		case strings.HasPrefix(key, "Panorama Server"):
+			ans = append(ans, PanoramaStatus{Server: val})
+		case len(ans) == 0:
+		case key == "Connected":
+			ans[len(ans)-1].Connected = val == "yes"
+		case key == "HA state":
+			ans[len(ans)-1].HaState = val
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,60 @@
+package pango
+
+import (
+	"testing"
+)
+
+func TestCheckConnectivity(t *testing.T) {
+	fw := &Firewall{Client: Client{
+		rb: [][]byte{
+			[]byte(`<response status="success"><result><![CDATA[Panorama Server 1 : 10.1.1.1
+    Connected     : yes
+    HA state      : disconnected
+Panorama Server 2 : 10.1.1.2
+    Connected     : no
+    HA state      : disconnected
+]]></result></response>`),
+			[]byte(`<response status="error"><msg><line>Logging service license not found</line></msg></response>`),
+			[]byte(`<response status="success"><result><content-updates last-updated-at="2020/01/01 00:00:00"/></result></response>`),
+			[]byte(`<response status="success"><result>Test wildfire
+        wildfire registration:   successful
+</result></response>`),
+		},
+	}}
+	if err := fw.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	ans := fw.CheckConnectivity()
+	if len(ans) != 4 {
+		t.Fatalf("Expected 4 results, got %#v", ans)
+	}
+
+	want := []struct {
+		target string
+		ok     bool
+	}{
+		{TargetPanorama, false},
+		{TargetLoggingService, false},
+		{TargetUpdateServer, true},
+		{TargetWildFire, true},
+	}
+	for i, w := range want {
+		if ans[i].Target != w.target || ans[i].Ok != w.ok {
+			t.Errorf("Result %d: expected %s/%t, got %#v", i, w.target, w.ok, ans[i])
+		}
+	}
+}
+
+func TestParsePanoramaStatus(t *testing.T) {
+	list := parsePanoramaStatus(`Panorama Server 1 : 10.1.1.1
+    Connected     : yes
+    HA state      : active`)
+
+	if len(list) != 1 {
+		t.Fatalf("Expected 1 server, got %#v", list)
+	}
+	if list[0].Server != "10.1.1.1" || !list[0].Connected || list[0].HaState != "active" {
+		t.Errorf("Unexpected status: %#v", list[0])
+	}
+}
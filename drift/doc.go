@@ -0,0 +1,29 @@
+/*
+Package drift detects out-of-band changes to a PAN-OS device's config.
+
+A Watcher periodically snapshots selected xpaths of the running config (or the
+whole config), compares the snapshot against a stored baseline, and invokes a
+callback with the structured differences.
+
+    w := &drift.Watcher{
+        Xpaths:   []string{"/config/shared", "/config/devices"},
+        Interval: 5 * time.Minute,
+        Handler: func(diffs []cfgtree.Difference) {
+            for _, d := range diffs {
+                log.Printf("%s: %s", d.Type, d.Path)
+            }
+        },
+    }
+    w.Initialize(fw)
+    if err := w.SetBaseline(); err != nil {
+        return err
+    }
+    err := w.Run(ctx)
+
+The baseline can be persisted with Snapshot.WriteTo and restored with
+ReadSnapshot, so that drift which happens between runs is also caught.
+
+Differences can be rendered as set commands or a unified diff for change
+tickets using cfgtree.Render.
+*/
+package drift
@@ -0,0 +1,124 @@
+package drift
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/cfgtree"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Watcher compares the running config against a baseline.
+//
+// If Xpaths is empty, the entire config is watched.  If Interval is zero, it
+// defaults to one minute.  If KeepBaseline is false, then after differences
+// are reported the baseline is moved forward to the current config, so each
+// change is only reported once.
+type Watcher struct {
+	Xpaths       []string
+	Interval     time.Duration
+	Handler      func([]cfgtree.Difference)
+	KeepBaseline bool
+	Baseline     Snapshot
+
+	con util.XapiClient
+}
+
+// Initialize sets the client used to retrieve the config.
+func (c *Watcher) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Snapshot retrieves the running config for all watched xpaths.
+func (c *Watcher) Snapshot() (Snapshot, error) {
+	list := c.Xpaths
+	if len(list) == 0 {
+		list = []string{"/config"}
+	}
+
+	type result struct {
+		Result util.RawXml `xml:"result"`
+	}
+
+	ans := make(Snapshot, len(list))
+	for _, path := range list {
+		resp := result{}
+		c.con.LogQuery("(show) drift snapshot of %s", path)
+		if _, err := c.con.Show(path, nil, &resp); err != nil {
+			return nil, err
+		}
+
+		n, err := cfgtree.ParseInner("result", []byte(strings.TrimSpace(resp.Result.Text)))
+		if err != nil {
+			return nil, err
+		}
+		ans[path] = n
+	}
+
+	return ans, nil
+}
+
+// SetBaseline takes a new snapshot and saves it as the baseline.
+func (c *Watcher) SetBaseline() error {
+	s, err := c.Snapshot()
+	if err != nil {
+		return err
+	}
+
+	c.Baseline = s
+	return nil
+}
+
+// Check takes a new snapshot and returns how it differs from the baseline.
+//
+// If there is no baseline yet, then the new snapshot becomes the baseline and
+// no differences are returned.  The Handler is not invoked by Check.
+func (c *Watcher) Check() ([]cfgtree.Difference, error) {
+	s, err := c.Snapshot()
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Baseline == nil {
+		c.Baseline = s
+		return nil, nil
+	}
+
+	ans := c.Baseline.Diff(s)
+	if len(ans) > 0 && !c.KeepBaseline {
+		c.Baseline = s
+	}
+
+	return ans, nil
+}
+
+// Run performs a Check every Interval until ctx is done or a check fails,
+// invoking Handler whenever differences are found.
+//
+// The first check is performed immediately.
+func (c *Watcher) Run(ctx context.Context) error {
+	interval := c.Interval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		diffs, err := c.Check()
+		if err != nil {
+			return err
+		}
+		if len(diffs) > 0 && c.Handler != nil {
+			c.Handler(diffs)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package drift
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/cfgtree"
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+const (
+	driftPath   = "/config/shared/address"
+	driftBefore = `<address><entry name="a"><ip-netmask>10.1.1.1</ip-netmask></entry><entry name="b"><fqdn>example.com</fqdn></entry></address>`
+	driftAfter  = `<address><entry name="a"><ip-netmask>10.2.2.2</ip-netmask></entry><entry name="c"><fqdn>example.org</fqdn></entry></address>`
+)
+
+func TestCheck(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(driftBefore)
+	mc.AddResp(driftAfter)
+
+	w := &Watcher{Xpaths: []string{driftPath}}
+	w.Initialize(mc)
+
+	if diffs, err := w.Check(); err != nil {
+		t.Fatalf("First check failed: %s", err)
+	} else if len(diffs) != 0 {
+		t.Fatalf("First check should set baseline, got %#v", diffs)
+	}
+
+	diffs, err := w.Check()
+	if err != nil {
+		t.Fatalf("Second check failed: %s", err)
+	}
+
+	want := map[string]string{
+		driftPath + "/entry[@name='a']/ip-netmask": cfgtree.Modified,
+		driftPath + "/entry[@name='b']":            cfgtree.Removed,
+		driftPath + "/entry[@name='c']":            cfgtree.Added,
+	}
+	if len(diffs) != len(want) {
+		t.Fatalf("Expected %d diffs, got %#v", len(want), diffs)
+	}
+	for _, d := range diffs {
+		if want[d.Path] != d.Type {
+			t.Errorf("Unexpected diff %s %s", d.Type, d.Path)
+		}
+	}
+
+	if mc.Function != "show" || mc.Path != driftPath {
+		t.Errorf("Unexpected call: %s %s", mc.Function, mc.Path)
+	}
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	n, err := cfgtree.ParseInner("result", []byte(driftBefore))
+	if err != nil {
+		t.Fatalf("Parse failed: %s", err)
+	}
+	s := Snapshot{driftPath: n}
+
+	var buf bytes.Buffer
+	if _, err = s.WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo failed: %s", err)
+	}
+
+	r, err := ReadSnapshot(&buf)
+	if err != nil {
+		t.Fatalf("ReadSnapshot failed: %s", err)
+	}
+
+	if diffs := s.Diff(r); len(diffs) != 0 {
+		t.Errorf("Restored snapshot differs: %#v", diffs)
+	}
+}
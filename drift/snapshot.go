@@ -0,0 +1,126 @@
+package drift
+
+import (
+	"encoding/xml"
+	"io"
+	"sort"
+
+	"github.com/PaloAltoNetworks/pango/cfgtree"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Snapshot is the running config found at each watched xpath.
+//
+// Each node is a wrapper whose children are the elements found at the xpath.
+type Snapshot map[string]*cfgtree.Node
+
+// Diff returns the differences between this snapshot (the baseline) and the
+// given snapshot.
+//
+// Xpaths present in only one of the snapshots are compared against empty
+// config.
+func (o Snapshot) Diff(cur Snapshot) []cfgtree.Difference {
+	var ans []cfgtree.Difference
+
+	for _, path := range o.union(cur) {
+		before, after := o[path], cur[path]
+		if before == nil {
+			before = &cfgtree.Node{}
+		}
+		if after == nil {
+			after = &cfgtree.Node{}
+		}
+		ans = append(ans, cfgtree.DiffChildren(parentXpath(path), before, after)...)
+	}
+
+	return ans
+}
+
+// WriteTo saves this snapshot as XML.
+func (o Snapshot) WriteTo(w io.Writer) (int64, error) {
+	s := snapshotXml{}
+	for _, path := range o.union(nil) {
+		s.Entries = append(s.Entries, snapshotEntry{
+			Xpath:  path,
+			Config: util.RawXml{Text: o[path].InnerXml()},
+		})
+	}
+
+	b, err := xml.Marshal(s)
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := w.Write(b)
+	return int64(n), err
+}
+
+// ReadSnapshot loads a snapshot previously saved with WriteTo.
+func ReadSnapshot(r io.Reader) (Snapshot, error) {
+	var s snapshotXml
+	if err := xml.NewDecoder(r).Decode(&s); err != nil {
+		return nil, err
+	}
+
+	ans := make(Snapshot, len(s.Entries))
+	for _, e := range s.Entries {
+		n, err := cfgtree.ParseInner("result", []byte(e.Config.Text))
+		if err != nil {
+			return nil, err
+		}
+		ans[e.Xpath] = n
+	}
+
+	return ans, nil
+}
+
+/** Internal functions. **/
+
+type snapshotXml struct {
+	XMLName xml.Name        `xml:"snapshot"`
+	Entries []snapshotEntry `xml:"entry"`
+}
+
+type snapshotEntry struct {
+	Xpath  string      `xml:"xpath,attr"`
+	Config util.RawXml `xml:"config"`
+}
+
+func (o Snapshot) union(other Snapshot) []string {
+	ans := make([]string, 0, len(o)+len(other))
+	for path := range o {
+		ans = append(ans, path)
+	}
+	for path := range other {
+		if _, ok := o[path]; !ok {
+			ans = append(ans, path)
+		}
+	}
+	sort.Strings(ans)
+
+	return ans
+}
+
+// parentXpath returns the xpath of the parent of the given xpath.
+func parentXpath(path string) string {
+	var quote rune
+	idx := -1
+
+	for i, r := range path {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == '/':
+			idx = i
+		}
+	}
+
+	if idx == -1 {
+		return ""
+	}
+	return path[:idx]
+}
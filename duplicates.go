@@ -0,0 +1,126 @@
+package pango
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/PaloAltoNetworks/pango/cfgtree"
+)
+
+// ObjectLocation is an object's name and the scope it is defined in, which
+// is either "shared" or a device group.
+type ObjectLocation struct {
+	Scope string
+	Name  string
+}
+
+// DuplicateObjects is a set of objects of the same kind that have identical
+// values, making them candidates for consolidation.
+//
+// Value is the canonical XML of the objects' values.  Descriptions and tags
+// do not count towards the value, and the order of group members does not
+// matter.
+type DuplicateObjects struct {
+	Kind    string
+	Value   string
+	Objects []ObjectLocation
+}
+
+// FindDuplicateObjects returns the objects of the given kinds that are
+// identical by value across Shared and all device groups in the given
+// Panorama config.
+//
+// The kinds are Object* constants.  If no kinds are given, then addresses,
+// address groups, services, and service groups are checked.
+func FindDuplicateObjects(cfg *cfgtree.Node, kinds ...string) []DuplicateObjects {
+	if len(kinds) == 0 {
+		kinds = []string{ObjectAddress, ObjectAddressGroup, ObjectService, ObjectServiceGroup}
+	}
+
+	var ans []DuplicateObjects
+	scopes := objectScopes(cfg)
+
+	for _, kind := range kinds {
+		byValue := make(map[string][]ObjectLocation)
+		var values []string
+
+		for _, scope := range scopes {
+			n := scopeNode(cfg, scope)
+			if n == nil {
+				continue
+			}
+			for _, obj := range n.FindAll(kind + "/entry") {
+				v := objectValue(obj)
+				if _, ok := byValue[v]; !ok {
+					values = append(values, v)
+				}
+				byValue[v] = append(byValue[v], ObjectLocation{Scope: scope, Name: obj.Attr("name")})
+			}
+		}
+
+		for _, v := range values {
+			if len(byValue[v]) > 1 {
+				ans = append(ans, DuplicateObjects{
+					Kind:    kind,
+					Value:   v,
+					Objects: byValue[v],
+				})
+			}
+		}
+	}
+
+	return ans
+}
+
+// DuplicateObjects retrieves the candidate config and returns the objects of
+// the given kinds that are identical by value across Shared and all device
+// groups.
+//
+// Refer to FindDuplicateObjects for more information.
+func (c *Panorama) DuplicateObjects(kinds ...string) ([]DuplicateObjects, error) {
+	cfg, err := c.CandidateConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return FindDuplicateObjects(cfg, kinds...), nil
+}
+
+/** Internal functions **/
+
+// objectValue returns the canonical value of an object entry.
+func objectValue(obj *cfgtree.Node) string {
+	parts := make([]string, 0, len(obj.Nodes))
+
+	for _, n := range obj.Nodes {
+		switch n.Name() {
+		case "description", "tag":
+			continue
+		}
+		parts = append(parts, canonicalNode(n))
+	}
+
+	return strings.Join(parts, "")
+}
+
+func canonicalNode(n *cfgtree.Node) string {
+	if n.IsLeaf() {
+		return n.String()
+	}
+
+	n = n.Copy()
+	members := true
+	for _, c := range n.Nodes {
+		if c.Name() != "member" {
+			members = false
+			break
+		}
+	}
+	if members {
+		sort.Slice(n.Nodes, func(i, j int) bool {
+			return n.Nodes[i].Text < n.Nodes[j].Text
+		})
+	}
+
+	return n.String()
+}
@@ -0,0 +1,54 @@
+package pango
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/cfgtree"
+)
+
+func TestFindDuplicateObjects(t *testing.T) {
+	cfg, err := cfgtree.Parse([]byte(`<config>
+<shared>
+    <address>
+        <entry name="web"><ip-netmask>10.1.1.1</ip-netmask><description>web server</description></entry>
+        <entry name="db"><ip-netmask>10.1.1.2</ip-netmask></entry>
+    </address>
+    <address-group><entry name="servers"><static><member>web</member><member>db</member></static></entry></address-group>
+</shared>
+<devices><entry name="localhost.localdomain"><device-group>
+    <entry name="dg1">
+        <address><entry name="h-10.1.1.1"><ip-netmask>10.1.1.1</ip-netmask><tag><member>x</member></tag></entry></address>
+        <address-group><entry name="both"><static><member>db</member><member>web</member></static></entry></address-group>
+    </entry>
+    <entry name="dg2">
+        <address><entry name="web"><ip-netmask>10.1.1.1</ip-netmask></entry><entry name="other"><fqdn>10.1.1.1</fqdn></entry></address>
+    </entry>
+</device-group></entry></devices>
+</config>`))
+	if err != nil {
+		t.Fatalf("Failed to parse config: %s", err)
+	}
+
+	list := FindDuplicateObjects(cfg)
+	if len(list) != 2 {
+		t.Fatalf("Expected 2 sets of duplicates, got %d: %#v", len(list), list)
+	}
+
+	if list[0].Kind != ObjectAddress || list[0].Value != "<ip-netmask>10.1.1.1</ip-netmask>" {
+		t.Errorf("Bad address duplicates: %#v", list[0])
+	}
+	want := []ObjectLocation{{"shared", "web"}, {"dg1", "h-10.1.1.1"}, {"dg2", "web"}}
+	if !reflect.DeepEqual(list[0].Objects, want) {
+		t.Errorf("Bad address objects: %#v", list[0].Objects)
+	}
+
+	want = []ObjectLocation{{"shared", "servers"}, {"dg1", "both"}}
+	if list[1].Kind != ObjectAddressGroup || !reflect.DeepEqual(list[1].Objects, want) {
+		t.Errorf("Bad address group duplicates: %#v", list[1])
+	}
+
+	if list = FindDuplicateObjects(cfg, ObjectService); len(list) != 0 {
+		t.Errorf("Expected no service duplicates, got %#v", list)
+	}
+}
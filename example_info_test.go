@@ -38,17 +38,17 @@ func Example_outputApiKey() {
 		out = About{
 			Hostname: x.Hostname,
 			Type:     "NGFW",
-			Model:    x.SystemInfo["model"],
+			Model:    x.Info.Model,
 			Version:  x.Version.String(),
-			Serial:   x.SystemInfo["serial"],
+			Serial:   x.Info.Serial,
 		}
 	case *pango.Panorama:
 		out = About{
 			Hostname: x.Hostname,
 			Type:     "Panorama",
-			Model:    x.SystemInfo["model"],
+			Model:    x.Info.Model,
 			Version:  x.Version.String(),
-			Serial:   x.SystemInfo["serial"],
+			Serial:   x.Info.Serial,
 		}
 	}
 
@@ -0,0 +1,19 @@
+/*
+Package export walks the config of a firewall or Panorama using the pango
+namespaces and emits it as Terraform resources.
+
+The Resource struct is a structured intermediate representation of a single
+Terraform resource, which can be either consumed directly by a code generator
+or rendered into HCL using the Hcl() function.
+
+	fw := &pango.Firewall{Client: pango.Client{...}}
+	if err := fw.Initialize(); err != nil {
+	    return err
+	}
+	list, err := export.Firewall(fw, "vsys1")
+	if err != nil {
+	    return err
+	}
+	fmt.Printf("%s", export.Hcl(list))
+*/
+package export
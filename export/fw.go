@@ -0,0 +1,181 @@
+package export
+
+import (
+	"github.com/PaloAltoNetworks/pango"
+)
+
+// Firewall exports the config of the given vsys as Terraform resources.
+//
+// If vsys is an empty string, then "vsys1" is assumed.  Resource names are
+// prefixed with the vsys, so that the exports of multiple vsys can be combined.
+//
+// The following are exported:
+//   - administrative tags
+//   - address objects
+//   - address groups
+//   - service objects
+//   - service groups
+//   - zones
+func Firewall(fw *pango.Firewall, vsys string) ([]Resource, error) {
+	if vsys == "" {
+		vsys = "vsys1"
+	}
+
+	ans := make([]Resource, 0, 100)
+	for _, fn := range []func(*pango.Firewall, string) ([]Resource, error){
+		fwTags,
+		fwAddresses,
+		fwAddressGroups,
+		fwServices,
+		fwServiceGroups,
+		fwZones,
+	} {
+		list, err := fn(fw, vsys)
+		if err != nil {
+			return nil, err
+		}
+		ans = append(ans, list...)
+	}
+
+	return UniqueNames(ans), nil
+}
+
+/** Internal functions. **/
+
+func fwTags(fw *pango.Firewall, vsys string) ([]Resource, error) {
+	names, err := fw.Objects.Tags.GetList(vsys)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := make([]Resource, 0, len(names))
+	for _, name := range names {
+		o, err := fw.Objects.Tags.Get(vsys, name)
+		if err != nil {
+			return nil, err
+		}
+		r := Resource{Type: "panos_administrative_tag", Name: ResourceName(vsys, "tag", name)}
+		r.Add("vsys", vsys)
+		r.Add("name", o.Name)
+		r.Add("color", o.Color)
+		r.Add("comment", o.Comment)
+		ans = append(ans, r)
+	}
+
+	return ans, nil
+}
+
+func fwAddresses(fw *pango.Firewall, vsys string) ([]Resource, error) {
+	list, err := fw.Objects.Address.GetAll(vsys)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := make([]Resource, 0, len(list))
+	for _, o := range list {
+		r := Resource{Type: "panos_address_object", Name: ResourceName(vsys, "addr", o.Name)}
+		r.Add("vsys", vsys)
+		r.Add("name", o.Name)
+		r.Add("type", o.Type)
+		r.Add("value", o.Value)
+		r.Add("description", o.Description)
+		r.Add("tags", o.Tags)
+		ans = append(ans, r)
+	}
+
+	return ans, nil
+}
+
+func fwAddressGroups(fw *pango.Firewall, vsys string) ([]Resource, error) {
+	names, err := fw.Objects.AddressGroup.GetList(vsys)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := make([]Resource, 0, len(names))
+	for _, name := range names {
+		o, err := fw.Objects.AddressGroup.Get(vsys, name)
+		if err != nil {
+			return nil, err
+		}
+		r := Resource{Type: "panos_address_group", Name: ResourceName(vsys, "addrgrp", name)}
+		r.Add("vsys", vsys)
+		r.Add("name", o.Name)
+		r.Add("description", o.Description)
+		r.Add("static_addresses", o.StaticAddresses)
+		r.Add("dynamic_match", o.DynamicMatch)
+		r.Add("tags", o.Tags)
+		ans = append(ans, r)
+	}
+
+	return ans, nil
+}
+
+func fwServices(fw *pango.Firewall, vsys string) ([]Resource, error) {
+	list, err := fw.Objects.Services.GetAll(vsys)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := make([]Resource, 0, len(list))
+	for _, o := range list {
+		r := Resource{Type: "panos_service_object", Name: ResourceName(vsys, "srvc", o.Name)}
+		r.Add("vsys", vsys)
+		r.Add("name", o.Name)
+		r.Add("description", o.Description)
+		r.Add("protocol", o.Protocol)
+		r.Add("source_port", o.SourcePort)
+		r.Add("destination_port", o.DestinationPort)
+		r.Add("tags", o.Tags)
+		ans = append(ans, r)
+	}
+
+	return ans, nil
+}
+
+func fwServiceGroups(fw *pango.Firewall, vsys string) ([]Resource, error) {
+	names, err := fw.Objects.ServiceGroup.GetList(vsys)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := make([]Resource, 0, len(names))
+	for _, name := range names {
+		o, err := fw.Objects.ServiceGroup.Get(vsys, name)
+		if err != nil {
+			return nil, err
+		}
+		r := Resource{Type: "panos_service_group", Name: ResourceName(vsys, "srvcgrp", name)}
+		r.Add("vsys", vsys)
+		r.Add("name", o.Name)
+		r.Add("services", o.Services)
+		r.Add("tags", o.Tags)
+		ans = append(ans, r)
+	}
+
+	return ans, nil
+}
+
+func fwZones(fw *pango.Firewall, vsys string) ([]Resource, error) {
+	list, err := fw.Network.Zone.GetAll(vsys)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := make([]Resource, 0, len(list))
+	for _, o := range list {
+		r := Resource{Type: "panos_zone", Name: ResourceName(vsys, "zone", o.Name)}
+		r.Add("vsys", vsys)
+		r.Add("name", o.Name)
+		r.Add("mode", o.Mode)
+		r.Add("interfaces", o.Interfaces)
+		r.Add("zone_profile", o.ZoneProfile)
+		r.Add("log_setting", o.LogSetting)
+		r.Add("enable_user_id", o.EnableUserId)
+		r.Add("include_acls", o.IncludeAcls)
+		r.Add("exclude_acls", o.ExcludeAcls)
+		ans = append(ans, r)
+	}
+
+	return ans, nil
+}
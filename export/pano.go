@@ -0,0 +1,155 @@
+package export
+
+import (
+	"github.com/PaloAltoNetworks/pango"
+)
+
+// Panorama exports the objects of the given device group as Terraform
+// resources.
+//
+// If dg is an empty string, then "shared" is assumed.
+//
+// The following are exported:
+//   - administrative tags
+//   - address objects
+//   - address groups
+//   - service objects
+//   - service groups
+func Panorama(pano *pango.Panorama, dg string) ([]Resource, error) {
+	if dg == "" {
+		dg = "shared"
+	}
+
+	ans := make([]Resource, 0, 100)
+	for _, fn := range []func(*pango.Panorama, string) ([]Resource, error){
+		panoTags,
+		panoAddresses,
+		panoAddressGroups,
+		panoServices,
+		panoServiceGroups,
+	} {
+		list, err := fn(pano, dg)
+		if err != nil {
+			return nil, err
+		}
+		ans = append(ans, list...)
+	}
+
+	return UniqueNames(ans), nil
+}
+
+/** Internal functions. **/
+
+func panoTags(pano *pango.Panorama, dg string) ([]Resource, error) {
+	names, err := pano.Objects.Tags.GetList(dg)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := make([]Resource, 0, len(names))
+	for _, name := range names {
+		o, err := pano.Objects.Tags.Get(dg, name)
+		if err != nil {
+			return nil, err
+		}
+		r := Resource{Type: "panos_panorama_administrative_tag", Name: ResourceName(dg, "tag", name)}
+		r.Add("device_group", dg)
+		r.Add("name", o.Name)
+		r.Add("color", o.Color)
+		r.Add("comment", o.Comment)
+		ans = append(ans, r)
+	}
+
+	return ans, nil
+}
+
+func panoAddresses(pano *pango.Panorama, dg string) ([]Resource, error) {
+	list, err := pano.Objects.Address.GetAll(dg)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := make([]Resource, 0, len(list))
+	for _, o := range list {
+		r := Resource{Type: "panos_panorama_address_object", Name: ResourceName(dg, "addr", o.Name)}
+		r.Add("device_group", dg)
+		r.Add("name", o.Name)
+		r.Add("type", o.Type)
+		r.Add("value", o.Value)
+		r.Add("description", o.Description)
+		r.Add("tags", o.Tags)
+		ans = append(ans, r)
+	}
+
+	return ans, nil
+}
+
+func panoAddressGroups(pano *pango.Panorama, dg string) ([]Resource, error) {
+	names, err := pano.Objects.AddressGroup.GetList(dg)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := make([]Resource, 0, len(names))
+	for _, name := range names {
+		o, err := pano.Objects.AddressGroup.Get(dg, name)
+		if err != nil {
+			return nil, err
+		}
+		r := Resource{Type: "panos_panorama_address_group", Name: ResourceName(dg, "addrgrp", name)}
+		r.Add("device_group", dg)
+		r.Add("name", o.Name)
+		r.Add("description", o.Description)
+		r.Add("static_addresses", o.StaticAddresses)
+		r.Add("dynamic_match", o.DynamicMatch)
+		r.Add("tags", o.Tags)
+		ans = append(ans, r)
+	}
+
+	return ans, nil
+}
+
+func panoServices(pano *pango.Panorama, dg string) ([]Resource, error) {
+	list, err := pano.Objects.Services.GetAll(dg)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := make([]Resource, 0, len(list))
+	for _, o := range list {
+		r := Resource{Type: "panos_panorama_service_object", Name: ResourceName(dg, "srvc", o.Name)}
+		r.Add("device_group", dg)
+		r.Add("name", o.Name)
+		r.Add("description", o.Description)
+		r.Add("protocol", o.Protocol)
+		r.Add("source_port", o.SourcePort)
+		r.Add("destination_port", o.DestinationPort)
+		r.Add("tags", o.Tags)
+		ans = append(ans, r)
+	}
+
+	return ans, nil
+}
+
+func panoServiceGroups(pano *pango.Panorama, dg string) ([]Resource, error) {
+	names, err := pano.Objects.ServiceGroup.GetList(dg)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := make([]Resource, 0, len(names))
+	for _, name := range names {
+		o, err := pano.Objects.ServiceGroup.Get(dg, name)
+		if err != nil {
+			return nil, err
+		}
+		r := Resource{Type: "panos_panorama_service_group", Name: ResourceName(dg, "srvcgrp", name)}
+		r.Add("device_group", dg)
+		r.Add("name", o.Name)
+		r.Add("services", o.Services)
+		r.Add("tags", o.Tags)
+		ans = append(ans, r)
+	}
+
+	return ans, nil
+}
@@ -0,0 +1,207 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Resource is a Terraform resource.
+//
+// Attributes are kept in the order in which they were added, so that the
+// rendered HCL is stable between runs.
+type Resource struct {
+	Type       string
+	Name       string
+	Attributes []Attribute
+}
+
+// Attribute is a single key / value pair of a Terraform resource.
+//
+// Value should be one of: string, bool, int, or []string.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Add appends an attribute to this resource.
+//
+// Attributes with empty values (empty string, false, zero, or an empty list)
+// are omitted, as this is how the Terraform provider treats unset params.
+func (o *Resource) Add(key string, value interface{}) {
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			return
+		}
+	case bool:
+		if !v {
+			return
+		}
+	case int:
+		if v == 0 {
+			return
+		}
+	case []string:
+		if len(v) == 0 {
+			return
+		}
+	}
+
+	o.Attributes = append(o.Attributes, Attribute{key, value})
+}
+
+// Address returns the Terraform address of this resource.
+func (o Resource) Address() string {
+	return fmt.Sprintf("%s.%s", o.Type, o.Name)
+}
+
+// Hcl returns this resource rendered as a HCL resource block.
+func (o Resource) Hcl() string {
+	var buf bytes.Buffer
+
+	width := 0
+	for _, a := range o.Attributes {
+		if len(a.Key) > width {
+			width = len(a.Key)
+		}
+	}
+
+	fmt.Fprintf(&buf, "resource %s %s {\n", hclString(o.Type), hclString(o.Name))
+	for _, a := range o.Attributes {
+		fmt.Fprintf(&buf, "    %-*s = %s\n", width, a.Key, hclValue(a.Value))
+	}
+	buf.WriteString("}\n")
+
+	return buf.String()
+}
+
+// Hcl renders the given resources as HCL, separated by blank lines.
+func Hcl(list []Resource) string {
+	blocks := make([]string, 0, len(list))
+	for _, r := range list {
+		blocks = append(blocks, r.Hcl())
+	}
+
+	return strings.Join(blocks, "\n")
+}
+
+// ResourceName returns a valid Terraform resource name for the given
+// PAN-OS object name(s).
+//
+// Characters that are not valid in a Terraform identifier are replaced with
+// underscores, and a leading underscore is added if the result would not
+// start with a letter or underscore.  As different names can map to the same
+// resource name (such as "web.1" and "web_1"), use UniqueNames on the full
+// list of resources.
+func ResourceName(parts ...string) string {
+	var buf bytes.Buffer
+
+	for i, p := range parts {
+		if p == "" {
+			continue
+		}
+		if i != 0 && buf.Len() > 0 {
+			buf.WriteString("_")
+		}
+		for _, r := range p {
+			switch {
+			case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '-':
+				buf.WriteRune(r)
+			default:
+				buf.WriteRune('_')
+			}
+		}
+	}
+
+	ans := buf.String()
+	if ans == "" || (ans[0] >= '0' && ans[0] <= '9') || ans[0] == '-' {
+		ans = "_" + ans
+	}
+
+	return ans
+}
+
+// UniqueNames makes the names of the given resources unique per resource
+// type, adding a numeric suffix to the second and later resources with the
+// same name.  The given list is modified and returned.
+func UniqueNames(list []Resource) []Resource {
+	seen := make(map[string]bool, len(list))
+	for i := range list {
+		addr := list[i].Address()
+		if seen[addr] {
+			for n := 2; ; n++ {
+				name := fmt.Sprintf("%s_%d", list[i].Name, n)
+				addr = list[i].Type + "." + name
+				if !seen[addr] {
+					list[i].Name = name
+					break
+				}
+			}
+		}
+		seen[addr] = true
+	}
+
+	return list
+}
+
+/** Internal functions. **/
+
+func hclValue(i interface{}) string {
+	switch v := i.(type) {
+	case string:
+		return hclString(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case []string:
+		vals := make([]string, 0, len(v))
+		for _, s := range v {
+			vals = append(vals, hclString(s))
+		}
+		return fmt.Sprintf("[%s]", strings.Join(vals, ", "))
+	default:
+		return hclString(fmt.Sprintf("%v", v))
+	}
+}
+
+// hclString returns s as a quoted HCL string.
+//
+// HCL only supports a subset of Go's escape sequences, and treats "${" and
+// "%{" as the start of template sequences, so strconv.Quote can't be used.
+func hclString(s string) string {
+	var buf bytes.Buffer
+
+	buf.WriteByte('"')
+	for i, r := range s {
+		switch r {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		case '$', '%':
+			buf.WriteRune(r)
+			if strings.HasPrefix(s[i+1:], "{") {
+				buf.WriteRune(r)
+			}
+		default:
+			if r < 0x20 || r == 0x7f || r == utf8.RuneError {
+				fmt.Fprintf(&buf, `\u%04x`, r)
+			} else {
+				buf.WriteRune(r)
+			}
+		}
+	}
+	buf.WriteByte('"')
+
+	return buf.String()
+}
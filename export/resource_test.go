@@ -0,0 +1,96 @@
+package export
+
+import (
+	"testing"
+)
+
+func TestResourceName(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		parts []string
+		want  string
+	}{
+		{"simple", []string{"addr", "web"}, "addr_web"},
+		{"spaces and dots", []string{"addr", "web server.1"}, "addr_web_server_1"},
+		{"leading digit", []string{"10.1.1.1"}, "_10_1_1_1"},
+		{"skip empty", []string{"", "tag", "red"}, "tag_red"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if got := ResourceName(tc.parts...); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAddSkipsEmptyValues(t *testing.T) {
+	r := Resource{Type: "panos_address_object", Name: "x"}
+	r.Add("name", "x")
+	r.Add("description", "")
+	r.Add("tags", []string{})
+	r.Add("enable", false)
+	r.Add("count", 0)
+
+	if len(r.Attributes) != 1 {
+		t.Errorf("Expected 1 attribute, got %d: %#v", len(r.Attributes), r.Attributes)
+	}
+}
+
+func TestHcl(t *testing.T) {
+	r := Resource{Type: "panos_address_object", Name: "addr_web"}
+	r.Add("name", "web")
+	r.Add("value", "10.1.1.1")
+	r.Add("tags", []string{"a", "${b}"})
+	r.Add("enable", true)
+
+	want := `resource "panos_address_object" "addr_web" {
+    name   = "web"
+    value  = "10.1.1.1"
+    tags   = ["a", "$${b}"]
+    enable = true
+}
+`
+
+	if got := r.Hcl(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestUniqueNames(t *testing.T) {
+	list := []Resource{
+		{Type: "panos_address_object", Name: ResourceName("vsys1", "addr", "web.1")},
+		{Type: "panos_address_object", Name: ResourceName("vsys1", "addr", "web_1")},
+		{Type: "panos_address_object", Name: "vsys1_addr_web_1_2"},
+		{Type: "panos_address_object", Name: ResourceName("vsys1", "addr", "web 1")},
+		{Type: "panos_address_group", Name: ResourceName("vsys1", "addr", "web.1")},
+	}
+	want := []string{"vsys1_addr_web_1", "vsys1_addr_web_1_2", "vsys1_addr_web_1_2_2", "vsys1_addr_web_1_3", "vsys1_addr_web_1"}
+
+	UniqueNames(list)
+	for i := range list {
+		if list[i].Name != want[i] {
+			t.Errorf("Resource %d: got %q, want %q", i, list[i].Name, want[i])
+		}
+	}
+}
+
+func TestHclString(t *testing.T) {
+	testCases := []struct {
+		in   string
+		want string
+	}{
+		{`say "hi"`, `"say \"hi\""`},
+		{"line\none\ttab", `"line\none\ttab"`},
+		{"bell\x07", `"bell\u0007"`},
+		{"café", `"café"`},
+		{"${var} and %{if} and $5 and 100%", `"$${var} and %%{if} and $5 and 100%"`},
+	}
+
+	for _, tc := range testCases {
+		if got := hclString(tc.in); got != tc.want {
+			t.Errorf("%q: got %s, want %s", tc.in, got, tc.want)
+		}
+	}
+}
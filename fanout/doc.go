@@ -0,0 +1,20 @@
+/*
+Package fanout runs the same sequence of operations against many PAN-OS
+devices concurrently.
+
+Each device is a util.XapiClient, so both firewalls and Panoramas can be
+used.  Steps that need the full client can type assert it, but a commit
+works on either using pango.CommitOptions:
+
+	e := fanout.Executor{Parallelism: 5}
+	results := e.Run(ctx, devices,
+	    func(ctx context.Context, c util.XapiClient) error {
+	        _, _, err := c.Commit(pango.CommitOptions{Sync: true}, "", nil)
+	        return err
+	    },
+	)
+	if err := results.Err(); err != nil {
+	    log.Printf("Commit failed on some devices: %s", err)
+	}
+*/
+package fanout
@@ -0,0 +1,158 @@
+package fanout
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// DefaultParallelism is the number of devices operated on at once if the
+// executor does not specify a Parallelism.
+const DefaultParallelism = 10
+
+// Step is a single operation to perform against a device.
+type Step func(ctx context.Context, c util.XapiClient) error
+
+// Executor runs steps against many devices concurrently.
+//
+// Parallelism is the max number of devices operated on at once.  If
+// StopOnFailure is true, then the first failure cancels the context passed to
+// the steps, and devices that have not yet started are skipped.
+type Executor struct {
+	Parallelism   int
+	StopOnFailure bool
+}
+
+// Result is the outcome of running the steps against a single device.
+//
+// Step is the index of the step that failed, or -1 if no step failed.  If the
+// device was skipped or stopped between steps because of StopOnFailure or the
+// context being canceled, then Skipped is true, Step is the first step not run,
+// and Err is the context's error.
+type Result struct {
+	Index   int
+	Device  util.XapiClient
+	Step    int
+	Skipped bool
+	Err     error
+}
+
+// Results is the results of all devices, in the same order as the devices
+// given to Run.
+type Results []Result
+
+// Failed returns the results of the devices that failed.
+func (o Results) Failed() Results {
+	var ans Results
+
+	for _, r := range o {
+		if r.Err != nil && !r.Skipped {
+			ans = append(ans, r)
+		}
+	}
+
+	return ans
+}
+
+// Err returns an error summarizing all failed devices.
+//
+// If no device failed but some were skipped, such as when the context given
+// to Run was canceled, then the context's error is returned.  Otherwise nil
+// is returned.
+func (o Results) Err() error {
+	failed := o.Failed()
+	if len(failed) != 0 {
+		return Error{Results: failed}
+	}
+
+	for _, r := range o {
+		if r.Skipped {
+			return r.Err
+		}
+	}
+
+	return nil
+}
+
+// Error is the aggregated error of all devices that failed.
+type Error struct {
+	Results Results
+}
+
+// Error returns the error message.
+func (e Error) Error() string {
+	msgs := make([]string, 0, len(e.Results))
+	for _, r := range e.Results {
+		msgs = append(msgs, fmt.Sprintf("device %d (%s) step %d: %s", r.Index, r.Device, r.Step, r.Err))
+	}
+
+	return fmt.Sprintf("%d device(s) failed: %s", len(e.Results), strings.Join(msgs, "; "))
+}
+
+// Run runs the steps in order against each device.
+//
+// A device stops at its first failing step, but this does not affect other
+// devices unless StopOnFailure is set.  Steps are given a context derived
+// from ctx that is canceled on the first failure if StopOnFailure is set, and
+// no further steps are started once that context is done.
+func (e Executor) Run(ctx context.Context, devices []util.XapiClient, steps ...Step) Results {
+	ans := make(Results, len(devices))
+	for i := range devices {
+		ans[i] = Result{Index: i, Device: devices[i], Step: -1}
+	}
+
+	n := e.Parallelism
+	if n <= 0 {
+		n = DefaultParallelism
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, n)
+
+	for i := range devices {
+		sem <- struct{}{}
+		if err := ctx.Err(); err != nil {
+			<-sem
+			for j := i; j < len(devices); j++ {
+				ans[j].Skipped = true
+				ans[j].Err = err
+			}
+			break
+		}
+
+		wg.Add(1)
+		go func(r *Result) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			for j, s := range steps {
+				if err := ctx.Err(); err != nil {
+					r.Step = j
+					r.Skipped = true
+					r.Err = err
+					return
+				}
+				if err := s(ctx, r.Device); err != nil {
+					r.Step = j
+					r.Err = err
+					if e.StopOnFailure {
+						cancel()
+					}
+					return
+				}
+			}
+		}(&ans[i])
+	}
+
+	wg.Wait()
+
+	return ans
+}
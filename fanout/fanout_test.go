@@ -0,0 +1,137 @@
+package fanout
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+func devices(n int) []util.XapiClient {
+	ans := make([]util.XapiClient, 0, n)
+	for i := 0; i < n; i++ {
+		mc := &testdata.MockClient{}
+		mc.AddResp(fmt.Sprintf("<id>%d</id>", i))
+		ans = append(ans, mc)
+	}
+
+	return ans
+}
+
+func TestRun(t *testing.T) {
+	var cur, peak int32
+	var mu sync.Mutex
+	seen := make(map[util.XapiClient]int)
+
+	e := Executor{Parallelism: 3}
+	results := e.Run(context.Background(), devices(10),
+		func(ctx context.Context, c util.XapiClient) error {
+			v := atomic.AddInt32(&cur, 1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if v <= p || atomic.CompareAndSwapInt32(&peak, p, v) {
+					break
+				}
+			}
+			_, err := c.Op("<show />", "", nil, nil)
+			atomic.AddInt32(&cur, -1)
+			return err
+		},
+		func(ctx context.Context, c util.XapiClient) error {
+			mu.Lock()
+			defer mu.Unlock()
+			seen[c]++
+			if c.(*testdata.MockClient).Called == 1 && len(seen) == 4 {
+				return fmt.Errorf("fourth device failed")
+			}
+			return nil
+		},
+	)
+
+	if len(results) != 10 || len(seen) != 10 {
+		t.Fatalf("Expected 10 results and devices, got %d and %d", len(results), len(seen))
+	}
+	if peak > 3 {
+		t.Errorf("Parallelism exceeded: %d", peak)
+	}
+
+	failed := results.Failed()
+	if len(failed) != 1 || failed[0].Step != 1 {
+		t.Errorf("Expected one failure on step 1, got %#v", failed)
+	}
+	if err := results.Err(); err == nil {
+		t.Errorf("No aggregated error")
+	}
+}
+
+func TestRunStopOnFailure(t *testing.T) {
+	e := Executor{Parallelism: 1, StopOnFailure: true}
+	results := e.Run(context.Background(), devices(5),
+		func(ctx context.Context, c util.XapiClient) error {
+			return fmt.Errorf("failed")
+		},
+	)
+
+	if results[0].Err == nil || results[0].Skipped {
+		t.Errorf("First device did not fail: %#v", results[0])
+	}
+	for _, r := range results[1:] {
+		if !r.Skipped {
+			t.Errorf("Device %d was not skipped", r.Index)
+		}
+	}
+	if len(results.Failed()) != 1 {
+		t.Errorf("Skipped devices counted as failed")
+	}
+}
+
+func TestRunStopOnFailureInFlight(t *testing.T) {
+	started := make(chan struct{})
+	list := devices(2)
+
+	e := Executor{Parallelism: 2, StopOnFailure: true}
+	results := e.Run(context.Background(), list,
+		func(ctx context.Context, c util.XapiClient) error {
+			if c == list[0] {
+				<-started
+				return fmt.Errorf("failed")
+			}
+			close(started)
+			<-ctx.Done()
+			return nil
+		},
+		func(ctx context.Context, c util.XapiClient) error {
+			return fmt.Errorf("second step should not run")
+		},
+	)
+
+	if results[0].Err == nil || results[0].Skipped {
+		t.Errorf("First device did not fail: %#v", results[0])
+	}
+	if !results[1].Skipped || results[1].Step != 1 || results[1].Err != context.Canceled {
+		t.Errorf("In flight device not stopped: %#v", results[1])
+	}
+}
+
+func TestRunCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	e := Executor{}
+	results := e.Run(ctx, devices(3),
+		func(ctx context.Context, c util.XapiClient) error {
+			return nil
+		},
+	)
+
+	if len(results.Failed()) != 0 {
+		t.Errorf("Skipped devices counted as failed")
+	}
+	if err := results.Err(); err != context.Canceled {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
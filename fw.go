@@ -7,10 +7,12 @@ import (
 
 	// Various namespace imports.
 	"github.com/PaloAltoNetworks/pango/dev"
+	"github.com/PaloAltoNetworks/pango/jobs"
 	"github.com/PaloAltoNetworks/pango/licen"
 	"github.com/PaloAltoNetworks/pango/netw"
 	"github.com/PaloAltoNetworks/pango/objs"
 	"github.com/PaloAltoNetworks/pango/poli"
+	"github.com/PaloAltoNetworks/pango/predefined"
 	"github.com/PaloAltoNetworks/pango/userid"
 )
 
@@ -25,22 +27,26 @@ import (
 //      * Objects
 //      * Licensing
 //      * UserId
+//      * Predefined
+//      * Jobs
 type Firewall struct {
 	Client
 
 	// Namespaces
-	Network   *netw.FwNetw
-	Device    *dev.FwDev
-	Policies  *poli.FwPoli
-	Objects   *objs.FwObjs
-	Licensing *licen.Licen
-	UserId    *userid.UserId
+	Network    *netw.FwNetw
+	Device     *dev.FwDev
+	Policies   *poli.FwPoli
+	Objects    *objs.FwObjs
+	Licensing  *licen.Licen
+	UserId     *userid.UserId
+	Predefined *predefined.Predefined
+	Jobs       *jobs.Jobs
 }
 
 // Initialize does some initial setup of the Firewall connection, retrieves
 // the API key if it was not already present, then performs "show system
 // info" to get the PAN-OS version.  The full results are saved into the
-// client's SystemInfo map.
+// client's SystemInfo map, and the typed form into the client's Info.
 //
 // If not specified, the following is assumed:
 //  * Protocol: https
@@ -162,4 +168,10 @@ func (c *Firewall) initNamespaces() {
 
 	c.UserId = &userid.UserId{}
 	c.UserId.Initialize(c)
+
+	c.Predefined = &predefined.Predefined{}
+	c.Predefined.Initialize(c)
+
+	c.Jobs = &jobs.Jobs{}
+	c.Jobs.Initialize(c)
 }
@@ -0,0 +1,192 @@
+package pango
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// DetectActive determines which of Hostname and HaPeer is the active HA
+// member, routing all future config changes, commits, and op commands to it.
+//
+// This is invoked automatically before the first such request, and again
+// whenever the active member can't be connected to or PAN-OS refuses a
+// request because the member is no longer active, such as after an HA
+// failover.  Both HA members must accept the same API key.
+func (c *Client) DetectActive() error {
+	if c.HaPeer == "" {
+		return fmt.Errorf("No HA peer specified")
+	}
+
+	for _, host := range []string{c.Hostname, c.HaPeer} {
+		u := c.apiUrl(host)
+		state, err := c.haState(u)
+		if err != nil {
+			c.LogOp("(op) failed to get HA state of %s: %s", host, err)
+			continue
+		}
+		if isActiveHaState(state) {
+			c.LogOp("(op) active HA member is %s", host)
+			c.active_url = u
+			return nil
+		}
+	}
+
+	c.active_url = ""
+	return fmt.Errorf("Neither %s nor %s is the active HA member", c.Hostname, c.HaPeer)
+}
+
+/** Private functions **/
+
+func (c *Client) haState(u string) (string, error) {
+	type req struct {
+		XMLName xml.Name `xml:"show"`
+		Cmd     string   `xml:"high-availability>state"`
+	}
+
+	type resp struct {
+		State string `xml:"result>group>local-info>state"`
+	}
+
+	data := url.Values{}
+	data.Set("type", "op")
+	if err := addToData("cmd", req{}, true, &data); err != nil {
+		return "", err
+	}
+	if c.ApiKey != "" {
+		data.Set("key", c.ApiKey)
+	}
+
+	body, err := c.postTo(u, data)
+	if err != nil {
+		return "", err
+	}
+
+	ans := resp{}
+	if _, err = c.endCommunication(body, &ans); err != nil {
+		return "", err
+	}
+
+	return ans.State, nil
+}
+
+// postActive sends the given data to the active HA member.  If the connection
+// to that member can't be established, or if PAN-OS responds that the member
+// is not active, then the active member is detected again and the request is
+// retried once.
+//
+// Other transport errors are returned as-is, as the request may have already
+// reached the member, and resending a config change or commit would run it
+// twice.
+func (c *Client) postActive(data url.Values) ([]byte, error) {
+	if c.active_url == "" {
+		if err := c.DetectActive(); err != nil {
+			return nil, err
+		}
+	}
+
+	body, err := c.postTo(c.active_url, data)
+	if err != nil && !isDialError(err) {
+		return nil, err
+	} else if err == nil && !isHaPeerError(body) {
+		return body, nil
+	}
+
+	if e2 := c.DetectActive(); e2 != nil {
+		return body, err
+	}
+
+	return c.postTo(c.active_url, data)
+}
+
+func (c *Client) postTo(u string, data url.Values) ([]byte, error) {
+	r, err := c.con.PostForm(u, data)
+	if err != nil {
+		return nil, err
+	}
+
+	defer r.Body.Close()
+	return c.readBody(r.Body)
+}
+
+func (c *Client) apiUrl(host string) string {
+	if c.Port == 0 {
+		return fmt.Sprintf("%s://%s/api", c.Protocol, host)
+	}
+
+	return fmt.Sprintf("%s://%s:%d/api", c.Protocol, host, c.Port)
+}
+
+// isActiveHaState returns true if the given HA state is that of the active
+// member.  Firewalls report "active" or "active-primary", while Panorama
+// reports "primary-active" or "secondary-active".
+func isActiveHaState(state string) bool {
+	switch state {
+	case "active", "active-primary", "primary-active", "secondary-active":
+		return true
+	}
+
+	return false
+}
+
+// isHaPeerError returns true if the given response is PAN-OS refusing the
+// request because this HA member is not the active one.
+func isHaPeerError(body []byte) bool {
+	e := panosErrorResponseWithoutLine{}
+	if err := xml.Unmarshal(body, &e); err != nil || !e.Failed() {
+		return false
+	}
+
+	switch e.ResponseCode {
+	case 14, 15:
+	default:
+		return false
+	}
+
+	msg := strings.ToLower(e.Error())
+	if msg == "" {
+		e2 := panosErrorResponseWithLine{}
+		if xml.Unmarshal(body, &e2) == nil {
+			msg = strings.ToLower(e2.ResponseMsg)
+		}
+	}
+
+	for _, x := range []string{"passive", "not active", "ha peer", "non-functional", "suspended"} {
+		if strings.Contains(msg, x) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isDialError returns true if the given error is from failing to connect,
+// meaning that the request was never sent.
+func isDialError(err error) bool {
+	if e, ok := err.(*url.Error); ok {
+		err = e.Err
+	}
+
+	e, ok := err.(*net.OpError)
+	return ok && e.Op == "dial"
+}
+
+// isHaActive returns true if the given request must go to the active HA
+// member.  This is all commits, config changes, and op commands, as op
+// commands include the job polling that follows a commit.
+func isHaActive(data url.Values) bool {
+	switch data.Get("type") {
+	case "commit", "op":
+		return true
+	case "config":
+		switch data.Get("action") {
+		case "show", "get":
+			return false
+		}
+		return true
+	}
+
+	return false
+}
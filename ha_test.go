@@ -0,0 +1,213 @@
+package pango
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type haMember struct {
+	sync.Mutex
+	state   string
+	refuse  bool
+	drop    bool
+	actions []string
+}
+
+func (m *haMember) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.Lock()
+	defer m.Unlock()
+
+	if r.FormValue("type") == "op" && strings.Contains(r.FormValue("cmd"), "high-availability") {
+		fmt.Fprintf(w, `<response status="success"><result><group><local-info><state>%s</state></local-info></group></result></response>`, m.state)
+		return
+	}
+
+	if m.drop {
+		m.actions = append(m.actions, "dropped")
+		if conn, _, err := w.(http.Hijacker).Hijack(); err == nil {
+			conn.Close()
+		}
+		return
+	}
+
+	if m.refuse {
+		fmt.Fprint(w, `<response status="error" code="15"><msg><line>Operation denied: device is in HA passive state</line></msg></response>`)
+		return
+	}
+
+	if r.FormValue("type") == "op" {
+		m.actions = append(m.actions, "op")
+	} else {
+		m.actions = append(m.actions, r.FormValue("action"))
+	}
+	fmt.Fprint(w, `<response status="success" code="20"><msg>command succeeded</msg></response>`)
+}
+
+func newHaClient(t *testing.T, host, peer string) *Client {
+	c := &Client{
+		Hostname: strings.TrimPrefix(host, "http://"),
+		HaPeer:   strings.TrimPrefix(peer, "http://"),
+		Protocol: "http",
+		ApiKey:   "secret",
+		Logging:  LogQuiet,
+	}
+	if err := c.initCon(); err != nil {
+		t.Fatalf("initCon failed: %s", err)
+	}
+
+	return c
+}
+
+func TestHaRouting(t *testing.T) {
+	a := &haMember{state: "passive"}
+	b := &haMember{state: "active"}
+	sa := httptest.NewServer(a)
+	defer sa.Close()
+	sb := httptest.NewServer(b)
+
+	c := newHaClient(t, sa.URL, sb.URL)
+
+	xpath := "/config/shared/address/entry[@name='one']"
+	elm := "<ip-netmask>10.1.1.1</ip-netmask>"
+
+	if err := c.SetXpath(xpath, elm); err != nil {
+		t.Fatalf("Set failed: %s", err)
+	}
+	var ans struct{}
+	if _, err := c.Show(xpath, nil, &ans); err != nil {
+		t.Fatalf("Show failed: %s", err)
+	}
+	if len(b.actions) != 1 || b.actions[0] != "set" {
+		t.Errorf("Set not sent to the active member: %v", b.actions)
+	}
+	if len(a.actions) != 1 || a.actions[0] != "show" {
+		t.Errorf("Show not sent to Hostname: %v", a.actions)
+	}
+
+	// Failover, with the active member no longer accepting connections.
+	sb.Close()
+	c.con.CloseIdleConnections()
+	a.Lock()
+	a.state = "active"
+	a.Unlock()
+
+	if err := c.DeleteXpath(xpath); err != nil {
+		t.Fatalf("Delete after failover failed: %s", err)
+	}
+	if len(a.actions) != 2 || a.actions[1] != "delete" {
+		t.Errorf("Delete not sent to the new active member: %v", a.actions)
+	}
+}
+
+func TestHaRoutingOp(t *testing.T) {
+	a := &haMember{state: "passive"}
+	b := &haMember{state: "active"}
+	sa := httptest.NewServer(a)
+	defer sa.Close()
+	sb := httptest.NewServer(b)
+	defer sb.Close()
+
+	c := newHaClient(t, sa.URL, sb.URL)
+
+	if _, err := c.Op("<show><jobs><id>4</id></jobs></show>", "", nil, nil); err != nil {
+		t.Fatalf("Op failed: %s", err)
+	}
+	if len(b.actions) != 1 || b.actions[0] != "op" {
+		t.Errorf("Op not sent to the active member: %v", b.actions)
+	}
+	if len(a.actions) != 0 {
+		t.Errorf("Op sent to the passive member: %v", a.actions)
+	}
+}
+
+func TestHaRoutingPanorama(t *testing.T) {
+	a := &haMember{state: "secondary-passive"}
+	b := &haMember{state: "primary-active"}
+	sa := httptest.NewServer(a)
+	defer sa.Close()
+	sb := httptest.NewServer(b)
+	defer sb.Close()
+
+	c := newHaClient(t, sa.URL, sb.URL)
+
+	if err := c.DetectActive(); err != nil {
+		t.Fatalf("DetectActive failed: %s", err)
+	}
+	if err := c.SetXpath("/config/shared/address/entry[@name='one']", "<fqdn>a.example.com</fqdn>"); err != nil {
+		t.Fatalf("Set failed: %s", err)
+	}
+	if len(b.actions) != 1 || b.actions[0] != "set" {
+		t.Errorf("Set not sent to the active member: %v", b.actions)
+	}
+}
+
+func TestHaRoutingPeerError(t *testing.T) {
+	a := &haMember{state: "passive"}
+	b := &haMember{state: "active"}
+	sa := httptest.NewServer(a)
+	defer sa.Close()
+	sb := httptest.NewServer(b)
+	defer sb.Close()
+
+	c := newHaClient(t, sa.URL, sb.URL)
+	xpath := "/config/shared/address/entry[@name='one']"
+
+	if err := c.SetXpath(xpath, "<fqdn>a.example.com</fqdn>"); err != nil {
+		t.Fatalf("Set failed: %s", err)
+	}
+
+	// Failover where the old active member still answers.
+	b.Lock()
+	b.state = "passive"
+	b.refuse = true
+	b.Unlock()
+	a.Lock()
+	a.state = "active"
+	a.Unlock()
+
+	if err := c.DeleteXpath(xpath); err != nil {
+		t.Fatalf("Delete after failover failed: %s", err)
+	}
+	if len(a.actions) != 1 || a.actions[0] != "delete" {
+		t.Errorf("Delete not sent to the new active member: %v", a.actions)
+	}
+}
+
+func TestHaRoutingNoResendAfterSend(t *testing.T) {
+	a := &haMember{state: "passive"}
+	b := &haMember{state: "active"}
+	sa := httptest.NewServer(a)
+	defer sa.Close()
+	sb := httptest.NewServer(b)
+	defer sb.Close()
+
+	c := newHaClient(t, sa.URL, sb.URL)
+	xpath := "/config/shared/address/entry[@name='one']"
+
+	if err := c.SetXpath(xpath, "<fqdn>a.example.com</fqdn>"); err != nil {
+		t.Fatalf("Set failed: %s", err)
+	}
+
+	// The active member receives the request, but the connection drops
+	// before the response.
+	b.Lock()
+	b.drop = true
+	b.Unlock()
+	a.Lock()
+	a.state = "active"
+	a.Unlock()
+
+	if err := c.DeleteXpath(xpath); err == nil {
+		t.Errorf("No error for a dropped connection")
+	}
+	if len(b.actions) != 2 || b.actions[1] != "dropped" {
+		t.Errorf("Delete not sent once to the active member: %v", b.actions)
+	}
+	if len(a.actions) != 0 {
+		t.Errorf("Delete resent to the other member: %v", a.actions)
+	}
+}
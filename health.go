@@ -0,0 +1,141 @@
+package pango
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/pnrm/managed"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// CertificateExpiryFormat is the time format of device certificate
+// expiration dates.
+const CertificateExpiryFormat = managed.CertificateExpiryFormat
+
+// DeviceHealth is the health of a single device managed by Panorama.
+//
+// LicenseExpiry is the earliest expiration of the device's licenses that
+// do not last forever, and ExpiredLicenses are the features whose license has
+// already expired.  CertificateExpiry is zero if the device has no device
+// certificate or if its expiration could not be parsed.  PendingChanges is
+// only checked for connected devices.
+//
+// Errors encountered gathering part of the information for this device are
+// in Errors; the rest of the fields are still populated.
+type DeviceHealth struct {
+	Serial            string
+	Hostname          string
+	IpAddress         string
+	Model             string
+	SwVersion         string
+	Connected         bool
+	HaState           string
+	AppVersion        string
+	ThreatVersion     string
+	AvVersion         string
+	WildfireVersion   string
+	LicenseExpiry     time.Time
+	ExpiredLicenses   []string
+	CertificateStatus string
+	CertificateExpiry time.Time
+	PendingChanges    bool
+	Errors            []string
+}
+
+// Healthy returns true if the device is connected, has no expired licenses,
+// and no errors were encountered getting its health.
+func (o DeviceHealth) Healthy() bool {
+	return o.Connected && len(o.ExpiredLicenses) == 0 && len(o.Errors) == 0
+}
+
+// DeviceHealth returns the health of each device managed by Panorama, in the
+// order the devices are returned by PAN-OS.
+//
+// An error is only returned if the managed device inventory could not be
+// retrieved.  Failing to get licensing or pending changes is recorded in each
+// affected device's Errors.
+func (c *Panorama) DeviceHealth() ([]DeviceHealth, error) {
+	list, err := c.Panorama.ManagedDevice.All()
+	if err != nil {
+		return nil, err
+	}
+
+	ans := make([]DeviceHealth, 0, len(list))
+	idx := make(map[string]int, len(list))
+	for _, d := range list {
+		h := DeviceHealth{
+			Serial:            d.Serial,
+			Hostname:          d.Hostname,
+			IpAddress:         d.IpAddress,
+			Model:             d.Model,
+			SwVersion:         d.SwVersion,
+			Connected:         d.Connected,
+			HaState:           d.HaState,
+			AppVersion:        d.AppVersion,
+			ThreatVersion:     d.ThreatVersion,
+			AvVersion:         d.AvVersion,
+			WildfireVersion:   d.WildfireVersion,
+			CertificateStatus: d.CertificateStatus,
+			CertificateExpiry: d.CertificateExpires,
+		}
+		idx[d.Serial] = len(ans)
+		ans = append(ans, h)
+	}
+
+	lics, err := c.Licensing.ManagedDevices()
+	if err != nil {
+		for i := range ans {
+			ans[i].Errors = append(ans[i].Errors, err.Error())
+		}
+	} else {
+		for _, v := range lics {
+			i, ok := idx[v.Serial]
+			if !ok {
+				continue
+			}
+			if v.Expired {
+				ans[i].ExpiredLicenses = append(ans[i].ExpiredLicenses, v.Feature)
+			} else if !v.Perpetual() && (ans[i].LicenseExpiry.IsZero() || v.ExpiresAt.Before(ans[i].LicenseExpiry)) {
+				ans[i].LicenseExpiry = v.ExpiresAt
+			}
+		}
+	}
+
+	for i := range ans {
+		if !ans[i].Connected {
+			continue
+		}
+		pending, err := c.devicePendingChanges(ans[i].Serial)
+		if err != nil {
+			ans[i].Errors = append(ans[i].Errors, err.Error())
+		} else {
+			ans[i].PendingChanges = pending
+		}
+	}
+
+	return ans, nil
+}
+
+/** Internal functions **/
+
+func (c *Panorama) devicePendingChanges(serial string) (bool, error) {
+	type req struct {
+		XMLName xml.Name `xml:"check"`
+		Cmd     string   `xml:"pending-changes"`
+	}
+
+	type resp struct {
+		Result string `xml:"result"`
+	}
+
+	ans := resp{}
+
+	c.LogOp("(op) check pending-changes on %s", serial)
+	if _, err := c.Op(req{}, "", util.TargetExtras(serial), &ans); err != nil {
+		return false, fmt.Errorf("Failed to check pending changes on %s: %s", serial, err)
+	}
+
+	return strings.TrimSpace(ans.Result) == "yes", nil
+}
@@ -0,0 +1,86 @@
+package pango
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDeviceHealth(t *testing.T) {
+	pano := &Panorama{Client: Client{
+		rb: [][]byte{
+			[]byte(`<response status="success"><result><devices>
+    <entry name="0001">
+        <serial>0001</serial>
+        <hostname>fw1</hostname>
+        <connected>yes</connected>
+        <app-version>8500-7000</app-version>
+        <device-cert-present>Valid</device-cert-present>
+        <device-cert-expiry-date>2030/01/01 00:00:00 UTC</device-cert-expiry-date>
+        <ha><state>passive</state></ha>
+    </entry>
+    <entry name="0002">
+        <serial>0002</serial>
+        <hostname>fw2</hostname>
+        <connected>no</connected>
+    </entry>
+</devices></result></response>`),
+			[]byte(`<response status="success"><result><devices>
+    <entry name="0001">
+        <serial-no>0001</serial-no>
+        <licenses>
+            <entry><feature>Support</feature><expires>Never</expires><expired>no</expired></entry>
+            <entry><feature>Threat Prevention</feature><expires>March 15, 2030</expires><expired>no</expired></entry>
+            <entry><feature>WildFire License</feature><expires>June 01, 2029</expires><expired>no</expired></entry>
+        </licenses>
+    </entry>
+    <entry name="0002">
+        <serial-no>0002</serial-no>
+        <licenses>
+            <entry><feature>Threat Prevention</feature><expires>January 01, 2020</expires><expired>yes</expired></entry>
+        </licenses>
+    </entry>
+</devices></result></response>`),
+			[]byte(`<response status="success"><result>yes</result></response>`),
+		},
+	}}
+	if err := pano.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	list, err := pano.DeviceHealth()
+	if err != nil {
+		t.Fatalf("DeviceHealth failed: %s", err)
+	}
+	if len(list) != 2 {
+		t.Fatalf("Expected 2 devices, got %d", len(list))
+	}
+
+	h := list[0]
+	if !h.Connected || h.HaState != "passive" || h.AppVersion != "8500-7000" {
+		t.Errorf("Bad device: %#v", h)
+	}
+	if !h.LicenseExpiry.Equal(time.Date(2029, 6, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Bad license expiry: %s", h.LicenseExpiry)
+	}
+	if h.CertificateStatus != "Valid" || h.CertificateExpiry.Year() != 2030 {
+		t.Errorf("Bad certificate: %q %s", h.CertificateStatus, h.CertificateExpiry)
+	}
+	if !h.PendingChanges || !h.Healthy() {
+		t.Errorf("Bad status: %#v", h)
+	}
+
+	h = list[1]
+	if h.Connected || h.PendingChanges || h.Healthy() {
+		t.Errorf("Bad status: %#v", h)
+	}
+	if len(h.ExpiredLicenses) != 1 || h.ExpiredLicenses[0] != "Threat Prevention" {
+		t.Errorf("Bad expired licenses: %v", h.ExpiredLicenses)
+	}
+
+	if len(pano.rp) != 3 {
+		t.Fatalf("Expected 3 requests, got %d", len(pano.rp))
+	}
+	if pano.rp[2].Get("target") != "0001" {
+		t.Errorf("Pending changes target is %q", pano.rp[2].Get("target"))
+	}
+}
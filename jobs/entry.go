@@ -0,0 +1,87 @@
+package jobs
+
+import (
+	"strings"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Job is a normalized representation of a PAN-OS job.
+//
+// The Progress field is usually the percent complete, but for some finished
+// jobs PAN-OS reports the completion time here instead.  Refer to
+// util.Job.ParseTimes for how the timestamps are interpreted.
+type Job struct {
+	Id          uint
+	Type        string
+	User        string
+	Status      string
+	Result      string
+	Progress    string
+	Description string
+	Queued      bool
+	Stoppable   bool
+	Enqueued    time.Time
+	Dequeued    time.Time
+	Finished    time.Time
+	Details     []string
+	Warnings    []string
+	Devices     []Device
+}
+
+// Device is the result of a job for a single device, such as a Panorama
+// commit all (push) to a device group.
+type Device struct {
+	Serial   string
+	Name     string
+	Vsys     string
+	Status   string
+	Result   string
+	Progress string
+	Errors   []string
+	Warnings []string
+}
+
+/** Structs / functions for normalization. **/
+
+func normalize(e util.Job, loc *time.Location) (Job, error) {
+	if err := e.ParseTimes(loc); err != nil {
+		return Job{}, err
+	}
+
+	ans := Job{
+		Id:          e.Id,
+		Type:        e.Type,
+		User:        e.User,
+		Status:      e.Status,
+		Result:      e.Result,
+		Progress:    e.Progress,
+		Description: e.Description,
+		Queued:      strings.EqualFold(e.Queued, "yes"),
+		Stoppable:   strings.EqualFold(e.Stoppable, "yes"),
+		Enqueued:    e.EnqueuedAt,
+		Dequeued:    e.DequeuedAt,
+		Finished:    e.FinishedAt,
+		Details:     e.Details.Strings(),
+		Warnings:    e.Warnings.Strings(),
+	}
+
+	if len(e.Devices) > 0 {
+		ans.Devices = make([]Device, 0, len(e.Devices))
+		for _, d := range e.Devices {
+			ans.Devices = append(ans.Devices, Device{
+				Serial:   d.Serial,
+				Name:     d.Name,
+				Vsys:     d.Vsys,
+				Status:   d.Status,
+				Result:   d.Result,
+				Progress: d.Progress,
+				Errors:   d.Errors.Strings(),
+				Warnings: d.Warnings.Strings(),
+			})
+		}
+	}
+
+	return ans, nil
+}
@@ -0,0 +1,152 @@
+// Package jobs is the client.Jobs namespace.
+//
+// Jobs are asynchronous tasks such as commits, content downloads, and
+// software installs.  This namespace allows for listing jobs, inspecting a
+// single job in detail, and cancelling a job.
+package jobs
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Valid values for Job.Status.
+const (
+	StatusPending  = "PEND"
+	StatusActive   = "ACT"
+	StatusFinished = "FIN"
+)
+
+// Some common values for Job.Type.
+const (
+	TypeCommit      = "Commit"
+	TypeCommitAll   = "CommitAll"
+	TypeValidate    = "Validate"
+	TypeDownload    = "Downld"
+	TypeContent     = "Content"
+	TypeSoftware    = "SWInstall"
+	TypeAutoContent = "AutoCom"
+)
+
+// TimeFormat is the format of timestamps in job output.
+const TimeFormat = util.TimeFormat
+
+// Jobs is the client.Jobs namespace.
+//
+// Location is the timezone of the PAN-OS device, used to interpret the job
+// timestamps, defaulting to UTC if unspecified.  If Target is set, then
+// the jobs of that managed device (by serial number) are managed through
+// Panorama instead of the jobs of the client itself.
+type Jobs struct {
+	Location *time.Location
+	Target   string
+
+	con util.XapiClient
+}
+
+// Initialize is invoked on client.Initialize().
+func (c *Jobs) Initialize(i util.XapiClient) {
+	c.con = i
+}
+
+// List returns all jobs matching the given filter.
+//
+// If the filter specifies a Location, it is used instead of the
+// namespace's Location.
+func (c *Jobs) List(f Filter) ([]Job, error) {
+	loc := f.Location
+	if loc == nil {
+		loc = c.Location
+	}
+
+	c.con.LogOp("(op) show jobs all")
+	ans := util.JobsResponse{}
+	if _, err := c.con.Op("<show><jobs><all /></jobs></show>", "", util.TargetExtras(c.Target), &ans); err != nil {
+		return nil, err
+	}
+
+	list := make([]Job, 0, len(ans.Jobs))
+	for _, x := range ans.Jobs {
+		j, err := normalize(x, loc)
+		if err != nil {
+			return nil, err
+		}
+		if f.Matches(j) {
+			list = append(list, j)
+		}
+	}
+
+	return list, nil
+}
+
+// Get returns the full details of the given job, including per device
+// results for Panorama pushes.
+func (c *Jobs) Get(id uint) (Job, error) {
+	type req struct {
+		XMLName xml.Name `xml:"show"`
+		Id      uint     `xml:"jobs>id"`
+	}
+
+	c.con.LogOp("(op) show jobs id %d", id)
+	ans := util.JobsResponse{}
+	if _, err := c.con.Op(req{Id: id}, "", util.TargetExtras(c.Target), &ans); err != nil {
+		return Job{}, err
+	} else if len(ans.Jobs) == 0 {
+		return Job{}, fmt.Errorf("Job %d not found", id)
+	}
+
+	return normalize(ans.Jobs[0], c.Location)
+}
+
+// Cancel cancels the given job, whether it is queued or already running.
+//
+// Not every job can be stopped once it is running; refer to the Stoppable
+// field of the job to know ahead of time.
+func (c *Jobs) Cancel(id uint) error {
+	type req struct {
+		XMLName xml.Name `xml:"clear"`
+		Id      uint     `xml:"job>id"`
+	}
+
+	c.con.LogOp("(op) cancelling job %d", id)
+	_, err := c.con.Op(req{Id: id}, "", util.TargetExtras(c.Target), nil)
+	return err
+}
+
+// Filter limits which jobs List returns.
+//
+// Empty fields match everything.  Jobs are matched against the time range
+// using their enqueue time; Location is the timezone of the PAN-OS device,
+// defaulting to UTC if unspecified.
+type Filter struct {
+	Type     string
+	Status   string
+	Result   string
+	User     string
+	After    time.Time
+	Before   time.Time
+	Location *time.Location
+}
+
+// Matches returns if the given job matches this filter.
+func (o Filter) Matches(j Job) bool {
+	switch {
+	case o.Type != "" && o.Type != j.Type:
+		return false
+	case o.Status != "" && o.Status != j.Status:
+		return false
+	case o.Result != "" && o.Result != j.Result:
+		return false
+	case o.User != "" && o.User != j.User:
+		return false
+	case !o.After.IsZero() && !j.Enqueued.After(o.After):
+		return false
+	case !o.Before.IsZero() && !j.Enqueued.Before(o.Before):
+		return false
+	}
+
+	return true
+}
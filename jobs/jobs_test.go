@@ -0,0 +1,87 @@
+package jobs
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+const jobsXml = `
+<job><tenq>2020/03/01 10:00:00</tenq><tdeq>10:00:01</tdeq><id>3</id><user>admin</user><type>Commit</type><status>FIN</status><queued>NO</queued><stoppable>no</stoppable><result>OK</result><tfin>2020/03/01 10:01:00</tfin><progress>100</progress><warnings><line>Rule "x" shadows rule "y"</line></warnings></job>
+<job><tenq>2020/03/02 10:00:00</tenq><id>4</id><user>admin</user><type>Downld</type><status>FIN</status><queued>NO</queued><stoppable>no</stoppable><result>FAIL</result><progress>100</progress><details><line>Failed to download</line></details></job>
+<job><tenq>2020/03/03 10:00:00</tenq><id>5</id><user>bob</user><type>Commit</type><status>PEND</status><queued>YES</queued><stoppable>yes</stoppable><result>PEND</result><progress>0</progress></job>
+`
+
+func TestList(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(jobsXml)
+
+	ns := &Jobs{}
+	ns.Initialize(mc)
+
+	list, err := ns.List(Filter{
+		Type:  TypeCommit,
+		After: time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("List failed: %s", err)
+	}
+
+	if len(list) != 1 {
+		t.Fatalf("Expected 1 job, got %#v", list)
+	}
+	j := list[0]
+	if j.Id != 5 || !j.Queued || !j.Stoppable || j.User != "bob" {
+		t.Errorf("Unexpected job: %#v", j)
+	}
+}
+
+func TestGet(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(`<job><tenq>2020/03/01 10:00:00</tenq><id>9</id><user>admin</user><type>CommitAll</type><status>FIN</status><result>FAIL</result><progress>100</progress><devices><entry><serial-no>0123</serial-no><devicename>fw1</devicename><status>commit failed</status><result>FAIL</result><progress>100</progress><details><msg><errors><line>zone z1 missing</line></errors><warnings><line>deprecated setting</line></warnings></msg></details></entry></devices></job>`)
+
+	ns := &Jobs{}
+	ns.Initialize(mc)
+
+	j, err := ns.Get(9)
+	if err != nil {
+		t.Fatalf("Get failed: %s", err)
+	}
+
+	if j.Id != 9 || j.Type != TypeCommitAll || j.Enqueued.IsZero() {
+		t.Errorf("Unexpected job: %#v", j)
+	}
+	if len(j.Devices) != 1 {
+		t.Fatalf("Expected 1 device, got %#v", j.Devices)
+	}
+	d := j.Devices[0]
+	if d.Serial != "0123" || d.Name != "fw1" || d.Result != "FAIL" {
+		t.Errorf("Unexpected device: %#v", d)
+	} else if len(d.Errors) != 1 || d.Errors[0] != "zone z1 missing" {
+		t.Errorf("Unexpected errors: %#v", d.Errors)
+	} else if len(d.Warnings) != 1 || d.Warnings[0] != "deprecated setting" {
+		t.Errorf("Unexpected warnings: %#v", d.Warnings)
+	}
+}
+
+func TestGetLocationAndTarget(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(`<job><tenq>2020/03/01 10:00:00</tenq><id>9</id><type>Commit</type><status>FIN</status><result>OK</result></job>`)
+
+	loc := time.FixedZone("PST", -8*60*60)
+	ns := &Jobs{Location: loc, Target: "0123"}
+	ns.Initialize(mc)
+
+	j, err := ns.Get(9)
+	if err != nil {
+		t.Fatalf("Get failed: %s", err)
+	}
+	if !j.Enqueued.Equal(time.Date(2020, 3, 1, 10, 0, 0, 0, loc)) {
+		t.Errorf("Enqueued time not in the namespace location: %s", j.Enqueued)
+	}
+	if v := mc.Extras.(url.Values).Get("target"); v != "0123" {
+		t.Errorf("Target is %q, not 0123", v)
+	}
+}
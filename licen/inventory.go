@@ -0,0 +1,121 @@
+package licen
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// ExpiresFormat is the time format of license expiration dates.
+const ExpiresFormat = util.LicenseDateFormat
+
+// DeviceLicense is a single license installed on a device managed by
+// Panorama.
+//
+// Expires is the expiration date as returned by PAN-OS, while ExpiresAt is
+// the parsed form of it.  Licenses that never expire have a zero ExpiresAt.
+type DeviceLicense struct {
+	Serial      string
+	Hostname    string
+	Feature     string
+	Description string
+	Issued      string
+	Expires     string
+	ExpiresAt   time.Time
+	Expired     bool
+}
+
+// Perpetual returns true if this license never expires.
+func (o DeviceLicense) Perpetual() bool {
+	return o.ExpiresAt.IsZero()
+}
+
+// ExpiresBefore returns true if the license has expired or will expire
+// before the given time.
+func (o DeviceLicense) ExpiresBefore(t time.Time) bool {
+	return o.Expired || (!o.Perpetual() && o.ExpiresAt.Before(t))
+}
+
+// ManagedDevices returns the licenses installed on each device managed by
+// Panorama, one row per device and license.
+//
+// This is only valid for Panorama.
+func (c *Licen) ManagedDevices() ([]DeviceLicense, error) {
+	type req struct {
+		XMLName xml.Name `xml:"request"`
+		Cmd     string   `xml:"batch>license>info"`
+	}
+
+	ans := batchLicenses{}
+
+	c.con.LogOp("(op) request batch license info")
+	if _, err := c.con.Op(req{}, "", nil, &ans); err != nil {
+		return nil, fmt.Errorf("Failed to get managed device licenses: %s", err)
+	}
+
+	return ans.rows()
+}
+
+// Expiring filters the given licenses to those that have expired or will
+// expire before the given time, such as for forecasting renewals.
+func Expiring(list []DeviceLicense, t time.Time) []DeviceLicense {
+	var ans []DeviceLicense
+
+	for _, v := range list {
+		if v.ExpiresBefore(t) {
+			ans = append(ans, v)
+		}
+	}
+
+	return ans
+}
+
+/** Internal structs **/
+
+type batchLicenses struct {
+	Devices []batchDevice `xml:"result>devices>entry"`
+}
+
+func (o *batchLicenses) rows() ([]DeviceLicense, error) {
+	var ans []DeviceLicense
+
+	for _, d := range o.Devices {
+		serial := d.SerialNo
+		if serial == "" {
+			serial = d.Serial
+		}
+		if serial == "" {
+			serial = d.Name
+		}
+		for _, v := range d.Licenses {
+			expires, err := v.ExpiresAt()
+			if err != nil {
+				return nil, fmt.Errorf("Bad expiry for %q on %s: %s", v.Feature, serial, err)
+			}
+			row := DeviceLicense{
+				Serial:      serial,
+				Hostname:    d.Hostname,
+				Feature:     v.Feature,
+				Description: v.Description,
+				Issued:      v.Issued,
+				Expires:     v.Expires,
+				ExpiresAt:   expires,
+				Expired:     strings.EqualFold(v.Expired, "yes"),
+			}
+			ans = append(ans, row)
+		}
+	}
+
+	return ans, nil
+}
+
+type batchDevice struct {
+	Name     string         `xml:"name,attr"`
+	Serial   string         `xml:"serial"`
+	SerialNo string         `xml:"serial-no"`
+	Hostname string         `xml:"devicename"`
+	Licenses []util.License `xml:"licenses>entry"`
+}
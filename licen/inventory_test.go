@@ -0,0 +1,71 @@
+package licen
+
+import (
+	"testing"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestManagedDevices(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(`<devices>
+    <entry name="0001">
+        <serial-no>0001</serial-no>
+        <devicename>fw1</devicename>
+        <licenses>
+            <entry>
+                <feature>Threat Prevention</feature>
+                <issued>January 01, 2020</issued>
+                <expires>January 01, 2021</expires>
+                <expired>yes</expired>
+            </entry>
+            <entry>
+                <feature>PAN-DB URL Filtering</feature>
+                <issued>January 01, 2020</issued>
+                <expires>March 15, 2030</expires>
+                <expired>no</expired>
+            </entry>
+        </licenses>
+    </entry>
+    <entry name="0002">
+        <serial-no>0002</serial-no>
+        <devicename>fw2</devicename>
+        <licenses>
+            <entry>
+                <feature>Support</feature>
+                <issued>January 01, 2020</issued>
+                <expires>Never</expires>
+                <expired>no</expired>
+            </entry>
+        </licenses>
+    </entry>
+</devices>`)
+
+	l := &Licen{}
+	l.Initialize(mc)
+
+	ans, err := l.ManagedDevices()
+	if err != nil {
+		t.Fatalf("Failed: %s", err)
+	}
+	if len(ans) != 3 {
+		t.Fatalf("Expected 3 rows, got %d", len(ans))
+	}
+
+	if ans[0].Serial != "0001" || ans[0].Hostname != "fw1" || !ans[0].Expired {
+		t.Errorf("Bad first row: %#v", ans[0])
+	}
+	want := time.Date(2030, time.March, 15, 0, 0, 0, 0, time.UTC)
+	if !ans[1].ExpiresAt.Equal(want) {
+		t.Errorf("Expected expiry %s, got %s", want, ans[1].ExpiresAt)
+	}
+	if !ans[2].Perpetual() {
+		t.Errorf("License that never expires is not perpetual")
+	}
+
+	exp := Expiring(ans, time.Date(2030, time.June, 1, 0, 0, 0, 0, time.UTC))
+	if len(exp) != 2 || exp[0].Feature != "Threat Prevention" || exp[1].Feature != "PAN-DB URL Filtering" {
+		t.Errorf("Unexpected expiring licenses: %#v", exp)
+	}
+}
@@ -0,0 +1,179 @@
+package licen
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// DeviceLicensing is the licensing state of a single device managed by
+// Panorama.
+type DeviceLicensing struct {
+	Serial   string
+	Hostname string
+	Licenses []DeviceLicense
+}
+
+// Has returns true if the device has an unexpired license for the given
+// feature.  The feature name comparison is case insensitive.
+func (o DeviceLicensing) Has(feature string) bool {
+	for _, v := range o.Licenses {
+		if !v.Expired && strings.EqualFold(v.Feature, feature) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Expiring returns the licenses of this device that have expired or will
+// expire before the given time.
+func (o DeviceLicensing) Expiring(t time.Time) []DeviceLicense {
+	return Expiring(o.Licenses, t)
+}
+
+// ApiKeyInstalled returns true if the licensing API key has been installed.
+//
+// The licensing API key is required for Panorama to deactivate licenses of
+// managed devices.
+func (c *Licen) ApiKeyInstalled() (bool, error) {
+	key, err := c.GetApiKey()
+	if err != nil {
+		return false, err
+	}
+
+	return key != "", nil
+}
+
+// ManagedDeviceLicensing returns the licensing state of each device managed
+// by Panorama, in the order the devices are returned by PAN-OS.
+//
+// This is only valid for Panorama.
+func (c *Licen) ManagedDeviceLicensing() ([]DeviceLicensing, error) {
+	list, err := c.ManagedDevices()
+	if err != nil {
+		return nil, err
+	}
+
+	var ans []DeviceLicensing
+	idx := make(map[string]int)
+	for _, v := range list {
+		i, ok := idx[v.Serial]
+		if !ok {
+			i = len(ans)
+			idx[v.Serial] = i
+			ans = append(ans, DeviceLicensing{
+				Serial:   v.Serial,
+				Hostname: v.Hostname,
+			})
+		}
+		ans[i].Licenses = append(ans[i].Licenses, v)
+	}
+
+	return ans, nil
+}
+
+// PushAuthCode activates the given auth code on the specified managed
+// devices, blocking until the batch job completes.
+//
+// This is only valid for Panorama.
+func (c *Licen) PushAuthCode(code string, serials ...string) error {
+	return c.wait(c.PushAuthCodeJob(code, serials...))
+}
+
+// PushAuthCodeJob starts activating the given auth code on the specified
+// managed devices, returning the batch job ID without waiting for it.
+//
+// This is only valid for Panorama.
+func (c *Licen) PushAuthCodeJob(code string, serials ...string) (uint, error) {
+	if len(serials) == 0 {
+		return 0, fmt.Errorf("No devices specified")
+	}
+
+	type req struct {
+		XMLName  xml.Name         `xml:"request"`
+		AuthCode string           `xml:"batch>license>activate>auth-code"`
+		Devices  *util.MemberType `xml:"batch>license>activate>devices"`
+	}
+
+	c.con.LogOp("(op) request batch license activate auth-code \"********\" devices %v", serials)
+	return c.batchJob(req{AuthCode: code, Devices: util.StrToMem(serials)})
+}
+
+// RefreshManagedDevices has the specified managed devices retrieve their
+// licenses from the license server, blocking until the batch job completes.
+//
+// This is only valid for Panorama.
+func (c *Licen) RefreshManagedDevices(serials ...string) error {
+	return c.wait(c.RefreshManagedDevicesJob(serials...))
+}
+
+// RefreshManagedDevicesJob starts having the specified managed devices
+// retrieve their licenses from the license server, returning the batch job
+// ID without waiting for it.
+//
+// This is only valid for Panorama.
+func (c *Licen) RefreshManagedDevicesJob(serials ...string) (uint, error) {
+	if len(serials) == 0 {
+		return 0, fmt.Errorf("No devices specified")
+	}
+
+	type req struct {
+		XMLName xml.Name         `xml:"request"`
+		Devices *util.MemberType `xml:"batch>license>refresh>devices"`
+	}
+
+	c.con.LogOp("(op) request batch license refresh devices %v", serials)
+	return c.batchJob(req{Devices: util.StrToMem(serials)})
+}
+
+// DeactivateManagedDevices deactivates the licenses of the specified managed
+// VM-Series firewalls, returning them to the license pool, blocking until the
+// batch job completes.  This is intended for when VM-Series firewalls are
+// decommissioned.
+//
+// The licensing API key must be installed on Panorama.  Refer to
+// ApiKeyInstalled.
+//
+// This is only valid for Panorama.
+func (c *Licen) DeactivateManagedDevices(serials ...string) error {
+	if len(serials) == 0 {
+		return fmt.Errorf("No devices specified")
+	}
+
+	if ok, err := c.ApiKeyInstalled(); err != nil {
+		return err
+	} else if !ok {
+		return fmt.Errorf("The licensing API key is not installed")
+	}
+
+	type req struct {
+		XMLName xml.Name         `xml:"request"`
+		Devices *util.MemberType `xml:"batch>license>deactivate>VM-Capacity>devices"`
+		Mode    string           `xml:"batch>license>deactivate>VM-Capacity>mode"`
+	}
+
+	c.con.LogOp("(op) request batch license deactivate VM-Capacity devices %v mode auto", serials)
+	return c.wait(c.batchJob(req{Devices: util.StrToMem(serials), Mode: "auto"}))
+}
+
+func (c *Licen) batchJob(req interface{}) (uint, error) {
+	ans := util.JobResponse{}
+
+	if _, err := c.con.Op(req, "", nil, &ans); err != nil {
+		return 0, err
+	}
+
+	return ans.Id, nil
+}
+
+func (c *Licen) wait(id uint, err error) error {
+	if err != nil || id == 0 {
+		return err
+	}
+
+	return c.con.WaitForJob(id, 0, nil)
+}
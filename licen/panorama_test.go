@@ -0,0 +1,113 @@
+package licen
+
+import (
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestManagedDeviceLicensing(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(`<devices>
+    <entry name="0001">
+        <serial-no>0001</serial-no>
+        <devicename>fw1</devicename>
+        <licenses>
+            <entry>
+                <feature>Threat Prevention</feature>
+                <expires>January 01, 2021</expires>
+                <expired>yes</expired>
+            </entry>
+            <entry>
+                <feature>PAN-DB URL Filtering</feature>
+                <expires>March 15, 2030</expires>
+                <expired>no</expired>
+            </entry>
+        </licenses>
+    </entry>
+    <entry name="0002">
+        <serial-no>0002</serial-no>
+        <devicename>fw2</devicename>
+        <licenses>
+            <entry>
+                <feature>Support</feature>
+                <expires>Never</expires>
+                <expired>no</expired>
+            </entry>
+        </licenses>
+    </entry>
+</devices>`)
+
+	l := &Licen{}
+	l.Initialize(mc)
+
+	ans, err := l.ManagedDeviceLicensing()
+	if err != nil {
+		t.Fatalf("Failed: %s", err)
+	}
+	if len(ans) != 2 {
+		t.Fatalf("Expected 2 devices, got %d", len(ans))
+	}
+	if ans[0].Serial != "0001" || ans[0].Hostname != "fw1" || len(ans[0].Licenses) != 2 {
+		t.Errorf("Bad first device: %#v", ans[0])
+	}
+	if ans[0].Has("threat prevention") {
+		t.Errorf("Expired license reported as present")
+	}
+	if !ans[0].Has("PAN-DB URL Filtering") {
+		t.Errorf("URL filtering license not found")
+	}
+	if !ans[1].Has("Support") {
+		t.Errorf("Support license not found")
+	}
+}
+
+func TestPushAuthCode(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp("<job>7</job>")
+
+	l := &Licen{}
+	l.Initialize(mc)
+
+	if err := l.PushAuthCode("I1234", "0001", "0002"); err != nil {
+		t.Fatalf("Failed: %s", err)
+	}
+
+	expected := "<request><batch><license><activate><auth-code>I1234</auth-code><devices><member>0001</member><member>0002</member></devices></activate></license></batch></request>"
+	if mc.Elm != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, mc.Elm)
+	}
+
+	if err := l.PushAuthCode("I1234"); err == nil {
+		t.Errorf("No error with no devices")
+	}
+}
+
+func TestDeactivateManagedDevices(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp("API key: abc123")
+	mc.AddResp("<job>8</job>")
+
+	l := &Licen{}
+	l.Initialize(mc)
+
+	if err := l.DeactivateManagedDevices("0001", "0002"); err != nil {
+		t.Fatalf("Failed: %s", err)
+	}
+
+	expected := "<request><batch><license><deactivate><VM-Capacity><devices><member>0001</member><member>0002</member></devices><mode>auto</mode></VM-Capacity></deactivate></license></batch></request>"
+	if mc.Elm != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, mc.Elm)
+	}
+
+	mc = &testdata.MockClient{}
+	mc.AddResp("")
+	l.Initialize(mc)
+	if err := l.DeactivateManagedDevices("0001"); err == nil {
+		t.Errorf("No error without a licensing API key")
+	}
+
+	if err := l.DeactivateManagedDevices(); err == nil {
+		t.Errorf("No error with no devices")
+	}
+}
@@ -0,0 +1,73 @@
+package pango
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// LimitError is returned when a response from PAN-OS exceeds one of the
+// client's response limits.
+type LimitError struct {
+	Limit string
+	Max   int64
+}
+
+// Error returns the error message.
+func (e LimitError) Error() string {
+	return fmt.Sprintf("Response exceeds the max %s of %d", e.Limit, e.Max)
+}
+
+/** Internal functions **/
+
+// readBody reads the body of a response, enforcing MaxResponseSize.
+func (c *Client) readBody(r io.Reader) ([]byte, error) {
+	if c.MaxResponseSize <= 0 {
+		return ioutil.ReadAll(r)
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(r, c.MaxResponseSize+1))
+	if err != nil {
+		return nil, err
+	} else if int64(len(body)) > c.MaxResponseSize {
+		return nil, LimitError{"response size", c.MaxResponseSize}
+	}
+
+	return body, nil
+}
+
+// checkLimits verifies that the given response is within the client's
+// response limits.
+func (c *Client) checkLimits(body []byte) error {
+	if c.MaxResponseSize > 0 && int64(len(body)) > c.MaxResponseSize {
+		return LimitError{"response size", c.MaxResponseSize}
+	}
+
+	if c.MaxResponseDepth <= 0 {
+		return nil
+	}
+
+	depth := 0
+	dec := xml.NewDecoder(bytes.NewReader(body))
+	for {
+		tok, err := dec.RawToken()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			// Leave reporting malformed XML to the unmarshaling.
+			return nil
+		}
+
+		switch tok.(type) {
+		case xml.StartElement:
+			depth++
+			if depth > c.MaxResponseDepth {
+				return LimitError{"response depth", int64(c.MaxResponseDepth)}
+			}
+		case xml.EndElement:
+			depth--
+		}
+	}
+}
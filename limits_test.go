@@ -0,0 +1,55 @@
+package pango
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestResponseLimits(t *testing.T) {
+	deep := `<response status="success"><result>` + strings.Repeat("<a>", 50) + strings.Repeat("</a>", 50) + `</result></response>`
+
+	testCases := []struct {
+		desc  string
+		size  int64
+		depth int
+		ok    bool
+	}{
+		{"no limits", 0, 0, true},
+		{"within limits", 1000, 100, true},
+		{"too large", 100, 0, false},
+		{"too deep", 0, 10, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			c := &Client{
+				MaxResponseSize:  tc.size,
+				MaxResponseDepth: tc.depth,
+			}
+			c.rb = [][]byte{[]byte(deep)}
+			if err := c.Initialize(); err != nil {
+				t.Fatalf("Initialize failed: %s", err)
+			}
+
+			_, err := c.Op("<show><system><info /></system></show>", "", nil, nil)
+			if tc.ok && err != nil {
+				t.Errorf("Unexpected error: %s", err)
+			} else if !tc.ok {
+				if _, ok := err.(LimitError); !ok {
+					t.Errorf("Expected LimitError, got %#v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestReadBody(t *testing.T) {
+	c := &Client{MaxResponseSize: 5}
+
+	if _, err := c.readBody(strings.NewReader("123456")); err == nil {
+		t.Errorf("No error reading body over the limit")
+	}
+	if b, err := c.readBody(strings.NewReader("12345")); err != nil || string(b) != "12345" {
+		t.Errorf("Bad read of body at the limit: %q %v", b, err)
+	}
+}
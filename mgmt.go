@@ -0,0 +1,210 @@
+package pango
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/netw/profile/mngtprof"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Valid values for the action param of UpdatePermittedIps.
+const (
+	PermittedIpsReplace = "replace"
+	PermittedIpsAdd     = "add"
+	PermittedIpsRemove  = "remove"
+)
+
+// ManagementAccess is the set of permitted IP lists that control who may
+// reach the firewall's management services.
+//
+// The Interface list is the management interface's permitted IPs, which
+// covers the web GUI, SSH, and API on the management port.  The Profiles map
+// is keyed by interface management profile name, which in turn control
+// management access on dataplane interfaces (such as loopbacks).
+//
+// An empty list means that access is not restricted by source IP.
+type ManagementAccess struct {
+	Interface []string
+	Profiles  map[string][]string
+}
+
+// ManagementAccess returns the permitted IP lists for the management interface
+// and all interface management profiles.
+func (c *Firewall) ManagementAccess() (ManagementAccess, error) {
+	ips, profiles, err := c.managementAccess()
+	if err != nil {
+		return ManagementAccess{}, err
+	}
+
+	ans := ManagementAccess{
+		Interface: ips,
+		Profiles:  make(map[string][]string, len(profiles)),
+	}
+	for _, o := range profiles {
+		ans.Profiles[o.Name] = o.PermittedIps
+	}
+
+	return ans, nil
+}
+
+// UpdatePermittedIps updates the permitted IP lists of the management interface
+// and the given interface management profiles in a single transaction, so
+// either all of them are updated or none are.
+//
+// The action param should be one of the PermittedIps constants.  If no
+// profiles are given, then all interface management profiles are updated.
+//
+// This is intended for emergency lockdown workflows, where access to the
+// management plane must be restricted everywhere at once.  Note that removing
+// every permitted IP from a list makes access unrestricted, so this is refused
+// for the remove action; use replace to specify the new list explicitly.
+func (c *Firewall) UpdatePermittedIps(action string, ips []string, profiles ...string) error {
+	switch action {
+	case PermittedIpsReplace, PermittedIpsAdd, PermittedIpsRemove:
+	default:
+		return fmt.Errorf("Invalid permitted IP action: %q", action)
+	}
+
+	cur, all, err := c.managementAccess()
+	if err != nil {
+		return err
+	}
+
+	if len(profiles) == 0 {
+		for _, o := range all {
+			profiles = append(profiles, o.Name)
+		}
+	}
+
+	updates := make([]mngtprof.Entry, 0, len(profiles))
+	for _, name := range profiles {
+		var o *mngtprof.Entry
+		for i := range all {
+			if all[i].Name == name {
+				o = &all[i]
+				break
+			}
+		}
+		if o == nil {
+			return fmt.Errorf("Interface management profile %q does not exist", name)
+		}
+		if o.PermittedIps, err = updatedPermittedIps(action, o.PermittedIps, ips); err != nil {
+			return fmt.Errorf("Interface management profile %q: %s", name, err)
+		}
+		updates = append(updates, *o)
+	}
+
+	mgmt, err := updatedPermittedIps(action, cur, ips)
+	if err != nil {
+		return fmt.Errorf("Management interface: %s", err)
+	}
+
+	type permittedIp struct {
+		XMLName xml.Name `xml:"permitted-ip"`
+		util.EntryType
+	}
+
+	c.LogAction("(%s) permitted IPs on management interface and profiles %v", action, profiles)
+	c.PrepareMultiConfigure(len(updates) + 1)
+	if len(mgmt) == 0 {
+		err = c.DeleteXpath(c.permittedIpXpath())
+	} else {
+		err = c.EditXpath(c.permittedIpXpath(), permittedIp{EntryType: *util.StrToEnt(mgmt)})
+	}
+	if err != nil {
+		c.MultiConfigure = nil
+		return err
+	}
+	for _, o := range updates {
+		if err = c.Network.ManagementProfile.Edit(o); err != nil {
+			c.MultiConfigure = nil
+			return err
+		}
+	}
+
+	resp, err := c.SendMultiConfigure(true)
+	if err != nil {
+		return err
+	} else if !resp.Ok() {
+		return fmt.Errorf("Failed to update permitted IPs: %s", resp.Error())
+	}
+
+	return nil
+}
+
+/** Private functions **/
+
+func (c *Firewall) managementAccess() ([]string, []mngtprof.Entry, error) {
+	ips := util.EntryType{}
+	if err := c.GetXpath(c.permittedIpXpath(), &ips); err != nil {
+		e2, ok := err.(PanosError)
+		if !ok || !e2.ObjectNotFound() {
+			return nil, nil, err
+		}
+	}
+
+	names, err := c.Network.ManagementProfile.GetList()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	profiles := make([]mngtprof.Entry, 0, len(names))
+	for _, name := range names {
+		o, err := c.Network.ManagementProfile.Get(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		profiles = append(profiles, o)
+	}
+
+	return util.EntToStr(&ips), profiles, nil
+}
+
+func (c *Firewall) permittedIpXpath() []string {
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"deviceconfig",
+		"system",
+		"permitted-ip",
+	}
+}
+
+func updatedPermittedIps(action string, cur, ips []string) ([]string, error) {
+	switch action {
+	case PermittedIpsReplace:
+		return ips, nil
+	case PermittedIpsAdd:
+		ans := append([]string(nil), cur...)
+		for _, ip := range ips {
+			if !containsString(ans, ip) {
+				ans = append(ans, ip)
+			}
+		}
+		return ans, nil
+	}
+
+	ans := make([]string, 0, len(cur))
+	for _, ip := range cur {
+		if !containsString(ips, ip) {
+			ans = append(ans, ip)
+		}
+	}
+	if len(ans) == 0 && len(cur) != 0 {
+		return nil, fmt.Errorf("Removing all permitted IPs would leave access unrestricted")
+	}
+
+	return ans, nil
+}
+
+func containsString(list []string, val string) bool {
+	for _, v := range list {
+		if v == val {
+			return true
+		}
+	}
+
+	return false
+}
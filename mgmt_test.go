@@ -0,0 +1,50 @@
+package pango
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUpdatePermittedIps(t *testing.T) {
+	fw := &Firewall{Client: Client{
+		rb: [][]byte{
+			[]byte(`<response status="success"><result><permitted-ip><entry name="10.1.1.0/24" /></permitted-ip></result></response>`),
+			[]byte(`<response status="success"><result><entry name="loopback-mgmt" /></result></response>`),
+			[]byte(`<response status="success"><result><entry name="loopback-mgmt"><https>yes</https><permitted-ip><entry name="10.2.2.0/24" /></permitted-ip></entry></result></response>`),
+			[]byte(`<response status="success" code="20"><response status="success" code="20"><msg>command succeeded</msg></response><response status="success" code="20"><msg>command succeeded</msg></response></response>`),
+		},
+	}}
+	if err := fw.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	if err := fw.UpdatePermittedIps(PermittedIpsAdd, []string{"192.168.1.1"}); err != nil {
+		t.Fatalf("UpdatePermittedIps failed: %s", err)
+	}
+
+	if len(fw.rp) != 4 {
+		t.Fatalf("Expected 4 requests, got %d", len(fw.rp))
+	}
+	req := fw.rp[3]
+	if req.Get("action") != "multi-config" {
+		t.Fatalf("Expected multi-config, got %q", req.Get("action"))
+	}
+	elm := req.Get("element")
+	for _, s := range []string{"10.1.1.0/24", "10.2.2.0/24", "loopback-mgmt"} {
+		if !strings.Contains(elm, s) {
+			t.Errorf("%q not in element: %s", s, elm)
+		}
+	}
+	if strings.Count(elm, "192.168.1.1") != 2 {
+		t.Errorf("New IP not added to both lists: %s", elm)
+	}
+	if fw.MultiConfigure != nil {
+		t.Errorf("Multi configure not cleared")
+	}
+}
+
+func TestUpdatedPermittedIpsRemoveAll(t *testing.T) {
+	if _, err := updatedPermittedIps(PermittedIpsRemove, []string{"a"}, []string{"a"}); err == nil {
+		t.Errorf("Removing all permitted IPs should be an error")
+	}
+}
@@ -0,0 +1,13 @@
+package dhcp
+
+// Valid values for ServerMode.
+const (
+	ServerModeEnabled  = "enabled"
+	ServerModeDisabled = "disabled"
+	ServerModeAuto     = "auto"
+)
+
+const (
+	singular = "dhcp interface"
+	plural   = "dhcp interfaces"
+)
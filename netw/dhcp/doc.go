@@ -0,0 +1,9 @@
+/*
+Package dhcp is the client.Network.Dhcp namespace.
+
+Each entry is named after the layer3 interface it is configured on, and
+configures that interface as either a DHCP server or a DHCP relay.
+
+Normalized object:  Entry
+*/
+package dhcp
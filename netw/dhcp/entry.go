@@ -0,0 +1,374 @@
+package dhcp
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of the DHCP
+// config of an interface.
+//
+// The Server* fields, IpPools, Reservations, and the DHCP option fields
+// configure the interface as a DHCP server, while the Relay* fields configure
+// it as a DHCP relay.  Only one of these should be specified.
+//
+// User defined DHCP server options are preserved as-is when the entry is
+// updated.
+type Entry struct {
+	Name              string
+	ServerMode        string
+	ServerPingIp      bool // XML: server/probe-ip
+	IpPools           []string
+	Reservations      []Reservation
+	LeaseUnlimited    bool
+	LeaseTimeout      int // minutes
+	InheritanceSource string
+	Gateway           string
+	SubnetMask        string
+	PrimaryDns        string
+	SecondaryDns      string
+	PrimaryWins       string
+	SecondaryWins     string
+	PrimaryNis        string
+	SecondaryNis      string
+	PrimaryNtp        string
+	SecondaryNtp      string
+	Pop3Server        string
+	SmtpServer        string
+	DnsSuffix         string
+	RelayIpv4Enable   bool
+	RelayIpv4Servers  []string
+	RelayIpv6Enable   bool
+	RelayIpv6Servers  []RelayIpv6Server
+
+	raw map[string]string
+}
+
+// Reservation is a static IP address assignment, keyed by the IP address.
+type Reservation struct {
+	Name        string
+	Mac         string
+	Description string
+}
+
+// RelayIpv6Server is a DHCPv6 server, keyed by the IPv6 address.  The
+// Interface is the egress interface, needed for link-local and multicast
+// server addresses.
+type RelayIpv6Server struct {
+	Name      string
+	Interface string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.ServerMode = s.ServerMode
+	o.ServerPingIp = s.ServerPingIp
+	o.IpPools = s.IpPools
+	if s.Reservations == nil {
+		o.Reservations = nil
+	} else {
+		o.Reservations = make([]Reservation, len(s.Reservations))
+		copy(o.Reservations, s.Reservations)
+	}
+	o.LeaseUnlimited = s.LeaseUnlimited
+	o.LeaseTimeout = s.LeaseTimeout
+	o.InheritanceSource = s.InheritanceSource
+	o.Gateway = s.Gateway
+	o.SubnetMask = s.SubnetMask
+	o.PrimaryDns = s.PrimaryDns
+	o.SecondaryDns = s.SecondaryDns
+	o.PrimaryWins = s.PrimaryWins
+	o.SecondaryWins = s.SecondaryWins
+	o.PrimaryNis = s.PrimaryNis
+	o.SecondaryNis = s.SecondaryNis
+	o.PrimaryNtp = s.PrimaryNtp
+	o.SecondaryNtp = s.SecondaryNtp
+	o.Pop3Server = s.Pop3Server
+	o.SmtpServer = s.SmtpServer
+	o.DnsSuffix = s.DnsSuffix
+	o.RelayIpv4Enable = s.RelayIpv4Enable
+	o.RelayIpv4Servers = s.RelayIpv4Servers
+	o.RelayIpv6Enable = s.RelayIpv6Enable
+	if s.RelayIpv6Servers == nil {
+		o.RelayIpv6Servers = nil
+	} else {
+		o.RelayIpv6Servers = make([]RelayIpv6Server, len(s.RelayIpv6Servers))
+		copy(o.RelayIpv6Servers, s.RelayIpv6Servers)
+	}
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name: o.Answer.Name,
+	}
+
+	if o.Answer.Server != nil {
+		s := o.Answer.Server
+		ans.ServerMode = s.Mode
+		ans.ServerPingIp = util.AsBool(s.ProbeIp)
+		ans.IpPools = util.MemToStr(s.IpPools)
+
+		if s.Reserved != nil {
+			list := make([]Reservation, 0, len(s.Reserved.Entries))
+			for _, x := range s.Reserved.Entries {
+				list = append(list, Reservation{
+					Name:        x.Name,
+					Mac:         x.Mac,
+					Description: x.Description,
+				})
+			}
+			ans.Reservations = list
+		}
+
+		if s.Option != nil {
+			opt := s.Option
+			if opt.Lease != nil {
+				ans.LeaseUnlimited = opt.Lease.Unlimited != nil
+				ans.LeaseTimeout = opt.Lease.Timeout
+			}
+			if opt.Inheritance != nil {
+				ans.InheritanceSource = opt.Inheritance.Source
+			}
+			ans.Gateway = opt.Gateway
+			ans.SubnetMask = opt.SubnetMask
+			ans.PrimaryDns, ans.SecondaryDns = opt.Dns.normalize()
+			ans.PrimaryWins, ans.SecondaryWins = opt.Wins.normalize()
+			ans.PrimaryNis, ans.SecondaryNis = opt.Nis.normalize()
+			ans.PrimaryNtp, ans.SecondaryNtp = opt.Ntp.normalize()
+			ans.Pop3Server = opt.Pop3Server
+			ans.SmtpServer = opt.SmtpServer
+			ans.DnsSuffix = opt.DnsSuffix
+
+			if opt.UserDefined != nil {
+				ans.raw = map[string]string{
+					"udo": util.CleanRawXml(opt.UserDefined.Text),
+				}
+			}
+		}
+	}
+
+	if o.Answer.Relay != nil {
+		if o.Answer.Relay.Ip != nil {
+			ans.RelayIpv4Enable = util.AsBool(o.Answer.Relay.Ip.Enabled)
+			ans.RelayIpv4Servers = util.MemToStr(o.Answer.Relay.Ip.Servers)
+		}
+
+		if o.Answer.Relay.Ipv6 != nil {
+			ans.RelayIpv6Enable = util.AsBool(o.Answer.Relay.Ipv6.Enabled)
+			if o.Answer.Relay.Ipv6.Servers != nil {
+				list := make([]RelayIpv6Server, 0, len(o.Answer.Relay.Ipv6.Servers.Entries))
+				for _, x := range o.Answer.Relay.Ipv6.Servers.Entries {
+					list = append(list, RelayIpv6Server{
+						Name:      x.Name,
+						Interface: x.Interface,
+					})
+				}
+				ans.RelayIpv6Servers = list
+			}
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName xml.Name `xml:"entry"`
+	Name    string   `xml:"name,attr"`
+	Server  *server  `xml:"server"`
+	Relay   *relay   `xml:"relay"`
+}
+
+type server struct {
+	Mode     string           `xml:"mode,omitempty"`
+	ProbeIp  string           `xml:"probe-ip"`
+	Option   *option          `xml:"option"`
+	IpPools  *util.MemberType `xml:"ip-pool"`
+	Reserved *reserved        `xml:"reserved"`
+}
+
+type option struct {
+	Lease       *lease       `xml:"lease"`
+	Inheritance *inheritance `xml:"inheritance"`
+	Gateway     string       `xml:"gateway,omitempty"`
+	SubnetMask  string       `xml:"subnet-mask,omitempty"`
+	Dns         *pair        `xml:"dns"`
+	Wins        *pair        `xml:"wins"`
+	Nis         *pair        `xml:"nis"`
+	Ntp         *pair        `xml:"ntp"`
+	Pop3Server  string       `xml:"pop3-server,omitempty"`
+	SmtpServer  string       `xml:"smtp-server,omitempty"`
+	DnsSuffix   string       `xml:"dns-suffix,omitempty"`
+	UserDefined *util.RawXml `xml:"user-defined"`
+}
+
+type lease struct {
+	Unlimited *string `xml:"unlimited"`
+	Timeout   int     `xml:"timeout,omitempty"`
+}
+
+type inheritance struct {
+	Source string `xml:"source"`
+}
+
+type pair struct {
+	Primary   string `xml:"primary,omitempty"`
+	Secondary string `xml:"secondary,omitempty"`
+}
+
+func (o *pair) normalize() (string, string) {
+	if o == nil {
+		return "", ""
+	}
+
+	return o.Primary, o.Secondary
+}
+
+func specifyPair(primary, secondary string) *pair {
+	if primary == "" && secondary == "" {
+		return nil
+	}
+
+	return &pair{
+		Primary:   primary,
+		Secondary: secondary,
+	}
+}
+
+type reserved struct {
+	Entries []reservation `xml:"entry"`
+}
+
+type reservation struct {
+	Name        string `xml:"name,attr"`
+	Mac         string `xml:"mac,omitempty"`
+	Description string `xml:"description,omitempty"`
+}
+
+type relay struct {
+	Ip   *relayIp   `xml:"ip"`
+	Ipv6 *relayIpv6 `xml:"ipv6"`
+}
+
+type relayIp struct {
+	Enabled string           `xml:"enabled"`
+	Servers *util.MemberType `xml:"server"`
+}
+
+type relayIpv6 struct {
+	Enabled string       `xml:"enabled"`
+	Servers *ipv6Servers `xml:"server"`
+}
+
+type ipv6Servers struct {
+	Entries []ipv6Server `xml:"entry"`
+}
+
+type ipv6Server struct {
+	Name      string `xml:"name,attr"`
+	Interface string `xml:"interface,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name: e.Name,
+	}
+
+	udo, hasUdo := e.raw["udo"]
+	hasOption := e.LeaseUnlimited || e.LeaseTimeout != 0 || e.InheritanceSource != "" || e.Gateway != "" || e.SubnetMask != "" || e.PrimaryDns != "" || e.SecondaryDns != "" || e.PrimaryWins != "" || e.SecondaryWins != "" || e.PrimaryNis != "" || e.SecondaryNis != "" || e.PrimaryNtp != "" || e.SecondaryNtp != "" || e.Pop3Server != "" || e.SmtpServer != "" || e.DnsSuffix != "" || hasUdo
+
+	if e.ServerMode != "" || e.ServerPingIp || len(e.IpPools) > 0 || len(e.Reservations) > 0 || hasOption {
+		s := server{
+			Mode:    e.ServerMode,
+			ProbeIp: util.YesNo(e.ServerPingIp),
+			IpPools: util.StrToMem(e.IpPools),
+		}
+
+		if len(e.Reservations) > 0 {
+			list := make([]reservation, 0, len(e.Reservations))
+			for _, x := range e.Reservations {
+				list = append(list, reservation{
+					Name:        x.Name,
+					Mac:         x.Mac,
+					Description: x.Description,
+				})
+			}
+			s.Reserved = &reserved{Entries: list}
+		}
+
+		if hasOption {
+			opt := option{
+				Gateway:    e.Gateway,
+				SubnetMask: e.SubnetMask,
+				Dns:        specifyPair(e.PrimaryDns, e.SecondaryDns),
+				Wins:       specifyPair(e.PrimaryWins, e.SecondaryWins),
+				Nis:        specifyPair(e.PrimaryNis, e.SecondaryNis),
+				Ntp:        specifyPair(e.PrimaryNtp, e.SecondaryNtp),
+				Pop3Server: e.Pop3Server,
+				SmtpServer: e.SmtpServer,
+				DnsSuffix:  e.DnsSuffix,
+			}
+
+			if e.LeaseUnlimited {
+				us := ""
+				opt.Lease = &lease{Unlimited: &us}
+			} else if e.LeaseTimeout != 0 {
+				opt.Lease = &lease{Timeout: e.LeaseTimeout}
+			}
+
+			if e.InheritanceSource != "" {
+				opt.Inheritance = &inheritance{Source: e.InheritanceSource}
+			}
+
+			if hasUdo {
+				opt.UserDefined = &util.RawXml{udo}
+			}
+
+			s.Option = &opt
+		}
+
+		ans.Server = &s
+	}
+
+	if e.RelayIpv4Enable || len(e.RelayIpv4Servers) > 0 || e.RelayIpv6Enable || len(e.RelayIpv6Servers) > 0 {
+		r := relay{}
+
+		if e.RelayIpv4Enable || len(e.RelayIpv4Servers) > 0 {
+			r.Ip = &relayIp{
+				Enabled: util.YesNo(e.RelayIpv4Enable),
+				Servers: util.StrToMem(e.RelayIpv4Servers),
+			}
+		}
+
+		if e.RelayIpv6Enable || len(e.RelayIpv6Servers) > 0 {
+			r.Ipv6 = &relayIpv6{
+				Enabled: util.YesNo(e.RelayIpv6Enable),
+			}
+			if len(e.RelayIpv6Servers) > 0 {
+				list := make([]ipv6Server, 0, len(e.RelayIpv6Servers))
+				for _, x := range e.RelayIpv6Servers {
+					list = append(list, ipv6Server{
+						Name:      x.Name,
+						Interface: x.Interface,
+					})
+				}
+				r.Ipv6.Servers = &ipv6Servers{Entries: list}
+			}
+		}
+
+		ans.Relay = &r
+	}
+
+	return ans
+}
@@ -0,0 +1,70 @@
+package dhcp
+
+func getTests() []testCase {
+	return []testCase{
+		{"v1 server basic", Entry{
+			Name:         "ethernet1/1",
+			ServerMode:   ServerModeEnabled,
+			ServerPingIp: true,
+			IpPools:      []string{"10.1.1.100-10.1.1.200"},
+			LeaseTimeout: 720,
+		}},
+		{"v1 server options", Entry{
+			Name:              "ethernet1/2",
+			ServerMode:        ServerModeAuto,
+			IpPools:           []string{"10.2.2.0/25", "10.2.2.200-10.2.2.250"},
+			LeaseUnlimited:    true,
+			InheritanceSource: "ethernet1/8",
+			Gateway:           "10.2.2.1",
+			SubnetMask:        "255.255.255.0",
+			PrimaryDns:        "10.0.0.53",
+			SecondaryDns:      "10.0.1.53",
+			PrimaryWins:       "10.0.0.10",
+			SecondaryWins:     "10.0.1.10",
+			PrimaryNis:        "10.0.0.11",
+			SecondaryNis:      "10.0.1.11",
+			PrimaryNtp:        "10.0.0.123",
+			SecondaryNtp:      "10.0.1.123",
+			Pop3Server:        "10.0.0.110",
+			SmtpServer:        "10.0.0.25",
+			DnsSuffix:         "example.com",
+		}},
+		{"v1 server reservations", Entry{
+			Name:       "ethernet1/3.5",
+			ServerMode: ServerModeDisabled,
+			IpPools:    []string{"10.3.3.0/24"},
+			Reservations: []Reservation{
+				{Name: "10.3.3.10", Mac: "00:11:22:33:44:55", Description: "printer"},
+				{Name: "10.3.3.11", Mac: "00:11:22:33:44:66"},
+			},
+		}},
+		{"v1 server user defined options", Entry{
+			Name:       "ethernet1/4",
+			ServerMode: ServerModeEnabled,
+			IpPools:    []string{"10.4.4.0/24"},
+			raw: map[string]string{
+				"udo": "<entry name=\"tftp\"><code>150</code><ip><member>10.0.0.69</member></ip></entry>",
+			},
+		}},
+		{"v1 relay ipv4", Entry{
+			Name:             "ethernet1/5",
+			RelayIpv4Enable:  true,
+			RelayIpv4Servers: []string{"10.0.0.67", "10.0.1.67"},
+		}},
+		{"v1 relay ipv6", Entry{
+			Name:             "ethernet1/6",
+			RelayIpv4Enable:  true,
+			RelayIpv4Servers: []string{"10.0.0.67"},
+			RelayIpv6Enable:  true,
+			RelayIpv6Servers: []RelayIpv6Server{
+				{Name: "2001:db8::67"},
+				{Name: "ff05::1:3", Interface: "ethernet1/7"},
+			},
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
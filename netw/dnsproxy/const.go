@@ -0,0 +1,6 @@
+package dnsproxy
+
+const (
+	singular = "dns proxy"
+	plural   = "dns proxies"
+)
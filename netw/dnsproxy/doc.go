@@ -0,0 +1,6 @@
+/*
+Package dnsproxy is the client.Network.DnsProxy namespace.
+
+Normalized object:  Entry
+*/
+package dnsproxy
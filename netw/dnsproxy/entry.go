@@ -0,0 +1,297 @@
+package dnsproxy
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a DNS proxy.
+//
+// PrimaryDns and SecondaryDns are the default DNS servers, used when a query
+// does not match any of the DomainServers.
+type Entry struct {
+	Name                  string
+	Enabled               bool
+	Interfaces            []string
+	InheritanceSource     string
+	PrimaryDns            string
+	SecondaryDns          string
+	DomainServers         []DomainServer
+	StaticEntries         []StaticEntry
+	CacheEnabled          bool
+	CacheEdns             bool
+	CacheMaxTtlEnabled    bool
+	CacheMaxTtl           int // XML: cache/max-ttl/time-to-live
+	TcpQueriesEnabled     bool
+	TcpMaxPendingRequests int
+	UdpRetryInterval      int
+	UdpRetryAttempts      int
+}
+
+// DomainServer sends queries matching the given domain names to specific DNS
+// servers.
+type DomainServer struct {
+	Name        string
+	Cacheable   bool
+	DomainNames []string
+	Primary     string
+	Secondary   string
+}
+
+// StaticEntry is a static FQDN to address mapping.
+type StaticEntry struct {
+	Name      string
+	Domain    string
+	Addresses []string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Enabled = s.Enabled
+	o.Interfaces = s.Interfaces
+	o.InheritanceSource = s.InheritanceSource
+	o.PrimaryDns = s.PrimaryDns
+	o.SecondaryDns = s.SecondaryDns
+	if s.DomainServers == nil {
+		o.DomainServers = nil
+	} else {
+		o.DomainServers = make([]DomainServer, len(s.DomainServers))
+		copy(o.DomainServers, s.DomainServers)
+	}
+	if s.StaticEntries == nil {
+		o.StaticEntries = nil
+	} else {
+		o.StaticEntries = make([]StaticEntry, len(s.StaticEntries))
+		copy(o.StaticEntries, s.StaticEntries)
+	}
+	o.CacheEnabled = s.CacheEnabled
+	o.CacheEdns = s.CacheEdns
+	o.CacheMaxTtlEnabled = s.CacheMaxTtlEnabled
+	o.CacheMaxTtl = s.CacheMaxTtl
+	o.TcpQueriesEnabled = s.TcpQueriesEnabled
+	o.TcpMaxPendingRequests = s.TcpMaxPendingRequests
+	o.UdpRetryInterval = s.UdpRetryInterval
+	o.UdpRetryAttempts = s.UdpRetryAttempts
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:       o.Answer.Name,
+		Enabled:    util.AsBool(o.Answer.Enabled),
+		Interfaces: util.MemToStr(o.Answer.Interfaces),
+	}
+
+	if o.Answer.Default != nil {
+		ans.PrimaryDns = o.Answer.Default.Primary
+		ans.SecondaryDns = o.Answer.Default.Secondary
+		if o.Answer.Default.Inheritance != nil {
+			ans.InheritanceSource = o.Answer.Default.Inheritance.Source
+		}
+	}
+
+	if o.Answer.DomainServers != nil {
+		list := make([]DomainServer, 0, len(o.Answer.DomainServers.Entries))
+		for _, x := range o.Answer.DomainServers.Entries {
+			list = append(list, DomainServer{
+				Name:        x.Name,
+				Cacheable:   util.AsBool(x.Cacheable),
+				DomainNames: util.MemToStr(x.DomainNames),
+				Primary:     x.Primary,
+				Secondary:   x.Secondary,
+			})
+		}
+		ans.DomainServers = list
+	}
+
+	if o.Answer.StaticEntries != nil {
+		list := make([]StaticEntry, 0, len(o.Answer.StaticEntries.Entries))
+		for _, x := range o.Answer.StaticEntries.Entries {
+			list = append(list, StaticEntry{
+				Name:      x.Name,
+				Domain:    x.Domain,
+				Addresses: util.MemToStr(x.Addresses),
+			})
+		}
+		ans.StaticEntries = list
+	}
+
+	if o.Answer.Cache != nil {
+		ans.CacheEnabled = util.AsBool(o.Answer.Cache.Enabled)
+		ans.CacheEdns = util.AsBool(o.Answer.Cache.CacheEdns)
+		if o.Answer.Cache.MaxTtl != nil {
+			ans.CacheMaxTtlEnabled = util.AsBool(o.Answer.Cache.MaxTtl.Enabled)
+			ans.CacheMaxTtl = o.Answer.Cache.MaxTtl.TimeToLive
+		}
+	}
+
+	if o.Answer.TcpQueries != nil {
+		ans.TcpQueriesEnabled = util.AsBool(o.Answer.TcpQueries.Enabled)
+		ans.TcpMaxPendingRequests = o.Answer.TcpQueries.MaxPendingRequests
+	}
+
+	if o.Answer.UdpQueries != nil && o.Answer.UdpQueries.Retries != nil {
+		ans.UdpRetryInterval = o.Answer.UdpQueries.Retries.Interval
+		ans.UdpRetryAttempts = o.Answer.UdpQueries.Retries.Attempts
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName       xml.Name         `xml:"entry"`
+	Name          string           `xml:"name,attr"`
+	Enabled       string           `xml:"enabled"`
+	Interfaces    *util.MemberType `xml:"interface"`
+	Default       *def             `xml:"default"`
+	DomainServers *domainServers   `xml:"domain-servers"`
+	StaticEntries *staticEntries   `xml:"static-entries"`
+	Cache         *cache           `xml:"cache"`
+	TcpQueries    *tcpQueries      `xml:"tcp-queries"`
+	UdpQueries    *udpQueries      `xml:"udp-queries"`
+}
+
+type def struct {
+	Inheritance *inheritance `xml:"inheritance"`
+	Primary     string       `xml:"primary,omitempty"`
+	Secondary   string       `xml:"secondary,omitempty"`
+}
+
+type inheritance struct {
+	Source string `xml:"source"`
+}
+
+type domainServers struct {
+	Entries []domainServer `xml:"entry"`
+}
+
+type domainServer struct {
+	Name        string           `xml:"name,attr"`
+	Cacheable   string           `xml:"cacheable"`
+	DomainNames *util.MemberType `xml:"domain-name"`
+	Primary     string           `xml:"primary,omitempty"`
+	Secondary   string           `xml:"secondary,omitempty"`
+}
+
+type staticEntries struct {
+	Entries []staticEntry `xml:"entry"`
+}
+
+type staticEntry struct {
+	Name      string           `xml:"name,attr"`
+	Domain    string           `xml:"domain,omitempty"`
+	Addresses *util.MemberType `xml:"address"`
+}
+
+type cache struct {
+	Enabled   string  `xml:"enabled"`
+	CacheEdns string  `xml:"cache-edns"`
+	MaxTtl    *maxTtl `xml:"max-ttl"`
+}
+
+type maxTtl struct {
+	Enabled    string `xml:"enabled"`
+	TimeToLive int    `xml:"time-to-live,omitempty"`
+}
+
+type tcpQueries struct {
+	Enabled            string `xml:"enabled"`
+	MaxPendingRequests int    `xml:"max-pending-requests,omitempty"`
+}
+
+type udpQueries struct {
+	Retries *retries `xml:"retries"`
+}
+
+type retries struct {
+	Interval int `xml:"interval,omitempty"`
+	Attempts int `xml:"attempts,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:       e.Name,
+		Enabled:    util.YesNo(e.Enabled),
+		Interfaces: util.StrToMem(e.Interfaces),
+	}
+
+	if e.InheritanceSource != "" || e.PrimaryDns != "" || e.SecondaryDns != "" {
+		ans.Default = &def{
+			Primary:   e.PrimaryDns,
+			Secondary: e.SecondaryDns,
+		}
+		if e.InheritanceSource != "" {
+			ans.Default.Inheritance = &inheritance{
+				Source: e.InheritanceSource,
+			}
+		}
+	}
+
+	if len(e.DomainServers) > 0 {
+		list := make([]domainServer, 0, len(e.DomainServers))
+		for _, x := range e.DomainServers {
+			list = append(list, domainServer{
+				Name:        x.Name,
+				Cacheable:   util.YesNo(x.Cacheable),
+				DomainNames: util.StrToMem(x.DomainNames),
+				Primary:     x.Primary,
+				Secondary:   x.Secondary,
+			})
+		}
+		ans.DomainServers = &domainServers{Entries: list}
+	}
+
+	if len(e.StaticEntries) > 0 {
+		list := make([]staticEntry, 0, len(e.StaticEntries))
+		for _, x := range e.StaticEntries {
+			list = append(list, staticEntry{
+				Name:      x.Name,
+				Domain:    x.Domain,
+				Addresses: util.StrToMem(x.Addresses),
+			})
+		}
+		ans.StaticEntries = &staticEntries{Entries: list}
+	}
+
+	if e.CacheEnabled || e.CacheEdns || e.CacheMaxTtlEnabled || e.CacheMaxTtl != 0 {
+		ans.Cache = &cache{
+			Enabled:   util.YesNo(e.CacheEnabled),
+			CacheEdns: util.YesNo(e.CacheEdns),
+		}
+		if e.CacheMaxTtlEnabled || e.CacheMaxTtl != 0 {
+			ans.Cache.MaxTtl = &maxTtl{
+				Enabled:    util.YesNo(e.CacheMaxTtlEnabled),
+				TimeToLive: e.CacheMaxTtl,
+			}
+		}
+	}
+
+	if e.TcpQueriesEnabled || e.TcpMaxPendingRequests != 0 {
+		ans.TcpQueries = &tcpQueries{
+			Enabled:            util.YesNo(e.TcpQueriesEnabled),
+			MaxPendingRequests: e.TcpMaxPendingRequests,
+		}
+	}
+
+	if e.UdpRetryInterval != 0 || e.UdpRetryAttempts != 0 {
+		ans.UdpQueries = &udpQueries{
+			Retries: &retries{
+				Interval: e.UdpRetryInterval,
+				Attempts: e.UdpRetryAttempts,
+			},
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,47 @@
+package dnsproxy
+
+func getTests() []testCase {
+	return []testCase{
+		{"v1 basic", Entry{
+			Name:         "proxy1",
+			Enabled:      true,
+			Interfaces:   []string{"ethernet1/1", "ethernet1/2"},
+			PrimaryDns:   "10.0.0.53",
+			SecondaryDns: "10.0.1.53",
+		}},
+		{"v1 inherited", Entry{
+			Name:              "proxy2",
+			Interfaces:        []string{"ethernet1/3"},
+			InheritanceSource: "ethernet1/8",
+		}},
+		{"v1 domain servers and static entries", Entry{
+			Name:       "proxy3",
+			Enabled:    true,
+			Interfaces: []string{"ethernet1/4"},
+			DomainServers: []DomainServer{
+				{Name: "corp", Cacheable: true, DomainNames: []string{"*.corp.example.com", "example.local"}, Primary: "10.5.0.53", Secondary: "10.5.1.53"},
+				{Name: "lab", DomainNames: []string{"*.lab.example.com"}, Primary: "10.6.0.53"},
+			},
+			StaticEntries: []StaticEntry{
+				{Name: "intranet", Domain: "intranet.example.com", Addresses: []string{"10.1.1.10", "10.1.1.11"}},
+			},
+		}},
+		{"v1 cache and queries", Entry{
+			Name:                  "proxy4",
+			Enabled:               true,
+			CacheEnabled:          true,
+			CacheEdns:             true,
+			CacheMaxTtlEnabled:    true,
+			CacheMaxTtl:           3600,
+			TcpQueriesEnabled:     true,
+			TcpMaxPendingRequests: 128,
+			UdpRetryInterval:      2,
+			UdpRetryAttempts:      5,
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
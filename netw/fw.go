@@ -1,10 +1,26 @@
 package netw
 
 import (
+	"github.com/PaloAltoNetworks/pango/netw/dhcp"
+	"github.com/PaloAltoNetworks/pango/netw/dnsproxy"
+	gpcvapp "github.com/PaloAltoNetworks/pango/netw/globalprotect/clientless/app"
+	gpcvappgroup "github.com/PaloAltoNetworks/pango/netw/globalprotect/clientless/appgroup"
+	gpgw "github.com/PaloAltoNetworks/pango/netw/globalprotect/gateway"
+	gpgwagent "github.com/PaloAltoNetworks/pango/netw/globalprotect/gateway/agent"
+	gpgwsat "github.com/PaloAltoNetworks/pango/netw/globalprotect/gateway/satellite"
+	"github.com/PaloAltoNetworks/pango/netw/globalprotect/portal"
+	gpagent "github.com/PaloAltoNetworks/pango/netw/globalprotect/portal/agent"
+	gpportalsat "github.com/PaloAltoNetworks/pango/netw/globalprotect/portal/satellite"
+	gpsatellite "github.com/PaloAltoNetworks/pango/netw/globalprotect/satellite"
 	"github.com/PaloAltoNetworks/pango/netw/ikegw"
 	aggeth "github.com/PaloAltoNetworks/pango/netw/interface/aggregate"
 	"github.com/PaloAltoNetworks/pango/netw/interface/arp"
 	"github.com/PaloAltoNetworks/pango/netw/interface/eth"
+	ipv6addr "github.com/PaloAltoNetworks/pango/netw/interface/ipv6/address"
+	ipv6dhcp "github.com/PaloAltoNetworks/pango/netw/interface/ipv6/dhcpclient"
+	ipv6inh "github.com/PaloAltoNetworks/pango/netw/interface/ipv6/inherited"
+	ipv6nd "github.com/PaloAltoNetworks/pango/netw/interface/ipv6/nd"
+	ipv6neighbor "github.com/PaloAltoNetworks/pango/netw/interface/ipv6/neighbor"
 	"github.com/PaloAltoNetworks/pango/netw/interface/loopback"
 	"github.com/PaloAltoNetworks/pango/netw/interface/subinterface/layer2"
 	"github.com/PaloAltoNetworks/pango/netw/interface/subinterface/layer3"
@@ -17,7 +33,23 @@ import (
 	"github.com/PaloAltoNetworks/pango/netw/profile/ipsec"
 	"github.com/PaloAltoNetworks/pango/netw/profile/mngtprof"
 	"github.com/PaloAltoNetworks/pango/netw/profile/monitor"
+	"github.com/PaloAltoNetworks/pango/netw/profile/sdwan"
+	"github.com/PaloAltoNetworks/pango/netw/profile/zoneprot"
+	qosiface "github.com/PaloAltoNetworks/pango/netw/qos/iface"
+	qosprof "github.com/PaloAltoNetworks/pango/netw/qos/profile"
+	"github.com/PaloAltoNetworks/pango/netw/routing/logical"
+	bgpredistprof "github.com/PaloAltoNetworks/pango/netw/routing/logical/profile/bgp/redist"
+	bgptimer "github.com/PaloAltoNetworks/pango/netw/routing/logical/profile/bgp/timer"
+	lrvrf "github.com/PaloAltoNetworks/pango/netw/routing/logical/vrf"
+	lrbgp "github.com/PaloAltoNetworks/pango/netw/routing/logical/vrf/bgp"
+	lrospf "github.com/PaloAltoNetworks/pango/netw/routing/logical/vrf/ospf"
+	lrstatic "github.com/PaloAltoNetworks/pango/netw/routing/logical/vrf/static/ipv4"
+	"github.com/PaloAltoNetworks/pango/netw/routing/multicast"
+	mcastiface "github.com/PaloAltoNetworks/pango/netw/routing/multicast/iface"
+	mcastrp "github.com/PaloAltoNetworks/pango/netw/routing/multicast/rp/external"
+	"github.com/PaloAltoNetworks/pango/netw/routing/multicast/spt"
 	redist4 "github.com/PaloAltoNetworks/pango/netw/routing/profile/redist/ipv4"
+	redist6 "github.com/PaloAltoNetworks/pango/netw/routing/profile/redist/ipv6"
 	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp"
 	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/aggregate"
 	agaf "github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/aggregate/filter/advertise"
@@ -32,52 +64,120 @@ import (
 	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/profile/auth"
 	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/profile/dampening"
 	bgpredist "github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/redist"
+	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/ospf"
+	ospfarea "github.com/PaloAltoNetworks/pango/netw/routing/protocol/ospf/area"
+	ospfiface "github.com/PaloAltoNetworks/pango/netw/routing/protocol/ospf/area/iface"
+	ospfvlink "github.com/PaloAltoNetworks/pango/netw/routing/protocol/ospf/area/vlink"
+	ospfexp "github.com/PaloAltoNetworks/pango/netw/routing/protocol/ospf/exp"
+	ospfauth "github.com/PaloAltoNetworks/pango/netw/routing/protocol/ospf/profile/auth"
+	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/ospfv3"
+	ospfv3area "github.com/PaloAltoNetworks/pango/netw/routing/protocol/ospfv3/area"
+	ospfv3iface "github.com/PaloAltoNetworks/pango/netw/routing/protocol/ospfv3/area/iface"
+	ospfv3vlink "github.com/PaloAltoNetworks/pango/netw/routing/protocol/ospfv3/area/vlink"
+	ospfv3exp "github.com/PaloAltoNetworks/pango/netw/routing/protocol/ospfv3/exp"
+	ospfv3auth "github.com/PaloAltoNetworks/pango/netw/routing/protocol/ospfv3/profile/auth"
+	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/rip"
+	ripexp "github.com/PaloAltoNetworks/pango/netw/routing/protocol/rip/exp"
+	ripiface "github.com/PaloAltoNetworks/pango/netw/routing/protocol/rip/iface"
+	ripauth "github.com/PaloAltoNetworks/pango/netw/routing/protocol/rip/profile/auth"
 	"github.com/PaloAltoNetworks/pango/netw/routing/route/static/ipv4"
+	"github.com/PaloAltoNetworks/pango/netw/routing/route/static/ipv6"
 	"github.com/PaloAltoNetworks/pango/netw/routing/router"
 	"github.com/PaloAltoNetworks/pango/netw/tunnel/gre"
 	"github.com/PaloAltoNetworks/pango/netw/vlan"
+	"github.com/PaloAltoNetworks/pango/netw/vwire"
 	"github.com/PaloAltoNetworks/pango/netw/zone"
 	"github.com/PaloAltoNetworks/pango/util"
 )
 
 // Netw is the client.Network namespace.
 type FwNetw struct {
-	AggregateInterface       *aggeth.FwAggregate
-	Arp                      *arp.FwArp
-	BfdProfile               *bfd.FwBfd
-	BgpAggregate             *aggregate.FwAggregate
-	BgpAggAdvertiseFilter    *agaf.FwAdvertise
-	BgpAggSuppressFilter     *suppress.FwSuppress
-	BgpAuthProfile           *auth.FwAuth
-	BgpConAdvAdvertiseFilter *advertise.FwAdvertise
-	BgpConAdvNonExistFilter  *nonexist.FwNonExist
-	BgpConditionalAdv        *conadv.FwConAdv
-	BgpConfig                *bgp.FwBgp
-	BgpDampeningProfile      *dampening.FwDampening
-	BgpExport                *exp.FwExp
-	BgpImport                *imp.FwImp
-	BgpPeer                  *peer.FwPeer
-	BgpPeerGroup             *group.FwGroup
-	BgpRedistRule            *bgpredist.FwRedist
-	EthernetInterface        *eth.FwEth
-	GreTunnel                *gre.FwGre
-	IkeCryptoProfile         *ike.FwIke
-	IkeGateway               *ikegw.FwIkeGw
-	IpsecCryptoProfile       *ipsec.FwIpsec
-	IpsecTunnel              *ipsectunnel.FwIpsecTunnel
-	IpsecTunnelProxyId       *tpiv4.FwIpv4
-	Layer2Subinterface       *layer2.FwLayer2
-	Layer3Subinterface       *layer3.FwLayer3
-	LoopbackInterface        *loopback.FwLoopback
-	ManagementProfile        *mngtprof.FwMngtProf
-	MonitorProfile           *monitor.FwMonitor
-	RedistributionProfile    *redist4.FwIpv4
-	StaticRoute              *ipv4.FwIpv4
-	TunnelInterface          *tunnel.FwTunnel
-	VirtualRouter            *router.FwRouter
-	Vlan                     *vlan.FwVlan
-	VlanInterface            *vli.FwVlan
-	Zone                     *zone.FwZone
+	AggregateInterface              *aggeth.FwAggregate
+	Arp                             *arp.FwArp
+	BfdProfile                      *bfd.FwBfd
+	BgpAggregate                    *aggregate.FwAggregate
+	BgpAggAdvertiseFilter           *agaf.FwAdvertise
+	BgpAggSuppressFilter            *suppress.FwSuppress
+	BgpAuthProfile                  *auth.FwAuth
+	BgpConAdvAdvertiseFilter        *advertise.FwAdvertise
+	BgpConAdvNonExistFilter         *nonexist.FwNonExist
+	BgpConditionalAdv               *conadv.FwConAdv
+	BgpConfig                       *bgp.FwBgp
+	BgpDampeningProfile             *dampening.FwDampening
+	BgpExport                       *exp.FwExp
+	BgpImport                       *imp.FwImp
+	BgpPeer                         *peer.FwPeer
+	BgpPeerGroup                    *group.FwGroup
+	BgpRedistRule                   *bgpredist.FwRedist
+	BgpRedistRoutingProfile         *bgpredistprof.FwRedist
+	BgpTimerRoutingProfile          *bgptimer.FwTimer
+	Dhcp                            *dhcp.FwDhcp
+	DnsProxy                        *dnsproxy.FwDnsProxy
+	EthernetInterface               *eth.FwEth
+	GlobalProtectClientlessApp      *gpcvapp.FwApp
+	GlobalProtectClientlessAppGroup *gpcvappgroup.FwAppGroup
+	GlobalProtectGateway            *gpgw.FwGateway
+	GlobalProtectGatewayAgent       *gpgwagent.FwAgent
+	GlobalProtectGatewaySat         *gpgwsat.FwSatellite
+	GlobalProtectPortal             *portal.FwPortal
+	GlobalProtectPortalAgent        *gpagent.FwAgent
+	GlobalProtectPortalSat          *gpportalsat.FwSatellite
+	GlobalProtectSatellite          *gpsatellite.FwSatellite
+	GreTunnel                       *gre.FwGre
+	IkeCryptoProfile                *ike.FwIke
+	IkeGateway                      *ikegw.FwIkeGw
+	IpsecCryptoProfile              *ipsec.FwIpsec
+	IpsecTunnel                     *ipsectunnel.FwIpsecTunnel
+	IpsecTunnelProxyId              *tpiv4.FwIpv4
+	Ipv6Address                     *ipv6addr.FwAddress
+	Ipv6DhcpClient                  *ipv6dhcp.FwDhcpClient
+	Ipv6InheritedAddress            *ipv6inh.FwInherited
+	Ipv6Neighbor                    *ipv6neighbor.FwNeighbor
+	Ipv6NeighborDiscovery           *ipv6nd.FwNd
+	Ipv6RedistributionProfile       *redist6.FwIpv6
+	Ipv6StaticRoute                 *ipv6.FwIpv6
+	Layer2Subinterface              *layer2.FwLayer2
+	Layer3Subinterface              *layer3.FwLayer3
+	LogicalRouter                   *logical.FwLogical
+	LogicalRouterBgp                *lrbgp.FwBgp
+	LogicalRouterOspf               *lrospf.FwOspf
+	LogicalRouterStaticRoute        *lrstatic.FwIpv4
+	LogicalRouterVrf                *lrvrf.FwVrf
+	LoopbackInterface               *loopback.FwLoopback
+	ManagementProfile               *mngtprof.FwMngtProf
+	MonitorProfile                  *monitor.FwMonitor
+	MulticastConfig                 *multicast.FwMulticast
+	MulticastExternalRp             *mcastrp.FwExternal
+	MulticastInterfaceGroup         *mcastiface.FwInterfaceGroup
+	MulticastSptThreshold           *spt.FwSpt
+	OspfArea                        *ospfarea.FwArea
+	OspfAreaInterface               *ospfiface.FwInterface
+	OspfAreaVirtualLink             *ospfvlink.FwVirtualLink
+	OspfAuthProfile                 *ospfauth.FwAuth
+	OspfConfig                      *ospf.FwOspf
+	OspfExport                      *ospfexp.FwExp
+	Ospfv3Area                      *ospfv3area.FwArea
+	Ospfv3AreaInterface             *ospfv3iface.FwInterface
+	Ospfv3AreaVirtualLink           *ospfv3vlink.FwVirtualLink
+	Ospfv3AuthProfile               *ospfv3auth.FwAuth
+	Ospfv3Config                    *ospfv3.FwOspfv3
+	Ospfv3Export                    *ospfv3exp.FwExp
+	QosInterface                    *qosiface.FwInterface
+	QosProfile                      *qosprof.FwProfile
+	RedistributionProfile           *redist4.FwIpv4
+	RipAuthProfile                  *ripauth.FwAuth
+	RipConfig                       *rip.FwRip
+	RipExport                       *ripexp.FwExp
+	RipInterface                    *ripiface.FwInterface
+	SdwanInterfaceProfile           *sdwan.FwSdwan
+	StaticRoute                     *ipv4.FwIpv4
+	TunnelInterface                 *tunnel.FwTunnel
+	VirtualRouter                   *router.FwRouter
+	VirtualWire                     *vwire.FwVwire
+	Vlan                            *vlan.FwVlan
+	VlanInterface                   *vli.FwVlan
+	Zone                            *zone.FwZone
+	ZoneProtectionProfile           *zoneprot.FwZoneProt
 }
 
 // Initialize is invoked on client.Initialize().
@@ -133,9 +233,48 @@ func (c *FwNetw) Initialize(i util.XapiClient) {
 	c.BgpRedistRule = &bgpredist.FwRedist{}
 	c.BgpRedistRule.Initialize(i)
 
+	c.BgpRedistRoutingProfile = &bgpredistprof.FwRedist{}
+	c.BgpRedistRoutingProfile.Initialize(i)
+
+	c.BgpTimerRoutingProfile = &bgptimer.FwTimer{}
+	c.BgpTimerRoutingProfile.Initialize(i)
+
+	c.Dhcp = &dhcp.FwDhcp{}
+	c.Dhcp.Initialize(i)
+
+	c.DnsProxy = &dnsproxy.FwDnsProxy{}
+	c.DnsProxy.Initialize(i)
+
 	c.EthernetInterface = &eth.FwEth{}
 	c.EthernetInterface.Initialize(i)
 
+	c.GlobalProtectClientlessApp = &gpcvapp.FwApp{}
+	c.GlobalProtectClientlessApp.Initialize(i)
+
+	c.GlobalProtectClientlessAppGroup = &gpcvappgroup.FwAppGroup{}
+	c.GlobalProtectClientlessAppGroup.Initialize(i)
+
+	c.GlobalProtectGateway = &gpgw.FwGateway{}
+	c.GlobalProtectGateway.Initialize(i)
+
+	c.GlobalProtectGatewayAgent = &gpgwagent.FwAgent{}
+	c.GlobalProtectGatewayAgent.Initialize(i)
+
+	c.GlobalProtectGatewaySat = &gpgwsat.FwSatellite{}
+	c.GlobalProtectGatewaySat.Initialize(i)
+
+	c.GlobalProtectPortal = &portal.FwPortal{}
+	c.GlobalProtectPortal.Initialize(i)
+
+	c.GlobalProtectPortalAgent = &gpagent.FwAgent{}
+	c.GlobalProtectPortalAgent.Initialize(i)
+
+	c.GlobalProtectPortalSat = &gpportalsat.FwSatellite{}
+	c.GlobalProtectPortalSat.Initialize(i)
+
+	c.GlobalProtectSatellite = &gpsatellite.FwSatellite{}
+	c.GlobalProtectSatellite.Initialize(i)
+
 	c.GreTunnel = &gre.FwGre{}
 	c.GreTunnel.Initialize(i)
 
@@ -154,12 +293,48 @@ func (c *FwNetw) Initialize(i util.XapiClient) {
 	c.IpsecTunnelProxyId = &tpiv4.FwIpv4{}
 	c.IpsecTunnelProxyId.Initialize(i)
 
+	c.Ipv6Address = &ipv6addr.FwAddress{}
+	c.Ipv6Address.Initialize(i)
+
+	c.Ipv6DhcpClient = &ipv6dhcp.FwDhcpClient{}
+	c.Ipv6DhcpClient.Initialize(i)
+
+	c.Ipv6InheritedAddress = &ipv6inh.FwInherited{}
+	c.Ipv6InheritedAddress.Initialize(i)
+
+	c.Ipv6Neighbor = &ipv6neighbor.FwNeighbor{}
+	c.Ipv6Neighbor.Initialize(i)
+
+	c.Ipv6NeighborDiscovery = &ipv6nd.FwNd{}
+	c.Ipv6NeighborDiscovery.Initialize(i)
+
+	c.Ipv6RedistributionProfile = &redist6.FwIpv6{}
+	c.Ipv6RedistributionProfile.Initialize(i)
+
+	c.Ipv6StaticRoute = &ipv6.FwIpv6{}
+	c.Ipv6StaticRoute.Initialize(i)
+
 	c.Layer2Subinterface = &layer2.FwLayer2{}
 	c.Layer2Subinterface.Initialize(i)
 
 	c.Layer3Subinterface = &layer3.FwLayer3{}
 	c.Layer3Subinterface.Initialize(i)
 
+	c.LogicalRouter = &logical.FwLogical{}
+	c.LogicalRouter.Initialize(i)
+
+	c.LogicalRouterBgp = &lrbgp.FwBgp{}
+	c.LogicalRouterBgp.Initialize(i)
+
+	c.LogicalRouterOspf = &lrospf.FwOspf{}
+	c.LogicalRouterOspf.Initialize(i)
+
+	c.LogicalRouterStaticRoute = &lrstatic.FwIpv4{}
+	c.LogicalRouterStaticRoute.Initialize(i)
+
+	c.LogicalRouterVrf = &lrvrf.FwVrf{}
+	c.LogicalRouterVrf.Initialize(i)
+
 	c.LoopbackInterface = &loopback.FwLoopback{}
 	c.LoopbackInterface.Initialize(i)
 
@@ -169,9 +344,78 @@ func (c *FwNetw) Initialize(i util.XapiClient) {
 	c.MonitorProfile = &monitor.FwMonitor{}
 	c.MonitorProfile.Initialize(i)
 
+	c.MulticastConfig = &multicast.FwMulticast{}
+	c.MulticastConfig.Initialize(i)
+
+	c.MulticastExternalRp = &mcastrp.FwExternal{}
+	c.MulticastExternalRp.Initialize(i)
+
+	c.MulticastInterfaceGroup = &mcastiface.FwInterfaceGroup{}
+	c.MulticastInterfaceGroup.Initialize(i)
+
+	c.MulticastSptThreshold = &spt.FwSpt{}
+	c.MulticastSptThreshold.Initialize(i)
+
+	c.OspfArea = &ospfarea.FwArea{}
+	c.OspfArea.Initialize(i)
+
+	c.OspfAreaInterface = &ospfiface.FwInterface{}
+	c.OspfAreaInterface.Initialize(i)
+
+	c.OspfAreaVirtualLink = &ospfvlink.FwVirtualLink{}
+	c.OspfAreaVirtualLink.Initialize(i)
+
+	c.OspfAuthProfile = &ospfauth.FwAuth{}
+	c.OspfAuthProfile.Initialize(i)
+
+	c.OspfConfig = &ospf.FwOspf{}
+	c.OspfConfig.Initialize(i)
+
+	c.OspfExport = &ospfexp.FwExp{}
+	c.OspfExport.Initialize(i)
+
+	c.Ospfv3Area = &ospfv3area.FwArea{}
+	c.Ospfv3Area.Initialize(i)
+
+	c.Ospfv3AreaInterface = &ospfv3iface.FwInterface{}
+	c.Ospfv3AreaInterface.Initialize(i)
+
+	c.Ospfv3AreaVirtualLink = &ospfv3vlink.FwVirtualLink{}
+	c.Ospfv3AreaVirtualLink.Initialize(i)
+
+	c.Ospfv3AuthProfile = &ospfv3auth.FwAuth{}
+	c.Ospfv3AuthProfile.Initialize(i)
+
+	c.Ospfv3Config = &ospfv3.FwOspfv3{}
+	c.Ospfv3Config.Initialize(i)
+
+	c.Ospfv3Export = &ospfv3exp.FwExp{}
+	c.Ospfv3Export.Initialize(i)
+
+	c.QosInterface = &qosiface.FwInterface{}
+	c.QosInterface.Initialize(i)
+
+	c.QosProfile = &qosprof.FwProfile{}
+	c.QosProfile.Initialize(i)
+
 	c.RedistributionProfile = &redist4.FwIpv4{}
 	c.RedistributionProfile.Initialize(i)
 
+	c.RipAuthProfile = &ripauth.FwAuth{}
+	c.RipAuthProfile.Initialize(i)
+
+	c.RipConfig = &rip.FwRip{}
+	c.RipConfig.Initialize(i)
+
+	c.RipExport = &ripexp.FwExp{}
+	c.RipExport.Initialize(i)
+
+	c.RipInterface = &ripiface.FwInterface{}
+	c.RipInterface.Initialize(i)
+
+	c.SdwanInterfaceProfile = &sdwan.FwSdwan{}
+	c.SdwanInterfaceProfile.Initialize(i)
+
 	c.StaticRoute = &ipv4.FwIpv4{}
 	c.StaticRoute.Initialize(i)
 
@@ -181,6 +425,9 @@ func (c *FwNetw) Initialize(i util.XapiClient) {
 	c.VirtualRouter = &router.FwRouter{}
 	c.VirtualRouter.Initialize(i)
 
+	c.VirtualWire = &vwire.FwVwire{}
+	c.VirtualWire.Initialize(i)
+
 	c.Vlan = &vlan.FwVlan{}
 	c.Vlan.Initialize(i)
 
@@ -189,4 +436,7 @@ func (c *FwNetw) Initialize(i util.XapiClient) {
 
 	c.Zone = &zone.FwZone{}
 	c.Zone.Initialize(i)
+
+	c.ZoneProtectionProfile = &zoneprot.FwZoneProt{}
+	c.ZoneProtectionProfile.Initialize(i)
 }
@@ -0,0 +1,14 @@
+/*
+Package app is the client.Network.GlobalProtectClientlessApp namespace.
+
+Clientless apps are the web applications that users can access through the
+GlobalProtect portal without the GlobalProtect agent.  Clientless apps are
+configured per vsys.  If vsys is left empty, then "vsys1" is used.
+
+Apps are grouped using the client.Network.GlobalProtectClientlessAppGroup
+namespace, and are assigned to users in the clientless VPN settings of the
+GlobalProtect portal.
+
+Normalized object:  Entry
+*/
+package app
@@ -0,0 +1,74 @@
+package app
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a
+// GlobalProtect clientless app.
+//
+// The app icon is preserved as-is when the app is updated.
+type Entry struct {
+	Name               string
+	ApplicationHomeUrl string
+	Description        string
+
+	raw map[string]string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.ApplicationHomeUrl = s.ApplicationHomeUrl
+	o.Description = s.Description
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:               o.Answer.Name,
+		ApplicationHomeUrl: o.Answer.ApplicationHomeUrl,
+		Description:        o.Answer.Description,
+	}
+
+	if o.Answer.AppIcon != nil {
+		ans.raw = map[string]string{
+			"icon": util.CleanRawXml(o.Answer.AppIcon.Text),
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName            xml.Name     `xml:"entry"`
+	Name               string       `xml:"name,attr"`
+	ApplicationHomeUrl string       `xml:"application-home-url,omitempty"`
+	Description        string       `xml:"description,omitempty"`
+	AppIcon            *util.RawXml `xml:"app-icon"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:               e.Name,
+		ApplicationHomeUrl: e.ApplicationHomeUrl,
+		Description:        e.Description,
+	}
+
+	if text, present := e.raw["icon"]; present {
+		ans.AppIcon = &util.RawXml{text}
+	}
+
+	return ans
+}
@@ -0,0 +1,27 @@
+package app
+
+func getTests() []testCase {
+	return []testCase{
+		{"v1 basic", Entry{
+			Name:               "app1",
+			ApplicationHomeUrl: "https://wiki.example.com",
+		}},
+		{"v1 with description", Entry{
+			Name:               "app2",
+			ApplicationHomeUrl: "https://mail.example.com/owa",
+			Description:        "webmail",
+		}},
+		{"v1 with raw", Entry{
+			Name:               "app3",
+			ApplicationHomeUrl: "https://hr.example.com",
+			raw: map[string]string{
+				"icon": "aWNvbg==",
+			},
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
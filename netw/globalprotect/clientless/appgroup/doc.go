@@ -0,0 +1,9 @@
+/*
+Package appgroup is the client.Network.GlobalProtectClientlessAppGroup namespace.
+
+Clientless app groups are configured per vsys.  If vsys is left empty, then
+"vsys1" is used.
+
+Normalized object:  Entry
+*/
+package appgroup
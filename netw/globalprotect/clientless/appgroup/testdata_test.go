@@ -0,0 +1,18 @@
+package appgroup
+
+func getTests() []testCase {
+	return []testCase{
+		{"v1 empty", Entry{
+			Name: "group1",
+		}},
+		{"v1 with apps", Entry{
+			Name:         "group2",
+			Applications: []string{"app1", "app2"},
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
@@ -0,0 +1,13 @@
+package agent
+
+// Valid values for AuthOverrideCookieLifetimeType.
+const (
+	CookieLifetimeDays    = "days"
+	CookieLifetimeHours   = "hours"
+	CookieLifetimeMinutes = "minutes"
+)
+
+const (
+	singular = "globalprotect gateway agent config"
+	plural   = "globalprotect gateway agent configs"
+)
@@ -0,0 +1,11 @@
+/*
+Package agent is the client.Network.GlobalProtectGatewayAgent namespace.
+
+Agent configs are the client settings of a GlobalProtect gateway.  They are
+evaluated in order by the gateway, with the first config whose selection
+criteria (source users and OS) matches the connecting endpoint being used to
+assign the client IP address, split tunnel routes, and DNS settings.
+
+Normalized object:  Entry
+*/
+package agent
@@ -0,0 +1,287 @@
+package agent
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+// Entry is a normalized, version independent representation of a
+// GlobalProtect gateway agent config.
+//
+// The SourceUsers and Os fields are the selection criteria for this config.
+// The AuthOverride* fields are the authentication override settings, with
+// the cookie lifetime only being used when AuthOverrideAcceptCookie is true.
+//
+// The source address selection criteria is preserved as-is when the config is
+// updated.
+type Entry struct {
+	Name                            string
+	SourceUsers                     []string // ordered
+	Os                              []string // ordered
+	IpPools                         []string // ordered
+	RetrieveFramedIpAddress         bool
+	NoDirectAccessToLocalNetwork    bool
+	AuthOverrideGenerateCookie      bool
+	AuthOverrideAcceptCookie        bool
+	AuthOverrideCookieLifetimeType  string
+	AuthOverrideCookieLifetimeValue int
+	AuthOverrideCookieCertificate   string   // XML: cookie-encrypt-decrypt-cert
+	AccessRoutes                    []string // ordered
+	ExcludeAccessRoutes             []string // ordered
+	IncludeDomains                  []Domain // 8.1+
+	ExcludeDomains                  []Domain // 8.1+
+	IncludeApplications             []string // 8.1+
+	ExcludeApplications             []string // 8.1+
+	DnsServers                      []string // ordered
+	DnsSuffixes                     []string // ordered
+
+	raw map[string]string
+}
+
+// Domain is a split tunnel domain, optionally restricted to the given ports.
+type Domain struct {
+	Name  string
+	Ports []string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.SourceUsers = s.SourceUsers
+	o.Os = s.Os
+	o.IpPools = s.IpPools
+	o.RetrieveFramedIpAddress = s.RetrieveFramedIpAddress
+	o.NoDirectAccessToLocalNetwork = s.NoDirectAccessToLocalNetwork
+	o.AuthOverrideGenerateCookie = s.AuthOverrideGenerateCookie
+	o.AuthOverrideAcceptCookie = s.AuthOverrideAcceptCookie
+	o.AuthOverrideCookieLifetimeType = s.AuthOverrideCookieLifetimeType
+	o.AuthOverrideCookieLifetimeValue = s.AuthOverrideCookieLifetimeValue
+	o.AuthOverrideCookieCertificate = s.AuthOverrideCookieCertificate
+	o.AccessRoutes = s.AccessRoutes
+	o.ExcludeAccessRoutes = s.ExcludeAccessRoutes
+	if s.IncludeDomains == nil {
+		o.IncludeDomains = nil
+	} else {
+		o.IncludeDomains = make([]Domain, len(s.IncludeDomains))
+		copy(o.IncludeDomains, s.IncludeDomains)
+	}
+	if s.ExcludeDomains == nil {
+		o.ExcludeDomains = nil
+	} else {
+		o.ExcludeDomains = make([]Domain, len(s.ExcludeDomains))
+		copy(o.ExcludeDomains, s.ExcludeDomains)
+	}
+	o.IncludeApplications = s.IncludeApplications
+	o.ExcludeApplications = s.ExcludeApplications
+	o.DnsServers = s.DnsServers
+	o.DnsSuffixes = s.DnsSuffixes
+}
+
+// Audit returns an error if this object uses fields that the given PAN-OS
+// version does not support.
+func (o *Entry) Audit(v version.Number) error {
+	a := util.NewAuditor(o.Name, v)
+	a.Since("IncludeDomains", len(o.IncludeDomains) > 0, version.Number{8, 1, 0, ""})
+	a.Since("ExcludeDomains", len(o.ExcludeDomains) > 0, version.Number{8, 1, 0, ""})
+	a.Since("IncludeApplications", len(o.IncludeApplications) > 0, version.Number{8, 1, 0, ""})
+	a.Since("ExcludeApplications", len(o.ExcludeApplications) > 0, version.Number{8, 1, 0, ""})
+
+	return a.Err()
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:                         o.Answer.Name,
+		SourceUsers:                  util.MemToStr(o.Answer.SourceUsers),
+		Os:                           util.MemToStr(o.Answer.Os),
+		IpPools:                      util.MemToStr(o.Answer.IpPools),
+		RetrieveFramedIpAddress:      util.AsBool(o.Answer.RetrieveFramedIpAddress),
+		NoDirectAccessToLocalNetwork: util.AsBool(o.Answer.NoDirectAccessToLocalNetwork),
+		DnsServers:                   util.MemToStr(o.Answer.DnsServers),
+		DnsSuffixes:                  util.MemToStr(o.Answer.DnsSuffixes),
+	}
+
+	if o.Answer.AuthOverride != nil {
+		ao := o.Answer.AuthOverride
+		ans.AuthOverrideGenerateCookie = util.AsBool(ao.GenerateCookie)
+		ans.AuthOverrideCookieCertificate = ao.CookieCertificate
+
+		if ao.AcceptCookie != nil {
+			ans.AuthOverrideAcceptCookie = true
+			if ao.AcceptCookie.Lifetime != nil {
+				lt := ao.AcceptCookie.Lifetime
+				if lt.Days != 0 {
+					ans.AuthOverrideCookieLifetimeType = CookieLifetimeDays
+					ans.AuthOverrideCookieLifetimeValue = lt.Days
+				} else if lt.Hours != 0 {
+					ans.AuthOverrideCookieLifetimeType = CookieLifetimeHours
+					ans.AuthOverrideCookieLifetimeValue = lt.Hours
+				} else if lt.Minutes != 0 {
+					ans.AuthOverrideCookieLifetimeType = CookieLifetimeMinutes
+					ans.AuthOverrideCookieLifetimeValue = lt.Minutes
+				}
+			}
+		}
+	}
+
+	if o.Answer.SplitTunnel != nil {
+		st := o.Answer.SplitTunnel
+		ans.AccessRoutes = util.MemToStr(st.AccessRoutes)
+		ans.ExcludeAccessRoutes = util.MemToStr(st.ExcludeAccessRoutes)
+		ans.IncludeDomains = st.IncludeDomains.normalize()
+		ans.ExcludeDomains = st.ExcludeDomains.normalize()
+		ans.IncludeApplications = util.MemToStr(st.IncludeApplications)
+		ans.ExcludeApplications = util.MemToStr(st.ExcludeApplications)
+	}
+
+	if o.Answer.SourceAddress != nil {
+		ans.raw = map[string]string{
+			"sa": util.CleanRawXml(o.Answer.SourceAddress.Text),
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName                      xml.Name         `xml:"entry"`
+	Name                         string           `xml:"name,attr"`
+	SourceUsers                  *util.MemberType `xml:"source-user"`
+	Os                           *util.MemberType `xml:"os"`
+	SourceAddress                *util.RawXml     `xml:"source-address"`
+	IpPools                      *util.MemberType `xml:"ip-pool"`
+	RetrieveFramedIpAddress      string           `xml:"retrieve-framed-ip-address"`
+	NoDirectAccessToLocalNetwork string           `xml:"no-direct-access-to-local-network"`
+	AuthOverride                 *authOverride    `xml:"authentication-override"`
+	SplitTunnel                  *splitTunnel     `xml:"split-tunneling"`
+	DnsServers                   *util.MemberType `xml:"dns-server"`
+	DnsSuffixes                  *util.MemberType `xml:"dns-suffix"`
+}
+
+type authOverride struct {
+	GenerateCookie    string        `xml:"generate-cookie"`
+	AcceptCookie      *acceptCookie `xml:"accept-cookie"`
+	CookieCertificate string        `xml:"cookie-encrypt-decrypt-cert,omitempty"`
+}
+
+type acceptCookie struct {
+	Lifetime *cookieLifetime `xml:"cookie-lifetime"`
+}
+
+type cookieLifetime struct {
+	Days    int `xml:"lifetime-in-days,omitempty"`
+	Hours   int `xml:"lifetime-in-hours,omitempty"`
+	Minutes int `xml:"lifetime-in-minutes,omitempty"`
+}
+
+type splitTunnel struct {
+	AccessRoutes        *util.MemberType `xml:"access-route"`
+	ExcludeAccessRoutes *util.MemberType `xml:"exclude-access-route"`
+	IncludeDomains      *domainList      `xml:"include-domains"`
+	ExcludeDomains      *domainList      `xml:"exclude-domains"`
+	IncludeApplications *util.MemberType `xml:"include-applications"`
+	ExcludeApplications *util.MemberType `xml:"exclude-applications"`
+}
+
+type domainList struct {
+	Entries []domainEntry `xml:"list>entry"`
+}
+
+type domainEntry struct {
+	Name  string           `xml:"name,attr"`
+	Ports *util.MemberType `xml:"ports"`
+}
+
+func (o *domainList) normalize() []Domain {
+	if o == nil || len(o.Entries) == 0 {
+		return nil
+	}
+
+	ans := make([]Domain, 0, len(o.Entries))
+	for _, x := range o.Entries {
+		ans = append(ans, Domain{
+			Name:  x.Name,
+			Ports: util.MemToStr(x.Ports),
+		})
+	}
+
+	return ans
+}
+
+func specifyDomains(list []Domain) *domainList {
+	if len(list) == 0 {
+		return nil
+	}
+
+	ans := make([]domainEntry, 0, len(list))
+	for _, x := range list {
+		ans = append(ans, domainEntry{
+			Name:  x.Name,
+			Ports: util.StrToMem(x.Ports),
+		})
+	}
+
+	return &domainList{Entries: ans}
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:                         e.Name,
+		SourceUsers:                  util.StrToMem(e.SourceUsers),
+		Os:                           util.StrToMem(e.Os),
+		IpPools:                      util.StrToMem(e.IpPools),
+		RetrieveFramedIpAddress:      util.YesNo(e.RetrieveFramedIpAddress),
+		NoDirectAccessToLocalNetwork: util.YesNo(e.NoDirectAccessToLocalNetwork),
+		DnsServers:                   util.StrToMem(e.DnsServers),
+		DnsSuffixes:                  util.StrToMem(e.DnsSuffixes),
+	}
+
+	if e.AuthOverrideGenerateCookie || e.AuthOverrideAcceptCookie || e.AuthOverrideCookieCertificate != "" {
+		ans.AuthOverride = &authOverride{
+			GenerateCookie:    util.YesNo(e.AuthOverrideGenerateCookie),
+			CookieCertificate: e.AuthOverrideCookieCertificate,
+		}
+
+		if e.AuthOverrideAcceptCookie {
+			ac := &acceptCookie{}
+			switch e.AuthOverrideCookieLifetimeType {
+			case CookieLifetimeDays:
+				ac.Lifetime = &cookieLifetime{Days: e.AuthOverrideCookieLifetimeValue}
+			case CookieLifetimeHours:
+				ac.Lifetime = &cookieLifetime{Hours: e.AuthOverrideCookieLifetimeValue}
+			case CookieLifetimeMinutes:
+				ac.Lifetime = &cookieLifetime{Minutes: e.AuthOverrideCookieLifetimeValue}
+			}
+			ans.AuthOverride.AcceptCookie = ac
+		}
+	}
+
+	if len(e.AccessRoutes) > 0 || len(e.ExcludeAccessRoutes) > 0 || len(e.IncludeDomains) > 0 || len(e.ExcludeDomains) > 0 || len(e.IncludeApplications) > 0 || len(e.ExcludeApplications) > 0 {
+		ans.SplitTunnel = &splitTunnel{
+			AccessRoutes:        util.StrToMem(e.AccessRoutes),
+			ExcludeAccessRoutes: util.StrToMem(e.ExcludeAccessRoutes),
+			IncludeDomains:      specifyDomains(e.IncludeDomains),
+			ExcludeDomains:      specifyDomains(e.ExcludeDomains),
+			IncludeApplications: util.StrToMem(e.IncludeApplications),
+			ExcludeApplications: util.StrToMem(e.ExcludeApplications),
+		}
+	}
+
+	if text, present := e.raw["sa"]; present {
+		ans.SourceAddress = &util.RawXml{text}
+	}
+
+	return ans
+}
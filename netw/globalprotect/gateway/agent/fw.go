@@ -0,0 +1,175 @@
+package agent
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwAgent is the client.Network.GlobalProtectGatewayAgent namespace.
+type FwAgent struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *FwAgent) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *FwAgent) ShowList(vsys, gateway string) ([]string, error) {
+	c.con.LogQuery("(show) list of %s", plural)
+	path := c.xpath(vsys, gateway, nil)
+	return c.con.EntryListUsing(c.con.Show, path[:len(path)-1])
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *FwAgent) GetList(vsys, gateway string) ([]string, error) {
+	c.con.LogQuery("(get) list of %s", plural)
+	path := c.xpath(vsys, gateway, nil)
+	return c.con.EntryListUsing(c.con.Get, path[:len(path)-1])
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *FwAgent) Get(vsys, gateway, name string) (Entry, error) {
+	c.con.LogQuery("(get) %s %q", singular, name)
+	return c.details(c.con.Get, vsys, gateway, name)
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *FwAgent) Show(vsys, gateway, name string) (Entry, error) {
+	c.con.LogQuery("(show) %s %q", singular, name)
+	return c.details(c.con.Show, vsys, gateway, name)
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *FwAgent) Set(vsys, gateway string, e ...Entry) error {
+	var err error
+
+	if len(e) == 0 {
+		return nil
+	} else if gateway == "" {
+		return fmt.Errorf("gateway must be specified")
+	}
+
+	for i := range e {
+		if err = e[i].Audit(c.con.Versioning()); err != nil {
+			return err
+		}
+	}
+
+	_, fn := c.versioning()
+	names := make([]string, len(e))
+
+	// Build up the struct.
+	d := util.BulkElement{XMLName: xml.Name{Local: "remote-user-tunnel-configs"}}
+	for i := range e {
+		d.Data = append(d.Data, fn(e[i]))
+		names[i] = e[i].Name
+	}
+	c.con.LogAction("(set) %s: %v", plural, names)
+
+	// Set xpath.
+	path := c.xpath(vsys, gateway, names)
+	if len(e) == 1 {
+		path = path[:len(path)-1]
+	} else {
+		path = path[:len(path)-2]
+	}
+
+	// Create the objects.
+	_, err = c.con.Set(path, d.Config(), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *FwAgent) Edit(vsys, gateway string, e Entry) error {
+	var err error
+
+	if gateway == "" {
+		return fmt.Errorf("gateway must be specified")
+	}
+
+	if err = e.Audit(c.con.Versioning()); err != nil {
+		return err
+	}
+
+	_, fn := c.versioning()
+
+	c.con.LogAction("(edit) %s %q", singular, e.Name)
+
+	// Set xpath.
+	path := c.xpath(vsys, gateway, []string{e.Name})
+
+	// Edit the object.
+	_, err = c.con.Edit(path, fn(e), nil, nil)
+	return err
+}
+
+// Delete removes the given objects.
+//
+// Objects can be a string or an Entry object.
+func (c *FwAgent) Delete(vsys, gateway string, e ...interface{}) error {
+	var err error
+
+	if len(e) == 0 {
+		return nil
+	} else if gateway == "" {
+		return fmt.Errorf("gateway must be specified")
+	}
+
+	names := make([]string, len(e))
+	for i := range e {
+		switch v := e[i].(type) {
+		case string:
+			names[i] = v
+		case Entry:
+			names[i] = v.Name
+		default:
+			return fmt.Errorf("Unknown type sent to delete: %s", v)
+		}
+	}
+	c.con.LogAction("(delete) %s: %v", plural, names)
+
+	// Remove the objects.
+	path := c.xpath(vsys, gateway, names)
+	_, err = c.con.Delete(path, nil, nil)
+	return err
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *FwAgent) versioning() (normalizer, func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwAgent) details(fn util.Retriever, vsys, gateway, name string) (Entry, error) {
+	path := c.xpath(vsys, gateway, []string{name})
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Entry{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *FwAgent) xpath(vsys, gateway string, vals []string) []string {
+	if vsys == "" {
+		vsys = "vsys1"
+	}
+
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"vsys",
+		util.AsEntryXpath([]string{vsys}),
+		"global-protect",
+		"global-protect-gateway",
+		util.AsEntryXpath([]string{gateway}),
+		"remote-user-tunnel-configs",
+		util.AsEntryXpath(vals),
+	}
+}
@@ -0,0 +1,185 @@
+package agent
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PanoAgent is the client.Network.GlobalProtectGatewayAgent namespace.
+type PanoAgent struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *PanoAgent) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *PanoAgent) ShowList(tmpl, ts, vsys, gateway string) ([]string, error) {
+	c.con.LogQuery("(show) list of %s", plural)
+	path := c.xpath(tmpl, ts, vsys, gateway, nil)
+	return c.con.EntryListUsing(c.con.Show, path[:len(path)-1])
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *PanoAgent) GetList(tmpl, ts, vsys, gateway string) ([]string, error) {
+	c.con.LogQuery("(get) list of %s", plural)
+	path := c.xpath(tmpl, ts, vsys, gateway, nil)
+	return c.con.EntryListUsing(c.con.Get, path[:len(path)-1])
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *PanoAgent) Get(tmpl, ts, vsys, gateway, name string) (Entry, error) {
+	c.con.LogQuery("(get) %s %q", singular, name)
+	return c.details(c.con.Get, tmpl, ts, vsys, gateway, name)
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *PanoAgent) Show(tmpl, ts, vsys, gateway, name string) (Entry, error) {
+	c.con.LogQuery("(show) %s %q", singular, name)
+	return c.details(c.con.Show, tmpl, ts, vsys, gateway, name)
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *PanoAgent) Set(tmpl, ts, vsys, gateway string, e ...Entry) error {
+	var err error
+
+	if len(e) == 0 {
+		return nil
+	} else if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	} else if gateway == "" {
+		return fmt.Errorf("gateway must be specified")
+	}
+
+	for i := range e {
+		if err = e[i].Audit(c.con.Versioning()); err != nil {
+			return err
+		}
+	}
+
+	_, fn := c.versioning()
+	names := make([]string, len(e))
+
+	// Build up the struct.
+	d := util.BulkElement{XMLName: xml.Name{Local: "remote-user-tunnel-configs"}}
+	for i := range e {
+		d.Data = append(d.Data, fn(e[i]))
+		names[i] = e[i].Name
+	}
+	c.con.LogAction("(set) %s: %v", plural, names)
+
+	// Set xpath.
+	path := c.xpath(tmpl, ts, vsys, gateway, names)
+	if len(e) == 1 {
+		path = path[:len(path)-1]
+	} else {
+		path = path[:len(path)-2]
+	}
+
+	// Create the objects.
+	_, err = c.con.Set(path, d.Config(), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *PanoAgent) Edit(tmpl, ts, vsys, gateway string, e Entry) error {
+	var err error
+
+	if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	} else if gateway == "" {
+		return fmt.Errorf("gateway must be specified")
+	}
+
+	if err = e.Audit(c.con.Versioning()); err != nil {
+		return err
+	}
+
+	_, fn := c.versioning()
+
+	c.con.LogAction("(edit) %s %q", singular, e.Name)
+
+	// Set xpath.
+	path := c.xpath(tmpl, ts, vsys, gateway, []string{e.Name})
+
+	// Edit the object.
+	_, err = c.con.Edit(path, fn(e), nil, nil)
+	return err
+}
+
+// Delete removes the given objects.
+//
+// Objects can be a string or an Entry object.
+func (c *PanoAgent) Delete(tmpl, ts, vsys, gateway string, e ...interface{}) error {
+	var err error
+
+	if len(e) == 0 {
+		return nil
+	} else if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	} else if gateway == "" {
+		return fmt.Errorf("gateway must be specified")
+	}
+
+	names := make([]string, len(e))
+	for i := range e {
+		switch v := e[i].(type) {
+		case string:
+			names[i] = v
+		case Entry:
+			names[i] = v.Name
+		default:
+			return fmt.Errorf("Unknown type sent to delete: %s", v)
+		}
+	}
+	c.con.LogAction("(delete) %s: %v", plural, names)
+
+	// Remove the objects.
+	path := c.xpath(tmpl, ts, vsys, gateway, names)
+	_, err = c.con.Delete(path, nil, nil)
+	return err
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *PanoAgent) versioning() (normalizer, func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *PanoAgent) details(fn util.Retriever, tmpl, ts, vsys, gateway, name string) (Entry, error) {
+	path := c.xpath(tmpl, ts, vsys, gateway, []string{name})
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Entry{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *PanoAgent) xpath(tmpl, ts, vsys, gateway string, vals []string) []string {
+	if vsys == "" {
+		vsys = "vsys1"
+	}
+
+	ans := make([]string, 0, 14)
+	ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"vsys",
+		util.AsEntryXpath([]string{vsys}),
+		"global-protect",
+		"global-protect-gateway",
+		util.AsEntryXpath([]string{gateway}),
+		"remote-user-tunnel-configs",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
@@ -0,0 +1,61 @@
+package agent
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+func TestPanoNormalization(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &PanoAgent{}
+	ns.Initialize(mc)
+
+	for _, tc := range getTests() {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.Version = tc.version
+			mc.AddResp("")
+			err := ns.Set("tmpl", "", "vsys1", "gateway", tc.conf)
+			if err != nil {
+				t.Errorf("Error in set: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get("tmpl", "", "vsys1", "gateway", tc.conf.Name)
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				}
+				if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
+
+func TestPanoAuditUnsupportedFields(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.Version = version.Number{8, 0, 0, ""}
+	ns := &PanoAgent{}
+	ns.Initialize(mc)
+
+	e := Entry{
+		Name:                "cfg1",
+		IncludeApplications: []string{"app.exe"},
+	}
+
+	if err := ns.Set("tmpl", "", "vsys1", "gateway", e); err == nil {
+		t.Errorf("Set did not return an error")
+	} else if _, ok := err.(util.AuditError); !ok {
+		t.Errorf("Set returned %T, not util.AuditError", err)
+	}
+	if err := ns.Edit("tmpl", "", "vsys1", "gateway", e); err == nil {
+		t.Errorf("Edit did not return an error")
+	}
+	if mc.Function != "" {
+		t.Errorf("Function is %q, not empty", mc.Function)
+	}
+}
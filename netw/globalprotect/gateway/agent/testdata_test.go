@@ -0,0 +1,70 @@
+package agent
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+func getTests() []testCase {
+	return []testCase{
+		{"v1 basic", version.Number{8, 0, 0, ""}, Entry{
+			Name:        "cfg1",
+			SourceUsers: []string{"any"},
+			Os:          []string{"any"},
+			IpPools:     []string{"10.5.0.0/16", "2001:db8:5::/64"},
+		}},
+		{"v1 dns and local network", version.Number{8, 0, 0, ""}, Entry{
+			Name:                         "cfg2",
+			SourceUsers:                  []string{"example\\user1", "example\\user2"},
+			Os:                           []string{"Windows", "Mac"},
+			RetrieveFramedIpAddress:      true,
+			NoDirectAccessToLocalNetwork: true,
+			DnsServers:                   []string{"10.1.1.53", "10.1.2.53"},
+			DnsSuffixes:                  []string{"example.com"},
+		}},
+		{"v1 auth override generate cookie", version.Number{8, 0, 0, ""}, Entry{
+			Name:                          "cfg3",
+			AuthOverrideGenerateCookie:    true,
+			AuthOverrideCookieCertificate: "cookie cert",
+		}},
+		{"v1 auth override accept cookie", version.Number{8, 0, 0, ""}, Entry{
+			Name:                            "cfg4",
+			AuthOverrideGenerateCookie:      true,
+			AuthOverrideAcceptCookie:        true,
+			AuthOverrideCookieLifetimeType:  CookieLifetimeHours,
+			AuthOverrideCookieLifetimeValue: 12,
+			AuthOverrideCookieCertificate:   "cookie cert",
+		}},
+		{"v1 access routes", version.Number{8, 0, 0, ""}, Entry{
+			Name:                "cfg5",
+			IpPools:             []string{"10.6.0.0/16"},
+			AccessRoutes:        []string{"10.0.0.0/8", "192.168.0.0/16"},
+			ExcludeAccessRoutes: []string{"10.200.0.0/16"},
+		}},
+		{"v1 split tunnel domains and apps", version.Number{8, 1, 0, ""}, Entry{
+			Name:         "cfg6",
+			AccessRoutes: []string{"10.0.0.0/8"},
+			IncludeDomains: []Domain{
+				{Name: "*.example.com", Ports: []string{"443", "8443"}},
+				{Name: "intranet.example.com"},
+			},
+			ExcludeDomains: []Domain{
+				{Name: "video.example.com"},
+			},
+			IncludeApplications: []string{"C:\\Program Files\\app.exe"},
+			ExcludeApplications: []string{"/Applications/Video.app"},
+		}},
+		{"v1 with raw", version.Number{9, 0, 0, ""}, Entry{
+			Name:    "cfg7",
+			IpPools: []string{"10.7.0.0/16"},
+			raw: map[string]string{
+				"sa": "<region><member>US</member></region>",
+			},
+		}},
+	}
+}
+
+type testCase struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
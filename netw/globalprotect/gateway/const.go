@@ -0,0 +1,33 @@
+package gateway
+
+// Valid values for IpAddressFamily.
+const (
+	IpAddressFamilyIpv4     = "ipv4"
+	IpAddressFamilyIpv6     = "ipv6"
+	IpAddressFamilyIpv4Ipv6 = "ipv4_ipv6"
+)
+
+// Valid values for ClientAuth.Os.
+const (
+	OsAny       = "Any"
+	OsAndroid   = "Android"
+	OsBrowser   = "Browser"
+	OsChrome    = "Chrome"
+	OsIos       = "iOS"
+	OsLinux     = "Linux"
+	OsMac       = "Mac"
+	OsSatellite = "Satellite"
+	OsWindows   = "Windows"
+)
+
+// Valid values for HipNotification.MatchShowNotificationAs and
+// HipNotification.NotMatchShowNotificationAs.
+const (
+	ShowNotificationAsSystemTrayBalloon = "system-tray-balloon"
+	ShowNotificationAsPopUpMessage      = "pop-up-message"
+)
+
+const (
+	singular = "globalprotect gateway"
+	plural   = "globalprotect gateways"
+)
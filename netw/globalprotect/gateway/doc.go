@@ -0,0 +1,15 @@
+/*
+Package gateway is the client.Network.GlobalProtectGateway namespace.
+
+GlobalProtect gateways are configured per vsys.  If vsys is left empty, then
+"vsys1" is used.
+
+Agent configs (the client IP pools, split tunneling, and authentication
+override settings pushed to connecting agents) are managed with the
+client.Network.GlobalProtectGatewayAgent namespace, while the LSVPN satellite
+tunnel settings are managed with the client.Network.GlobalProtectGatewaySat
+namespace.
+
+Normalized object:  Entry
+*/
+package gateway
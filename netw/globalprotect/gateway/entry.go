@@ -0,0 +1,306 @@
+package gateway
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a
+// GlobalProtect gateway.
+//
+// TunnelInterface is the tunnel interface used for remote user tunnels, and
+// is only used when TunnelMode is enabled.
+//
+// Agent configs, roles, and the satellite tunnel settings are preserved as-is
+// when the gateway is updated.
+type Entry struct {
+	Name                 string
+	Interface            string
+	IpAddressFamily      string
+	Ipv4Address          string
+	Ipv6Address          string
+	SslTlsServiceProfile string
+	ClientAuths          []ClientAuth
+	CertificateProfile   string
+	LogSuccess           bool
+	LogFail              bool
+	LogSetting           string
+	TunnelMode           bool
+	TunnelInterface      string // XML: remote-user-tunnel
+	HipNotifications     []HipNotification
+
+	raw map[string]string
+}
+
+// ClientAuth is a gateway client authentication entry.
+type ClientAuth struct {
+	Name                  string
+	Os                    string
+	AuthenticationProfile string
+	AuthenticationMessage string
+	UsernameLabel         string
+	PasswordLabel         string
+}
+
+// HipNotification is the notification shown to the user when a HIP object or
+// profile is or is not matched.  The Name field is the HIP object or profile.
+type HipNotification struct {
+	Name                       string
+	MatchEnable                bool
+	MatchShowNotificationAs    string
+	MatchMessage               string
+	NotMatchEnable             bool
+	NotMatchShowNotificationAs string
+	NotMatchMessage            string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Interface = s.Interface
+	o.IpAddressFamily = s.IpAddressFamily
+	o.Ipv4Address = s.Ipv4Address
+	o.Ipv6Address = s.Ipv6Address
+	o.SslTlsServiceProfile = s.SslTlsServiceProfile
+	if s.ClientAuths == nil {
+		o.ClientAuths = nil
+	} else {
+		o.ClientAuths = make([]ClientAuth, len(s.ClientAuths))
+		copy(o.ClientAuths, s.ClientAuths)
+	}
+	o.CertificateProfile = s.CertificateProfile
+	o.LogSuccess = s.LogSuccess
+	o.LogFail = s.LogFail
+	o.LogSetting = s.LogSetting
+	o.TunnelMode = s.TunnelMode
+	o.TunnelInterface = s.TunnelInterface
+	if s.HipNotifications == nil {
+		o.HipNotifications = nil
+	} else {
+		o.HipNotifications = make([]HipNotification, len(s.HipNotifications))
+		copy(o.HipNotifications, s.HipNotifications)
+	}
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:                 o.Answer.Name,
+		SslTlsServiceProfile: o.Answer.SslTlsServiceProfile,
+		CertificateProfile:   o.Answer.CertificateProfile,
+		LogSuccess:           util.AsBool(o.Answer.LogSuccess),
+		LogFail:              util.AsBool(o.Answer.LogFail),
+		LogSetting:           o.Answer.LogSetting,
+		TunnelMode:           util.AsBool(o.Answer.TunnelMode),
+		TunnelInterface:      o.Answer.TunnelInterface,
+	}
+
+	if o.Answer.Local != nil {
+		ans.Interface = o.Answer.Local.Interface
+		ans.IpAddressFamily = o.Answer.Local.IpAddressFamily
+		if o.Answer.Local.Ip != nil {
+			ans.Ipv4Address = o.Answer.Local.Ip.Ipv4
+			ans.Ipv6Address = o.Answer.Local.Ip.Ipv6
+		}
+	}
+
+	if o.Answer.ClientAuth != nil {
+		list := make([]ClientAuth, 0, len(o.Answer.ClientAuth.Entries))
+		for _, x := range o.Answer.ClientAuth.Entries {
+			list = append(list, ClientAuth{
+				Name:                  x.Name,
+				Os:                    x.Os,
+				AuthenticationProfile: x.AuthenticationProfile,
+				AuthenticationMessage: x.AuthenticationMessage,
+				UsernameLabel:         x.UsernameLabel,
+				PasswordLabel:         x.PasswordLabel,
+			})
+		}
+		ans.ClientAuths = list
+	}
+
+	if o.Answer.HipNotification != nil {
+		list := make([]HipNotification, 0, len(o.Answer.HipNotification.Entries))
+		for _, x := range o.Answer.HipNotification.Entries {
+			item := HipNotification{
+				Name: x.Name,
+			}
+			if x.Match != nil {
+				item.MatchEnable = util.AsBool(x.Match.Enable)
+				item.MatchShowNotificationAs = x.Match.ShowNotificationAs
+				item.MatchMessage = x.Match.Message
+			}
+			if x.NotMatch != nil {
+				item.NotMatchEnable = util.AsBool(x.NotMatch.Enable)
+				item.NotMatchShowNotificationAs = x.NotMatch.ShowNotificationAs
+				item.NotMatchMessage = x.NotMatch.Message
+			}
+			list = append(list, item)
+		}
+		ans.HipNotifications = list
+	}
+
+	raw := make(map[string]string)
+
+	if o.Answer.Configs != nil {
+		raw["configs"] = util.CleanRawXml(o.Answer.Configs.Text)
+	}
+	if o.Answer.Roles != nil {
+		raw["roles"] = util.CleanRawXml(o.Answer.Roles.Text)
+	}
+	if o.Answer.SatelliteTunnel != nil {
+		raw["sattun"] = util.CleanRawXml(o.Answer.SatelliteTunnel.Text)
+	}
+
+	if len(raw) != 0 {
+		ans.raw = raw
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName              xml.Name         `xml:"entry"`
+	Name                 string           `xml:"name,attr"`
+	Local                *local           `xml:"local-address"`
+	SslTlsServiceProfile string           `xml:"ssl-tls-service-profile,omitempty"`
+	ClientAuth           *clientAuth      `xml:"client-auth"`
+	CertificateProfile   string           `xml:"certificate-profile,omitempty"`
+	LogSuccess           string           `xml:"log-success"`
+	LogFail              string           `xml:"log-fail"`
+	LogSetting           string           `xml:"log-setting,omitempty"`
+	TunnelMode           string           `xml:"tunnel-mode"`
+	TunnelInterface      string           `xml:"remote-user-tunnel,omitempty"`
+	HipNotification      *hipNotification `xml:"hip-notification"`
+
+	Configs         *util.RawXml `xml:"remote-user-tunnel-configs"`
+	Roles           *util.RawXml `xml:"roles"`
+	SatelliteTunnel *util.RawXml `xml:"satellite-tunnel"`
+}
+
+type local struct {
+	Interface       string `xml:"interface,omitempty"`
+	IpAddressFamily string `xml:"ip-address-family,omitempty"`
+	Ip              *ip    `xml:"ip"`
+}
+
+type ip struct {
+	Ipv4 string `xml:"ipv4,omitempty"`
+	Ipv6 string `xml:"ipv6,omitempty"`
+}
+
+type clientAuth struct {
+	Entries []clientAuthEntry `xml:"entry"`
+}
+
+type clientAuthEntry struct {
+	Name                  string `xml:"name,attr"`
+	Os                    string `xml:"os,omitempty"`
+	AuthenticationProfile string `xml:"authentication-profile,omitempty"`
+	AuthenticationMessage string `xml:"authentication-message,omitempty"`
+	UsernameLabel         string `xml:"username-label,omitempty"`
+	PasswordLabel         string `xml:"password-label,omitempty"`
+}
+
+type hipNotification struct {
+	Entries []hipNotificationEntry `xml:"entry"`
+}
+
+type hipNotificationEntry struct {
+	Name     string   `xml:"name,attr"`
+	Match    *message `xml:"match-message"`
+	NotMatch *message `xml:"not-match-message"`
+}
+
+type message struct {
+	Enable             string `xml:"enable"`
+	ShowNotificationAs string `xml:"show-notification-as,omitempty"`
+	Message            string `xml:"message,omitempty"`
+}
+
+func specifyMessage(enable bool, showAs, msg string) *message {
+	if !enable && showAs == "" && msg == "" {
+		return nil
+	}
+
+	return &message{
+		Enable:             util.YesNo(enable),
+		ShowNotificationAs: showAs,
+		Message:            msg,
+	}
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:                 e.Name,
+		SslTlsServiceProfile: e.SslTlsServiceProfile,
+		CertificateProfile:   e.CertificateProfile,
+		LogSuccess:           util.YesNo(e.LogSuccess),
+		LogFail:              util.YesNo(e.LogFail),
+		LogSetting:           e.LogSetting,
+		TunnelMode:           util.YesNo(e.TunnelMode),
+		TunnelInterface:      e.TunnelInterface,
+	}
+
+	if e.Interface != "" || e.IpAddressFamily != "" || e.Ipv4Address != "" || e.Ipv6Address != "" {
+		ans.Local = &local{
+			Interface:       e.Interface,
+			IpAddressFamily: e.IpAddressFamily,
+		}
+		if e.Ipv4Address != "" || e.Ipv6Address != "" {
+			ans.Local.Ip = &ip{
+				Ipv4: e.Ipv4Address,
+				Ipv6: e.Ipv6Address,
+			}
+		}
+	}
+
+	if len(e.ClientAuths) > 0 {
+		list := make([]clientAuthEntry, 0, len(e.ClientAuths))
+		for _, x := range e.ClientAuths {
+			list = append(list, clientAuthEntry{
+				Name:                  x.Name,
+				Os:                    x.Os,
+				AuthenticationProfile: x.AuthenticationProfile,
+				AuthenticationMessage: x.AuthenticationMessage,
+				UsernameLabel:         x.UsernameLabel,
+				PasswordLabel:         x.PasswordLabel,
+			})
+		}
+		ans.ClientAuth = &clientAuth{Entries: list}
+	}
+
+	if len(e.HipNotifications) > 0 {
+		list := make([]hipNotificationEntry, 0, len(e.HipNotifications))
+		for _, x := range e.HipNotifications {
+			list = append(list, hipNotificationEntry{
+				Name:     x.Name,
+				Match:    specifyMessage(x.MatchEnable, x.MatchShowNotificationAs, x.MatchMessage),
+				NotMatch: specifyMessage(x.NotMatchEnable, x.NotMatchShowNotificationAs, x.NotMatchMessage),
+			})
+		}
+		ans.HipNotification = &hipNotification{Entries: list}
+	}
+
+	if text, present := e.raw["configs"]; present {
+		ans.Configs = &util.RawXml{text}
+	}
+	if text, present := e.raw["roles"]; present {
+		ans.Roles = &util.RawXml{text}
+	}
+	if text, present := e.raw["sattun"]; present {
+		ans.SatelliteTunnel = &util.RawXml{text}
+	}
+
+	return ans
+}
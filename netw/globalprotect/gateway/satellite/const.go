@@ -0,0 +1,6 @@
+package satellite
+
+const (
+	singular = "globalprotect gateway satellite config"
+	plural   = "globalprotect gateway satellite configs"
+)
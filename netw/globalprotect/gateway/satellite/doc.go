@@ -0,0 +1,10 @@
+/*
+Package satellite is the client.Network.GlobalProtectGatewaySat namespace.
+
+This is the LSVPN satellite tunnel configuration of a GlobalProtect gateway,
+which controls the network settings pushed to the satellites that connect
+to it and the routes it will accept from them.
+
+Normalized object:  Entry
+*/
+package satellite
@@ -0,0 +1,187 @@
+package satellite
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a
+// GlobalProtect gateway's LSVPN satellite tunnel config.
+//
+// The network settings (InheritanceSource, PrimaryDns, SecondaryDns,
+// DnsSuffixes, IpPools, and AccessRoutes) are pushed to satellites, while
+// AcceptPublishedRoutes and PermittedSubnets control which of the satellites'
+// published routes are installed on the gateway.
+type Entry struct {
+	Name                       string
+	TunnelInterface            string
+	Interface                  string // XML: local-address/interface
+	Ipv4Address                string // XML: local-address/ip/ipv4
+	Ipv6Address                string // XML: local-address/ip/ipv6
+	TunnelMonitorEnable        bool
+	TunnelMonitorDestinationIp string
+	TunnelMonitorProfile       string
+	InheritanceSource          string
+	PrimaryDns                 string
+	SecondaryDns               string
+	DnsSuffixes                []string // ordered
+	IpPools                    []string // ordered
+	AccessRoutes               []string // ordered
+	AcceptPublishedRoutes      bool
+	PermittedSubnets           []string // ordered
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.TunnelInterface = s.TunnelInterface
+	o.Interface = s.Interface
+	o.Ipv4Address = s.Ipv4Address
+	o.Ipv6Address = s.Ipv6Address
+	o.TunnelMonitorEnable = s.TunnelMonitorEnable
+	o.TunnelMonitorDestinationIp = s.TunnelMonitorDestinationIp
+	o.TunnelMonitorProfile = s.TunnelMonitorProfile
+	o.InheritanceSource = s.InheritanceSource
+	o.PrimaryDns = s.PrimaryDns
+	o.SecondaryDns = s.SecondaryDns
+	o.DnsSuffixes = s.DnsSuffixes
+	o.IpPools = s.IpPools
+	o.AccessRoutes = s.AccessRoutes
+	o.AcceptPublishedRoutes = s.AcceptPublishedRoutes
+	o.PermittedSubnets = s.PermittedSubnets
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:            o.Answer.Name,
+		TunnelInterface: o.Answer.TunnelInterface,
+		IpPools:         util.MemToStr(o.Answer.IpPools),
+	}
+
+	if o.Answer.Local != nil {
+		ans.Interface = o.Answer.Local.Interface
+		if o.Answer.Local.Ip != nil {
+			ans.Ipv4Address = o.Answer.Local.Ip.Ipv4
+			ans.Ipv6Address = o.Answer.Local.Ip.Ipv6
+		}
+	}
+
+	if o.Answer.TunnelMonitor != nil {
+		ans.TunnelMonitorEnable = util.AsBool(o.Answer.TunnelMonitor.Enable)
+		ans.TunnelMonitorDestinationIp = o.Answer.TunnelMonitor.DestinationIp
+		ans.TunnelMonitorProfile = o.Answer.TunnelMonitor.TunnelMonitorProfile
+	}
+
+	if o.Answer.Network != nil {
+		ans.InheritanceSource = o.Answer.Network.InheritanceSource
+		ans.PrimaryDns = o.Answer.Network.PrimaryDns
+		ans.SecondaryDns = o.Answer.Network.SecondaryDns
+		ans.DnsSuffixes = util.MemToStr(o.Answer.Network.DnsSuffixes)
+		ans.AccessRoutes = util.MemToStr(o.Answer.Network.AccessRoutes)
+	}
+
+	if o.Answer.RouteFilter != nil {
+		ans.AcceptPublishedRoutes = util.AsBool(o.Answer.RouteFilter.AcceptPublishedRoutes)
+		ans.PermittedSubnets = util.MemToStr(o.Answer.RouteFilter.PermittedSubnets)
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName         xml.Name         `xml:"entry"`
+	Name            string           `xml:"name,attr"`
+	TunnelInterface string           `xml:"tunnel-interface,omitempty"`
+	Local           *local           `xml:"local-address"`
+	TunnelMonitor   *tunnelMonitor   `xml:"tunnel-monitoring"`
+	IpPools         *util.MemberType `xml:"ip-pool"`
+	Network         *network         `xml:"network-settings"`
+	RouteFilter     *routeFilter     `xml:"route-filter"`
+}
+
+type local struct {
+	Interface string `xml:"interface,omitempty"`
+	Ip        *ip    `xml:"ip"`
+}
+
+type ip struct {
+	Ipv4 string `xml:"ipv4,omitempty"`
+	Ipv6 string `xml:"ipv6,omitempty"`
+}
+
+type tunnelMonitor struct {
+	Enable               string `xml:"enable"`
+	DestinationIp        string `xml:"destination-ip,omitempty"`
+	TunnelMonitorProfile string `xml:"tunnel-monitor-profile,omitempty"`
+}
+
+type network struct {
+	InheritanceSource string           `xml:"inheritance-source,omitempty"`
+	PrimaryDns        string           `xml:"dns-primary,omitempty"`
+	SecondaryDns      string           `xml:"dns-secondary,omitempty"`
+	DnsSuffixes       *util.MemberType `xml:"dns-suffix"`
+	AccessRoutes      *util.MemberType `xml:"access-route"`
+}
+
+type routeFilter struct {
+	AcceptPublishedRoutes string           `xml:"accept-published-routes"`
+	PermittedSubnets      *util.MemberType `xml:"permitted-subnets"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:            e.Name,
+		TunnelInterface: e.TunnelInterface,
+		IpPools:         util.StrToMem(e.IpPools),
+	}
+
+	if e.Interface != "" || e.Ipv4Address != "" || e.Ipv6Address != "" {
+		ans.Local = &local{
+			Interface: e.Interface,
+		}
+		if e.Ipv4Address != "" || e.Ipv6Address != "" {
+			ans.Local.Ip = &ip{
+				Ipv4: e.Ipv4Address,
+				Ipv6: e.Ipv6Address,
+			}
+		}
+	}
+
+	if e.TunnelMonitorEnable || e.TunnelMonitorDestinationIp != "" || e.TunnelMonitorProfile != "" {
+		ans.TunnelMonitor = &tunnelMonitor{
+			Enable:               util.YesNo(e.TunnelMonitorEnable),
+			DestinationIp:        e.TunnelMonitorDestinationIp,
+			TunnelMonitorProfile: e.TunnelMonitorProfile,
+		}
+	}
+
+	if e.InheritanceSource != "" || e.PrimaryDns != "" || e.SecondaryDns != "" || len(e.DnsSuffixes) > 0 || len(e.AccessRoutes) > 0 {
+		ans.Network = &network{
+			InheritanceSource: e.InheritanceSource,
+			PrimaryDns:        e.PrimaryDns,
+			SecondaryDns:      e.SecondaryDns,
+			DnsSuffixes:       util.StrToMem(e.DnsSuffixes),
+			AccessRoutes:      util.StrToMem(e.AccessRoutes),
+		}
+	}
+
+	if e.AcceptPublishedRoutes || len(e.PermittedSubnets) > 0 {
+		ans.RouteFilter = &routeFilter{
+			AcceptPublishedRoutes: util.YesNo(e.AcceptPublishedRoutes),
+			PermittedSubnets:      util.StrToMem(e.PermittedSubnets),
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,43 @@
+package satellite
+
+func getTests() []testCase {
+	return []testCase{
+		{"v1 basic", Entry{
+			Name:            "gw1",
+			TunnelInterface: "tunnel.20",
+			Interface:       "ethernet1/3",
+			Ipv4Address:     "192.168.1.1/24",
+			IpPools:         []string{"10.100.0.0/16"},
+		}},
+		{"v1 tunnel monitor", Entry{
+			Name:                       "gw2",
+			TunnelInterface:            "tunnel.21",
+			Interface:                  "ethernet1/4",
+			Ipv6Address:                "2001:db8::1/64",
+			TunnelMonitorEnable:        true,
+			TunnelMonitorDestinationIp: "10.100.0.1",
+			TunnelMonitorProfile:       "failover",
+		}},
+		{"v1 network settings", Entry{
+			Name:              "gw3",
+			TunnelInterface:   "tunnel.22",
+			InheritanceSource: "ethernet1/5",
+			PrimaryDns:        "10.0.0.53",
+			SecondaryDns:      "10.0.1.53",
+			DnsSuffixes:       []string{"example.com", "corp.example.com"},
+			IpPools:           []string{"10.101.0.0/16", "10.102.0.0/16"},
+			AccessRoutes:      []string{"10.0.0.0/8"},
+		}},
+		{"v1 route filter", Entry{
+			Name:                  "gw4",
+			TunnelInterface:       "tunnel.23",
+			AcceptPublishedRoutes: true,
+			PermittedSubnets:      []string{"10.5.0.0/16", "10.6.0.0/16"},
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
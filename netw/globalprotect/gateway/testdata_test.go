@@ -0,0 +1,82 @@
+package gateway
+
+func getTests() []testCase {
+	return []testCase{
+		{"v1 basic", Entry{
+			Name:                 "gw1",
+			Interface:            "ethernet1/1",
+			IpAddressFamily:      IpAddressFamilyIpv4,
+			Ipv4Address:          "10.1.1.1/24",
+			SslTlsServiceProfile: "ssl profile",
+			LogFail:              true,
+		}},
+		{"v1 authentication", Entry{
+			Name:               "gw2",
+			Interface:          "ethernet1/2",
+			IpAddressFamily:    IpAddressFamilyIpv4Ipv6,
+			Ipv4Address:        "10.1.2.1/24",
+			Ipv6Address:        "2001:db8::1/64",
+			CertificateProfile: "cert profile",
+			ClientAuths: []ClientAuth{
+				{
+					Name:                  "windows",
+					Os:                    OsWindows,
+					AuthenticationProfile: "ldap",
+					AuthenticationMessage: "Enter login credentials",
+					UsernameLabel:         "Username",
+					PasswordLabel:         "Password",
+				},
+				{
+					Name:                  "any",
+					Os:                    OsAny,
+					AuthenticationProfile: "saml",
+				},
+			},
+			LogSuccess: true,
+			LogFail:    true,
+			LogSetting: "log fwd",
+		}},
+		{"v1 tunnel mode", Entry{
+			Name:            "gw3",
+			Interface:       "ethernet1/3",
+			TunnelMode:      true,
+			TunnelInterface: "tunnel.5",
+		}},
+		{"v1 hip notifications", Entry{
+			Name:      "gw4",
+			Interface: "ethernet1/4",
+			HipNotifications: []HipNotification{
+				{
+					Name:                       "av",
+					MatchEnable:                true,
+					MatchShowNotificationAs:    ShowNotificationAsSystemTrayBalloon,
+					MatchMessage:               "AV is installed",
+					NotMatchEnable:             true,
+					NotMatchShowNotificationAs: ShowNotificationAsPopUpMessage,
+					NotMatchMessage:            "Please install AV",
+				},
+				{
+					Name:            "disk encryption",
+					NotMatchEnable:  true,
+					NotMatchMessage: "Please encrypt your disk",
+				},
+			},
+		}},
+		{"v1 with raw", Entry{
+			Name:            "gw5",
+			Interface:       "ethernet1/5",
+			TunnelMode:      true,
+			TunnelInterface: "tunnel.6",
+			raw: map[string]string{
+				"configs": "<entry name=\"cfg\"><ip-pool><member>10.5.0.0/16</member></ip-pool></entry>",
+				"roles":   "<entry name=\"default\"><login-lifetime><days>30</days></login-lifetime></entry>",
+				"sattun":  "<tunnel-interface>tunnel.7</tunnel-interface>",
+			},
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
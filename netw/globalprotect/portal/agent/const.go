@@ -0,0 +1,17 @@
+package agent
+
+// Valid values for SaveUserCredentials.
+const (
+	SaveUserCredentialsNo            = "0"
+	SaveUserCredentialsYes           = "1"
+	SaveUserCredentialsUsernameOnly  = "2"
+	SaveUserCredentialsWithBiometric = "3"
+)
+
+// Valid special value for PriorityRule.Priority, besides "1" through "5".
+const PriorityNone = "None"
+
+const (
+	singular = "globalprotect portal agent config"
+	plural   = "globalprotect portal agent configs"
+)
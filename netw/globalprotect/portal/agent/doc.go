@@ -0,0 +1,10 @@
+/*
+Package agent is the client.Network.GlobalProtectPortalAgent namespace.
+
+Agent configs are evaluated in order by the portal, with the first config whose
+selection criteria (source users, OS, and machine certificate profile)
+matches the connecting endpoint being sent to the agent.
+
+Normalized object:  Entry
+*/
+package agent
@@ -0,0 +1,402 @@
+package agent
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a
+// GlobalProtect portal agent config.
+//
+// The SourceUsers, Os, and CertificateProfile fields are the selection
+// criteria for this config.
+//
+// The agent UI, HIP collection, internal host detection, client certificate,
+// custom checks, authentication override, third party VPN clients, and app
+// config settings are preserved as-is when the config is updated.
+type Entry struct {
+	Name                            string
+	SourceUsers                     []string // ordered
+	Os                              []string // ordered
+	CertificateProfile              string   // XML: certificate/criteria/certificate-profile
+	SaveUserCredentials             string
+	Portal2fa                       bool
+	InternalGateway2fa              bool
+	AutoDiscoveryExternalGateway2fa bool
+	ManualOnlyGateway2fa            bool
+	RefreshConfig                   bool
+	MdmAddress                      string
+	InternalGateways                []InternalGateway
+	ExternalGatewayCutoffTime       int
+	ExternalGateways                []ExternalGateway
+
+	raw map[string]string
+}
+
+// InternalGateway is an internal gateway of an agent config.
+type InternalGateway struct {
+	Name        string
+	Fqdn        string
+	Ipv4Address string
+	Ipv6Address string
+}
+
+// ExternalGateway is an external gateway of an agent config.
+type ExternalGateway struct {
+	Name          string
+	Fqdn          string
+	Ipv4Address   string
+	Ipv6Address   string
+	Manual        bool
+	PriorityRules []PriorityRule
+}
+
+// PriorityRule is the priority of an external gateway for a given region.
+type PriorityRule struct {
+	Name     string
+	Priority string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.SourceUsers = s.SourceUsers
+	o.Os = s.Os
+	o.CertificateProfile = s.CertificateProfile
+	o.SaveUserCredentials = s.SaveUserCredentials
+	o.Portal2fa = s.Portal2fa
+	o.InternalGateway2fa = s.InternalGateway2fa
+	o.AutoDiscoveryExternalGateway2fa = s.AutoDiscoveryExternalGateway2fa
+	o.ManualOnlyGateway2fa = s.ManualOnlyGateway2fa
+	o.RefreshConfig = s.RefreshConfig
+	o.MdmAddress = s.MdmAddress
+	if s.InternalGateways == nil {
+		o.InternalGateways = nil
+	} else {
+		o.InternalGateways = make([]InternalGateway, len(s.InternalGateways))
+		copy(o.InternalGateways, s.InternalGateways)
+	}
+	o.ExternalGatewayCutoffTime = s.ExternalGatewayCutoffTime
+	if s.ExternalGateways == nil {
+		o.ExternalGateways = nil
+	} else {
+		o.ExternalGateways = make([]ExternalGateway, 0, len(s.ExternalGateways))
+		for _, x := range s.ExternalGateways {
+			gw := x
+			if x.PriorityRules != nil {
+				gw.PriorityRules = make([]PriorityRule, len(x.PriorityRules))
+				copy(gw.PriorityRules, x.PriorityRules)
+			}
+			o.ExternalGateways = append(o.ExternalGateways, gw)
+		}
+	}
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:                            o.Answer.Name,
+		SourceUsers:                     util.MemToStr(o.Answer.SourceUsers),
+		Os:                              util.MemToStr(o.Answer.Os),
+		SaveUserCredentials:             o.Answer.SaveUserCredentials,
+		Portal2fa:                       util.AsBool(o.Answer.Portal2fa),
+		InternalGateway2fa:              util.AsBool(o.Answer.InternalGateway2fa),
+		AutoDiscoveryExternalGateway2fa: util.AsBool(o.Answer.AutoDiscoveryExternalGateway2fa),
+		ManualOnlyGateway2fa:            util.AsBool(o.Answer.ManualOnlyGateway2fa),
+		RefreshConfig:                   util.AsBool(o.Answer.RefreshConfig),
+		MdmAddress:                      o.Answer.MdmAddress,
+	}
+
+	if o.Answer.Certificate != nil && o.Answer.Certificate.Criteria != nil {
+		ans.CertificateProfile = o.Answer.Certificate.Criteria.CertificateProfile
+	}
+
+	if o.Answer.Gateways != nil {
+		if o.Answer.Gateways.Internal != nil && o.Answer.Gateways.Internal.List != nil {
+			list := make([]InternalGateway, 0, len(o.Answer.Gateways.Internal.List.Entries))
+			for _, x := range o.Answer.Gateways.Internal.List.Entries {
+				gw := InternalGateway{
+					Name: x.Name,
+					Fqdn: x.Fqdn,
+				}
+				if x.Ip != nil {
+					gw.Ipv4Address = x.Ip.Ipv4
+					gw.Ipv6Address = x.Ip.Ipv6
+				}
+				list = append(list, gw)
+			}
+			ans.InternalGateways = list
+		}
+
+		if o.Answer.Gateways.External != nil {
+			ans.ExternalGatewayCutoffTime = o.Answer.Gateways.External.CutoffTime
+			if o.Answer.Gateways.External.List != nil {
+				list := make([]ExternalGateway, 0, len(o.Answer.Gateways.External.List.Entries))
+				for _, x := range o.Answer.Gateways.External.List.Entries {
+					gw := ExternalGateway{
+						Name:   x.Name,
+						Fqdn:   x.Fqdn,
+						Manual: util.AsBool(x.Manual),
+					}
+					if x.Ip != nil {
+						gw.Ipv4Address = x.Ip.Ipv4
+						gw.Ipv6Address = x.Ip.Ipv6
+					}
+					if x.PriorityRule != nil {
+						gw.PriorityRules = make([]PriorityRule, 0, len(x.PriorityRule.Entries))
+						for _, pr := range x.PriorityRule.Entries {
+							gw.PriorityRules = append(gw.PriorityRules, PriorityRule{
+								Name:     pr.Name,
+								Priority: pr.Priority,
+							})
+						}
+					}
+					list = append(list, gw)
+				}
+				ans.ExternalGateways = list
+			}
+		}
+	}
+
+	raw := make(map[string]string)
+
+	if o.Answer.AgentUi != nil {
+		raw["aui"] = util.CleanRawXml(o.Answer.AgentUi.Text)
+	}
+	if o.Answer.HipCollection != nil {
+		raw["hip"] = util.CleanRawXml(o.Answer.HipCollection.Text)
+	}
+	if o.Answer.InternalHostDetection != nil {
+		raw["ihd"] = util.CleanRawXml(o.Answer.InternalHostDetection.Text)
+	}
+	if o.Answer.InternalHostDetectionIpv6 != nil {
+		raw["ihd6"] = util.CleanRawXml(o.Answer.InternalHostDetectionIpv6.Text)
+	}
+	if o.Answer.ClientCertificate != nil {
+		raw["cc"] = util.CleanRawXml(o.Answer.ClientCertificate.Text)
+	}
+	if o.Answer.CustomChecks != nil {
+		raw["cch"] = util.CleanRawXml(o.Answer.CustomChecks.Text)
+	}
+	if o.Answer.AuthenticationOverride != nil {
+		raw["ao"] = util.CleanRawXml(o.Answer.AuthenticationOverride.Text)
+	}
+	if o.Answer.ThirdPartyVpnClients != nil {
+		raw["tpvc"] = util.CleanRawXml(o.Answer.ThirdPartyVpnClients.Text)
+	}
+	if o.Answer.AppConfig != nil {
+		raw["app"] = util.CleanRawXml(o.Answer.AppConfig.Text)
+	}
+
+	if len(raw) != 0 {
+		ans.raw = raw
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName                         xml.Name         `xml:"entry"`
+	Name                            string           `xml:"name,attr"`
+	SourceUsers                     *util.MemberType `xml:"source-user"`
+	Os                              *util.MemberType `xml:"os"`
+	Certificate                     *certificate     `xml:"certificate"`
+	SaveUserCredentials             string           `xml:"save-user-credentials,omitempty"`
+	Portal2fa                       string           `xml:"portal-2fa"`
+	InternalGateway2fa              string           `xml:"internal-gateway-2fa"`
+	AutoDiscoveryExternalGateway2fa string           `xml:"auto-discovery-external-gateway-2fa"`
+	ManualOnlyGateway2fa            string           `xml:"manual-only-gateway-2fa"`
+	RefreshConfig                   string           `xml:"refresh-config"`
+	MdmAddress                      string           `xml:"mdm-address,omitempty"`
+	Gateways                        *gateways        `xml:"gateways"`
+
+	AgentUi                   *util.RawXml `xml:"agent-ui"`
+	HipCollection             *util.RawXml `xml:"hip-collection"`
+	InternalHostDetection     *util.RawXml `xml:"internal-host-detection"`
+	InternalHostDetectionIpv6 *util.RawXml `xml:"internal-host-detection-ipv6"`
+	ClientCertificate         *util.RawXml `xml:"client-certificate"`
+	CustomChecks              *util.RawXml `xml:"custom-checks"`
+	AuthenticationOverride    *util.RawXml `xml:"authentication-override"`
+	ThirdPartyVpnClients      *util.RawXml `xml:"third-party-vpn-clients"`
+	AppConfig                 *util.RawXml `xml:"gp-app-config"`
+}
+
+type certificate struct {
+	Criteria *criteria `xml:"criteria"`
+}
+
+type criteria struct {
+	CertificateProfile string `xml:"certificate-profile,omitempty"`
+}
+
+type gateways struct {
+	Internal *internal `xml:"internal"`
+	External *external `xml:"external"`
+}
+
+type internal struct {
+	List *internalList `xml:"list"`
+}
+
+type internalList struct {
+	Entries []internalEntry `xml:"entry"`
+}
+
+type internalEntry struct {
+	Name string `xml:"name,attr"`
+	Fqdn string `xml:"fqdn,omitempty"`
+	Ip   *ip    `xml:"ip"`
+}
+
+type ip struct {
+	Ipv4 string `xml:"ipv4,omitempty"`
+	Ipv6 string `xml:"ipv6,omitempty"`
+}
+
+type external struct {
+	CutoffTime int           `xml:"cutoff-time,omitempty"`
+	List       *externalList `xml:"list"`
+}
+
+type externalList struct {
+	Entries []externalEntry `xml:"entry"`
+}
+
+type externalEntry struct {
+	Name         string        `xml:"name,attr"`
+	Fqdn         string        `xml:"fqdn,omitempty"`
+	Ip           *ip           `xml:"ip"`
+	PriorityRule *priorityRule `xml:"priority-rule"`
+	Manual       string        `xml:"manual"`
+}
+
+type priorityRule struct {
+	Entries []priorityEntry `xml:"entry"`
+}
+
+type priorityEntry struct {
+	Name     string `xml:"name,attr"`
+	Priority string `xml:"priority,omitempty"`
+}
+
+func specifyIp(v4, v6 string) *ip {
+	if v4 == "" && v6 == "" {
+		return nil
+	}
+
+	return &ip{
+		Ipv4: v4,
+		Ipv6: v6,
+	}
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:                            e.Name,
+		SourceUsers:                     util.StrToMem(e.SourceUsers),
+		Os:                              util.StrToMem(e.Os),
+		SaveUserCredentials:             e.SaveUserCredentials,
+		Portal2fa:                       util.YesNo(e.Portal2fa),
+		InternalGateway2fa:              util.YesNo(e.InternalGateway2fa),
+		AutoDiscoveryExternalGateway2fa: util.YesNo(e.AutoDiscoveryExternalGateway2fa),
+		ManualOnlyGateway2fa:            util.YesNo(e.ManualOnlyGateway2fa),
+		RefreshConfig:                   util.YesNo(e.RefreshConfig),
+		MdmAddress:                      e.MdmAddress,
+	}
+
+	if e.CertificateProfile != "" {
+		ans.Certificate = &certificate{
+			Criteria: &criteria{
+				CertificateProfile: e.CertificateProfile,
+			},
+		}
+	}
+
+	if len(e.InternalGateways) > 0 || e.ExternalGatewayCutoffTime != 0 || len(e.ExternalGateways) > 0 {
+		ans.Gateways = &gateways{}
+
+		if len(e.InternalGateways) > 0 {
+			list := make([]internalEntry, 0, len(e.InternalGateways))
+			for _, x := range e.InternalGateways {
+				list = append(list, internalEntry{
+					Name: x.Name,
+					Fqdn: x.Fqdn,
+					Ip:   specifyIp(x.Ipv4Address, x.Ipv6Address),
+				})
+			}
+			ans.Gateways.Internal = &internal{
+				List: &internalList{Entries: list},
+			}
+		}
+
+		if e.ExternalGatewayCutoffTime != 0 || len(e.ExternalGateways) > 0 {
+			ans.Gateways.External = &external{
+				CutoffTime: e.ExternalGatewayCutoffTime,
+			}
+
+			if len(e.ExternalGateways) > 0 {
+				list := make([]externalEntry, 0, len(e.ExternalGateways))
+				for _, x := range e.ExternalGateways {
+					gw := externalEntry{
+						Name:   x.Name,
+						Fqdn:   x.Fqdn,
+						Ip:     specifyIp(x.Ipv4Address, x.Ipv6Address),
+						Manual: util.YesNo(x.Manual),
+					}
+					if len(x.PriorityRules) > 0 {
+						prs := make([]priorityEntry, 0, len(x.PriorityRules))
+						for _, pr := range x.PriorityRules {
+							prs = append(prs, priorityEntry{
+								Name:     pr.Name,
+								Priority: pr.Priority,
+							})
+						}
+						gw.PriorityRule = &priorityRule{Entries: prs}
+					}
+					list = append(list, gw)
+				}
+				ans.Gateways.External.List = &externalList{Entries: list}
+			}
+		}
+	}
+
+	if text, present := e.raw["aui"]; present {
+		ans.AgentUi = &util.RawXml{text}
+	}
+	if text, present := e.raw["hip"]; present {
+		ans.HipCollection = &util.RawXml{text}
+	}
+	if text, present := e.raw["ihd"]; present {
+		ans.InternalHostDetection = &util.RawXml{text}
+	}
+	if text, present := e.raw["ihd6"]; present {
+		ans.InternalHostDetectionIpv6 = &util.RawXml{text}
+	}
+	if text, present := e.raw["cc"]; present {
+		ans.ClientCertificate = &util.RawXml{text}
+	}
+	if text, present := e.raw["cch"]; present {
+		ans.CustomChecks = &util.RawXml{text}
+	}
+	if text, present := e.raw["ao"]; present {
+		ans.AuthenticationOverride = &util.RawXml{text}
+	}
+	if text, present := e.raw["tpvc"]; present {
+		ans.ThirdPartyVpnClients = &util.RawXml{text}
+	}
+	if text, present := e.raw["app"]; present {
+		ans.AppConfig = &util.RawXml{text}
+	}
+
+	return ans
+}
@@ -0,0 +1,66 @@
+package agent
+
+func getTests() []testCase {
+	return []testCase{
+		{"v1 basic", Entry{
+			Name:          "cfg1",
+			SourceUsers:   []string{"any"},
+			Os:            []string{"any"},
+			RefreshConfig: true,
+		}},
+		{"v1 selection criteria", Entry{
+			Name:                "cfg2",
+			SourceUsers:         []string{"group1", "user1"},
+			Os:                  []string{"Windows", "Mac"},
+			CertificateProfile:  "machine certs",
+			SaveUserCredentials: SaveUserCredentialsUsernameOnly,
+			Portal2fa:           true,
+			InternalGateway2fa:  true,
+			MdmAddress:          "mdm.example.com",
+		}},
+		{"v1 gateways", Entry{
+			Name:                            "cfg3",
+			AutoDiscoveryExternalGateway2fa: true,
+			ManualOnlyGateway2fa:            true,
+			InternalGateways: []InternalGateway{
+				{Name: "int1", Fqdn: "int1.example.com"},
+				{Name: "int2", Ipv4Address: "10.1.1.1", Ipv6Address: "2001:db8::1"},
+			},
+			ExternalGatewayCutoffTime: 5,
+			ExternalGateways: []ExternalGateway{
+				{
+					Name:   "ext1",
+					Fqdn:   "ext1.example.com",
+					Manual: true,
+					PriorityRules: []PriorityRule{
+						{Name: "Any", Priority: "1"},
+						{Name: "Europe", Priority: PriorityNone},
+					},
+				},
+				{
+					Name:        "ext2",
+					Ipv4Address: "192.0.2.1",
+				},
+			},
+		}},
+		{"v1 with raw", Entry{
+			Name: "cfg4",
+			raw: map[string]string{
+				"aui":  "<passcode>secret</passcode>",
+				"hip":  "<max-wait-time>20</max-wait-time>",
+				"ihd":  "<ip-address>10.1.1.1</ip-address><hostname>int.example.com</hostname>",
+				"ihd6": "<ip-address>2001:db8::1</ip-address><hostname>int.example.com</hostname>",
+				"cc":   "<local>cert</local>",
+				"cch":  "<criteria><windows/></criteria>",
+				"ao":   "<generate-cookie>yes</generate-cookie>",
+				"tpvc": "<member>vpn</member>",
+				"app":  "<config><entry name=\"connect-method\"><value><member>user-logon</member></value></entry></config>",
+			},
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
@@ -0,0 +1,33 @@
+package portal
+
+// Valid values for IpAddressFamily.
+const (
+	IpAddressFamilyIpv4     = "ipv4"
+	IpAddressFamilyIpv6     = "ipv6"
+	IpAddressFamilyIpv4Ipv6 = "ipv4_ipv6"
+)
+
+// Valid values for ClientAuth.Os.
+const (
+	OsAny       = "Any"
+	OsAndroid   = "Android"
+	OsBrowser   = "Browser"
+	OsChrome    = "Chrome"
+	OsIos       = "iOS"
+	OsLinux     = "Linux"
+	OsMac       = "Mac"
+	OsSatellite = "Satellite"
+	OsWindows   = "Windows"
+)
+
+// Valid values for ClientlessLoginLifetimeType and
+// ClientlessInactivityLogoutType.
+const (
+	TimeMinutes = "minutes"
+	TimeHours   = "hours"
+)
+
+const (
+	singular = "globalprotect portal"
+	plural   = "globalprotect portals"
+)
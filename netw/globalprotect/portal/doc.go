@@ -0,0 +1,17 @@
+/*
+Package portal is the client.Network.GlobalProtectPortal namespace.
+
+GlobalProtect portals are configured per vsys.  If vsys is left empty, then
+"vsys1" is used.
+
+Agent configs are managed with the client.Network.GlobalProtectPortalAgent
+namespace, while satellite configs are managed with the
+client.Network.GlobalProtectPortalSat namespace.
+
+The clientless apps and app groups referenced by the clientless VPN settings
+are managed with the client.Network.GlobalProtectClientlessApp and
+client.Network.GlobalProtectClientlessAppGroup namespaces.
+
+Normalized object:  Entry
+*/
+package portal
@@ -0,0 +1,529 @@
+package portal
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a
+// GlobalProtect portal.
+//
+// The Satellite* fields are the LSVPN satellite settings of the portal.  If
+// satellite certificates are issued using SCEP instead of a local issuing
+// certificate, then the SCEP config is preserved as-is.
+//
+// The Clientless* fields are the clientless VPN settings of the portal.  The
+// login lifetime and inactivity logout are a number of minutes or hours, as
+// specified by the corresponding Type field.
+//
+// Agent configs, satellite configs, and the clientless VPN crypto settings,
+// proxy settings, and rewrite exclude domains are preserved as-is when the
+// portal is updated.
+type Entry struct {
+	Name                   string
+	Interface              string
+	IpAddressFamily        string
+	Ipv4Address            string
+	Ipv6Address            string
+	SslTlsServiceProfile   string
+	ClientAuths            []ClientAuth
+	CertificateProfile     string
+	CustomLoginPage        string
+	CustomHomePage         string
+	CustomHelpPage         string
+	LogSuccess             bool
+	LogFail                bool
+	LogSetting             string
+	AgentUserOverrideKey   string
+	TrustedRootCas         []TrustedRootCa
+	ClientlessHostname     string
+	ClientlessSecurityZone string
+	ClientlessDnsProxy     string
+
+	ClientlessLoginLifetimeType     string
+	ClientlessLoginLifetimeValue    int
+	ClientlessInactivityLogoutType  string
+	ClientlessInactivityLogoutValue int
+	ClientlessMaxUsers              int
+	ClientlessApplications          []ClientlessApplication
+
+	SatelliteRootCas                  []string // ordered
+	SatelliteIssuingCertificate       string
+	SatelliteOcspResponder            string
+	SatelliteValidityPeriod           int
+	SatelliteCertificateRenewalPeriod int
+
+	raw map[string]string
+}
+
+// ClientAuth is a portal client authentication entry.
+type ClientAuth struct {
+	Name                  string
+	Os                    string
+	AuthenticationProfile string
+	AuthenticationMessage string
+	UsernameLabel         string
+	PasswordLabel         string
+}
+
+// TrustedRootCa is a trusted root CA that is pushed to agents.
+type TrustedRootCa struct {
+	Name               string
+	InstallInCertStore bool
+}
+
+// ClientlessApplication maps clientless apps and app groups to users.
+type ClientlessApplication struct {
+	Name                 string
+	SourceUsers          []string // ordered
+	Applications         []string // ordered
+	DisplayUrlAddressBar bool     // XML: display-application-url-address-bar
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Interface = s.Interface
+	o.IpAddressFamily = s.IpAddressFamily
+	o.Ipv4Address = s.Ipv4Address
+	o.Ipv6Address = s.Ipv6Address
+	o.SslTlsServiceProfile = s.SslTlsServiceProfile
+	if s.ClientAuths == nil {
+		o.ClientAuths = nil
+	} else {
+		o.ClientAuths = make([]ClientAuth, len(s.ClientAuths))
+		copy(o.ClientAuths, s.ClientAuths)
+	}
+	o.CertificateProfile = s.CertificateProfile
+	o.CustomLoginPage = s.CustomLoginPage
+	o.CustomHomePage = s.CustomHomePage
+	o.CustomHelpPage = s.CustomHelpPage
+	o.LogSuccess = s.LogSuccess
+	o.LogFail = s.LogFail
+	o.LogSetting = s.LogSetting
+	o.AgentUserOverrideKey = s.AgentUserOverrideKey
+	if s.TrustedRootCas == nil {
+		o.TrustedRootCas = nil
+	} else {
+		o.TrustedRootCas = make([]TrustedRootCa, len(s.TrustedRootCas))
+		copy(o.TrustedRootCas, s.TrustedRootCas)
+	}
+	o.ClientlessHostname = s.ClientlessHostname
+	o.ClientlessSecurityZone = s.ClientlessSecurityZone
+	o.ClientlessDnsProxy = s.ClientlessDnsProxy
+	o.ClientlessLoginLifetimeType = s.ClientlessLoginLifetimeType
+	o.ClientlessLoginLifetimeValue = s.ClientlessLoginLifetimeValue
+	o.ClientlessInactivityLogoutType = s.ClientlessInactivityLogoutType
+	o.ClientlessInactivityLogoutValue = s.ClientlessInactivityLogoutValue
+	o.ClientlessMaxUsers = s.ClientlessMaxUsers
+	if s.ClientlessApplications == nil {
+		o.ClientlessApplications = nil
+	} else {
+		o.ClientlessApplications = make([]ClientlessApplication, len(s.ClientlessApplications))
+		copy(o.ClientlessApplications, s.ClientlessApplications)
+	}
+	o.SatelliteRootCas = s.SatelliteRootCas
+	o.SatelliteIssuingCertificate = s.SatelliteIssuingCertificate
+	o.SatelliteOcspResponder = s.SatelliteOcspResponder
+	o.SatelliteValidityPeriod = s.SatelliteValidityPeriod
+	o.SatelliteCertificateRenewalPeriod = s.SatelliteCertificateRenewalPeriod
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name: o.Answer.Name,
+	}
+
+	raw := make(map[string]string)
+
+	if o.Answer.Portal != nil {
+		p := o.Answer.Portal
+		ans.SslTlsServiceProfile = p.SslTlsServiceProfile
+		ans.CertificateProfile = p.CertificateProfile
+		ans.CustomLoginPage = p.CustomLoginPage
+		ans.CustomHomePage = p.CustomHomePage
+		ans.CustomHelpPage = p.CustomHelpPage
+		ans.LogSuccess = util.AsBool(p.LogSuccess)
+		ans.LogFail = util.AsBool(p.LogFail)
+		ans.LogSetting = p.LogSetting
+
+		if p.Local != nil {
+			ans.Interface = p.Local.Interface
+			ans.IpAddressFamily = p.Local.IpAddressFamily
+			if p.Local.Ip != nil {
+				ans.Ipv4Address = p.Local.Ip.Ipv4
+				ans.Ipv6Address = p.Local.Ip.Ipv6
+			}
+		}
+
+		if p.ClientAuth != nil {
+			list := make([]ClientAuth, 0, len(p.ClientAuth.Entries))
+			for _, x := range p.ClientAuth.Entries {
+				list = append(list, ClientAuth{
+					Name:                  x.Name,
+					Os:                    x.Os,
+					AuthenticationProfile: x.AuthenticationProfile,
+					AuthenticationMessage: x.AuthenticationMessage,
+					UsernameLabel:         x.UsernameLabel,
+					PasswordLabel:         x.PasswordLabel,
+				})
+			}
+			ans.ClientAuths = list
+		}
+	}
+
+	if o.Answer.Client != nil {
+		ans.AgentUserOverrideKey = o.Answer.Client.AgentUserOverrideKey
+
+		if o.Answer.Client.RootCa != nil {
+			list := make([]TrustedRootCa, 0, len(o.Answer.Client.RootCa.Entries))
+			for _, x := range o.Answer.Client.RootCa.Entries {
+				list = append(list, TrustedRootCa{
+					Name:               x.Name,
+					InstallInCertStore: util.AsBool(x.InstallInCertStore),
+				})
+			}
+			ans.TrustedRootCas = list
+		}
+
+		if o.Answer.Client.Configs != nil {
+			raw["configs"] = util.CleanRawXml(o.Answer.Client.Configs.Text)
+		}
+	}
+
+	if o.Answer.Clientless != nil {
+		c := o.Answer.Clientless
+		ans.ClientlessHostname = c.Hostname
+		ans.ClientlessSecurityZone = c.SecurityZone
+		ans.ClientlessDnsProxy = c.DnsProxy
+		ans.ClientlessLoginLifetimeType, ans.ClientlessLoginLifetimeValue = c.LoginLifetime.normalize()
+		ans.ClientlessInactivityLogoutType, ans.ClientlessInactivityLogoutValue = c.InactivityLogout.normalize()
+		ans.ClientlessMaxUsers = c.MaxUser
+
+		if c.Applications != nil {
+			list := make([]ClientlessApplication, 0, len(c.Applications.Entries))
+			for _, x := range c.Applications.Entries {
+				list = append(list, ClientlessApplication{
+					Name:                 x.Name,
+					SourceUsers:          util.MemToStr(x.SourceUsers),
+					Applications:         util.MemToStr(x.Applications),
+					DisplayUrlAddressBar: util.AsBool(x.DisplayUrlAddressBar),
+				})
+			}
+			ans.ClientlessApplications = list
+		}
+
+		if c.CryptoSettings != nil {
+			raw["cvcs"] = util.CleanRawXml(c.CryptoSettings.Text)
+		}
+		if c.ProxyServerSetting != nil {
+			raw["cvps"] = util.CleanRawXml(c.ProxyServerSetting.Text)
+		}
+		if c.RewriteExcludeDomainList != nil {
+			raw["cvre"] = util.CleanRawXml(c.RewriteExcludeDomainList.Text)
+		}
+	}
+
+	if o.Answer.Satellite != nil {
+		sat := o.Answer.Satellite
+		ans.SatelliteRootCas = util.MemToStr(sat.RootCa)
+
+		if sat.ClientCertificate != nil {
+			if sat.ClientCertificate.Local != nil {
+				ans.SatelliteIssuingCertificate = sat.ClientCertificate.Local.IssuingCertificate
+				ans.SatelliteOcspResponder = sat.ClientCertificate.Local.OcspResponder
+				ans.SatelliteValidityPeriod = sat.ClientCertificate.Local.ValidityPeriod
+				ans.SatelliteCertificateRenewalPeriod = sat.ClientCertificate.Local.CertificateRenewalPeriod
+			}
+			if sat.ClientCertificate.Scep != nil {
+				raw["satscep"] = util.CleanRawXml(sat.ClientCertificate.Scep.Text)
+			}
+		}
+
+		if sat.Configs != nil {
+			raw["satcfg"] = util.CleanRawXml(sat.Configs.Text)
+		}
+	}
+
+	if len(raw) != 0 {
+		ans.raw = raw
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName    xml.Name    `xml:"entry"`
+	Name       string      `xml:"name,attr"`
+	Portal     *portal     `xml:"portal-config"`
+	Client     *client     `xml:"client-config"`
+	Clientless *clientless `xml:"clientless-vpn"`
+	Satellite  *satellite  `xml:"satellite-config"`
+}
+
+type portal struct {
+	Local                *local      `xml:"local-address"`
+	SslTlsServiceProfile string      `xml:"ssl-tls-service-profile,omitempty"`
+	ClientAuth           *clientAuth `xml:"client-auth"`
+	CertificateProfile   string      `xml:"certificate-profile,omitempty"`
+	CustomLoginPage      string      `xml:"custom-login-page,omitempty"`
+	CustomHomePage       string      `xml:"custom-home-page,omitempty"`
+	CustomHelpPage       string      `xml:"custom-help-page,omitempty"`
+	LogSuccess           string      `xml:"log-success"`
+	LogFail              string      `xml:"log-fail"`
+	LogSetting           string      `xml:"log-setting,omitempty"`
+}
+
+type local struct {
+	Interface       string `xml:"interface,omitempty"`
+	IpAddressFamily string `xml:"ip-address-family,omitempty"`
+	Ip              *ip    `xml:"ip"`
+}
+
+type ip struct {
+	Ipv4 string `xml:"ipv4,omitempty"`
+	Ipv6 string `xml:"ipv6,omitempty"`
+}
+
+type clientAuth struct {
+	Entries []clientAuthEntry `xml:"entry"`
+}
+
+type clientAuthEntry struct {
+	Name                  string `xml:"name,attr"`
+	Os                    string `xml:"os,omitempty"`
+	AuthenticationProfile string `xml:"authentication-profile,omitempty"`
+	AuthenticationMessage string `xml:"authentication-message,omitempty"`
+	UsernameLabel         string `xml:"username-label,omitempty"`
+	PasswordLabel         string `xml:"password-label,omitempty"`
+}
+
+type client struct {
+	AgentUserOverrideKey string       `xml:"agent-user-override-key,omitempty"`
+	RootCa               *rootCa      `xml:"root-ca"`
+	Configs              *util.RawXml `xml:"configs"`
+}
+
+type rootCa struct {
+	Entries []rootCaEntry `xml:"entry"`
+}
+
+type rootCaEntry struct {
+	Name               string `xml:"name,attr"`
+	InstallInCertStore string `xml:"install-in-cert-store"`
+}
+
+type clientless struct {
+	Hostname                 string       `xml:"hostname,omitempty"`
+	SecurityZone             string       `xml:"security-zone,omitempty"`
+	DnsProxy                 string       `xml:"dns-proxy,omitempty"`
+	LoginLifetime            *timeout     `xml:"login-lifetime"`
+	InactivityLogout         *timeout     `xml:"inactivity-logout"`
+	MaxUser                  int          `xml:"max-user,omitempty"`
+	Applications             *cvApps      `xml:"applications"`
+	CryptoSettings           *util.RawXml `xml:"crypto-settings"`
+	ProxyServerSetting       *util.RawXml `xml:"proxy-server-setting"`
+	RewriteExcludeDomainList *util.RawXml `xml:"rewrite-exclude-domain-list"`
+}
+
+type timeout struct {
+	Minutes int `xml:"minutes,omitempty"`
+	Hours   int `xml:"hours,omitempty"`
+}
+
+func (o *timeout) normalize() (string, int) {
+	if o == nil {
+		return "", 0
+	} else if o.Minutes != 0 {
+		return TimeMinutes, o.Minutes
+	} else if o.Hours != 0 {
+		return TimeHours, o.Hours
+	}
+
+	return "", 0
+}
+
+func specifyTimeout(t string, v int) *timeout {
+	switch t {
+	case TimeMinutes:
+		return &timeout{Minutes: v}
+	case TimeHours:
+		return &timeout{Hours: v}
+	}
+
+	return nil
+}
+
+type cvApps struct {
+	Entries []cvAppEntry `xml:"entry"`
+}
+
+type cvAppEntry struct {
+	Name                 string           `xml:"name,attr"`
+	SourceUsers          *util.MemberType `xml:"source-user"`
+	Applications         *util.MemberType `xml:"applications"`
+	DisplayUrlAddressBar string           `xml:"display-application-url-address-bar"`
+}
+
+type satellite struct {
+	ClientCertificate *satelliteCert   `xml:"client-certificate"`
+	RootCa            *util.MemberType `xml:"root-ca"`
+	Configs           *util.RawXml     `xml:"configs"`
+}
+
+type satelliteCert struct {
+	Local *satelliteLocal `xml:"local"`
+	Scep  *util.RawXml    `xml:"scep"`
+}
+
+type satelliteLocal struct {
+	IssuingCertificate       string `xml:"issuing-certificate,omitempty"`
+	OcspResponder            string `xml:"ocsp-responder,omitempty"`
+	ValidityPeriod           int    `xml:"validity-period,omitempty"`
+	CertificateRenewalPeriod int    `xml:"certificate-renewal-period,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name: e.Name,
+		Portal: &portal{
+			SslTlsServiceProfile: e.SslTlsServiceProfile,
+			CertificateProfile:   e.CertificateProfile,
+			CustomLoginPage:      e.CustomLoginPage,
+			CustomHomePage:       e.CustomHomePage,
+			CustomHelpPage:       e.CustomHelpPage,
+			LogSuccess:           util.YesNo(e.LogSuccess),
+			LogFail:              util.YesNo(e.LogFail),
+			LogSetting:           e.LogSetting,
+		},
+	}
+
+	if e.Interface != "" || e.IpAddressFamily != "" || e.Ipv4Address != "" || e.Ipv6Address != "" {
+		ans.Portal.Local = &local{
+			Interface:       e.Interface,
+			IpAddressFamily: e.IpAddressFamily,
+		}
+		if e.Ipv4Address != "" || e.Ipv6Address != "" {
+			ans.Portal.Local.Ip = &ip{
+				Ipv4: e.Ipv4Address,
+				Ipv6: e.Ipv6Address,
+			}
+		}
+	}
+
+	if len(e.ClientAuths) > 0 {
+		list := make([]clientAuthEntry, 0, len(e.ClientAuths))
+		for _, x := range e.ClientAuths {
+			list = append(list, clientAuthEntry{
+				Name:                  x.Name,
+				Os:                    x.Os,
+				AuthenticationProfile: x.AuthenticationProfile,
+				AuthenticationMessage: x.AuthenticationMessage,
+				UsernameLabel:         x.UsernameLabel,
+				PasswordLabel:         x.PasswordLabel,
+			})
+		}
+		ans.Portal.ClientAuth = &clientAuth{Entries: list}
+	}
+
+	configs, hasConfigs := e.raw["configs"]
+	if e.AgentUserOverrideKey != "" || len(e.TrustedRootCas) > 0 || hasConfigs {
+		ans.Client = &client{
+			AgentUserOverrideKey: e.AgentUserOverrideKey,
+		}
+
+		if len(e.TrustedRootCas) > 0 {
+			list := make([]rootCaEntry, 0, len(e.TrustedRootCas))
+			for _, x := range e.TrustedRootCas {
+				list = append(list, rootCaEntry{
+					Name:               x.Name,
+					InstallInCertStore: util.YesNo(x.InstallInCertStore),
+				})
+			}
+			ans.Client.RootCa = &rootCa{Entries: list}
+		}
+
+		if hasConfigs {
+			ans.Client.Configs = &util.RawXml{configs}
+		}
+	}
+
+	c := clientless{
+		Hostname:         e.ClientlessHostname,
+		SecurityZone:     e.ClientlessSecurityZone,
+		DnsProxy:         e.ClientlessDnsProxy,
+		LoginLifetime:    specifyTimeout(e.ClientlessLoginLifetimeType, e.ClientlessLoginLifetimeValue),
+		InactivityLogout: specifyTimeout(e.ClientlessInactivityLogoutType, e.ClientlessInactivityLogoutValue),
+		MaxUser:          e.ClientlessMaxUsers,
+	}
+	hasClientless := e.ClientlessHostname != "" || e.ClientlessSecurityZone != "" || e.ClientlessDnsProxy != "" || c.LoginLifetime != nil || c.InactivityLogout != nil || c.MaxUser != 0
+	if len(e.ClientlessApplications) > 0 {
+		list := make([]cvAppEntry, 0, len(e.ClientlessApplications))
+		for _, x := range e.ClientlessApplications {
+			list = append(list, cvAppEntry{
+				Name:                 x.Name,
+				SourceUsers:          util.StrToMem(x.SourceUsers),
+				Applications:         util.StrToMem(x.Applications),
+				DisplayUrlAddressBar: util.YesNo(x.DisplayUrlAddressBar),
+			})
+		}
+		c.Applications = &cvApps{Entries: list}
+		hasClientless = true
+	}
+	if text, present := e.raw["cvcs"]; present {
+		c.CryptoSettings = &util.RawXml{text}
+		hasClientless = true
+	}
+	if text, present := e.raw["cvps"]; present {
+		c.ProxyServerSetting = &util.RawXml{text}
+		hasClientless = true
+	}
+	if text, present := e.raw["cvre"]; present {
+		c.RewriteExcludeDomainList = &util.RawXml{text}
+		hasClientless = true
+	}
+	if hasClientless {
+		ans.Clientless = &c
+	}
+
+	sat := satellite{
+		RootCa: util.StrToMem(e.SatelliteRootCas),
+	}
+	hasSatellite := len(e.SatelliteRootCas) > 0
+	if e.SatelliteIssuingCertificate != "" || e.SatelliteOcspResponder != "" || e.SatelliteValidityPeriod != 0 || e.SatelliteCertificateRenewalPeriod != 0 {
+		sat.ClientCertificate = &satelliteCert{
+			Local: &satelliteLocal{
+				IssuingCertificate:       e.SatelliteIssuingCertificate,
+				OcspResponder:            e.SatelliteOcspResponder,
+				ValidityPeriod:           e.SatelliteValidityPeriod,
+				CertificateRenewalPeriod: e.SatelliteCertificateRenewalPeriod,
+			},
+		}
+		hasSatellite = true
+	} else if text, present := e.raw["satscep"]; present {
+		sat.ClientCertificate = &satelliteCert{
+			Scep: &util.RawXml{text},
+		}
+		hasSatellite = true
+	}
+	if text, present := e.raw["satcfg"]; present {
+		sat.Configs = &util.RawXml{text}
+		hasSatellite = true
+	}
+	if hasSatellite {
+		ans.Satellite = &sat
+	}
+
+	return ans
+}
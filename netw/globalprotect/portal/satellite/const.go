@@ -0,0 +1,6 @@
+package satellite
+
+const (
+	singular = "globalprotect portal satellite config"
+	plural   = "globalprotect portal satellite configs"
+)
@@ -0,0 +1,9 @@
+/*
+Package satellite is the client.Network.GlobalProtectPortalSat namespace.
+
+Satellite configs are matched against the serial number or the enrollment
+user group of a satellite when it connects to the portal.
+
+Normalized object:  Entry
+*/
+package satellite
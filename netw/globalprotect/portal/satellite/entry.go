@@ -0,0 +1,177 @@
+package satellite
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a
+// GlobalProtect portal satellite config.
+type Entry struct {
+	Name                  string
+	Devices               []Device
+	EnrollmentUserGroups  []string // ordered
+	Gateways              []Gateway
+	ConfigRefreshInterval int
+}
+
+// Device is a satellite firewall, identified by its serial number.
+type Device struct {
+	Name     string
+	Hostname string
+}
+
+// Gateway is a gateway that satellites matching this config connect to.
+type Gateway struct {
+	Name           string
+	Fqdn           string
+	Ipv4Address    string
+	Ipv6Address    string
+	Priority       int
+	Ipv6Preference bool
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	if s.Devices == nil {
+		o.Devices = nil
+	} else {
+		o.Devices = make([]Device, len(s.Devices))
+		copy(o.Devices, s.Devices)
+	}
+	o.EnrollmentUserGroups = s.EnrollmentUserGroups
+	if s.Gateways == nil {
+		o.Gateways = nil
+	} else {
+		o.Gateways = make([]Gateway, len(s.Gateways))
+		copy(o.Gateways, s.Gateways)
+	}
+	o.ConfigRefreshInterval = s.ConfigRefreshInterval
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:                  o.Answer.Name,
+		EnrollmentUserGroups:  util.MemToStr(o.Answer.EnrollmentUserGroups),
+		ConfigRefreshInterval: o.Answer.ConfigRefreshInterval,
+	}
+
+	if o.Answer.Devices != nil {
+		list := make([]Device, 0, len(o.Answer.Devices.Entries))
+		for _, x := range o.Answer.Devices.Entries {
+			list = append(list, Device{
+				Name:     x.Name,
+				Hostname: x.Hostname,
+			})
+		}
+		ans.Devices = list
+	}
+
+	if o.Answer.Gateways != nil {
+		list := make([]Gateway, 0, len(o.Answer.Gateways.Entries))
+		for _, x := range o.Answer.Gateways.Entries {
+			gw := Gateway{
+				Name:           x.Name,
+				Fqdn:           x.Fqdn,
+				Priority:       x.Priority,
+				Ipv6Preference: util.AsBool(x.Ipv6Preference),
+			}
+			if x.Ip != nil {
+				gw.Ipv4Address = x.Ip.Ipv4
+				gw.Ipv6Address = x.Ip.Ipv6
+			}
+			list = append(list, gw)
+		}
+		ans.Gateways = list
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName               xml.Name         `xml:"entry"`
+	Name                  string           `xml:"name,attr"`
+	Devices               *devices         `xml:"devices"`
+	EnrollmentUserGroups  *util.MemberType `xml:"enrollment-user-group"`
+	Gateways              *gateways        `xml:"gateways"`
+	ConfigRefreshInterval int              `xml:"config-refresh-interval,omitempty"`
+}
+
+type devices struct {
+	Entries []deviceEntry `xml:"entry"`
+}
+
+type deviceEntry struct {
+	Name     string `xml:"name,attr"`
+	Hostname string `xml:"hostname,omitempty"`
+}
+
+type gateways struct {
+	Entries []gatewayEntry `xml:"entry"`
+}
+
+type gatewayEntry struct {
+	Name           string `xml:"name,attr"`
+	Fqdn           string `xml:"fqdn,omitempty"`
+	Ip             *ip    `xml:"ip"`
+	Priority       int    `xml:"priority,omitempty"`
+	Ipv6Preference string `xml:"ipv6-preference"`
+}
+
+type ip struct {
+	Ipv4 string `xml:"ipv4,omitempty"`
+	Ipv6 string `xml:"ipv6,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:                  e.Name,
+		EnrollmentUserGroups:  util.StrToMem(e.EnrollmentUserGroups),
+		ConfigRefreshInterval: e.ConfigRefreshInterval,
+	}
+
+	if len(e.Devices) > 0 {
+		list := make([]deviceEntry, 0, len(e.Devices))
+		for _, x := range e.Devices {
+			list = append(list, deviceEntry{
+				Name:     x.Name,
+				Hostname: x.Hostname,
+			})
+		}
+		ans.Devices = &devices{Entries: list}
+	}
+
+	if len(e.Gateways) > 0 {
+		list := make([]gatewayEntry, 0, len(e.Gateways))
+		for _, x := range e.Gateways {
+			gw := gatewayEntry{
+				Name:           x.Name,
+				Fqdn:           x.Fqdn,
+				Priority:       x.Priority,
+				Ipv6Preference: util.YesNo(x.Ipv6Preference),
+			}
+			if x.Ipv4Address != "" || x.Ipv6Address != "" {
+				gw.Ip = &ip{
+					Ipv4: x.Ipv4Address,
+					Ipv6: x.Ipv6Address,
+				}
+			}
+			list = append(list, gw)
+		}
+		ans.Gateways = &gateways{Entries: list}
+	}
+
+	return ans
+}
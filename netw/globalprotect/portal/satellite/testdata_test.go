@@ -0,0 +1,27 @@
+package satellite
+
+func getTests() []testCase {
+	return []testCase{
+		{"v1 devices", Entry{
+			Name: "cfg1",
+			Devices: []Device{
+				{Name: "001122334455", Hostname: "branch1"},
+				{Name: "001122334466"},
+			},
+			ConfigRefreshInterval: 24,
+		}},
+		{"v1 user groups and gateways", Entry{
+			Name:                 "cfg2",
+			EnrollmentUserGroups: []string{"group1", "group2"},
+			Gateways: []Gateway{
+				{Name: "gw1", Fqdn: "gw1.example.com", Priority: 1},
+				{Name: "gw2", Ipv4Address: "10.1.1.1", Ipv6Address: "2001:db8::1", Priority: 2, Ipv6Preference: true},
+			},
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
@@ -0,0 +1,117 @@
+package portal
+
+func getTests() []testCase {
+	return []testCase{
+		{"v1 basic", Entry{
+			Name:                 "portal1",
+			Interface:            "ethernet1/1",
+			IpAddressFamily:      IpAddressFamilyIpv4,
+			Ipv4Address:          "10.1.1.1/24",
+			SslTlsServiceProfile: "ssl profile",
+			LogFail:              true,
+		}},
+		{"v1 authentication", Entry{
+			Name:               "portal2",
+			Interface:          "ethernet1/2",
+			IpAddressFamily:    IpAddressFamilyIpv4Ipv6,
+			Ipv4Address:        "10.1.2.1/24",
+			Ipv6Address:        "2001:db8::1/64",
+			CertificateProfile: "cert profile",
+			ClientAuths: []ClientAuth{
+				{
+					Name:                  "windows",
+					Os:                    OsWindows,
+					AuthenticationProfile: "ldap",
+					AuthenticationMessage: "Enter login credentials",
+					UsernameLabel:         "Username",
+					PasswordLabel:         "Password",
+				},
+				{
+					Name:                  "any",
+					Os:                    OsAny,
+					AuthenticationProfile: "saml",
+				},
+			},
+			CustomLoginPage: "factory-default",
+			CustomHomePage:  "factory-default",
+			CustomHelpPage:  "help",
+			LogSuccess:      true,
+			LogFail:         true,
+			LogSetting:      "log fwd",
+		}},
+		{"v1 client config", Entry{
+			Name:                 "portal3",
+			Interface:            "ethernet1/3",
+			AgentUserOverrideKey: "override",
+			TrustedRootCas: []TrustedRootCa{
+				{Name: "root1", InstallInCertStore: true},
+				{Name: "root2"},
+			},
+		}},
+		{"v1 clientless references", Entry{
+			Name:                   "portal4",
+			Interface:              "ethernet1/4",
+			ClientlessHostname:     "vpn.example.com",
+			ClientlessSecurityZone: "untrust",
+			ClientlessDnsProxy:     "dns proxy",
+		}},
+		{"v1 with raw", Entry{
+			Name:               "portal5",
+			Interface:          "ethernet1/5",
+			ClientlessHostname: "vpn.example.com",
+			raw: map[string]string{
+				"configs": "<entry name=\"cfg\"><refresh-config>yes</refresh-config></entry>",
+				"cvcs":    "<ssl-protocol><min-version>tls1-2</min-version></ssl-protocol>",
+				"cvps":    "<entry name=\"proxy\"><use-proxy>no</use-proxy></entry>",
+				"cvre":    "<member>example.com</member>",
+				"satcfg":  "<entry name=\"sat\"><config-refresh-interval>24</config-refresh-interval></entry>",
+			},
+		}},
+		{"v1 clientless settings", Entry{
+			Name:                            "portal8",
+			Interface:                       "ethernet1/8",
+			ClientlessHostname:              "vpn.example.com",
+			ClientlessSecurityZone:          "untrust",
+			ClientlessLoginLifetimeType:     TimeHours,
+			ClientlessLoginLifetimeValue:    3,
+			ClientlessInactivityLogoutType:  TimeMinutes,
+			ClientlessInactivityLogoutValue: 30,
+			ClientlessMaxUsers:              10,
+			ClientlessApplications: []ClientlessApplication{
+				{
+					Name:                 "employees",
+					SourceUsers:          []string{"example\\employees"},
+					Applications:         []string{"app1", "group1"},
+					DisplayUrlAddressBar: true,
+				},
+				{
+					Name:         "everyone",
+					SourceUsers:  []string{"any"},
+					Applications: []string{"app2"},
+				},
+			},
+		}},
+		{"v1 satellite settings", Entry{
+			Name:                              "portal6",
+			Interface:                         "ethernet1/6",
+			SatelliteRootCas:                  []string{"root1", "root2"},
+			SatelliteIssuingCertificate:       "issuer",
+			SatelliteOcspResponder:            "ocsp",
+			SatelliteValidityPeriod:           7,
+			SatelliteCertificateRenewalPeriod: 3,
+		}},
+		{"v1 satellite scep", Entry{
+			Name:             "portal7",
+			Interface:        "ethernet1/7",
+			SatelliteRootCas: []string{"root1"},
+			raw: map[string]string{
+				"satscep": "<entry name=\"scep\"/>",
+			},
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
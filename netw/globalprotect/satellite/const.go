@@ -0,0 +1,6 @@
+package satellite
+
+const (
+	singular = "globalprotect satellite"
+	plural   = "globalprotect satellites"
+)
@@ -0,0 +1,10 @@
+/*
+Package satellite is the client.Network.GlobalProtectSatellite namespace.
+
+This is the LSVPN satellite configuration of a remote firewall, which
+connects to a GlobalProtect portal to retrieve its gateway list and tunnel
+settings.
+
+Normalized object:  Entry
+*/
+package satellite
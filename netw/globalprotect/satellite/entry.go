@@ -0,0 +1,198 @@
+package satellite
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a
+// GlobalProtect LSVPN satellite.
+type Entry struct {
+	Name                   string
+	PortalAddress          string
+	Ipv6Preference         bool
+	Interface              string
+	Ipv4Address            string
+	Ipv6Address            string
+	TunnelInterface        string // XML: tunnel
+	PublishConnectedRoutes bool
+	PublishRoutes          []string // ordered
+	LocalCertificate       string   // XML: external-ca/local-certificate
+	CertificateProfile     string   // XML: external-ca/certificate-profile
+	ExternalGateways       []ExternalGateway
+}
+
+// ExternalGateway is a gateway the satellite connects to.
+type ExternalGateway struct {
+	Name           string
+	Gateway        string
+	Priority       int
+	Ipv6Preference bool
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.PortalAddress = s.PortalAddress
+	o.Ipv6Preference = s.Ipv6Preference
+	o.Interface = s.Interface
+	o.Ipv4Address = s.Ipv4Address
+	o.Ipv6Address = s.Ipv6Address
+	o.TunnelInterface = s.TunnelInterface
+	o.PublishConnectedRoutes = s.PublishConnectedRoutes
+	o.PublishRoutes = s.PublishRoutes
+	o.LocalCertificate = s.LocalCertificate
+	o.CertificateProfile = s.CertificateProfile
+	if s.ExternalGateways == nil {
+		o.ExternalGateways = nil
+	} else {
+		o.ExternalGateways = make([]ExternalGateway, len(s.ExternalGateways))
+		copy(o.ExternalGateways, s.ExternalGateways)
+	}
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:            o.Answer.Name,
+		PortalAddress:   o.Answer.PortalAddress,
+		Ipv6Preference:  util.AsBool(o.Answer.Ipv6Preference),
+		TunnelInterface: o.Answer.TunnelInterface,
+		PublishRoutes:   util.MemToStr(o.Answer.PublishRoutes),
+	}
+
+	if o.Answer.Local != nil {
+		ans.Interface = o.Answer.Local.Interface
+		if o.Answer.Local.Ip != nil {
+			ans.Ipv4Address = o.Answer.Local.Ip.Ipv4
+			ans.Ipv6Address = o.Answer.Local.Ip.Ipv6
+		}
+	}
+
+	if o.Answer.PublishConnectedRoutes != nil {
+		ans.PublishConnectedRoutes = util.AsBool(o.Answer.PublishConnectedRoutes.Enable)
+	}
+
+	if o.Answer.ExternalCa != nil {
+		ans.LocalCertificate = o.Answer.ExternalCa.LocalCertificate
+		ans.CertificateProfile = o.Answer.ExternalCa.CertificateProfile
+	}
+
+	if o.Answer.ExternalGateway != nil {
+		list := make([]ExternalGateway, 0, len(o.Answer.ExternalGateway.Entries))
+		for _, x := range o.Answer.ExternalGateway.Entries {
+			list = append(list, ExternalGateway{
+				Name:           x.Name,
+				Gateway:        x.Gateway,
+				Priority:       x.Priority,
+				Ipv6Preference: util.AsBool(x.Ipv6Preference),
+			})
+		}
+		ans.ExternalGateways = list
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName                xml.Name         `xml:"entry"`
+	Name                   string           `xml:"name,attr"`
+	PortalAddress          string           `xml:"portal-address,omitempty"`
+	Ipv6Preference         string           `xml:"ipv6-preference"`
+	Local                  *local           `xml:"local-address"`
+	TunnelInterface        string           `xml:"tunnel,omitempty"`
+	PublishConnectedRoutes *publishRoutes   `xml:"publish-connected-routes"`
+	PublishRoutes          *util.MemberType `xml:"publish-routes"`
+	ExternalCa             *externalCa      `xml:"external-ca"`
+	ExternalGateway        *externalGateway `xml:"external-gateway"`
+}
+
+type local struct {
+	Interface string `xml:"interface,omitempty"`
+	Ip        *ip    `xml:"ip"`
+}
+
+type ip struct {
+	Ipv4 string `xml:"ipv4,omitempty"`
+	Ipv6 string `xml:"ipv6,omitempty"`
+}
+
+type publishRoutes struct {
+	Enable string `xml:"enable"`
+}
+
+type externalCa struct {
+	LocalCertificate   string `xml:"local-certificate,omitempty"`
+	CertificateProfile string `xml:"certificate-profile,omitempty"`
+}
+
+type externalGateway struct {
+	Entries []gatewayEntry `xml:"entry"`
+}
+
+type gatewayEntry struct {
+	Name           string `xml:"name,attr"`
+	Gateway        string `xml:"gateway,omitempty"`
+	Priority       int    `xml:"priority,omitempty"`
+	Ipv6Preference string `xml:"ipv6-preference"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:            e.Name,
+		PortalAddress:   e.PortalAddress,
+		Ipv6Preference:  util.YesNo(e.Ipv6Preference),
+		TunnelInterface: e.TunnelInterface,
+		PublishRoutes:   util.StrToMem(e.PublishRoutes),
+	}
+
+	if e.Interface != "" || e.Ipv4Address != "" || e.Ipv6Address != "" {
+		ans.Local = &local{
+			Interface: e.Interface,
+		}
+		if e.Ipv4Address != "" || e.Ipv6Address != "" {
+			ans.Local.Ip = &ip{
+				Ipv4: e.Ipv4Address,
+				Ipv6: e.Ipv6Address,
+			}
+		}
+	}
+
+	if e.PublishConnectedRoutes {
+		ans.PublishConnectedRoutes = &publishRoutes{
+			Enable: util.YesNo(e.PublishConnectedRoutes),
+		}
+	}
+
+	if e.LocalCertificate != "" || e.CertificateProfile != "" {
+		ans.ExternalCa = &externalCa{
+			LocalCertificate:   e.LocalCertificate,
+			CertificateProfile: e.CertificateProfile,
+		}
+	}
+
+	if len(e.ExternalGateways) > 0 {
+		list := make([]gatewayEntry, 0, len(e.ExternalGateways))
+		for _, x := range e.ExternalGateways {
+			list = append(list, gatewayEntry{
+				Name:           x.Name,
+				Gateway:        x.Gateway,
+				Priority:       x.Priority,
+				Ipv6Preference: util.YesNo(x.Ipv6Preference),
+			})
+		}
+		ans.ExternalGateway = &externalGateway{Entries: list}
+	}
+
+	return ans
+}
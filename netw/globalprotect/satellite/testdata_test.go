@@ -0,0 +1,39 @@
+package satellite
+
+func getTests() []testCase {
+	return []testCase{
+		{"v1 basic", Entry{
+			Name:            "sat1",
+			PortalAddress:   "portal.example.com",
+			Interface:       "ethernet1/1",
+			Ipv4Address:     "10.1.1.1/24",
+			TunnelInterface: "tunnel.10",
+		}},
+		{"v1 routes and certs", Entry{
+			Name:                   "sat2",
+			PortalAddress:          "2001:db8::10",
+			Ipv6Preference:         true,
+			Interface:              "ethernet1/2",
+			Ipv6Address:            "2001:db8::1/64",
+			TunnelInterface:        "tunnel.11",
+			PublishConnectedRoutes: true,
+			PublishRoutes:          []string{"10.5.0.0/16", "10.6.0.0/16"},
+			LocalCertificate:       "satcert",
+			CertificateProfile:     "satprof",
+		}},
+		{"v1 external gateways", Entry{
+			Name:            "sat3",
+			PortalAddress:   "portal.example.com",
+			TunnelInterface: "tunnel.12",
+			ExternalGateways: []ExternalGateway{
+				{Name: "gw1", Gateway: "gw1.example.com", Priority: 1},
+				{Name: "gw2", Gateway: "gw2.example.com", Priority: 5, Ipv6Preference: true},
+			},
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
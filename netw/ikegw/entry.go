@@ -31,6 +31,12 @@ const (
 	LocalTypeFloatingIp = "floating-ip"
 )
 
+const (
+	ExchangeModeAuto       = "auto"
+	ExchangeModeMain       = "main"
+	ExchangeModeAggressive = "aggressive"
+)
+
 const (
 	AuthPreSharedKey = "pre-shared-key"
 	AuthCertificate  = "certificate"
@@ -246,6 +252,7 @@ func (o *container_v2) Normalize() Entry {
 		ans.CertProfile = o.Answer.CAuth.CertProfile
 		ans.CertEnableStrictValidation = util.AsBool(o.Answer.CAuth.CertEnableStrictValidation)
 		ans.CertPermitPayloadMismatch = util.AsBool(o.Answer.CAuth.CertPermitPayloadMismatch)
+		ans.CertUseManagementAsSource = util.AsBool(o.Answer.CAuth.CertUseManagementAsSource)
 
 		if o.Answer.CAuth.CLocal.Hau != nil {
 			ans.CertEnableHashAndUrl = util.AsBool(o.Answer.CAuth.CLocal.Hau.CertEnableHashAndUrl)
@@ -342,6 +349,7 @@ func (o *container_v3) Normalize() Entry {
 		ans.CertProfile = o.Answer.CAuth.CertProfile
 		ans.CertEnableStrictValidation = util.AsBool(o.Answer.CAuth.CertEnableStrictValidation)
 		ans.CertPermitPayloadMismatch = util.AsBool(o.Answer.CAuth.CertPermitPayloadMismatch)
+		ans.CertUseManagementAsSource = util.AsBool(o.Answer.CAuth.CertUseManagementAsSource)
 
 		if o.Answer.CAuth.CLocal.Hau != nil {
 			ans.CertEnableHashAndUrl = util.AsBool(o.Answer.CAuth.CLocal.Hau.CertEnableHashAndUrl)
@@ -441,6 +449,7 @@ func (o *container_v4) Normalize() Entry {
 		ans.CertProfile = o.Answer.CAuth.CertProfile
 		ans.CertEnableStrictValidation = util.AsBool(o.Answer.CAuth.CertEnableStrictValidation)
 		ans.CertPermitPayloadMismatch = util.AsBool(o.Answer.CAuth.CertPermitPayloadMismatch)
+		ans.CertUseManagementAsSource = util.AsBool(o.Answer.CAuth.CertUseManagementAsSource)
 
 		if o.Answer.CAuth.CLocal.Hau != nil {
 			ans.CertEnableHashAndUrl = util.AsBool(o.Answer.CAuth.CLocal.Hau.CertEnableHashAndUrl)
@@ -641,6 +641,53 @@ func TestFwNormalization(t *testing.T) {
 			LivenessCheckInterval:     11,
 			EnableFragmentation:       true,
 		}},
+		{"test32", version.Number{7, 1, 0, ""}, Entry{
+			Name:                       "test32",
+			Version:                    Ikev2,
+			PeerIpType:                 PeerTypeDynamic,
+			Interface:                  "ethernet1/1",
+			LocalIpAddressType:         LocalTypeIp,
+			LocalIpAddressValue:        "10.2.1.1",
+			AuthType:                   AuthCertificate,
+			LocalCert:                  "local cert",
+			CertUseManagementAsSource:  true,
+			CertProfile:                "cert profile",
+			CertEnableStrictValidation: true,
+			PeerIdType:                 IdTypeDn,
+			PeerIdValue:                "CN=peer",
+			PeerIdCheck:                PeerIdCheckExact,
+			Ikev2CryptoProfile:         "v2 crypto profile",
+		}},
+		{"test33", version.Number{8, 1, 0, ""}, Entry{
+			Name:                          "test33",
+			Version:                       Ikev2Preferred,
+			PeerIpType:                    PeerTypeFqdn,
+			PeerIpValue:                   "peer.example.com",
+			Interface:                     "ethernet1/1",
+			LocalIpAddressType:            LocalTypeFloatingIp,
+			LocalIpAddressValue:           "10.2.1.1",
+			AuthType:                      AuthCertificate,
+			LocalCert:                     "local cert",
+			CertEnableHashAndUrl:          true,
+			CertBaseUrl:                   "http://certs.example.com",
+			CertUseManagementAsSource:     true,
+			CertPermitPayloadMismatch:     true,
+			CertProfile:                   "cert profile",
+			LocalIdType:                   IdTypeFqdn,
+			LocalIdValue:                  "local.example.com",
+			PeerIdType:                    IdTypeUfqdn,
+			PeerIdValue:                   "peer@example.com",
+			PeerIdCheck:                   PeerIdCheckWildcard,
+			EnableNatTraversal:            true,
+			NatTraversalKeepAlive:         20,
+			NatTraversalEnableUdpChecksum: true,
+			EnableFragmentation:           true,
+			Ikev1ExchangeMode:             ExchangeModeMain,
+			Ikev1CryptoProfile:            "v1 crypto profile",
+			Ikev2CryptoProfile:            "v2 crypto profile",
+			EnableLivenessCheck:           true,
+			LivenessCheckInterval:         5,
+		}},
 	}
 
 	mc := &testdata.MockClient{}
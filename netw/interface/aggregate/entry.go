@@ -132,6 +132,12 @@ func (o *entry_v1) normalize() Entry {
 			if o.L3.Ipv6.Neighbor != nil {
 				ans.raw["v6nd"] = util.CleanRawXml(o.L3.Ipv6.Neighbor.Text)
 			}
+			if o.L3.Ipv6.DhcpClient != nil {
+				ans.raw["v6dhcp"] = util.CleanRawXml(o.L3.Ipv6.DhcpClient.Text)
+			}
+			if o.L3.Ipv6.Inherited != nil {
+				ans.raw["v6inh"] = util.CleanRawXml(o.L3.Ipv6.Inherited.Text)
+			}
 		}
 
 		if o.L3.Dhcp != nil {
@@ -229,6 +235,12 @@ func (o *entry_v2) normalize() Entry {
 			if o.L3.Ipv6.Neighbor != nil {
 				ans.raw["v6nd"] = util.CleanRawXml(o.L3.Ipv6.Neighbor.Text)
 			}
+			if o.L3.Ipv6.DhcpClient != nil {
+				ans.raw["v6dhcp"] = util.CleanRawXml(o.L3.Ipv6.DhcpClient.Text)
+			}
+			if o.L3.Ipv6.Inherited != nil {
+				ans.raw["v6inh"] = util.CleanRawXml(o.L3.Ipv6.Inherited.Text)
+			}
 		}
 
 		if o.L3.Dhcp != nil {
@@ -326,6 +338,12 @@ func (o *entry_v3) normalize() Entry {
 			if o.L3.Ipv6.Neighbor != nil {
 				ans.raw["v6nd"] = util.CleanRawXml(o.L3.Ipv6.Neighbor.Text)
 			}
+			if o.L3.Ipv6.DhcpClient != nil {
+				ans.raw["v6dhcp"] = util.CleanRawXml(o.L3.Ipv6.DhcpClient.Text)
+			}
+			if o.L3.Ipv6.Inherited != nil {
+				ans.raw["v6inh"] = util.CleanRawXml(o.L3.Ipv6.Inherited.Text)
+			}
 		}
 
 		if o.L3.Dhcp != nil {
@@ -398,6 +416,8 @@ type ipv6 struct {
 	Ipv6InterfaceId string       `xml:"interface-id,omitempty"`
 	Address         *util.RawXml `xml:"address"`
 	Neighbor        *util.RawXml `xml:"neighbor-discovery"`
+	DhcpClient      *util.RawXml `xml:"dhcp-client"`
+	Inherited       *util.RawXml `xml:"inherited"`
 }
 
 type dhcpSettings_v1 struct {
@@ -454,7 +474,9 @@ func specify_v1(e Entry) interface{} {
 
 		v6addr := e.raw["v6addr"]
 		v6nd := e.raw["v6nd"]
-		if e.Ipv6Enabled || e.Ipv6InterfaceId != "" || v6addr != "" || v6nd != "" {
+		v6dhcp := e.raw["v6dhcp"]
+		v6inh := e.raw["v6inh"]
+		if e.Ipv6Enabled || e.Ipv6InterfaceId != "" || v6addr != "" || v6nd != "" || v6dhcp != "" || v6inh != "" {
 			ans.L3.Ipv6 = &ipv6{
 				Ipv6Enabled:     util.YesNo(e.Ipv6Enabled),
 				Ipv6InterfaceId: e.Ipv6InterfaceId,
@@ -466,6 +488,12 @@ func specify_v1(e Entry) interface{} {
 			if v6nd != "" {
 				ans.L3.Ipv6.Neighbor = &util.RawXml{v6nd}
 			}
+			if v6dhcp != "" {
+				ans.L3.Ipv6.DhcpClient = &util.RawXml{v6dhcp}
+			}
+			if v6inh != "" {
+				ans.L3.Ipv6.Inherited = &util.RawXml{v6inh}
+			}
 		}
 
 		if e.EnableDhcp || e.CreateDhcpDefaultRoute || e.DhcpDefaultRouteMetric != 0 {
@@ -568,7 +596,9 @@ func specify_v2(e Entry) interface{} {
 
 		v6addr := e.raw["v6addr"]
 		v6nd := e.raw["v6nd"]
-		if e.Ipv6Enabled || e.Ipv6InterfaceId != "" || v6addr != "" || v6nd != "" {
+		v6dhcp := e.raw["v6dhcp"]
+		v6inh := e.raw["v6inh"]
+		if e.Ipv6Enabled || e.Ipv6InterfaceId != "" || v6addr != "" || v6nd != "" || v6dhcp != "" || v6inh != "" {
 			ans.L3.Ipv6 = &ipv6{
 				Ipv6Enabled:     util.YesNo(e.Ipv6Enabled),
 				Ipv6InterfaceId: e.Ipv6InterfaceId,
@@ -580,6 +610,12 @@ func specify_v2(e Entry) interface{} {
 			if v6nd != "" {
 				ans.L3.Ipv6.Neighbor = &util.RawXml{v6nd}
 			}
+			if v6dhcp != "" {
+				ans.L3.Ipv6.DhcpClient = &util.RawXml{v6dhcp}
+			}
+			if v6inh != "" {
+				ans.L3.Ipv6.Inherited = &util.RawXml{v6inh}
+			}
 		}
 
 		if e.EnableDhcp || e.CreateDhcpDefaultRoute || e.DhcpDefaultRouteMetric != 0 {
@@ -694,7 +730,9 @@ func specify_v3(e Entry) interface{} {
 
 		v6addr := e.raw["v6addr"]
 		v6nd := e.raw["v6nd"]
-		if e.Ipv6Enabled || e.Ipv6InterfaceId != "" || v6addr != "" || v6nd != "" {
+		v6dhcp := e.raw["v6dhcp"]
+		v6inh := e.raw["v6inh"]
+		if e.Ipv6Enabled || e.Ipv6InterfaceId != "" || v6addr != "" || v6nd != "" || v6dhcp != "" || v6inh != "" {
 			ans.L3.Ipv6 = &ipv6{
 				Ipv6Enabled:     util.YesNo(e.Ipv6Enabled),
 				Ipv6InterfaceId: e.Ipv6InterfaceId,
@@ -706,6 +744,12 @@ func specify_v3(e Entry) interface{} {
 			if v6nd != "" {
 				ans.L3.Ipv6.Neighbor = &util.RawXml{v6nd}
 			}
+			if v6dhcp != "" {
+				ans.L3.Ipv6.DhcpClient = &util.RawXml{v6dhcp}
+			}
+			if v6inh != "" {
+				ans.L3.Ipv6.Inherited = &util.RawXml{v6inh}
+			}
 		}
 
 		if e.EnableDhcp || e.CreateDhcpDefaultRoute || e.DhcpDefaultRouteMetric != 0 || e.DhcpSendHostnameEnable || e.DhcpSendHostnameValue != "" {
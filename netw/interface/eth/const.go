@@ -1,5 +1,12 @@
 package eth
 
+// Valid values for PppoeAuthentication.
+const (
+	PppoeAuthenticationChap = "CHAP"
+	PppoeAuthenticationPap  = "PAP"
+	PppoeAuthenticationAuto = "auto"
+)
+
 const (
 	singular = "ethernet interface"
 	plural   = "ethernet interfaces"
@@ -8,6 +8,18 @@ import (
 
 // Entry is a normalized, version independent representation of an ethernet
 // interface.
+//
+// The LogCard fields are only used for the "log-card" mode, which is the
+// dedicated log interface of platforms with a log forwarding card.  Like HA
+// interfaces, log card interfaces are not imported into a vsys.  On these
+// platforms, logs are forwarded (syslog, SNMP traps, email, and so on) out of
+// the log card interface, so service routes for those services do not apply.
+//
+// The Pppoe fields configure a layer3 interface as a PPPoE client, with
+// PppoeStaticAddress being the optional static IP address to request.
+//
+// The Sdwan fields enable SD-WAN on a layer3 interface, with
+// SdwanInterfaceProfile being the name of an SD-WAN interface profile.
 type Entry struct {
 	Name                       string
 	Mode                       string
@@ -31,11 +43,27 @@ type Entry struct {
 	Ipv4MssAdjust              int    // 7.1+
 	Ipv6MssAdjust              int    // 7.1+
 	EnableUntaggedSubinterface bool   // 7.1+
+	PppoeEnable                bool   // 7.1+
+	PppoeUsername              string // 7.1+
+	PppoePassword              string // 7.1+, encrypted
+	PppoeAuthentication        string // 7.1+
+	PppoeStaticAddress         string // 7.1+
+	PppoeDefaultRouteMetric    int    // 7.1+
+	PppoeAccessConcentrator    string // 7.1+
+	PppoeService               string // 7.1+
+	PppoePassive               bool   // 7.1+
 	DecryptForward             bool   // 8.1+
 	RxPolicingRate             int    // 8.1+
 	TxPolicingRate             int    // 8.1+
 	DhcpSendHostnameEnable     bool   // 9.0+
 	DhcpSendHostnameValue      string // 9.0+
+	SdwanEnable                bool   // 9.1+
+	SdwanInterfaceProfile      string // 9.1+
+	LogCardIpAddress           string
+	LogCardNetmask             string
+	LogCardDefaultGateway      string
+	LogCardIpv6Address         string
+	LogCardIpv6DefaultGateway  string
 
 	raw map[string]string
 }
@@ -63,11 +91,27 @@ func (o *Entry) Copy(s Entry) {
 	o.Ipv4MssAdjust = s.Ipv4MssAdjust
 	o.Ipv6MssAdjust = s.Ipv6MssAdjust
 	o.EnableUntaggedSubinterface = s.EnableUntaggedSubinterface
+	o.PppoeEnable = s.PppoeEnable
+	o.PppoeUsername = s.PppoeUsername
+	o.PppoePassword = s.PppoePassword
+	o.PppoeAuthentication = s.PppoeAuthentication
+	o.PppoeStaticAddress = s.PppoeStaticAddress
+	o.PppoeDefaultRouteMetric = s.PppoeDefaultRouteMetric
+	o.PppoeAccessConcentrator = s.PppoeAccessConcentrator
+	o.PppoeService = s.PppoeService
+	o.PppoePassive = s.PppoePassive
 	o.DecryptForward = s.DecryptForward
 	o.RxPolicingRate = s.RxPolicingRate
 	o.TxPolicingRate = s.TxPolicingRate
 	o.DhcpSendHostnameEnable = s.DhcpSendHostnameEnable
 	o.DhcpSendHostnameValue = s.DhcpSendHostnameValue
+	o.SdwanEnable = s.SdwanEnable
+	o.SdwanInterfaceProfile = s.SdwanInterfaceProfile
+	o.LogCardIpAddress = s.LogCardIpAddress
+	o.LogCardNetmask = s.LogCardNetmask
+	o.LogCardDefaultGateway = s.LogCardDefaultGateway
+	o.LogCardIpv6Address = s.LogCardIpv6Address
+	o.LogCardIpv6DefaultGateway = s.LogCardIpv6DefaultGateway
 }
 
 /** Structs / functions for this namespace. **/
@@ -131,6 +175,12 @@ func (o *entry_v1) normalize() Entry {
 			if o.ModeL3.Ipv6.Neighbor != nil {
 				ans.raw["v6nd"] = util.CleanRawXml(o.ModeL3.Ipv6.Neighbor.Text)
 			}
+			if o.ModeL3.Ipv6.DhcpClient != nil {
+				ans.raw["v6dhcp"] = util.CleanRawXml(o.ModeL3.Ipv6.DhcpClient.Text)
+			}
+			if o.ModeL3.Ipv6.Inherited != nil {
+				ans.raw["v6inh"] = util.CleanRawXml(o.ModeL3.Ipv6.Inherited.Text)
+			}
 		}
 
 		if o.ModeL3.Arp != nil {
@@ -165,6 +215,13 @@ func (o *entry_v1) normalize() Entry {
 		ans.Mode = "ha"
 	case o.DecryptMirrorMode != nil:
 		ans.Mode = "decrypt-mirror"
+	case o.LogCardMode != nil:
+		ans.Mode = "log-card"
+		ans.LogCardIpAddress = o.LogCardMode.IpAddress
+		ans.LogCardNetmask = o.LogCardMode.Netmask
+		ans.LogCardDefaultGateway = o.LogCardMode.DefaultGateway
+		ans.LogCardIpv6Address = o.LogCardMode.Ipv6Address
+		ans.LogCardIpv6DefaultGateway = o.LogCardMode.Ipv6DefaultGateway
 	case o.AggregateGroup != "":
 		ans.Mode = "aggregate-group"
 		ans.AggregateGroup = o.AggregateGroup
@@ -186,6 +243,7 @@ type entry_v1 struct {
 	TapMode           *emptyMode `xml:"tap"`
 	HaMode            *emptyMode `xml:"ha"`
 	DecryptMirrorMode *emptyMode `xml:"decrypt-mirror"`
+	LogCardMode       *logCard   `xml:"log-card"`
 	AggregateGroup    string     `xml:"aggregate-group,omitempty"`
 	LinkSpeed         string     `xml:"link-speed,omitempty"`
 	LinkDuplex        string     `xml:"link-duplex,omitempty"`
@@ -195,6 +253,14 @@ type entry_v1 struct {
 
 type emptyMode struct{}
 
+type logCard struct {
+	IpAddress          string `xml:"ip-address,omitempty"`
+	Netmask            string `xml:"netmask,omitempty"`
+	DefaultGateway     string `xml:"default-gateway,omitempty"`
+	Ipv6Address        string `xml:"ipv6-address,omitempty"`
+	Ipv6DefaultGateway string `xml:"ipv6-default-gateway,omitempty"`
+}
+
 type otherMode struct {
 	NetflowProfile string       `xml:"netflow-profile,omitempty"`
 	Lldp           *omLldp      `xml:"lldp"`
@@ -223,6 +289,8 @@ type ipv6 struct {
 	Ipv6InterfaceId string       `xml:"interface-id,omitempty"`
 	Address         *util.RawXml `xml:"address"`
 	Neighbor        *util.RawXml `xml:"neighbor-discovery"`
+	DhcpClient      *util.RawXml `xml:"dhcp-client"`
+	Inherited       *util.RawXml `xml:"inherited"`
 }
 
 type dhcpSettings_v1 struct {
@@ -289,6 +357,12 @@ func (o *entry_v2) normalize() Entry {
 			if o.ModeL3.Ipv6.Neighbor != nil {
 				ans.raw["v6nd"] = util.CleanRawXml(o.ModeL3.Ipv6.Neighbor.Text)
 			}
+			if o.ModeL3.Ipv6.DhcpClient != nil {
+				ans.raw["v6dhcp"] = util.CleanRawXml(o.ModeL3.Ipv6.DhcpClient.Text)
+			}
+			if o.ModeL3.Ipv6.Inherited != nil {
+				ans.raw["v6inh"] = util.CleanRawXml(o.ModeL3.Ipv6.Inherited.Text)
+			}
 		}
 
 		if o.ModeL3.Arp != nil {
@@ -298,7 +372,19 @@ func (o *entry_v2) normalize() Entry {
 			ans.raw["l3subinterface"] = util.CleanRawXml(o.ModeL3.Subinterface.Text)
 		}
 		if o.ModeL3.Pppoe != nil {
-			ans.raw["pppoe"] = util.CleanRawXml(o.ModeL3.Pppoe.Text)
+			ans.PppoeEnable = util.AsBool(o.ModeL3.Pppoe.Enable)
+			ans.PppoeUsername = o.ModeL3.Pppoe.Username
+			ans.PppoePassword = o.ModeL3.Pppoe.Password
+			ans.PppoeAuthentication = o.ModeL3.Pppoe.Authentication
+			ans.PppoeDefaultRouteMetric = o.ModeL3.Pppoe.DefaultRouteMetric
+			ans.PppoeAccessConcentrator = o.ModeL3.Pppoe.AccessConcentrator
+			ans.PppoeService = o.ModeL3.Pppoe.Service
+			if o.ModeL3.Pppoe.StaticAddress != nil {
+				ans.PppoeStaticAddress = o.ModeL3.Pppoe.StaticAddress.Ip
+			}
+			if o.ModeL3.Pppoe.Passive != nil {
+				ans.PppoePassive = util.AsBool(o.ModeL3.Pppoe.Passive.Enable)
+			}
 		}
 		if o.ModeL3.Ndp != nil {
 			ans.raw["ndp"] = util.CleanRawXml(o.ModeL3.Ndp.Text)
@@ -329,6 +415,13 @@ func (o *entry_v2) normalize() Entry {
 		ans.Mode = "ha"
 	case o.DecryptMirrorMode != nil:
 		ans.Mode = "decrypt-mirror"
+	case o.LogCardMode != nil:
+		ans.Mode = "log-card"
+		ans.LogCardIpAddress = o.LogCardMode.IpAddress
+		ans.LogCardNetmask = o.LogCardMode.Netmask
+		ans.LogCardDefaultGateway = o.LogCardMode.DefaultGateway
+		ans.LogCardIpv6Address = o.LogCardMode.Ipv6Address
+		ans.LogCardIpv6DefaultGateway = o.LogCardMode.Ipv6DefaultGateway
 	case o.AggregateGroup != "":
 		ans.Mode = "aggregate-group"
 		ans.AggregateGroup = o.AggregateGroup
@@ -405,6 +498,12 @@ func (o *entry_v3) normalize() Entry {
 			if o.ModeL3.Ipv6.Neighbor != nil {
 				ans.raw["v6nd"] = util.CleanRawXml(o.ModeL3.Ipv6.Neighbor.Text)
 			}
+			if o.ModeL3.Ipv6.DhcpClient != nil {
+				ans.raw["v6dhcp"] = util.CleanRawXml(o.ModeL3.Ipv6.DhcpClient.Text)
+			}
+			if o.ModeL3.Ipv6.Inherited != nil {
+				ans.raw["v6inh"] = util.CleanRawXml(o.ModeL3.Ipv6.Inherited.Text)
+			}
 		}
 
 		if o.ModeL3.Arp != nil {
@@ -414,7 +513,19 @@ func (o *entry_v3) normalize() Entry {
 			ans.raw["l3subinterface"] = util.CleanRawXml(o.ModeL3.Subinterface.Text)
 		}
 		if o.ModeL3.Pppoe != nil {
-			ans.raw["pppoe"] = util.CleanRawXml(o.ModeL3.Pppoe.Text)
+			ans.PppoeEnable = util.AsBool(o.ModeL3.Pppoe.Enable)
+			ans.PppoeUsername = o.ModeL3.Pppoe.Username
+			ans.PppoePassword = o.ModeL3.Pppoe.Password
+			ans.PppoeAuthentication = o.ModeL3.Pppoe.Authentication
+			ans.PppoeDefaultRouteMetric = o.ModeL3.Pppoe.DefaultRouteMetric
+			ans.PppoeAccessConcentrator = o.ModeL3.Pppoe.AccessConcentrator
+			ans.PppoeService = o.ModeL3.Pppoe.Service
+			if o.ModeL3.Pppoe.StaticAddress != nil {
+				ans.PppoeStaticAddress = o.ModeL3.Pppoe.StaticAddress.Ip
+			}
+			if o.ModeL3.Pppoe.Passive != nil {
+				ans.PppoePassive = util.AsBool(o.ModeL3.Pppoe.Passive.Enable)
+			}
 		}
 		if o.ModeL3.Ndp != nil {
 			ans.raw["ndp"] = util.CleanRawXml(o.ModeL3.Ndp.Text)
@@ -445,6 +556,13 @@ func (o *entry_v3) normalize() Entry {
 		ans.Mode = "ha"
 	case o.DecryptMirrorMode != nil:
 		ans.Mode = "decrypt-mirror"
+	case o.LogCardMode != nil:
+		ans.Mode = "log-card"
+		ans.LogCardIpAddress = o.LogCardMode.IpAddress
+		ans.LogCardNetmask = o.LogCardMode.Netmask
+		ans.LogCardDefaultGateway = o.LogCardMode.DefaultGateway
+		ans.LogCardIpv6Address = o.LogCardMode.Ipv6Address
+		ans.LogCardIpv6DefaultGateway = o.LogCardMode.Ipv6DefaultGateway
 	case o.AggregateGroup != "":
 		ans.Mode = "aggregate-group"
 		ans.AggregateGroup = o.AggregateGroup
@@ -525,6 +643,12 @@ func (o *entry_v4) normalize() Entry {
 			if o.ModeL3.Ipv6.Neighbor != nil {
 				ans.raw["v6nd"] = util.CleanRawXml(o.ModeL3.Ipv6.Neighbor.Text)
 			}
+			if o.ModeL3.Ipv6.DhcpClient != nil {
+				ans.raw["v6dhcp"] = util.CleanRawXml(o.ModeL3.Ipv6.DhcpClient.Text)
+			}
+			if o.ModeL3.Ipv6.Inherited != nil {
+				ans.raw["v6inh"] = util.CleanRawXml(o.ModeL3.Ipv6.Inherited.Text)
+			}
 		}
 
 		if o.ModeL3.Arp != nil {
@@ -534,7 +658,19 @@ func (o *entry_v4) normalize() Entry {
 			ans.raw["l3subinterface"] = util.CleanRawXml(o.ModeL3.Subinterface.Text)
 		}
 		if o.ModeL3.Pppoe != nil {
-			ans.raw["pppoe"] = util.CleanRawXml(o.ModeL3.Pppoe.Text)
+			ans.PppoeEnable = util.AsBool(o.ModeL3.Pppoe.Enable)
+			ans.PppoeUsername = o.ModeL3.Pppoe.Username
+			ans.PppoePassword = o.ModeL3.Pppoe.Password
+			ans.PppoeAuthentication = o.ModeL3.Pppoe.Authentication
+			ans.PppoeDefaultRouteMetric = o.ModeL3.Pppoe.DefaultRouteMetric
+			ans.PppoeAccessConcentrator = o.ModeL3.Pppoe.AccessConcentrator
+			ans.PppoeService = o.ModeL3.Pppoe.Service
+			if o.ModeL3.Pppoe.StaticAddress != nil {
+				ans.PppoeStaticAddress = o.ModeL3.Pppoe.StaticAddress.Ip
+			}
+			if o.ModeL3.Pppoe.Passive != nil {
+				ans.PppoePassive = util.AsBool(o.ModeL3.Pppoe.Passive.Enable)
+			}
 		}
 		if o.ModeL3.Ndp != nil {
 			ans.raw["ndp"] = util.CleanRawXml(o.ModeL3.Ndp.Text)
@@ -571,6 +707,172 @@ func (o *entry_v4) normalize() Entry {
 		ans.Mode = "ha"
 	case o.DecryptMirrorMode != nil:
 		ans.Mode = "decrypt-mirror"
+	case o.LogCardMode != nil:
+		ans.Mode = "log-card"
+		ans.LogCardIpAddress = o.LogCardMode.IpAddress
+		ans.LogCardNetmask = o.LogCardMode.Netmask
+		ans.LogCardDefaultGateway = o.LogCardMode.DefaultGateway
+		ans.LogCardIpv6Address = o.LogCardMode.Ipv6Address
+		ans.LogCardIpv6DefaultGateway = o.LogCardMode.Ipv6DefaultGateway
+	case o.AggregateGroup != "":
+		ans.Mode = "aggregate-group"
+		ans.AggregateGroup = o.AggregateGroup
+	}
+
+	if len(ans.raw) == 0 {
+		ans.raw = nil
+	}
+
+	return ans
+}
+
+type container_v5 struct {
+	Answer []entry_v5 `xml:"entry"`
+}
+
+func (o *container_v5) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v5) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v5) normalize() Entry {
+	ans := Entry{
+		Name:       o.Name,
+		LinkSpeed:  o.LinkSpeed,
+		LinkDuplex: o.LinkDuplex,
+		LinkState:  o.LinkState,
+		Comment:    o.Comment,
+	}
+	ans.raw = make(map[string]string)
+	switch {
+	case o.ModeL3 != nil:
+		ans.Mode = "layer3"
+		ans.ManagementProfile = o.ModeL3.ManagementProfile
+		ans.Mtu = o.ModeL3.Mtu
+		ans.NetflowProfile = o.ModeL3.NetflowProfile
+		ans.AdjustTcpMss = util.AsBool(o.ModeL3.AdjustTcpMss)
+		ans.Ipv4MssAdjust = o.ModeL3.Ipv4MssAdjust
+		ans.Ipv6MssAdjust = o.ModeL3.Ipv6MssAdjust
+		ans.StaticIps = util.EntToStr(o.ModeL3.StaticIps)
+		ans.EnableUntaggedSubinterface = util.AsBool(o.ModeL3.EnableUntaggedSubinterface)
+		ans.DecryptForward = util.AsBool(o.ModeL3.DecryptForward)
+
+		if o.ModeL3.Dhcp != nil {
+			ans.EnableDhcp = util.AsBool(o.ModeL3.Dhcp.Enable)
+			ans.CreateDhcpDefaultRoute = util.AsBool(o.ModeL3.Dhcp.CreateDefaultRoute)
+			ans.DhcpDefaultRouteMetric = o.ModeL3.Dhcp.Metric
+			if o.ModeL3.Dhcp.Hostname != nil {
+				ans.DhcpSendHostnameEnable = util.AsBool(o.ModeL3.Dhcp.Hostname.DhcpSendHostnameEnable)
+				ans.DhcpSendHostnameValue = o.ModeL3.Dhcp.Hostname.DhcpSendHostnameValue
+			}
+		}
+
+		if o.ModeL3.Policing != nil {
+			ans.RxPolicingRate = o.ModeL3.Policing.RxPolicingRate
+			ans.TxPolicingRate = o.ModeL3.Policing.TxPolicingRate
+		}
+
+		if o.ModeL3.Ipv6 != nil {
+			ans.Ipv6Enabled = util.AsBool(o.ModeL3.Ipv6.Enabled)
+			ans.Ipv6InterfaceId = o.ModeL3.Ipv6.Ipv6InterfaceId
+			if o.ModeL3.Ipv6.Address != nil {
+				ans.raw["v6adr"] = util.CleanRawXml(o.ModeL3.Ipv6.Address.Text)
+			}
+			if o.ModeL3.Ipv6.Neighbor != nil {
+				ans.raw["v6nd"] = util.CleanRawXml(o.ModeL3.Ipv6.Neighbor.Text)
+			}
+			if o.ModeL3.Ipv6.DhcpClient != nil {
+				ans.raw["v6dhcp"] = util.CleanRawXml(o.ModeL3.Ipv6.DhcpClient.Text)
+			}
+			if o.ModeL3.Ipv6.Inherited != nil {
+				ans.raw["v6inh"] = util.CleanRawXml(o.ModeL3.Ipv6.Inherited.Text)
+			}
+		}
+
+		if o.ModeL3.Arp != nil {
+			ans.raw["arp"] = util.CleanRawXml(o.ModeL3.Arp.Text)
+		}
+		if o.ModeL3.Subinterface != nil {
+			ans.raw["l3subinterface"] = util.CleanRawXml(o.ModeL3.Subinterface.Text)
+		}
+		if o.ModeL3.Pppoe != nil {
+			ans.PppoeEnable = util.AsBool(o.ModeL3.Pppoe.Enable)
+			ans.PppoeUsername = o.ModeL3.Pppoe.Username
+			ans.PppoePassword = o.ModeL3.Pppoe.Password
+			ans.PppoeAuthentication = o.ModeL3.Pppoe.Authentication
+			ans.PppoeDefaultRouteMetric = o.ModeL3.Pppoe.DefaultRouteMetric
+			ans.PppoeAccessConcentrator = o.ModeL3.Pppoe.AccessConcentrator
+			ans.PppoeService = o.ModeL3.Pppoe.Service
+			if o.ModeL3.Pppoe.StaticAddress != nil {
+				ans.PppoeStaticAddress = o.ModeL3.Pppoe.StaticAddress.Ip
+			}
+			if o.ModeL3.Pppoe.Passive != nil {
+				ans.PppoePassive = util.AsBool(o.ModeL3.Pppoe.Passive.Enable)
+			}
+		}
+		if o.ModeL3.Ndp != nil {
+			ans.raw["ndp"] = util.CleanRawXml(o.ModeL3.Ndp.Text)
+		}
+		if o.ModeL3.Ipv6Client != nil {
+			ans.raw["v6client"] = util.CleanRawXml(o.ModeL3.Ipv6Client.Text)
+		}
+		if o.ModeL3.Ddns != nil {
+			ans.raw["ddns"] = util.CleanRawXml(o.ModeL3.Ddns.Text)
+		}
+
+		if o.ModeL3.Sdwan != nil {
+			ans.SdwanEnable = util.AsBool(o.ModeL3.Sdwan.Enable)
+			ans.SdwanInterfaceProfile = o.ModeL3.Sdwan.InterfaceProfile
+			if o.ModeL3.Sdwan.UpstreamNat != nil {
+				ans.raw["sdwanunat"] = util.CleanRawXml(o.ModeL3.Sdwan.UpstreamNat.Text)
+			}
+		}
+	case o.ModeL2 != nil:
+		ans.Mode = "layer2"
+		ans.NetflowProfile = o.ModeL2.NetflowProfile
+		if o.ModeL2.Lldp != nil {
+			ans.LldpEnabled = util.AsBool(o.ModeL2.Lldp.LldpEnabled)
+			ans.LldpProfile = o.ModeL2.Lldp.LldpProfile
+		}
+		if o.ModeL2.Subinterface != nil {
+			ans.raw["l2subinterface"] = util.CleanRawXml(o.ModeL2.Subinterface.Text)
+		}
+	case o.ModeVwire != nil:
+		ans.Mode = "virtual-wire"
+		ans.NetflowProfile = o.ModeVwire.NetflowProfile
+		if o.ModeVwire.Lldp != nil {
+			ans.LldpEnabled = util.AsBool(o.ModeVwire.Lldp.LldpEnabled)
+			ans.LldpProfile = o.ModeVwire.Lldp.LldpProfile
+		}
+		if o.ModeVwire.Subinterface != nil {
+			ans.raw["vwsub"] = util.CleanRawXml(o.ModeVwire.Subinterface.Text)
+		}
+	case o.TapMode != nil:
+		ans.Mode = "tap"
+	case o.HaMode != nil:
+		ans.Mode = "ha"
+	case o.DecryptMirrorMode != nil:
+		ans.Mode = "decrypt-mirror"
+	case o.LogCardMode != nil:
+		ans.Mode = "log-card"
+		ans.LogCardIpAddress = o.LogCardMode.IpAddress
+		ans.LogCardNetmask = o.LogCardMode.Netmask
+		ans.LogCardDefaultGateway = o.LogCardMode.DefaultGateway
+		ans.LogCardIpv6Address = o.LogCardMode.Ipv6Address
+		ans.LogCardIpv6DefaultGateway = o.LogCardMode.Ipv6DefaultGateway
 	case o.AggregateGroup != "":
 		ans.Mode = "aggregate-group"
 		ans.AggregateGroup = o.AggregateGroup
@@ -592,6 +894,7 @@ type entry_v2 struct {
 	TapMode           *emptyMode `xml:"tap"`
 	HaMode            *emptyMode `xml:"ha"`
 	DecryptMirrorMode *emptyMode `xml:"decrypt-mirror"`
+	LogCardMode       *logCard   `xml:"log-card"`
 	AggregateGroup    string     `xml:"aggregate-group,omitempty"`
 	LinkSpeed         string     `xml:"link-speed,omitempty"`
 	LinkDuplex        string     `xml:"link-duplex,omitempty"`
@@ -611,7 +914,7 @@ type l3Mode_v2 struct {
 	Dhcp                       *dhcpSettings_v1 `xml:"dhcp-client"`
 	EnableUntaggedSubinterface string           `xml:"untagged-sub-interface,omitempty"`
 	Arp                        *util.RawXml     `xml:"arp"`
-	Pppoe                      *util.RawXml     `xml:"pppoe"`
+	Pppoe                      *pppoe           `xml:"pppoe"`
 	Ndp                        *util.RawXml     `xml:"ndp-proxy"`
 	Subinterface               *util.RawXml     `xml:"units"`
 }
@@ -625,6 +928,7 @@ type entry_v3 struct {
 	TapMode           *emptyMode `xml:"tap"`
 	HaMode            *emptyMode `xml:"ha"`
 	DecryptMirrorMode *emptyMode `xml:"decrypt-mirror"`
+	LogCardMode       *logCard   `xml:"log-card"`
 	AggregateGroup    string     `xml:"aggregate-group,omitempty"`
 	LinkSpeed         string     `xml:"link-speed,omitempty"`
 	LinkDuplex        string     `xml:"link-duplex,omitempty"`
@@ -646,11 +950,31 @@ type l3Mode_v3 struct {
 	DecryptForward             string           `xml:"decrypt-forward,omitempty"`
 	Policing                   *policing        `xml:"policing"`
 	Arp                        *util.RawXml     `xml:"arp"`
-	Pppoe                      *util.RawXml     `xml:"pppoe"`
+	Pppoe                      *pppoe           `xml:"pppoe"`
 	Ndp                        *util.RawXml     `xml:"ndp-proxy"`
 	Subinterface               *util.RawXml     `xml:"units"`
 }
 
+type pppoe struct {
+	Enable             string              `xml:"enable"`
+	Username           string              `xml:"username,omitempty"`
+	Password           string              `xml:"password,omitempty"`
+	Authentication     string              `xml:"authentication,omitempty"`
+	StaticAddress      *pppoeStaticAddress `xml:"static-address"`
+	DefaultRouteMetric int                 `xml:"default-route-metric,omitempty"`
+	AccessConcentrator string              `xml:"access-concentrator,omitempty"`
+	Service            string              `xml:"service,omitempty"`
+	Passive            *pppoePassive       `xml:"passive"`
+}
+
+type pppoeStaticAddress struct {
+	Ip string `xml:"ip"`
+}
+
+type pppoePassive struct {
+	Enable string `xml:"enable"`
+}
+
 type policing struct {
 	RxPolicingRate int `xml:"rx-rate,omitempty"`
 	TxPolicingRate int `xml:"tx-rate,omitempty"`
@@ -665,6 +989,7 @@ type entry_v4 struct {
 	TapMode           *emptyMode `xml:"tap"`
 	HaMode            *emptyMode `xml:"ha"`
 	DecryptMirrorMode *emptyMode `xml:"decrypt-mirror"`
+	LogCardMode       *logCard   `xml:"log-card"`
 	AggregateGroup    string     `xml:"aggregate-group,omitempty"`
 	LinkSpeed         string     `xml:"link-speed,omitempty"`
 	LinkDuplex        string     `xml:"link-duplex,omitempty"`
@@ -686,11 +1011,56 @@ type l3Mode_v4 struct {
 	DecryptForward             string           `xml:"decrypt-forward,omitempty"`
 	Policing                   *policing        `xml:"policing"`
 	Arp                        *util.RawXml     `xml:"arp"`
-	Pppoe                      *util.RawXml     `xml:"pppoe"`
+	Pppoe                      *pppoe           `xml:"pppoe"`
+	Ndp                        *util.RawXml     `xml:"ndp-proxy"`
+	Ipv6Client                 *util.RawXml     `xml:"ipv6-client"`
+	Subinterface               *util.RawXml     `xml:"units"`
+	Ddns                       *util.RawXml     `xml:"ddns-config"`
+}
+
+type entry_v5 struct {
+	XMLName           xml.Name   `xml:"entry"`
+	Name              string     `xml:"name,attr"`
+	ModeL3            *l3Mode_v5 `xml:"layer3"`
+	ModeL2            *otherMode `xml:"layer2"`
+	ModeVwire         *otherMode `xml:"virtual-wire"`
+	TapMode           *emptyMode `xml:"tap"`
+	HaMode            *emptyMode `xml:"ha"`
+	DecryptMirrorMode *emptyMode `xml:"decrypt-mirror"`
+	LogCardMode       *logCard   `xml:"log-card"`
+	AggregateGroup    string     `xml:"aggregate-group,omitempty"`
+	LinkSpeed         string     `xml:"link-speed,omitempty"`
+	LinkDuplex        string     `xml:"link-duplex,omitempty"`
+	LinkState         string     `xml:"link-state,omitempty"`
+	Comment           string     `xml:"comment"`
+}
+
+type l3Mode_v5 struct {
+	Ipv6                       *ipv6            `xml:"ipv6"`
+	ManagementProfile          string           `xml:"interface-management-profile,omitempty"`
+	Mtu                        int              `xml:"mtu,omitempty"`
+	NetflowProfile             string           `xml:"netflow-profile,omitempty"`
+	AdjustTcpMss               string           `xml:"adjust-tcp-mss>enable"`
+	Ipv4MssAdjust              int              `xml:"adjust-tcp-mss>ipv4-mss-adjustment,omitempty"`
+	Ipv6MssAdjust              int              `xml:"adjust-tcp-mss>ipv6-mss-adjustment,omitempty"`
+	StaticIps                  *util.EntryType  `xml:"ip"`
+	Dhcp                       *dhcpSettings_v2 `xml:"dhcp-client"`
+	EnableUntaggedSubinterface string           `xml:"untagged-sub-interface,omitempty"`
+	DecryptForward             string           `xml:"decrypt-forward,omitempty"`
+	Policing                   *policing        `xml:"policing"`
+	Arp                        *util.RawXml     `xml:"arp"`
+	Pppoe                      *pppoe           `xml:"pppoe"`
 	Ndp                        *util.RawXml     `xml:"ndp-proxy"`
 	Ipv6Client                 *util.RawXml     `xml:"ipv6-client"`
 	Subinterface               *util.RawXml     `xml:"units"`
 	Ddns                       *util.RawXml     `xml:"ddns-config"`
+	Sdwan                      *sdwan           `xml:"sdwan-link-settings"`
+}
+
+type sdwan struct {
+	Enable           string       `xml:"enable"`
+	InterfaceProfile string       `xml:"sdwan-interface-profile,omitempty"`
+	UpstreamNat      *util.RawXml `xml:"upstream-nat"`
 }
 
 type dhcpSettings_v2 struct {
@@ -734,7 +1104,9 @@ func specify_v1(e Entry) interface{} {
 
 		v6adr := e.raw["v6adr"]
 		v6nd := e.raw["v6nd"]
-		if e.Ipv6Enabled || e.Ipv6InterfaceId != "" || v6adr != "" || v6nd != "" {
+		v6dhcp := e.raw["v6dhcp"]
+		v6inh := e.raw["v6inh"]
+		if e.Ipv6Enabled || e.Ipv6InterfaceId != "" || v6adr != "" || v6nd != "" || v6dhcp != "" || v6inh != "" {
 			v6 := ipv6{
 				Enabled:         util.YesNo(e.Ipv6Enabled),
 				Ipv6InterfaceId: e.Ipv6InterfaceId,
@@ -745,6 +1117,12 @@ func specify_v1(e Entry) interface{} {
 			if v6nd != "" {
 				v6.Neighbor = &util.RawXml{v6nd}
 			}
+			if v6dhcp != "" {
+				v6.DhcpClient = &util.RawXml{v6dhcp}
+			}
+			if v6inh != "" {
+				v6.Inherited = &util.RawXml{v6inh}
+			}
 			i.Ipv6 = &v6
 		}
 
@@ -787,6 +1165,14 @@ func specify_v1(e Entry) interface{} {
 		ans.HaMode = &emptyMode{}
 	case "decrypt-mirror":
 		ans.DecryptMirrorMode = &emptyMode{}
+	case "log-card":
+		ans.LogCardMode = &logCard{
+			IpAddress:          e.LogCardIpAddress,
+			Netmask:            e.LogCardNetmask,
+			DefaultGateway:     e.LogCardDefaultGateway,
+			Ipv6Address:        e.LogCardIpv6Address,
+			Ipv6DefaultGateway: e.LogCardIpv6DefaultGateway,
+		}
 	case "aggregate-group":
 		ans.AggregateGroup = e.AggregateGroup
 	}
@@ -829,7 +1215,9 @@ func specify_v2(e Entry) interface{} {
 
 		v6adr := e.raw["v6adr"]
 		v6nd := e.raw["v6nd"]
-		if e.Ipv6Enabled || e.Ipv6InterfaceId != "" || v6adr != "" || v6nd != "" {
+		v6dhcp := e.raw["v6dhcp"]
+		v6inh := e.raw["v6inh"]
+		if e.Ipv6Enabled || e.Ipv6InterfaceId != "" || v6adr != "" || v6nd != "" || v6dhcp != "" || v6inh != "" {
 			v6 := ipv6{
 				Enabled:         util.YesNo(e.Ipv6Enabled),
 				Ipv6InterfaceId: e.Ipv6InterfaceId,
@@ -840,6 +1228,12 @@ func specify_v2(e Entry) interface{} {
 			if v6nd != "" {
 				v6.Neighbor = &util.RawXml{v6nd}
 			}
+			if v6dhcp != "" {
+				v6.DhcpClient = &util.RawXml{v6dhcp}
+			}
+			if v6inh != "" {
+				v6.Inherited = &util.RawXml{v6inh}
+			}
 			i.Ipv6 = &v6
 		}
 
@@ -849,8 +1243,27 @@ func specify_v2(e Entry) interface{} {
 		if text, present := e.raw["l3subinterface"]; present {
 			i.Subinterface = &util.RawXml{text}
 		}
-		if text := e.raw["pppoe"]; text != "" {
-			i.Pppoe = &util.RawXml{text}
+		if e.PppoeEnable || e.PppoeUsername != "" || e.PppoePassword != "" || e.PppoeAuthentication != "" || e.PppoeStaticAddress != "" || e.PppoeDefaultRouteMetric != 0 || e.PppoeAccessConcentrator != "" || e.PppoeService != "" || e.PppoePassive {
+			pe := pppoe{
+				Enable:             util.YesNo(e.PppoeEnable),
+				Username:           e.PppoeUsername,
+				Password:           e.PppoePassword,
+				Authentication:     e.PppoeAuthentication,
+				DefaultRouteMetric: e.PppoeDefaultRouteMetric,
+				AccessConcentrator: e.PppoeAccessConcentrator,
+				Service:            e.PppoeService,
+			}
+			if e.PppoeStaticAddress != "" {
+				pe.StaticAddress = &pppoeStaticAddress{
+					Ip: e.PppoeStaticAddress,
+				}
+			}
+			if e.PppoePassive {
+				pe.Passive = &pppoePassive{
+					Enable: util.YesNo(e.PppoePassive),
+				}
+			}
+			i.Pppoe = &pe
 		}
 		if text := e.raw["ndp"]; text != "" {
 			i.Ndp = &util.RawXml{text}
@@ -888,6 +1301,14 @@ func specify_v2(e Entry) interface{} {
 		ans.HaMode = &emptyMode{}
 	case "decrypt-mirror":
 		ans.DecryptMirrorMode = &emptyMode{}
+	case "log-card":
+		ans.LogCardMode = &logCard{
+			IpAddress:          e.LogCardIpAddress,
+			Netmask:            e.LogCardNetmask,
+			DefaultGateway:     e.LogCardDefaultGateway,
+			Ipv6Address:        e.LogCardIpv6Address,
+			Ipv6DefaultGateway: e.LogCardIpv6DefaultGateway,
+		}
 	case "aggregate-group":
 		ans.AggregateGroup = e.AggregateGroup
 	}
@@ -941,7 +1362,9 @@ func specify_v3(e Entry) interface{} {
 
 		v6adr := e.raw["v6adr"]
 		v6nd := e.raw["v6nd"]
-		if e.Ipv6Enabled || e.Ipv6InterfaceId != "" || v6adr != "" || v6nd != "" {
+		v6dhcp := e.raw["v6dhcp"]
+		v6inh := e.raw["v6inh"]
+		if e.Ipv6Enabled || e.Ipv6InterfaceId != "" || v6adr != "" || v6nd != "" || v6dhcp != "" || v6inh != "" {
 			v6 := ipv6{
 				Enabled:         util.YesNo(e.Ipv6Enabled),
 				Ipv6InterfaceId: e.Ipv6InterfaceId,
@@ -952,6 +1375,12 @@ func specify_v3(e Entry) interface{} {
 			if v6nd != "" {
 				v6.Neighbor = &util.RawXml{v6nd}
 			}
+			if v6dhcp != "" {
+				v6.DhcpClient = &util.RawXml{v6dhcp}
+			}
+			if v6inh != "" {
+				v6.Inherited = &util.RawXml{v6inh}
+			}
 			i.Ipv6 = &v6
 		}
 
@@ -961,8 +1390,27 @@ func specify_v3(e Entry) interface{} {
 		if text, present := e.raw["l3subinterface"]; present {
 			i.Subinterface = &util.RawXml{text}
 		}
-		if text := e.raw["pppoe"]; text != "" {
-			i.Pppoe = &util.RawXml{text}
+		if e.PppoeEnable || e.PppoeUsername != "" || e.PppoePassword != "" || e.PppoeAuthentication != "" || e.PppoeStaticAddress != "" || e.PppoeDefaultRouteMetric != 0 || e.PppoeAccessConcentrator != "" || e.PppoeService != "" || e.PppoePassive {
+			pe := pppoe{
+				Enable:             util.YesNo(e.PppoeEnable),
+				Username:           e.PppoeUsername,
+				Password:           e.PppoePassword,
+				Authentication:     e.PppoeAuthentication,
+				DefaultRouteMetric: e.PppoeDefaultRouteMetric,
+				AccessConcentrator: e.PppoeAccessConcentrator,
+				Service:            e.PppoeService,
+			}
+			if e.PppoeStaticAddress != "" {
+				pe.StaticAddress = &pppoeStaticAddress{
+					Ip: e.PppoeStaticAddress,
+				}
+			}
+			if e.PppoePassive {
+				pe.Passive = &pppoePassive{
+					Enable: util.YesNo(e.PppoePassive),
+				}
+			}
+			i.Pppoe = &pe
 		}
 		if text := e.raw["ndp"]; text != "" {
 			i.Ndp = &util.RawXml{text}
@@ -1000,6 +1448,14 @@ func specify_v3(e Entry) interface{} {
 		ans.HaMode = &emptyMode{}
 	case "decrypt-mirror":
 		ans.DecryptMirrorMode = &emptyMode{}
+	case "log-card":
+		ans.LogCardMode = &logCard{
+			IpAddress:          e.LogCardIpAddress,
+			Netmask:            e.LogCardNetmask,
+			DefaultGateway:     e.LogCardDefaultGateway,
+			Ipv6Address:        e.LogCardIpv6Address,
+			Ipv6DefaultGateway: e.LogCardIpv6DefaultGateway,
+		}
 	case "aggregate-group":
 		ans.AggregateGroup = e.AggregateGroup
 	}
@@ -1060,7 +1516,9 @@ func specify_v4(e Entry) interface{} {
 
 		v6adr := e.raw["v6adr"]
 		v6nd := e.raw["v6nd"]
-		if e.Ipv6Enabled || e.Ipv6InterfaceId != "" || v6adr != "" || v6nd != "" {
+		v6dhcp := e.raw["v6dhcp"]
+		v6inh := e.raw["v6inh"]
+		if e.Ipv6Enabled || e.Ipv6InterfaceId != "" || v6adr != "" || v6nd != "" || v6dhcp != "" || v6inh != "" {
 			v6 := ipv6{
 				Enabled:         util.YesNo(e.Ipv6Enabled),
 				Ipv6InterfaceId: e.Ipv6InterfaceId,
@@ -1071,6 +1529,12 @@ func specify_v4(e Entry) interface{} {
 			if v6nd != "" {
 				v6.Neighbor = &util.RawXml{v6nd}
 			}
+			if v6dhcp != "" {
+				v6.DhcpClient = &util.RawXml{v6dhcp}
+			}
+			if v6inh != "" {
+				v6.Inherited = &util.RawXml{v6inh}
+			}
 			i.Ipv6 = &v6
 		}
 
@@ -1080,8 +1544,27 @@ func specify_v4(e Entry) interface{} {
 		if text, present := e.raw["l3subinterface"]; present {
 			i.Subinterface = &util.RawXml{text}
 		}
-		if text := e.raw["pppoe"]; text != "" {
-			i.Pppoe = &util.RawXml{text}
+		if e.PppoeEnable || e.PppoeUsername != "" || e.PppoePassword != "" || e.PppoeAuthentication != "" || e.PppoeStaticAddress != "" || e.PppoeDefaultRouteMetric != 0 || e.PppoeAccessConcentrator != "" || e.PppoeService != "" || e.PppoePassive {
+			pe := pppoe{
+				Enable:             util.YesNo(e.PppoeEnable),
+				Username:           e.PppoeUsername,
+				Password:           e.PppoePassword,
+				Authentication:     e.PppoeAuthentication,
+				DefaultRouteMetric: e.PppoeDefaultRouteMetric,
+				AccessConcentrator: e.PppoeAccessConcentrator,
+				Service:            e.PppoeService,
+			}
+			if e.PppoeStaticAddress != "" {
+				pe.StaticAddress = &pppoeStaticAddress{
+					Ip: e.PppoeStaticAddress,
+				}
+			}
+			if e.PppoePassive {
+				pe.Passive = &pppoePassive{
+					Enable: util.YesNo(e.PppoePassive),
+				}
+			}
+			i.Pppoe = &pe
 		}
 		if text := e.raw["ndp"]; text != "" {
 			i.Ndp = &util.RawXml{text}
@@ -1125,6 +1608,185 @@ func specify_v4(e Entry) interface{} {
 		ans.HaMode = &emptyMode{}
 	case "decrypt-mirror":
 		ans.DecryptMirrorMode = &emptyMode{}
+	case "log-card":
+		ans.LogCardMode = &logCard{
+			IpAddress:          e.LogCardIpAddress,
+			Netmask:            e.LogCardNetmask,
+			DefaultGateway:     e.LogCardDefaultGateway,
+			Ipv6Address:        e.LogCardIpv6Address,
+			Ipv6DefaultGateway: e.LogCardIpv6DefaultGateway,
+		}
+	case "aggregate-group":
+		ans.AggregateGroup = e.AggregateGroup
+	}
+
+	return ans
+}
+
+func specify_v5(e Entry) interface{} {
+	ans := entry_v5{
+		Name:       e.Name,
+		LinkSpeed:  e.LinkSpeed,
+		LinkDuplex: e.LinkDuplex,
+		LinkState:  e.LinkState,
+		Comment:    e.Comment,
+	}
+
+	switch e.Mode {
+	case "layer3":
+		i := &l3Mode_v5{
+			StaticIps:         util.StrToEnt(e.StaticIps),
+			ManagementProfile: e.ManagementProfile,
+			Mtu:               e.Mtu,
+			NetflowProfile:    e.NetflowProfile,
+			AdjustTcpMss:      util.YesNo(e.AdjustTcpMss),
+			Ipv4MssAdjust:     e.Ipv4MssAdjust,
+			Ipv6MssAdjust:     e.Ipv6MssAdjust,
+		}
+
+		if e.EnableUntaggedSubinterface {
+			i.EnableUntaggedSubinterface = util.YesNo(e.EnableUntaggedSubinterface)
+		}
+
+		if e.DecryptForward {
+			i.DecryptForward = util.YesNo(e.DecryptForward)
+		}
+
+		if e.RxPolicingRate != 0 || e.TxPolicingRate != 0 {
+			i.Policing = &policing{
+				RxPolicingRate: e.RxPolicingRate,
+				TxPolicingRate: e.TxPolicingRate,
+			}
+		}
+
+		if e.EnableDhcp || e.CreateDhcpDefaultRoute || e.DhcpDefaultRouteMetric != 0 || e.DhcpSendHostnameEnable || e.DhcpSendHostnameValue != "" {
+			i.Dhcp = &dhcpSettings_v2{
+				Enable:             util.YesNo(e.EnableDhcp),
+				CreateDefaultRoute: util.YesNo(e.CreateDhcpDefaultRoute),
+				Metric:             e.DhcpDefaultRouteMetric,
+			}
+
+			if e.DhcpSendHostnameEnable || e.DhcpSendHostnameValue != "" {
+				i.Dhcp.Hostname = &dhcpHostname{
+					DhcpSendHostnameEnable: util.YesNo(e.DhcpSendHostnameEnable),
+					DhcpSendHostnameValue:  e.DhcpSendHostnameValue,
+				}
+			}
+		}
+
+		v6adr := e.raw["v6adr"]
+		v6nd := e.raw["v6nd"]
+		v6dhcp := e.raw["v6dhcp"]
+		v6inh := e.raw["v6inh"]
+		if e.Ipv6Enabled || e.Ipv6InterfaceId != "" || v6adr != "" || v6nd != "" || v6dhcp != "" || v6inh != "" {
+			v6 := ipv6{
+				Enabled:         util.YesNo(e.Ipv6Enabled),
+				Ipv6InterfaceId: e.Ipv6InterfaceId,
+			}
+			if v6adr != "" {
+				v6.Address = &util.RawXml{v6adr}
+			}
+			if v6nd != "" {
+				v6.Neighbor = &util.RawXml{v6nd}
+			}
+			if v6dhcp != "" {
+				v6.DhcpClient = &util.RawXml{v6dhcp}
+			}
+			if v6inh != "" {
+				v6.Inherited = &util.RawXml{v6inh}
+			}
+			i.Ipv6 = &v6
+		}
+
+		if text, present := e.raw["arp"]; present {
+			i.Arp = &util.RawXml{text}
+		}
+		if text, present := e.raw["l3subinterface"]; present {
+			i.Subinterface = &util.RawXml{text}
+		}
+		if e.PppoeEnable || e.PppoeUsername != "" || e.PppoePassword != "" || e.PppoeAuthentication != "" || e.PppoeStaticAddress != "" || e.PppoeDefaultRouteMetric != 0 || e.PppoeAccessConcentrator != "" || e.PppoeService != "" || e.PppoePassive {
+			pe := pppoe{
+				Enable:             util.YesNo(e.PppoeEnable),
+				Username:           e.PppoeUsername,
+				Password:           e.PppoePassword,
+				Authentication:     e.PppoeAuthentication,
+				DefaultRouteMetric: e.PppoeDefaultRouteMetric,
+				AccessConcentrator: e.PppoeAccessConcentrator,
+				Service:            e.PppoeService,
+			}
+			if e.PppoeStaticAddress != "" {
+				pe.StaticAddress = &pppoeStaticAddress{
+					Ip: e.PppoeStaticAddress,
+				}
+			}
+			if e.PppoePassive {
+				pe.Passive = &pppoePassive{
+					Enable: util.YesNo(e.PppoePassive),
+				}
+			}
+			i.Pppoe = &pe
+		}
+		if text := e.raw["ndp"]; text != "" {
+			i.Ndp = &util.RawXml{text}
+		}
+		if text := e.raw["v6client"]; text != "" {
+			i.Ipv6Client = &util.RawXml{text}
+		}
+		if text := e.raw["ddns"]; text != "" {
+			i.Ddns = &util.RawXml{text}
+		}
+
+		sunat := e.raw["sdwanunat"]
+		if e.SdwanEnable || e.SdwanInterfaceProfile != "" || sunat != "" {
+			i.Sdwan = &sdwan{
+				Enable:           util.YesNo(e.SdwanEnable),
+				InterfaceProfile: e.SdwanInterfaceProfile,
+			}
+			if sunat != "" {
+				i.Sdwan.UpstreamNat = &util.RawXml{sunat}
+			}
+		}
+		ans.ModeL3 = i
+	case "layer2":
+		ans.ModeL2 = &otherMode{
+			NetflowProfile: e.NetflowProfile,
+		}
+		if e.LldpEnabled || e.LldpProfile != "" {
+			ans.ModeL2.Lldp = &omLldp{
+				LldpEnabled: util.YesNo(e.LldpEnabled),
+				LldpProfile: e.LldpProfile,
+			}
+		}
+		if text := e.raw["l2subinterface"]; text != "" {
+			ans.ModeL2.Subinterface = &util.RawXml{text}
+		}
+	case "virtual-wire":
+		ans.ModeVwire = &otherMode{
+			NetflowProfile: e.NetflowProfile,
+		}
+		if e.LldpEnabled || e.LldpProfile != "" {
+			ans.ModeVwire.Lldp = &omLldp{
+				LldpEnabled: util.YesNo(e.LldpEnabled),
+				LldpProfile: e.LldpProfile,
+			}
+		}
+		if text := e.raw["vwsub"]; text != "" {
+			ans.ModeVwire.Subinterface = &util.RawXml{text}
+		}
+	case "tap":
+		ans.TapMode = &emptyMode{}
+	case "ha":
+		ans.HaMode = &emptyMode{}
+	case "decrypt-mirror":
+		ans.DecryptMirrorMode = &emptyMode{}
+	case "log-card":
+		ans.LogCardMode = &logCard{
+			IpAddress:          e.LogCardIpAddress,
+			Netmask:            e.LogCardNetmask,
+			DefaultGateway:     e.LogCardDefaultGateway,
+			Ipv6Address:        e.LogCardIpv6Address,
+			Ipv6DefaultGateway: e.LogCardIpv6DefaultGateway,
+		}
 	case "aggregate-group":
 		ans.AggregateGroup = e.AggregateGroup
 	}
@@ -95,7 +95,7 @@ func (c *FwEth) Set(vsys string, e ...Entry) error {
 	for i := range e {
 		d.Data = append(d.Data, fn(e[i]))
 		n1[i] = e[i].Name
-		if e[i].Mode != "ha" && e[i].Mode != "aggregate-group" {
+		if e[i].Mode != "ha" && e[i].Mode != "aggregate-group" && e[i].Mode != "log-card" {
 			n2 = append(n2, e[i].Name)
 		}
 	}
@@ -152,7 +152,7 @@ func (c *FwEth) Edit(vsys string, e Entry) error {
 	}
 
 	// Check if we should skip the import step.
-	if e.Mode == "ha" || e.Mode == "aggregate-group" {
+	if e.Mode == "ha" || e.Mode == "aggregate-group" || e.Mode == "log-card" {
 		return nil
 	}
 
@@ -199,7 +199,9 @@ func (c *FwEth) Delete(e ...interface{}) error {
 func (c *FwEth) versioning() (normalizer, func(Entry) interface{}) {
 	v := c.con.Versioning()
 
-	if v.Gte(version.Number{9, 0, 0, ""}) {
+	if v.Gte(version.Number{9, 1, 0, ""}) {
+		return &container_v5{}, specify_v5
+	} else if v.Gte(version.Number{9, 0, 0, ""}) {
 		return &container_v4{}, specify_v4
 	} else if v.Gte(version.Number{8, 1, 0, ""}) {
 		return &container_v3{}, specify_v3
@@ -99,7 +99,7 @@ func (c *PanoEth) Set(tmpl, ts, vsys string, e ...Entry) error {
 	for i := range e {
 		d.Data = append(d.Data, fn(e[i]))
 		n1[i] = e[i].Name
-		if e[i].Mode != "ha" && e[i].Mode != "aggregate-group" {
+		if e[i].Mode != "ha" && e[i].Mode != "aggregate-group" && e[i].Mode != "log-card" {
 			n2 = append(n2, e[i].Name)
 		}
 	}
@@ -162,7 +162,7 @@ func (c *PanoEth) Edit(tmpl, ts, vsys string, e Entry) error {
 	}
 
 	// Check if we should skip the import step.
-	if e.Mode == "ha" || e.Mode == "aggregate-group" {
+	if e.Mode == "ha" || e.Mode == "aggregate-group" || e.Mode == "log-card" {
 		return nil
 	}
 
@@ -211,7 +211,9 @@ func (c *PanoEth) Delete(tmpl, ts string, e ...interface{}) error {
 func (c *PanoEth) versioning() (normalizer, func(Entry) interface{}) {
 	v := c.con.Versioning()
 
-	if v.Gte(version.Number{9, 0, 0, ""}) {
+	if v.Gte(version.Number{9, 1, 0, ""}) {
+		return &container_v5{}, specify_v5
+	} else if v.Gte(version.Number{9, 0, 0, ""}) {
 		return &container_v4{}, specify_v4
 	} else if v.Gte(version.Number{8, 1, 0, ""}) {
 		return &container_v3{}, specify_v3
@@ -107,7 +107,6 @@ func getTests() []testCase {
 				"arp":            "<arp>raw arp</arp>",
 				"v6adr":          "<address>raw ipv6 addresses</address>",
 				"v6nd":           "ipv6 neighbor info",
-				"pppoe":          "pppoe info",
 				"ndp":            "ndp proxy info",
 				"l3subinterface": "<units>raw l3 subinterfaces</units>",
 			},
@@ -171,7 +170,6 @@ func getTests() []testCase {
 				"arp":            "<arp>raw arp</arp>",
 				"v6adr":          "<address>raw ipv6 addresses</address>",
 				"v6nd":           "ipv6 neighbor info",
-				"pppoe":          "pppoe info",
 				"ndp":            "ndp proxy info",
 				"l3subinterface": "<units>raw l3 subinterfaces</units>",
 			},
@@ -237,7 +235,6 @@ func getTests() []testCase {
 				"arp":            "<arp>raw arp</arp>",
 				"v6adr":          "<address>raw ipv6 addresses</address>",
 				"v6nd":           "ipv6 neighbor info",
-				"pppoe":          "pppoe info",
 				"ndp":            "ndp proxy info",
 				"l3subinterface": "<units>raw l3 subinterfaces</units>",
 				"v6client":       "ipv6 client info",
@@ -269,5 +266,99 @@ func getTests() []testCase {
 			Name: "ethernet1/7",
 			Mode: "tap",
 		}},
+		{version.Number{9, 0, 0, ""}, "vsys8", "vsys8", []string{}, Entry{
+			Name:                      "ethernet1/8",
+			Mode:                      "log-card",
+			LogCardIpAddress:          "10.5.1.10",
+			LogCardNetmask:            "255.255.255.0",
+			LogCardDefaultGateway:     "10.5.1.1",
+			LogCardIpv6Address:        "2001:db8::10/64",
+			LogCardIpv6DefaultGateway: "2001:db8::1",
+			Comment:                   "v4 log card no import",
+		}},
+		{version.Number{5, 0, 0, ""}, "vsys8", "vsys8", []string{}, Entry{
+			Name:             "ethernet1/8",
+			Mode:             "log-card",
+			LogCardIpAddress: "10.5.1.10",
+			LogCardNetmask:   "255.255.255.0",
+			Comment:          "v1 log card no import",
+		}},
+		{version.Number{8, 0, 0, ""}, "vsys1", "vsys1", []string{"ethernet1/20"}, Entry{
+			Name:                    "ethernet1/20",
+			Mode:                    "layer3",
+			PppoeEnable:             true,
+			PppoeUsername:           "user",
+			PppoePassword:           "secret",
+			PppoeAuthentication:     PppoeAuthenticationChap,
+			PppoeStaticAddress:      "10.5.5.5",
+			PppoeDefaultRouteMetric: 10,
+			PppoeAccessConcentrator: "ac1",
+			PppoeService:            "internet",
+			PppoePassive:            true,
+			Comment:                 "v2 pppoe",
+		}},
+		{version.Number{8, 1, 0, ""}, "vsys1", "vsys1", []string{"ethernet1/21"}, Entry{
+			Name:                    "ethernet1/21",
+			Mode:                    "layer3",
+			PppoeEnable:             true,
+			PppoeUsername:           "user",
+			PppoePassword:           "secret",
+			PppoeAuthentication:     PppoeAuthenticationChap,
+			PppoeStaticAddress:      "10.5.5.5",
+			PppoeDefaultRouteMetric: 10,
+			PppoeAccessConcentrator: "ac1",
+			PppoeService:            "internet",
+			PppoePassive:            true,
+			Comment:                 "v3 pppoe",
+		}},
+		{version.Number{9, 0, 0, ""}, "vsys1", "vsys1", []string{"ethernet1/22"}, Entry{
+			Name:                    "ethernet1/22",
+			Mode:                    "layer3",
+			PppoeEnable:             true,
+			PppoeUsername:           "user",
+			PppoePassword:           "secret",
+			PppoeAuthentication:     PppoeAuthenticationChap,
+			PppoeStaticAddress:      "10.5.5.5",
+			PppoeDefaultRouteMetric: 10,
+			PppoeAccessConcentrator: "ac1",
+			PppoeService:            "internet",
+			PppoePassive:            true,
+			Comment:                 "v4 pppoe",
+		}},
+		{version.Number{9, 1, 0, ""}, "vsys1", "vsys1", []string{"ethernet1/23"}, Entry{
+			Name:                   "ethernet1/23",
+			Mode:                   "layer3",
+			EnableDhcp:             true,
+			CreateDhcpDefaultRoute: true,
+			DhcpSendHostnameEnable: true,
+			DhcpSendHostnameValue:  "system-hostname",
+			SdwanEnable:            true,
+			SdwanInterfaceProfile:  "broadband",
+			Comment:                "v5 sdwan",
+		}},
+		{version.Number{9, 1, 0, ""}, "vsys1", "vsys1", []string{"ethernet1/24"}, Entry{
+			Name:                  "ethernet1/24",
+			Mode:                  "layer3",
+			StaticIps:             []string{"10.1.1.1/24"},
+			PppoeEnable:           true,
+			PppoeUsername:         "user",
+			SdwanEnable:           true,
+			SdwanInterfaceProfile: "dsl",
+			raw: map[string]string{
+				"sdwanunat": "<upstream-nat>raw upstream nat</upstream-nat>",
+				"ddns":      "<ddns-config>raw ddns</ddns-config>",
+			},
+			Comment: "v5 sdwan with upstream nat",
+		}},
+		{version.Number{10, 0, 0, ""}, "vsys1", "vsys1", []string{"ethernet1/25"}, Entry{
+			Name:        "ethernet1/25",
+			Mode:        "layer3",
+			Ipv6Enabled: true,
+			raw: map[string]string{
+				"v6dhcp": "<enable>yes</enable>",
+				"v6inh":  "<assign-addr>raw inherited</assign-addr>",
+			},
+			Comment: "v5 ipv6 dhcp client with raw config",
+		}},
 	}
 }
@@ -0,0 +1,13 @@
+package address
+
+// Valid values for the iType param.
+const (
+	TypeEthernet  = "ethernet"
+	TypeAggregate = "aggregate-ethernet"
+	TypeVlan      = "vlan"
+)
+
+const (
+	singular = "ipv6 address"
+	plural   = "ipv6 addresses"
+)
@@ -0,0 +1,13 @@
+/*
+Package address is the client.Network.Ipv6Address namespace.
+
+This namespace manages the IPv6 addresses of a layer3 interface, including the
+router advertisement settings of the prefix for each address.  The interface
+itself must also have IPv6 enabled.
+
+If you're configuring addresses for a VLAN interface, leave the iName param
+empty and specify the vlan name (eg: vlan.1) as the subName.
+
+Normalized object:  Entry
+*/
+package address
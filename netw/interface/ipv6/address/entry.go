@@ -0,0 +1,129 @@
+package address
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an IPv6
+// address on an interface.
+//
+// The Advertise fields control whether the address prefix is included in the
+// interface's router advertisements.  ValidLifetime and PreferredLifetime are
+// either a number of seconds or "infinity".
+type Entry struct {
+	Name              string
+	EnableOnInterface bool
+	Prefix            bool
+	Anycast           bool
+	Advertise         bool
+	ValidLifetime     string
+	PreferredLifetime string
+	OnlinkFlag        bool
+	AutoConfigFlag    bool
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.EnableOnInterface = s.EnableOnInterface
+	o.Prefix = s.Prefix
+	o.Anycast = s.Anycast
+	o.Advertise = s.Advertise
+	o.ValidLifetime = s.ValidLifetime
+	o.PreferredLifetime = s.PreferredLifetime
+	o.OnlinkFlag = s.OnlinkFlag
+	o.AutoConfigFlag = s.AutoConfigFlag
+}
+
+/** Structs / functions for normalization. **/
+
+type normalizer interface {
+	Normalize() []Entry
+	Names() []string
+}
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *container_v1) Normalize() []Entry {
+	arr := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		arr = append(arr, o.Answer[i].normalize())
+	}
+	return arr
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:              o.Name,
+		EnableOnInterface: util.AsBool(o.EnableOnInterface),
+		Prefix:            o.Prefix != nil,
+		Anycast:           o.Anycast != nil,
+	}
+
+	if o.Advertise != nil {
+		ans.Advertise = util.AsBool(o.Advertise.Enable)
+		ans.ValidLifetime = o.Advertise.ValidLifetime
+		ans.PreferredLifetime = o.Advertise.PreferredLifetime
+		ans.OnlinkFlag = util.AsBool(o.Advertise.OnlinkFlag)
+		ans.AutoConfigFlag = util.AsBool(o.Advertise.AutoConfigFlag)
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName           xml.Name   `xml:"entry"`
+	Name              string     `xml:"name,attr"`
+	EnableOnInterface string     `xml:"enable-on-interface"`
+	Prefix            *string    `xml:"prefix"`
+	Anycast           *string    `xml:"anycast"`
+	Advertise         *advertise `xml:"advertise"`
+}
+
+type advertise struct {
+	Enable            string `xml:"enable"`
+	ValidLifetime     string `xml:"valid-lifetime,omitempty"`
+	PreferredLifetime string `xml:"preferred-lifetime,omitempty"`
+	OnlinkFlag        string `xml:"onlink-flag"`
+	AutoConfigFlag    string `xml:"auto-config-flag"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:              e.Name,
+		EnableOnInterface: util.YesNo(e.EnableOnInterface),
+	}
+
+	s := ""
+	if e.Prefix {
+		ans.Prefix = &s
+	}
+	if e.Anycast {
+		ans.Anycast = &s
+	}
+
+	if e.Advertise || e.ValidLifetime != "" || e.PreferredLifetime != "" || e.OnlinkFlag || e.AutoConfigFlag {
+		ans.Advertise = &advertise{
+			Enable:            util.YesNo(e.Advertise),
+			ValidLifetime:     e.ValidLifetime,
+			PreferredLifetime: e.PreferredLifetime,
+			OnlinkFlag:        util.YesNo(e.OnlinkFlag),
+			AutoConfigFlag:    util.YesNo(e.AutoConfigFlag),
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,43 @@
+package address
+
+type tc struct {
+	desc    string
+	iType   string
+	iName   string
+	subName string
+	conf    Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"eth no sub", TypeEthernet, "ethernet1/5", "", Entry{
+			Name:              "2001:db8:1::1/64",
+			EnableOnInterface: true,
+		}},
+		{"eth with sub and advertise", TypeEthernet, "ethernet1/5", "ethernet1/5.7", Entry{
+			Name:              "2001:db8:2::/64",
+			EnableOnInterface: true,
+			Prefix:            true,
+			Advertise:         true,
+			ValidLifetime:     "2592000",
+			PreferredLifetime: "604800",
+			OnlinkFlag:        true,
+			AutoConfigFlag:    true,
+		}},
+		{"agg no sub anycast", TypeAggregate, "ae5", "", Entry{
+			Name:    "2001:db8:3::1/64",
+			Anycast: true,
+		}},
+		{"agg with sub", TypeAggregate, "ae5", "ae5.4", Entry{
+			Name:              "2001:db8:4::1/64",
+			EnableOnInterface: true,
+			Advertise:         true,
+			ValidLifetime:     "infinity",
+			PreferredLifetime: "infinity",
+		}},
+		{"vlan", TypeVlan, "", "vlan.4", Entry{
+			Name:              "2001:db8:5::1/64",
+			EnableOnInterface: true,
+		}},
+	}
+}
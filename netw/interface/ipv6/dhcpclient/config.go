@@ -0,0 +1,181 @@
+package dhcpclient
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Config is a normalized, version independent representation of an
+// interface's DHCPv6 client config.
+//
+// The PrefixPool, PrefixLength, and PrefixLengthHint fields are only used if
+// PrefixDelegation is enabled.  NonTemporaryAddress and TemporaryAddress are
+// only used if RequestAddress is enabled.  The DHCPv6 client's neighbor
+// discovery settings are preserved as-is when the config is updated.
+type Config struct {
+	Enable                bool
+	AcceptRaRoute         bool
+	DefaultRouteMetric    int
+	Preference            string
+	PrefixDelegation      bool
+	PrefixPool            string
+	PrefixLength          int
+	PrefixLengthHint      bool
+	DuidType              string
+	RapidCommit           bool
+	SupportServerReconfig bool
+	RequestAddress        bool
+	NonTemporaryAddress   bool
+	TemporaryAddress      bool
+
+	raw map[string]string
+}
+
+// Copy copies the information from source Config `s` to this object.
+func (o *Config) Copy(s Config) {
+	o.Enable = s.Enable
+	o.AcceptRaRoute = s.AcceptRaRoute
+	o.DefaultRouteMetric = s.DefaultRouteMetric
+	o.Preference = s.Preference
+	o.PrefixDelegation = s.PrefixDelegation
+	o.PrefixPool = s.PrefixPool
+	o.PrefixLength = s.PrefixLength
+	o.PrefixLengthHint = s.PrefixLengthHint
+	o.DuidType = s.DuidType
+	o.RapidCommit = s.RapidCommit
+	o.SupportServerReconfig = s.SupportServerReconfig
+	o.RequestAddress = s.RequestAddress
+	o.NonTemporaryAddress = s.NonTemporaryAddress
+	o.TemporaryAddress = s.TemporaryAddress
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Config
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>dhcp-client"`
+}
+
+func (o *container_v1) Normalize() Config {
+	ans := Config{
+		Enable:             util.AsBool(o.Answer.Enable),
+		AcceptRaRoute:      util.AsBool(o.Answer.AcceptRaRoute),
+		DefaultRouteMetric: o.Answer.DefaultRouteMetric,
+		Preference:         o.Answer.Preference,
+	}
+
+	if o.Answer.Pd != nil && o.Answer.Pd.Yes != nil {
+		ans.PrefixDelegation = true
+		ans.PrefixPool = o.Answer.Pd.Yes.PrefixPool
+		ans.PrefixLength = o.Answer.Pd.Yes.PrefixLength
+		ans.PrefixLengthHint = util.AsBool(o.Answer.Pd.Yes.PrefixLengthHint)
+	}
+
+	if o.Answer.Options != nil {
+		ans.DuidType = o.Answer.Options.DuidType
+		ans.RapidCommit = util.AsBool(o.Answer.Options.RapidCommit)
+		ans.SupportServerReconfig = util.AsBool(o.Answer.Options.SupportServerReconfig)
+		if o.Answer.Options.Request != nil && o.Answer.Options.Request.Yes != nil {
+			ans.RequestAddress = true
+			ans.NonTemporaryAddress = util.AsBool(o.Answer.Options.Request.Yes.NonTemporaryAddress)
+			ans.TemporaryAddress = util.AsBool(o.Answer.Options.Request.Yes.TemporaryAddress)
+		}
+	}
+
+	if o.Answer.Nd != nil {
+		ans.raw = map[string]string{
+			"nd": util.CleanRawXml(o.Answer.Nd.Text),
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName            xml.Name     `xml:"dhcp-client"`
+	Enable             string       `xml:"enable"`
+	AcceptRaRoute      string       `xml:"accept-ra-route"`
+	DefaultRouteMetric int          `xml:"default-route-metric,omitempty"`
+	Preference         string       `xml:"preference,omitempty"`
+	Pd                 *pd          `xml:"prefix-delegation>enable"`
+	Options            *options     `xml:"v6-options"`
+	Nd                 *util.RawXml `xml:"neighbor-discovery"`
+}
+
+type pd struct {
+	Yes *pdYes  `xml:"yes"`
+	No  *string `xml:"no"`
+}
+
+type pdYes struct {
+	PrefixPool       string `xml:"pfx-pool-name,omitempty"`
+	PrefixLength     int    `xml:"prefix-len,omitempty"`
+	PrefixLengthHint string `xml:"prefix-len-hint"`
+}
+
+type options struct {
+	DuidType              string   `xml:"duid-type,omitempty"`
+	RapidCommit           string   `xml:"rapid-commit"`
+	SupportServerReconfig string   `xml:"support-srvr-reconfig"`
+	Request               *request `xml:"enable"`
+}
+
+type request struct {
+	Yes *requestYes `xml:"yes"`
+	No  *string     `xml:"no"`
+}
+
+type requestYes struct {
+	NonTemporaryAddress string `xml:"non-temp-addr"`
+	TemporaryAddress    string `xml:"temp-addr"`
+}
+
+func specify_v1(e Config) interface{} {
+	ans := entry_v1{
+		Enable:             util.YesNo(e.Enable),
+		AcceptRaRoute:      util.YesNo(e.AcceptRaRoute),
+		DefaultRouteMetric: e.DefaultRouteMetric,
+		Preference:         e.Preference,
+	}
+
+	s := ""
+	if e.PrefixDelegation {
+		ans.Pd = &pd{
+			Yes: &pdYes{
+				PrefixPool:       e.PrefixPool,
+				PrefixLength:     e.PrefixLength,
+				PrefixLengthHint: util.YesNo(e.PrefixLengthHint),
+			},
+		}
+	} else {
+		ans.Pd = &pd{No: &s}
+	}
+
+	if e.DuidType != "" || e.RapidCommit || e.SupportServerReconfig || e.RequestAddress {
+		ans.Options = &options{
+			DuidType:              e.DuidType,
+			RapidCommit:           util.YesNo(e.RapidCommit),
+			SupportServerReconfig: util.YesNo(e.SupportServerReconfig),
+		}
+		if e.RequestAddress {
+			ans.Options.Request = &request{
+				Yes: &requestYes{
+					NonTemporaryAddress: util.YesNo(e.NonTemporaryAddress),
+					TemporaryAddress:    util.YesNo(e.TemporaryAddress),
+				},
+			}
+		} else {
+			ans.Options.Request = &request{No: &s}
+		}
+	}
+
+	if text, present := e.raw["nd"]; present {
+		ans.Nd = &util.RawXml{text}
+	}
+
+	return ans
+}
@@ -0,0 +1,21 @@
+package dhcpclient
+
+// Valid values for the iType param.
+const (
+	TypeEthernet  = "ethernet"
+	TypeAggregate = "aggregate-ethernet"
+	TypeVlan      = "vlan"
+)
+
+// Valid values for Preference.
+const (
+	PreferenceHigh   = "high"
+	PreferenceMedium = "medium"
+	PreferenceLow    = "low"
+)
+
+// Valid values for DuidType.
+const (
+	DuidTypeLlt = "duid-type-llt"
+	DuidTypeLl  = "duid-type-ll"
+)
@@ -0,0 +1,16 @@
+/*
+Package dhcpclient is the client.Network.Ipv6DhcpClient namespace.
+
+This namespace manages the DHCPv6 client config of a layer3 interface.  When
+prefix delegation is enabled, the delegated prefix is stored in the prefix
+pool named by PrefixPool; downstream interfaces can then reference that pool
+using the client.Network.Ipv6InheritedAddress namespace.
+
+If you're configuring a VLAN interface, leave the iName param empty and
+specify the vlan name (eg: vlan.1) as the subName.
+
+PAN-OS 10.0+.
+
+Normalized object:  Config
+*/
+package dhcpclient
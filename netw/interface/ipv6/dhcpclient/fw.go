@@ -0,0 +1,120 @@
+package dhcpclient
+
+import (
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwDhcpClient is the client.Network.Ipv6DhcpClient namespace.
+type FwDhcpClient struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *FwDhcpClient) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Get performs GET to retrieve the DHCPv6 client config.
+func (c *FwDhcpClient) Get(iType, iName, subName string) (Config, error) {
+	c.con.LogQuery("(get) DHCPv6 client config for %s %q %q", iType, iName, subName)
+	return c.details(c.con.Get, iType, iName, subName)
+}
+
+// Show performs SHOW to retrieve the DHCPv6 client config.
+func (c *FwDhcpClient) Show(iType, iName, subName string) (Config, error) {
+	c.con.LogQuery("(show) DHCPv6 client config for %s %q %q", iType, iName, subName)
+	return c.details(c.con.Show, iType, iName, subName)
+}
+
+// Set performs SET to create / update the DHCPv6 client config.
+func (c *FwDhcpClient) Set(iType, iName, subName string, e Config) error {
+	var err error
+
+	if iType == "" {
+		return fmt.Errorf("iType must be specified")
+	}
+
+	_, fn := c.versioning()
+	c.con.LogAction("(set) DHCPv6 client config for %s %q %q", iType, iName, subName)
+	path := c.xpath(iType, iName, subName)
+	path = path[:len(path)-1]
+
+	_, err = c.con.Set(path, fn(e), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to create / update the DHCPv6 client config.
+func (c *FwDhcpClient) Edit(iType, iName, subName string, e Config) error {
+	var err error
+
+	if iType == "" {
+		return fmt.Errorf("iType must be specified")
+	}
+
+	_, fn := c.versioning()
+	c.con.LogAction("(edit) DHCPv6 client config for %s %q %q", iType, iName, subName)
+	path := c.xpath(iType, iName, subName)
+
+	_, err = c.con.Edit(path, fn(e), nil, nil)
+	return err
+}
+
+// Delete removes the DHCPv6 client config for the given interface.
+func (c *FwDhcpClient) Delete(iType, iName, subName string) error {
+	var err error
+
+	if iType == "" {
+		return fmt.Errorf("iType must be specified")
+	}
+
+	c.con.LogAction("(delete) DHCPv6 client config for %s %q %q", iType, iName, subName)
+
+	// Remove the objects.
+	path := c.xpath(iType, iName, subName)
+	_, err = c.con.Delete(path, nil, nil)
+	return err
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *FwDhcpClient) versioning() (normalizer, func(Config) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwDhcpClient) details(fn util.Retriever, iType, iName, subName string) (Config, error) {
+	path := c.xpath(iType, iName, subName)
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Config{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *FwDhcpClient) xpath(iType, iName, subName string) []string {
+	ans := make([]string, 0, 12)
+
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"network",
+		"interface",
+		iType,
+	)
+
+	if iType != TypeVlan {
+		ans = append(ans, util.AsEntryXpath([]string{iName}), "layer3")
+	}
+
+	if subName != "" {
+		ans = append(ans, "units", util.AsEntryXpath([]string{subName}))
+	}
+
+	ans = append(ans, "ipv6", "dhcp-client")
+
+	return ans
+}
@@ -0,0 +1,42 @@
+package dhcpclient
+
+type tc struct {
+	desc    string
+	iType   string
+	iName   string
+	subName string
+	conf    Config
+}
+
+func getTests() []tc {
+	return []tc{
+		{"eth basic", TypeEthernet, "ethernet1/1", "", Config{
+			Enable:             true,
+			AcceptRaRoute:      true,
+			DefaultRouteMetric: 10,
+			Preference:         PreferenceHigh,
+		}},
+		{"eth with prefix delegation", TypeEthernet, "ethernet1/1", "", Config{
+			Enable:           true,
+			PrefixDelegation: true,
+			PrefixPool:       "isp-pool",
+			PrefixLength:     48,
+			PrefixLengthHint: true,
+		}},
+		{"agg sub with v6 options", TypeAggregate, "ae1", "ae1.5", Config{
+			Enable:                true,
+			DuidType:              DuidTypeLl,
+			RapidCommit:           true,
+			SupportServerReconfig: true,
+			RequestAddress:        true,
+			NonTemporaryAddress:   true,
+		}},
+		{"vlan with raw nd", TypeVlan, "", "vlan.4", Config{
+			Enable:   true,
+			DuidType: DuidTypeLlt,
+			raw: map[string]string{
+				"nd": "<enable-dad>yes</enable-dad>",
+			},
+		}},
+	}
+}
@@ -0,0 +1,19 @@
+package inherited
+
+// Valid values for the iType param.
+const (
+	TypeEthernet  = "ethernet"
+	TypeAggregate = "aggregate-ethernet"
+	TypeVlan      = "vlan"
+)
+
+// Valid values for AddressType.
+const (
+	AddressTypeGua = "gua"
+	AddressTypeUla = "ula"
+)
+
+const (
+	singular = "inherited ipv6 address"
+	plural   = "inherited ipv6 addresses"
+)
@@ -0,0 +1,16 @@
+/*
+Package inherited is the client.Network.Ipv6InheritedAddress namespace.
+
+Inherited addresses are how a downstream interface is addressed from a prefix
+that was delegated to an upstream interface's DHCPv6 client.  Global unicast
+addresses reference the prefix pool configured in the
+client.Network.Ipv6DhcpClient namespace.
+
+If you're configuring a VLAN interface, leave the iName param empty and
+specify the vlan name (eg: vlan.1) as the subName.
+
+PAN-OS 10.0+.
+
+Normalized object:  Entry
+*/
+package inherited
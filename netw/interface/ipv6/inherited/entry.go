@@ -0,0 +1,144 @@
+package inherited
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an inherited
+// IPv6 address on an interface.
+//
+// PrefixPool is only used for AddressTypeGua, while Address is only used for
+// AddressTypeUla.  The pool type and router advertisement settings are
+// preserved as-is when the address is updated.
+type Entry struct {
+	Name              string
+	AddressType       string
+	EnableOnInterface bool
+	PrefixPool        string
+	Address           string
+
+	raw map[string]string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.AddressType = s.AddressType
+	o.EnableOnInterface = s.EnableOnInterface
+	o.PrefixPool = s.PrefixPool
+	o.Address = s.Address
+}
+
+/** Structs / functions for normalization. **/
+
+type normalizer interface {
+	Normalize() []Entry
+	Names() []string
+}
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *container_v1) Normalize() []Entry {
+	arr := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		arr = append(arr, o.Answer[i].normalize())
+	}
+	return arr
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name: o.Name,
+	}
+
+	raw := make(map[string]string)
+
+	switch {
+	case o.Gua != nil:
+		ans.AddressType = AddressTypeGua
+		ans.EnableOnInterface = util.AsBool(o.Gua.EnableOnInterface)
+		ans.PrefixPool = o.Gua.PrefixPool
+		if o.Gua.PoolType != nil {
+			raw["pt"] = util.CleanRawXml(o.Gua.PoolType.Text)
+		}
+		if o.Gua.Advertise != nil {
+			raw["adv"] = util.CleanRawXml(o.Gua.Advertise.Text)
+		}
+	case o.Ula != nil:
+		ans.AddressType = AddressTypeUla
+		ans.EnableOnInterface = util.AsBool(o.Ula.EnableOnInterface)
+		ans.Address = o.Ula.Address
+		if o.Ula.Advertise != nil {
+			raw["adv"] = util.CleanRawXml(o.Ula.Advertise.Text)
+		}
+	}
+
+	if len(raw) != 0 {
+		ans.raw = raw
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName xml.Name `xml:"entry"`
+	Name    string   `xml:"name,attr"`
+	Gua     *gua     `xml:"type>gua"`
+	Ula     *ula     `xml:"type>ula"`
+}
+
+type gua struct {
+	EnableOnInterface string       `xml:"enable-on-interface"`
+	PrefixPool        string       `xml:"prefix-pool,omitempty"`
+	PoolType          *util.RawXml `xml:"pool-type"`
+	Advertise         *util.RawXml `xml:"advertise"`
+}
+
+type ula struct {
+	EnableOnInterface string       `xml:"enable-on-interface"`
+	Address           string       `xml:"address,omitempty"`
+	Advertise         *util.RawXml `xml:"advertise"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name: e.Name,
+	}
+
+	switch e.AddressType {
+	case AddressTypeGua:
+		ans.Gua = &gua{
+			EnableOnInterface: util.YesNo(e.EnableOnInterface),
+			PrefixPool:        e.PrefixPool,
+		}
+		if text, present := e.raw["pt"]; present {
+			ans.Gua.PoolType = &util.RawXml{text}
+		}
+		if text, present := e.raw["adv"]; present {
+			ans.Gua.Advertise = &util.RawXml{text}
+		}
+	case AddressTypeUla:
+		ans.Ula = &ula{
+			EnableOnInterface: util.YesNo(e.EnableOnInterface),
+			Address:           e.Address,
+		}
+		if text, present := e.raw["adv"]; present {
+			ans.Ula.Advertise = &util.RawXml{text}
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,40 @@
+package inherited
+
+type tc struct {
+	desc    string
+	iType   string
+	iName   string
+	subName string
+	conf    Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"eth gua", TypeEthernet, "ethernet1/2", "", Entry{
+			Name:              "lan1",
+			AddressType:       AddressTypeGua,
+			EnableOnInterface: true,
+			PrefixPool:        "isp-pool",
+		}},
+		{"eth sub gua with raw", TypeEthernet, "ethernet1/2", "ethernet1/2.3", Entry{
+			Name:        "lan2",
+			AddressType: AddressTypeGua,
+			PrefixPool:  "isp-pool",
+			raw: map[string]string{
+				"pt":  "<dynamic/>",
+				"adv": "<enable>yes</enable>",
+			},
+		}},
+		{"agg ula", TypeAggregate, "ae1", "", Entry{
+			Name:              "lan3",
+			AddressType:       AddressTypeUla,
+			EnableOnInterface: true,
+			Address:           "fd00::1/64",
+		}},
+		{"vlan gua", TypeVlan, "", "vlan.4", Entry{
+			Name:        "lan4",
+			AddressType: AddressTypeGua,
+			PrefixPool:  "isp-pool",
+		}},
+	}
+}
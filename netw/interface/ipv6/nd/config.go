@@ -0,0 +1,250 @@
+package nd
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Config is a normalized, version independent representation of an
+// interface's IPv6 neighbor discovery config.
+//
+// The Ra fields are the router advertisement settings.  RaLinkMtu,
+// RaReachableTime, RaRetransmissionTimer, and RaHopLimit are either a number
+// or "unspecified".  Static neighbors are managed in the
+// client.Network.Ipv6Neighbor namespace, and are preserved as-is when the
+// config is updated.
+type Config struct {
+	EnableNdpMonitor         bool
+	EnableDad                bool
+	DadAttempts              int
+	NsInterval               int
+	ReachableTime            int
+	RaEnable                 bool
+	RaMinInterval            int
+	RaMaxInterval            int
+	RaLinkMtu                string
+	RaReachableTime          string
+	RaRetransmissionTimer    string
+	RaHopLimit               string
+	RaLifetime               int
+	RaRouterPreference       string
+	RaManagedFlag            bool
+	RaOtherFlag              bool
+	RaEnableConsistencyCheck bool
+	RaDnsSupport             bool
+	RaDnsServers             []DnsEntry
+	RaDnsSuffixes            []DnsEntry
+
+	raw map[string]string
+}
+
+// DnsEntry is a recursive DNS server or DNS search list suffix included in
+// router advertisements, along with its lifetime in seconds.
+type DnsEntry struct {
+	Name     string
+	Lifetime int
+}
+
+// Copy copies the information from source Config `s` to this object.
+func (o *Config) Copy(s Config) {
+	o.EnableNdpMonitor = s.EnableNdpMonitor
+	o.EnableDad = s.EnableDad
+	o.DadAttempts = s.DadAttempts
+	o.NsInterval = s.NsInterval
+	o.ReachableTime = s.ReachableTime
+	o.RaEnable = s.RaEnable
+	o.RaMinInterval = s.RaMinInterval
+	o.RaMaxInterval = s.RaMaxInterval
+	o.RaLinkMtu = s.RaLinkMtu
+	o.RaReachableTime = s.RaReachableTime
+	o.RaRetransmissionTimer = s.RaRetransmissionTimer
+	o.RaHopLimit = s.RaHopLimit
+	o.RaLifetime = s.RaLifetime
+	o.RaRouterPreference = s.RaRouterPreference
+	o.RaManagedFlag = s.RaManagedFlag
+	o.RaOtherFlag = s.RaOtherFlag
+	o.RaEnableConsistencyCheck = s.RaEnableConsistencyCheck
+	o.RaDnsSupport = s.RaDnsSupport
+	if s.RaDnsServers == nil {
+		o.RaDnsServers = nil
+	} else {
+		o.RaDnsServers = make([]DnsEntry, len(s.RaDnsServers))
+		copy(o.RaDnsServers, s.RaDnsServers)
+	}
+	if s.RaDnsSuffixes == nil {
+		o.RaDnsSuffixes = nil
+	} else {
+		o.RaDnsSuffixes = make([]DnsEntry, len(s.RaDnsSuffixes))
+		copy(o.RaDnsSuffixes, s.RaDnsSuffixes)
+	}
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Config
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>neighbor-discovery"`
+}
+
+func (o *container_v1) Normalize() Config {
+	ans := Config{
+		EnableNdpMonitor: util.AsBool(o.Answer.EnableNdpMonitor),
+		EnableDad:        util.AsBool(o.Answer.EnableDad),
+		DadAttempts:      o.Answer.DadAttempts,
+		NsInterval:       o.Answer.NsInterval,
+		ReachableTime:    o.Answer.ReachableTime,
+	}
+
+	if o.Answer.Ra != nil {
+		ra := o.Answer.Ra
+		ans.RaEnable = util.AsBool(ra.Enable)
+		ans.RaMinInterval = ra.MinInterval
+		ans.RaMaxInterval = ra.MaxInterval
+		ans.RaLinkMtu = ra.LinkMtu
+		ans.RaReachableTime = ra.ReachableTime
+		ans.RaRetransmissionTimer = ra.RetransmissionTimer
+		ans.RaHopLimit = ra.HopLimit
+		ans.RaLifetime = ra.Lifetime
+		ans.RaRouterPreference = ra.RouterPreference
+		ans.RaManagedFlag = util.AsBool(ra.ManagedFlag)
+		ans.RaOtherFlag = util.AsBool(ra.OtherFlag)
+		ans.RaEnableConsistencyCheck = util.AsBool(ra.EnableConsistencyCheck)
+
+		if ra.Dns != nil {
+			ans.RaDnsSupport = util.AsBool(ra.Dns.Enable)
+			ans.RaDnsServers = ra.Dns.Servers.normalize()
+			ans.RaDnsSuffixes = ra.Dns.Suffixes.normalize()
+		}
+	}
+
+	if o.Answer.Neighbor != nil {
+		ans.raw = map[string]string{
+			"neighbor": util.CleanRawXml(o.Answer.Neighbor.Text),
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName          xml.Name     `xml:"neighbor-discovery"`
+	EnableNdpMonitor string       `xml:"enable-ndp-monitor"`
+	EnableDad        string       `xml:"enable-dad"`
+	DadAttempts      int          `xml:"dad-attempts,omitempty"`
+	NsInterval       int          `xml:"ns-interval,omitempty"`
+	ReachableTime    int          `xml:"reachable-time,omitempty"`
+	Ra               *ra          `xml:"router-advertisement"`
+	Neighbor         *util.RawXml `xml:"neighbor"`
+}
+
+type ra struct {
+	Enable                 string `xml:"enable"`
+	MinInterval            int    `xml:"min-interval,omitempty"`
+	MaxInterval            int    `xml:"max-interval,omitempty"`
+	LinkMtu                string `xml:"link-mtu,omitempty"`
+	ReachableTime          string `xml:"reachable-time,omitempty"`
+	RetransmissionTimer    string `xml:"retransmission-timer,omitempty"`
+	HopLimit               string `xml:"hop-limit,omitempty"`
+	Lifetime               int    `xml:"lifetime,omitempty"`
+	RouterPreference       string `xml:"router-preference,omitempty"`
+	ManagedFlag            string `xml:"managed-flag"`
+	OtherFlag              string `xml:"other-flag"`
+	EnableConsistencyCheck string `xml:"enable-consistency-check"`
+	Dns                    *dns   `xml:"dns-support"`
+}
+
+type dns struct {
+	Enable   string    `xml:"enable"`
+	Servers  *dnsEntry `xml:"server"`
+	Suffixes *dnsEntry `xml:"suffix"`
+}
+
+type dnsEntry struct {
+	Entries []dnsItem `xml:"entry"`
+}
+
+type dnsItem struct {
+	Name     string `xml:"name,attr"`
+	Lifetime int    `xml:"lifetime,omitempty"`
+}
+
+func (o *dnsEntry) normalize() []DnsEntry {
+	if o == nil {
+		return nil
+	}
+
+	ans := make([]DnsEntry, 0, len(o.Entries))
+	for _, x := range o.Entries {
+		ans = append(ans, DnsEntry{
+			Name:     x.Name,
+			Lifetime: x.Lifetime,
+		})
+	}
+
+	return ans
+}
+
+func specifyDnsEntry(list []DnsEntry) *dnsEntry {
+	if len(list) == 0 {
+		return nil
+	}
+
+	ans := &dnsEntry{
+		Entries: make([]dnsItem, 0, len(list)),
+	}
+	for _, x := range list {
+		ans.Entries = append(ans.Entries, dnsItem{
+			Name:     x.Name,
+			Lifetime: x.Lifetime,
+		})
+	}
+
+	return ans
+}
+
+func specify_v1(e Config) interface{} {
+	ans := entry_v1{
+		EnableNdpMonitor: util.YesNo(e.EnableNdpMonitor),
+		EnableDad:        util.YesNo(e.EnableDad),
+		DadAttempts:      e.DadAttempts,
+		NsInterval:       e.NsInterval,
+		ReachableTime:    e.ReachableTime,
+	}
+
+	if e.RaEnable || e.RaMinInterval != 0 || e.RaMaxInterval != 0 || e.RaLinkMtu != "" || e.RaReachableTime != "" || e.RaRetransmissionTimer != "" || e.RaHopLimit != "" || e.RaLifetime != 0 || e.RaRouterPreference != "" || e.RaManagedFlag || e.RaOtherFlag || e.RaEnableConsistencyCheck || e.RaDnsSupport || len(e.RaDnsServers) > 0 || len(e.RaDnsSuffixes) > 0 {
+		r := ra{
+			Enable:                 util.YesNo(e.RaEnable),
+			MinInterval:            e.RaMinInterval,
+			MaxInterval:            e.RaMaxInterval,
+			LinkMtu:                e.RaLinkMtu,
+			ReachableTime:          e.RaReachableTime,
+			RetransmissionTimer:    e.RaRetransmissionTimer,
+			HopLimit:               e.RaHopLimit,
+			Lifetime:               e.RaLifetime,
+			RouterPreference:       e.RaRouterPreference,
+			ManagedFlag:            util.YesNo(e.RaManagedFlag),
+			OtherFlag:              util.YesNo(e.RaOtherFlag),
+			EnableConsistencyCheck: util.YesNo(e.RaEnableConsistencyCheck),
+		}
+
+		if e.RaDnsSupport || len(e.RaDnsServers) > 0 || len(e.RaDnsSuffixes) > 0 {
+			r.Dns = &dns{
+				Enable:   util.YesNo(e.RaDnsSupport),
+				Servers:  specifyDnsEntry(e.RaDnsServers),
+				Suffixes: specifyDnsEntry(e.RaDnsSuffixes),
+			}
+		}
+
+		ans.Ra = &r
+	}
+
+	if text, present := e.raw["neighbor"]; present {
+		ans.Neighbor = &util.RawXml{text}
+	}
+
+	return ans
+}
@@ -0,0 +1,15 @@
+package nd
+
+// Valid values for the iType param.
+const (
+	TypeEthernet  = "ethernet"
+	TypeAggregate = "aggregate-ethernet"
+	TypeVlan      = "vlan"
+)
+
+// Valid values for RaRouterPreference.
+const (
+	RouterPreferenceHigh   = "High"
+	RouterPreferenceMedium = "Medium"
+	RouterPreferenceLow    = "Low"
+)
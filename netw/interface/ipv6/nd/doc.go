@@ -0,0 +1,13 @@
+/*
+Package nd is the client.Network.Ipv6NeighborDiscovery namespace.
+
+This namespace manages the IPv6 neighbor discovery and router advertisement
+settings of a layer3 interface.  The prefixes that are advertised are
+configured per address in the client.Network.Ipv6Address namespace.
+
+If you're configuring a VLAN interface, leave the iName param empty and
+specify the vlan name (eg: vlan.1) as the subName.
+
+Normalized object:  Config
+*/
+package nd
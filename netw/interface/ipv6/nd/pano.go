@@ -0,0 +1,127 @@
+package nd
+
+import (
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PanoNd is the client.Network.Ipv6NeighborDiscovery namespace.
+type PanoNd struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *PanoNd) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Get performs GET to retrieve the neighbor discovery config.
+func (c *PanoNd) Get(tmpl, ts, iType, iName, subName string) (Config, error) {
+	c.con.LogQuery("(get) neighbor discovery config for %s %q %q", iType, iName, subName)
+	return c.details(c.con.Get, tmpl, ts, iType, iName, subName)
+}
+
+// Show performs SHOW to retrieve the neighbor discovery config.
+func (c *PanoNd) Show(tmpl, ts, iType, iName, subName string) (Config, error) {
+	c.con.LogQuery("(show) neighbor discovery config for %s %q %q", iType, iName, subName)
+	return c.details(c.con.Show, tmpl, ts, iType, iName, subName)
+}
+
+// Set performs SET to create / update the neighbor discovery config.
+func (c *PanoNd) Set(tmpl, ts, iType, iName, subName string, e Config) error {
+	var err error
+
+	if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	} else if iType == "" {
+		return fmt.Errorf("iType must be specified")
+	}
+
+	_, fn := c.versioning()
+	c.con.LogAction("(set) neighbor discovery config for %s %q %q", iType, iName, subName)
+	path := c.xpath(tmpl, ts, iType, iName, subName)
+	path = path[:len(path)-1]
+
+	_, err = c.con.Set(path, fn(e), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to create / update the neighbor discovery config.
+func (c *PanoNd) Edit(tmpl, ts, iType, iName, subName string, e Config) error {
+	var err error
+
+	if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	} else if iType == "" {
+		return fmt.Errorf("iType must be specified")
+	}
+
+	_, fn := c.versioning()
+	c.con.LogAction("(edit) neighbor discovery config for %s %q %q", iType, iName, subName)
+	path := c.xpath(tmpl, ts, iType, iName, subName)
+
+	_, err = c.con.Edit(path, fn(e), nil, nil)
+	return err
+}
+
+// Delete removes the neighbor discovery config for the given interface.
+func (c *PanoNd) Delete(tmpl, ts, iType, iName, subName string) error {
+	var err error
+
+	if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	} else if iType == "" {
+		return fmt.Errorf("iType must be specified")
+	}
+
+	c.con.LogAction("(delete) neighbor discovery config for %s %q %q", iType, iName, subName)
+
+	// Remove the objects.
+	path := c.xpath(tmpl, ts, iType, iName, subName)
+	_, err = c.con.Delete(path, nil, nil)
+	return err
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *PanoNd) versioning() (normalizer, func(Config) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *PanoNd) details(fn util.Retriever, tmpl, ts, iType, iName, subName string) (Config, error) {
+	path := c.xpath(tmpl, ts, iType, iName, subName)
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Config{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *PanoNd) xpath(tmpl, ts, iType, iName, subName string) []string {
+	ans := make([]string, 0, 17)
+
+	ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"network",
+		"interface",
+		iType,
+	)
+
+	if iType != TypeVlan {
+		ans = append(ans, util.AsEntryXpath([]string{iName}), "layer3")
+	}
+
+	if subName != "" {
+		ans = append(ans, "units", util.AsEntryXpath([]string{subName}))
+	}
+
+	ans = append(ans, "ipv6", "neighbor-discovery")
+
+	return ans
+}
@@ -0,0 +1,54 @@
+package nd
+
+type tc struct {
+	desc    string
+	iType   string
+	iName   string
+	subName string
+	conf    Config
+}
+
+func getTests() []tc {
+	return []tc{
+		{"eth nd only", TypeEthernet, "ethernet1/5", "", Config{
+			EnableNdpMonitor: true,
+			EnableDad:        true,
+			DadAttempts:      3,
+			NsInterval:       2,
+			ReachableTime:    45,
+		}},
+		{"eth sub with ra", TypeEthernet, "ethernet1/5", "ethernet1/5.7", Config{
+			EnableDad:                true,
+			DadAttempts:              1,
+			RaEnable:                 true,
+			RaMinInterval:            200,
+			RaMaxInterval:            600,
+			RaLinkMtu:                "unspecified",
+			RaReachableTime:          "unspecified",
+			RaRetransmissionTimer:    "unspecified",
+			RaHopLimit:               "64",
+			RaLifetime:               1800,
+			RaRouterPreference:       RouterPreferenceHigh,
+			RaManagedFlag:            true,
+			RaOtherFlag:              true,
+			RaEnableConsistencyCheck: true,
+		}},
+		{"agg with ra dns", TypeAggregate, "ae5", "", Config{
+			RaEnable:     true,
+			RaDnsSupport: true,
+			RaDnsServers: []DnsEntry{
+				{Name: "2001:db8::53", Lifetime: 1200},
+				{Name: "2001:db8::54"},
+			},
+			RaDnsSuffixes: []DnsEntry{
+				{Name: "example.com", Lifetime: 1200},
+			},
+		}},
+		{"vlan with raw neighbors", TypeVlan, "", "vlan.4", Config{
+			EnableDad: true,
+			raw: map[string]string{
+				"neighbor": "<entry name=\"2001:db8::2\"><hw-address>00:30:48:52:ab:c1</hw-address></entry>",
+			},
+		}},
+	}
+}
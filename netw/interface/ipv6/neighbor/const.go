@@ -0,0 +1,13 @@
+package neighbor
+
+// Valid values for the iType param.
+const (
+	TypeEthernet  = "ethernet"
+	TypeAggregate = "aggregate-ethernet"
+	TypeVlan      = "vlan"
+)
+
+const (
+	singular = "ipv6 neighbor"
+	plural   = "ipv6 neighbors"
+)
@@ -0,0 +1,12 @@
+/*
+Package neighbor is the client.Network.Ipv6Neighbor namespace.
+
+This namespace manages the static IPv6 neighbors of a layer3 interface.  This
+is the IPv6 counterpart of the static ARP entries found in the arp namespace.
+
+If you're configuring neighbors for a VLAN interface, leave the iName param
+empty and specify the vlan name (eg: vlan.1) as the subName.
+
+Normalized object:  Entry
+*/
+package neighbor
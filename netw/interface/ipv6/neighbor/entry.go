@@ -0,0 +1,70 @@
+package neighbor
+
+import (
+	"encoding/xml"
+)
+
+// Entry is a normalized, version independent representation of a static IPv6
+// neighbor.
+type Entry struct {
+	Ip         string
+	MacAddress string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Ip field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.MacAddress = s.MacAddress
+}
+
+/** Structs / functions for normalization. **/
+
+type normalizer interface {
+	Normalize() []Entry
+	Names() []string
+}
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Ip)
+	}
+
+	return ans
+}
+
+func (o *container_v1) Normalize() []Entry {
+	arr := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		arr = append(arr, o.Answer[i].normalize())
+	}
+	return arr
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Ip:         o.Ip,
+		MacAddress: o.MacAddress,
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName    xml.Name `xml:"entry"`
+	Ip         string   `xml:"name,attr"`
+	MacAddress string   `xml:"hw-address"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Ip:         e.Ip,
+		MacAddress: e.MacAddress,
+	}
+
+	return ans
+}
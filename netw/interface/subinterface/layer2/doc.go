@@ -1,4 +1,12 @@
 // Package layer2 is the client.Network.Layer2Subinterface namespace.
 //
+// Both layer2 and virtual wire subinterfaces are managed with this namespace,
+// as chosen by the mType param.
+//
+// The parent interface is given by the iType and eth params, where iType is
+// either EthernetInterface or AggregateInterface.  Set and Edit verify that
+// each subinterface is named after its parent (such as "ethernet1/1.5" for
+// a parent of "ethernet1/1"), but do not verify that the parent exists.
+//
 // Normalized object:  Entry
 package layer2
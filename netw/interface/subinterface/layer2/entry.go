@@ -2,6 +2,9 @@ package layer2
 
 import (
 	"encoding/xml"
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
 )
 
 // Entry is a normalized, version independent representation of a layer2
@@ -79,3 +82,28 @@ func specify_v1(e Entry) interface{} {
 
 	return ans
 }
+
+// validateNames checks that the parent interface params are valid and that
+// each subinterface is named after its parent interface.  The parent interface
+// itself is not retrieved, so its existence is left to PAN-OS to verify.
+func validateNames(iType, eth, mType string, e ...Entry) error {
+	switch iType {
+	case EthernetInterface, AggregateInterface:
+	default:
+		return fmt.Errorf("invalid iType: %q", iType)
+	}
+
+	switch mType {
+	case Layer2, VirtualWire:
+	default:
+		return fmt.Errorf("invalid mType: %q", mType)
+	}
+
+	for i := range e {
+		if !util.ValidSubinterfaceName(eth, e[i].Name) {
+			return fmt.Errorf("%s %q is not a subinterface of %q", singular, e[i].Name, eth)
+		}
+	}
+
+	return nil
+}
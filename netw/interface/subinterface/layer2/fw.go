@@ -86,6 +86,10 @@ func (c *FwLayer2) Set(iType, eth, mType, vsys string, e ...Entry) error {
 		return fmt.Errorf("mType must be specified")
 	}
 
+	if err = validateNames(iType, eth, mType, e...); err != nil {
+		return err
+	}
+
 	_, fn := c.versioning()
 	names := make([]string, len(e))
 
@@ -132,6 +136,10 @@ func (c *FwLayer2) Edit(iType, eth, mType, vsys string, e Entry) error {
 		return fmt.Errorf("mType must be specified")
 	}
 
+	if err = validateNames(iType, eth, mType, e); err != nil {
+		return err
+	}
+
 	_, fn := c.versioning()
 
 	c.con.LogAction("(edit) %s %q", singular, e.Name)
@@ -42,3 +42,38 @@ func TestFwNormalization(t *testing.T) {
 		})
 	}
 }
+
+func TestFwInvalidParent(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		iType string
+		eth   string
+		mType string
+		name  string
+	}{
+		{"invalid iType", "loopback", "ethernet1/1", Layer2, "ethernet1/1.2"},
+		{"invalid mType", EthernetInterface, "ethernet1/1", "layer3", "ethernet1/1.2"},
+		{"different parent", EthernetInterface, "ethernet1/1", Layer2, "ethernet1/2.2"},
+		{"missing tag suffix", AggregateInterface, "ae1", VirtualWire, "ae1."},
+	}
+
+	mc := &testdata.MockClient{}
+	ns := &FwLayer2{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			e := Entry{Name: tc.name, Tag: 2}
+			if err := ns.Set(tc.iType, tc.eth, tc.mType, "vsys1", e); err == nil {
+				t.Errorf("Set did not return an error")
+			}
+			if err := ns.Edit(tc.iType, tc.eth, tc.mType, "vsys1", e); err == nil {
+				t.Errorf("Edit did not return an error")
+			}
+			if mc.Function != "" {
+				t.Errorf("Function is %q, not empty", mc.Function)
+			}
+		})
+	}
+}
@@ -88,6 +88,10 @@ func (c *PanoLayer2) Set(tmpl, ts, iType, eth, mType, vsys string, e ...Entry) e
 		return fmt.Errorf("tmpl or ts must be specified")
 	}
 
+	if err = validateNames(iType, eth, mType, e...); err != nil {
+		return err
+	}
+
 	_, fn := c.versioning()
 	names := make([]string, len(e))
 
@@ -136,6 +140,10 @@ func (c *PanoLayer2) Edit(tmpl, ts, iType, eth, mType, vsys string, e Entry) err
 		return fmt.Errorf("tmpl or ts must be specified")
 	}
 
+	if err = validateNames(iType, eth, mType, e); err != nil {
+		return err
+	}
+
 	_, fn := c.versioning()
 
 	c.con.LogAction("(edit) %s %q", singular, e.Name)
@@ -2,6 +2,7 @@ package layer3
 
 import (
 	"encoding/xml"
+	"fmt"
 
 	"github.com/PaloAltoNetworks/pango/util"
 )
@@ -101,6 +102,12 @@ func (o *entry_v1) normalize() Entry {
 		if o.Ipv6.Neighbor != nil {
 			ans.raw["v6nbr"] = util.CleanRawXml(o.Ipv6.Neighbor.Text)
 		}
+		if o.Ipv6.DhcpClient != nil {
+			ans.raw["v6dhcp"] = util.CleanRawXml(o.Ipv6.DhcpClient.Text)
+		}
+		if o.Ipv6.Inherited != nil {
+			ans.raw["v6inh"] = util.CleanRawXml(o.Ipv6.Inherited.Text)
+		}
 	}
 
 	if o.Mss != nil {
@@ -149,6 +156,8 @@ type ipv6 struct {
 	Ipv6InterfaceId string       `xml:"interface-id,omitempty"`
 	Addresses       *util.RawXml `xml:"address"`
 	Neighbor        *util.RawXml `xml:"neighbor-discovery"`
+	DhcpClient      *util.RawXml `xml:"dhcp-client"`
+	Inherited       *util.RawXml `xml:"inherited"`
 }
 
 type mss struct {
@@ -207,6 +216,12 @@ func (o *entry_v2) normalize() Entry {
 		if o.Ipv6.Neighbor != nil {
 			ans.raw["v6nbr"] = util.CleanRawXml(o.Ipv6.Neighbor.Text)
 		}
+		if o.Ipv6.DhcpClient != nil {
+			ans.raw["v6dhcp"] = util.CleanRawXml(o.Ipv6.DhcpClient.Text)
+		}
+		if o.Ipv6.Inherited != nil {
+			ans.raw["v6inh"] = util.CleanRawXml(o.Ipv6.Inherited.Text)
+		}
 	}
 
 	if o.Mss != nil {
@@ -278,6 +293,12 @@ func (o *entry_v3) normalize() Entry {
 		if o.Ipv6.Neighbor != nil {
 			ans.raw["v6nbr"] = util.CleanRawXml(o.Ipv6.Neighbor.Text)
 		}
+		if o.Ipv6.DhcpClient != nil {
+			ans.raw["v6dhcp"] = util.CleanRawXml(o.Ipv6.DhcpClient.Text)
+		}
+		if o.Ipv6.Inherited != nil {
+			ans.raw["v6inh"] = util.CleanRawXml(o.Ipv6.Inherited.Text)
+		}
 	}
 
 	if o.Mss != nil {
@@ -371,7 +392,9 @@ func specify_v1(e Entry) interface{} {
 
 	v6adr := e.raw["v6adr"]
 	v6nbr := e.raw["v6nbr"]
-	if e.Ipv6Enabled || e.Ipv6InterfaceId != "" || v6adr != "" || v6nbr != "" {
+	v6dhcp := e.raw["v6dhcp"]
+	v6inh := e.raw["v6inh"]
+	if e.Ipv6Enabled || e.Ipv6InterfaceId != "" || v6adr != "" || v6nbr != "" || v6dhcp != "" || v6inh != "" {
 		i6 := ipv6{
 			Ipv6Enabled:     util.YesNo(e.Ipv6Enabled),
 			Ipv6InterfaceId: e.Ipv6InterfaceId,
@@ -383,6 +406,12 @@ func specify_v1(e Entry) interface{} {
 		if v6nbr != "" {
 			i6.Neighbor = &util.RawXml{v6nbr}
 		}
+		if v6dhcp != "" {
+			i6.DhcpClient = &util.RawXml{v6dhcp}
+		}
+		if v6inh != "" {
+			i6.Inherited = &util.RawXml{v6inh}
+		}
 		ans.Ipv6 = &i6
 	}
 
@@ -429,7 +458,9 @@ func specify_v2(e Entry) interface{} {
 
 	v6adr := e.raw["v6adr"]
 	v6nbr := e.raw["v6nbr"]
-	if e.Ipv6Enabled || e.Ipv6InterfaceId != "" || v6adr != "" || v6nbr != "" {
+	v6dhcp := e.raw["v6dhcp"]
+	v6inh := e.raw["v6inh"]
+	if e.Ipv6Enabled || e.Ipv6InterfaceId != "" || v6adr != "" || v6nbr != "" || v6dhcp != "" || v6inh != "" {
 		i6 := ipv6{
 			Ipv6Enabled:     util.YesNo(e.Ipv6Enabled),
 			Ipv6InterfaceId: e.Ipv6InterfaceId,
@@ -441,6 +472,12 @@ func specify_v2(e Entry) interface{} {
 		if v6nbr != "" {
 			i6.Neighbor = &util.RawXml{v6nbr}
 		}
+		if v6dhcp != "" {
+			i6.DhcpClient = &util.RawXml{v6dhcp}
+		}
+		if v6inh != "" {
+			i6.Inherited = &util.RawXml{v6inh}
+		}
 		ans.Ipv6 = &i6
 	}
 
@@ -487,7 +524,9 @@ func specify_v3(e Entry) interface{} {
 
 	v6adr := e.raw["v6adr"]
 	v6nbr := e.raw["v6nbr"]
-	if e.Ipv6Enabled || e.Ipv6InterfaceId != "" || v6adr != "" || v6nbr != "" {
+	v6dhcp := e.raw["v6dhcp"]
+	v6inh := e.raw["v6inh"]
+	if e.Ipv6Enabled || e.Ipv6InterfaceId != "" || v6adr != "" || v6nbr != "" || v6dhcp != "" || v6inh != "" {
 		i6 := ipv6{
 			Ipv6Enabled:     util.YesNo(e.Ipv6Enabled),
 			Ipv6InterfaceId: e.Ipv6InterfaceId,
@@ -499,6 +538,12 @@ func specify_v3(e Entry) interface{} {
 		if v6nbr != "" {
 			i6.Neighbor = &util.RawXml{v6nbr}
 		}
+		if v6dhcp != "" {
+			i6.DhcpClient = &util.RawXml{v6dhcp}
+		}
+		if v6inh != "" {
+			i6.Inherited = &util.RawXml{v6inh}
+		}
 		ans.Ipv6 = &i6
 	}
 
@@ -535,3 +580,22 @@ func specify_v3(e Entry) interface{} {
 
 	return ans
 }
+
+// validateNames checks that the parent interface params are valid and that
+// each subinterface is named after its parent interface.  The parent interface
+// itself is not retrieved, so its existence is left to PAN-OS to verify.
+func validateNames(iType, eth string, e ...Entry) error {
+	switch iType {
+	case EthernetInterface, AggregateInterface:
+	default:
+		return fmt.Errorf("invalid iType: %q", iType)
+	}
+
+	for i := range e {
+		if !util.ValidSubinterfaceName(eth, e[i].Name) {
+			return fmt.Errorf("%s %q is not a subinterface of %q", singular, e[i].Name, eth)
+		}
+	}
+
+	return nil
+}
@@ -85,6 +85,10 @@ func (c *FwLayer3) Set(iType, eth, vsys string, e ...Entry) error {
 		return fmt.Errorf("eth must be specified")
 	}
 
+	if err = validateNames(iType, eth, e...); err != nil {
+		return err
+	}
+
 	_, fn := c.versioning()
 	names := make([]string, len(e))
 
@@ -129,6 +133,10 @@ func (c *FwLayer3) Edit(iType, eth, vsys string, e Entry) error {
 		return fmt.Errorf("eth must be specified")
 	}
 
+	if err = validateNames(iType, eth, e); err != nil {
+		return err
+	}
+
 	_, fn := c.versioning()
 
 	c.con.LogAction("(edit) %s %q", singular, e.Name)
@@ -42,3 +42,36 @@ func TestFwNormalization(t *testing.T) {
 		})
 	}
 }
+
+func TestFwInvalidParent(t *testing.T) {
+	testCases := []struct {
+		desc  string
+		iType string
+		eth   string
+		name  string
+	}{
+		{"invalid iType", "loopback", "ethernet1/1", "ethernet1/1.2"},
+		{"different parent", EthernetInterface, "ethernet1/1", "ethernet1/2.2"},
+		{"missing tag suffix", AggregateInterface, "ae1", "ae1."},
+	}
+
+	mc := &testdata.MockClient{}
+	ns := &FwLayer3{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			e := Entry{Name: tc.name, Tag: 2}
+			if err := ns.Set(tc.iType, tc.eth, "vsys1", e); err == nil {
+				t.Errorf("Set did not return an error")
+			}
+			if err := ns.Edit(tc.iType, tc.eth, "vsys1", e); err == nil {
+				t.Errorf("Edit did not return an error")
+			}
+			if mc.Function != "" {
+				t.Errorf("Function is %q, not empty", mc.Function)
+			}
+		})
+	}
+}
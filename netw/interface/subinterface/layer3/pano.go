@@ -87,6 +87,10 @@ func (c *PanoLayer3) Set(tmpl, ts, iType, eth, vsys string, e ...Entry) error {
 		return fmt.Errorf("tmpl or ts must be specified")
 	}
 
+	if err = validateNames(iType, eth, e...); err != nil {
+		return err
+	}
+
 	_, fn := c.versioning()
 	names := make([]string, len(e))
 
@@ -133,6 +137,10 @@ func (c *PanoLayer3) Edit(tmpl, ts, iType, eth, vsys string, e Entry) error {
 		return fmt.Errorf("tmpl or ts must be specified")
 	}
 
+	if err = validateNames(iType, eth, e); err != nil {
+		return err
+	}
+
 	_, fn := c.versioning()
 
 	c.con.LogAction("(edit) %s %q", singular, e.Name)
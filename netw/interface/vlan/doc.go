@@ -1,4 +1,8 @@
 // Package vlan is the client.Network.VlanInterface namespace.
 //
+// VLAN interfaces are layer3 interfaces named "vlan" or "vlan.N" that are
+// bound to a VLAN by the VlanInterface field of the client.Network.Vlan
+// Entry.
+//
 // Normalized object:  Entry
 package vlan
@@ -4,6 +4,7 @@ import (
 	"encoding/xml"
 
 	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
 )
 
 const (
@@ -35,7 +36,14 @@ const (
 	MkAuthTypeNone   = "none"
 )
 
-// Entry is a normalized, version independent representation of an IKE gateway.
+// Entry is a normalized, version independent representation of an IPSec
+// tunnel.
+//
+// The TunnelMonitor fields control tunnel failover:  TunnelMonitorProfile is
+// the name of a monitor profile (client.Network.MonitorProfile) whose action
+// is taken when TunnelMonitorDestinationIp stops responding, and
+// TunnelMonitorProxyId optionally names one of this tunnel's proxy IDs to
+// monitor.
 type Entry struct {
 	Name                       string
 	TunnelInterface            string
@@ -71,7 +79,7 @@ type Entry struct {
 	EnableTunnelMonitor        bool
 	TunnelMonitorDestinationIp string
 	TunnelMonitorSourceIp      string
-	TunnelMonitorProxyId       string
+	TunnelMonitorProxyId       string // 7.0+
 	TunnelMonitorProfile       string
 	Disabled                   bool
 
@@ -119,6 +127,15 @@ func (o *Entry) Copy(s Entry) {
 	o.Disabled = s.Disabled
 }
 
+// Audit returns an error if this object uses fields that the given PAN-OS
+// version does not support.
+func (o *Entry) Audit(v version.Number) error {
+	a := util.NewAuditor(o.Name, v)
+	a.Since("TunnelMonitorProxyId", o.TunnelMonitorProxyId != "", version.Number{7, 0, 0, ""})
+
+	return a.Err()
+}
+
 // SpecifyEncryption takes normalized encryption values and changes them to the
 // version specific values PAN-OS will be expecting.
 //
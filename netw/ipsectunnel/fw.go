@@ -54,6 +54,12 @@ func (c *FwIpsecTunnel) Set(e ...Entry) error {
 		return nil
 	}
 
+	for i := range e {
+		if err = e[i].Audit(c.con.Versioning()); err != nil {
+			return err
+		}
+	}
+
 	_, fn, vint := c.versioning()
 	names := make([]string, len(e))
 
@@ -86,6 +92,10 @@ func (c *FwIpsecTunnel) Set(e ...Entry) error {
 func (c *FwIpsecTunnel) Edit(e Entry) error {
 	var err error
 
+	if err = e.Audit(c.con.Versioning()); err != nil {
+		return err
+	}
+
 	_, fn, vint := c.versioning()
 
 	c.con.LogAction("(edit) ipsec tunnel %q", e.Name)
@@ -56,6 +56,12 @@ func (c *PanoIpsecTunnel) Set(tmpl, ts string, e ...Entry) error {
 		return fmt.Errorf("tmpl or ts must be specified")
 	}
 
+	for i := range e {
+		if err = e[i].Audit(c.con.Versioning()); err != nil {
+			return err
+		}
+	}
+
 	_, fn, vint := c.versioning()
 	names := make([]string, len(e))
 
@@ -92,6 +98,10 @@ func (c *PanoIpsecTunnel) Edit(tmpl, ts string, e Entry) error {
 		return fmt.Errorf("tmpl or ts must be specified")
 	}
 
+	if err = e.Audit(c.con.Versioning()); err != nil {
+		return err
+	}
+
 	_, fn, vint := c.versioning()
 
 	c.con.LogAction("(edit) ipsec tunnel %q", e.Name)
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/PaloAltoNetworks/pango/testdata"
+	"github.com/PaloAltoNetworks/pango/util"
 	"github.com/PaloAltoNetworks/pango/version"
 )
 
@@ -472,6 +473,17 @@ func TestPanoNormalization(t *testing.T) {
 			TunnelMonitorSourceIp:      "192.168.1.1",
 			TunnelMonitorProfile:       "tun prof",
 		}},
+		{"v3 tunnel monitor proxy id", version.Number{8, 0, 0, ""}, Entry{
+			Name:                       "monitored",
+			TunnelInterface:            "tunnel.2",
+			Type:                       TypeAutoKey,
+			AkIkeGateway:               "my gateway",
+			AkIpsecCryptoProfile:       "my profile",
+			EnableTunnelMonitor:        true,
+			TunnelMonitorDestinationIp: "10.2.2.2",
+			TunnelMonitorProxyId:       "proxy1",
+			TunnelMonitorProfile:       "failover",
+		}},
 		{"v3 manual key esp md5", version.Number{8, 0, 0, ""}, Entry{
 			Name:                       "manual key esp md5",
 			TunnelInterface:            "tunnel.1",
@@ -709,3 +721,31 @@ func TestPanoNormalization(t *testing.T) {
 		})
 	}
 }
+
+func TestPanoAuditUnsupportedFields(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.Version = version.Number{6, 1, 0, ""}
+	ns := &PanoIpsecTunnel{}
+	ns.Initialize(mc)
+
+	e := Entry{
+		Name:                       "t1",
+		TunnelInterface:            "tunnel.1",
+		Type:                       TypeAutoKey,
+		EnableTunnelMonitor:        true,
+		TunnelMonitorDestinationIp: "10.1.1.1",
+		TunnelMonitorProxyId:       "proxy1",
+	}
+
+	if err := ns.Set("some template", "", e); err == nil {
+		t.Errorf("Set did not return an error")
+	} else if _, ok := err.(util.AuditError); !ok {
+		t.Errorf("Set returned %T, not util.AuditError", err)
+	}
+	if err := ns.Edit("some template", "", e); err == nil {
+		t.Errorf("Edit did not return an error")
+	}
+	if mc.Function != "" {
+		t.Errorf("Function is %q, not empty", mc.Function)
+	}
+}
@@ -1,10 +1,26 @@
 package netw
 
 import (
+	"github.com/PaloAltoNetworks/pango/netw/dhcp"
+	"github.com/PaloAltoNetworks/pango/netw/dnsproxy"
+	gpcvapp "github.com/PaloAltoNetworks/pango/netw/globalprotect/clientless/app"
+	gpcvappgroup "github.com/PaloAltoNetworks/pango/netw/globalprotect/clientless/appgroup"
+	gpgw "github.com/PaloAltoNetworks/pango/netw/globalprotect/gateway"
+	gpgwagent "github.com/PaloAltoNetworks/pango/netw/globalprotect/gateway/agent"
+	gpgwsat "github.com/PaloAltoNetworks/pango/netw/globalprotect/gateway/satellite"
+	"github.com/PaloAltoNetworks/pango/netw/globalprotect/portal"
+	gpagent "github.com/PaloAltoNetworks/pango/netw/globalprotect/portal/agent"
+	gpportalsat "github.com/PaloAltoNetworks/pango/netw/globalprotect/portal/satellite"
+	gpsatellite "github.com/PaloAltoNetworks/pango/netw/globalprotect/satellite"
 	"github.com/PaloAltoNetworks/pango/netw/ikegw"
 	aggeth "github.com/PaloAltoNetworks/pango/netw/interface/aggregate"
 	"github.com/PaloAltoNetworks/pango/netw/interface/arp"
 	"github.com/PaloAltoNetworks/pango/netw/interface/eth"
+	ipv6addr "github.com/PaloAltoNetworks/pango/netw/interface/ipv6/address"
+	ipv6dhcp "github.com/PaloAltoNetworks/pango/netw/interface/ipv6/dhcpclient"
+	ipv6inh "github.com/PaloAltoNetworks/pango/netw/interface/ipv6/inherited"
+	ipv6nd "github.com/PaloAltoNetworks/pango/netw/interface/ipv6/nd"
+	ipv6neighbor "github.com/PaloAltoNetworks/pango/netw/interface/ipv6/neighbor"
 	"github.com/PaloAltoNetworks/pango/netw/interface/loopback"
 	"github.com/PaloAltoNetworks/pango/netw/interface/subinterface/layer2"
 	"github.com/PaloAltoNetworks/pango/netw/interface/subinterface/layer3"
@@ -17,7 +33,23 @@ import (
 	"github.com/PaloAltoNetworks/pango/netw/profile/ipsec"
 	"github.com/PaloAltoNetworks/pango/netw/profile/mngtprof"
 	"github.com/PaloAltoNetworks/pango/netw/profile/monitor"
+	"github.com/PaloAltoNetworks/pango/netw/profile/sdwan"
+	"github.com/PaloAltoNetworks/pango/netw/profile/zoneprot"
+	qosiface "github.com/PaloAltoNetworks/pango/netw/qos/iface"
+	qosprof "github.com/PaloAltoNetworks/pango/netw/qos/profile"
+	"github.com/PaloAltoNetworks/pango/netw/routing/logical"
+	bgpredistprof "github.com/PaloAltoNetworks/pango/netw/routing/logical/profile/bgp/redist"
+	bgptimer "github.com/PaloAltoNetworks/pango/netw/routing/logical/profile/bgp/timer"
+	lrvrf "github.com/PaloAltoNetworks/pango/netw/routing/logical/vrf"
+	lrbgp "github.com/PaloAltoNetworks/pango/netw/routing/logical/vrf/bgp"
+	lrospf "github.com/PaloAltoNetworks/pango/netw/routing/logical/vrf/ospf"
+	lrstatic "github.com/PaloAltoNetworks/pango/netw/routing/logical/vrf/static/ipv4"
+	"github.com/PaloAltoNetworks/pango/netw/routing/multicast"
+	mcastiface "github.com/PaloAltoNetworks/pango/netw/routing/multicast/iface"
+	mcastrp "github.com/PaloAltoNetworks/pango/netw/routing/multicast/rp/external"
+	"github.com/PaloAltoNetworks/pango/netw/routing/multicast/spt"
 	redist4 "github.com/PaloAltoNetworks/pango/netw/routing/profile/redist/ipv4"
+	redist6 "github.com/PaloAltoNetworks/pango/netw/routing/profile/redist/ipv6"
 	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp"
 	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/aggregate"
 	agaf "github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/aggregate/filter/advertise"
@@ -32,52 +64,120 @@ import (
 	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/profile/auth"
 	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/profile/dampening"
 	bgpredist "github.com/PaloAltoNetworks/pango/netw/routing/protocol/bgp/redist"
+	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/ospf"
+	ospfarea "github.com/PaloAltoNetworks/pango/netw/routing/protocol/ospf/area"
+	ospfiface "github.com/PaloAltoNetworks/pango/netw/routing/protocol/ospf/area/iface"
+	ospfvlink "github.com/PaloAltoNetworks/pango/netw/routing/protocol/ospf/area/vlink"
+	ospfexp "github.com/PaloAltoNetworks/pango/netw/routing/protocol/ospf/exp"
+	ospfauth "github.com/PaloAltoNetworks/pango/netw/routing/protocol/ospf/profile/auth"
+	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/ospfv3"
+	ospfv3area "github.com/PaloAltoNetworks/pango/netw/routing/protocol/ospfv3/area"
+	ospfv3iface "github.com/PaloAltoNetworks/pango/netw/routing/protocol/ospfv3/area/iface"
+	ospfv3vlink "github.com/PaloAltoNetworks/pango/netw/routing/protocol/ospfv3/area/vlink"
+	ospfv3exp "github.com/PaloAltoNetworks/pango/netw/routing/protocol/ospfv3/exp"
+	ospfv3auth "github.com/PaloAltoNetworks/pango/netw/routing/protocol/ospfv3/profile/auth"
+	"github.com/PaloAltoNetworks/pango/netw/routing/protocol/rip"
+	ripexp "github.com/PaloAltoNetworks/pango/netw/routing/protocol/rip/exp"
+	ripiface "github.com/PaloAltoNetworks/pango/netw/routing/protocol/rip/iface"
+	ripauth "github.com/PaloAltoNetworks/pango/netw/routing/protocol/rip/profile/auth"
 	"github.com/PaloAltoNetworks/pango/netw/routing/route/static/ipv4"
+	"github.com/PaloAltoNetworks/pango/netw/routing/route/static/ipv6"
 	"github.com/PaloAltoNetworks/pango/netw/routing/router"
 	"github.com/PaloAltoNetworks/pango/netw/tunnel/gre"
 	"github.com/PaloAltoNetworks/pango/netw/vlan"
+	"github.com/PaloAltoNetworks/pango/netw/vwire"
 	"github.com/PaloAltoNetworks/pango/netw/zone"
 	"github.com/PaloAltoNetworks/pango/util"
 )
 
 // PanoNetw is the client.Network namespace.
 type PanoNetw struct {
-	AggregateInterface       *aggeth.PanoAggregate
-	Arp                      *arp.PanoArp
-	BfdProfile               *bfd.PanoBfd
-	BgpAggregate             *aggregate.PanoAggregate
-	BgpAggAdvertiseFilter    *agaf.PanoAdvertise
-	BgpAggSuppressFilter     *suppress.PanoSuppress
-	BgpAuthProfile           *auth.PanoAuth
-	BgpConAdvAdvertiseFilter *advertise.PanoAdvertise
-	BgpConAdvNonExistFilter  *nonexist.PanoNonExist
-	BgpConditionalAdv        *conadv.PanoConAdv
-	BgpConfig                *bgp.PanoBgp
-	BgpDampeningProfile      *dampening.PanoDampening
-	BgpExport                *exp.PanoExp
-	BgpImport                *imp.PanoImp
-	BgpPeer                  *peer.PanoPeer
-	BgpPeerGroup             *group.PanoGroup
-	BgpRedistRule            *bgpredist.PanoRedist
-	EthernetInterface        *eth.PanoEth
-	GreTunnel                *gre.PanoGre
-	IkeCryptoProfile         *ike.PanoIke
-	IkeGateway               *ikegw.PanoIkeGw
-	IpsecCryptoProfile       *ipsec.PanoIpsec
-	IpsecTunnel              *ipsectunnel.PanoIpsecTunnel
-	IpsecTunnelProxyId       *tpiv4.PanoIpv4
-	Layer2Subinterface       *layer2.PanoLayer2
-	Layer3Subinterface       *layer3.PanoLayer3
-	LoopbackInterface        *loopback.PanoLoopback
-	ManagementProfile        *mngtprof.PanoMngtProf
-	MonitorProfile           *monitor.PanoMonitor
-	RedistributionProfile    *redist4.PanoIpv4
-	StaticRoute              *ipv4.PanoIpv4
-	TunnelInterface          *tunnel.PanoTunnel
-	VirtualRouter            *router.PanoRouter
-	Vlan                     *vlan.PanoVlan
-	VlanInterface            *vli.PanoVlan
-	Zone                     *zone.PanoZone
+	AggregateInterface              *aggeth.PanoAggregate
+	Arp                             *arp.PanoArp
+	BfdProfile                      *bfd.PanoBfd
+	BgpAggregate                    *aggregate.PanoAggregate
+	BgpAggAdvertiseFilter           *agaf.PanoAdvertise
+	BgpAggSuppressFilter            *suppress.PanoSuppress
+	BgpAuthProfile                  *auth.PanoAuth
+	BgpConAdvAdvertiseFilter        *advertise.PanoAdvertise
+	BgpConAdvNonExistFilter         *nonexist.PanoNonExist
+	BgpConditionalAdv               *conadv.PanoConAdv
+	BgpConfig                       *bgp.PanoBgp
+	BgpDampeningProfile             *dampening.PanoDampening
+	BgpExport                       *exp.PanoExp
+	BgpImport                       *imp.PanoImp
+	BgpPeer                         *peer.PanoPeer
+	BgpPeerGroup                    *group.PanoGroup
+	BgpRedistRule                   *bgpredist.PanoRedist
+	BgpRedistRoutingProfile         *bgpredistprof.PanoRedist
+	BgpTimerRoutingProfile          *bgptimer.PanoTimer
+	Dhcp                            *dhcp.PanoDhcp
+	DnsProxy                        *dnsproxy.PanoDnsProxy
+	EthernetInterface               *eth.PanoEth
+	GlobalProtectClientlessApp      *gpcvapp.PanoApp
+	GlobalProtectClientlessAppGroup *gpcvappgroup.PanoAppGroup
+	GlobalProtectGateway            *gpgw.PanoGateway
+	GlobalProtectGatewayAgent       *gpgwagent.PanoAgent
+	GlobalProtectGatewaySat         *gpgwsat.PanoSatellite
+	GlobalProtectPortal             *portal.PanoPortal
+	GlobalProtectPortalAgent        *gpagent.PanoAgent
+	GlobalProtectPortalSat          *gpportalsat.PanoSatellite
+	GlobalProtectSatellite          *gpsatellite.PanoSatellite
+	GreTunnel                       *gre.PanoGre
+	IkeCryptoProfile                *ike.PanoIke
+	IkeGateway                      *ikegw.PanoIkeGw
+	IpsecCryptoProfile              *ipsec.PanoIpsec
+	IpsecTunnel                     *ipsectunnel.PanoIpsecTunnel
+	IpsecTunnelProxyId              *tpiv4.PanoIpv4
+	Ipv6Address                     *ipv6addr.PanoAddress
+	Ipv6DhcpClient                  *ipv6dhcp.PanoDhcpClient
+	Ipv6InheritedAddress            *ipv6inh.PanoInherited
+	Ipv6Neighbor                    *ipv6neighbor.PanoNeighbor
+	Ipv6NeighborDiscovery           *ipv6nd.PanoNd
+	Ipv6RedistributionProfile       *redist6.PanoIpv6
+	Ipv6StaticRoute                 *ipv6.PanoIpv6
+	Layer2Subinterface              *layer2.PanoLayer2
+	Layer3Subinterface              *layer3.PanoLayer3
+	LogicalRouter                   *logical.PanoLogical
+	LogicalRouterBgp                *lrbgp.PanoBgp
+	LogicalRouterOspf               *lrospf.PanoOspf
+	LogicalRouterStaticRoute        *lrstatic.PanoIpv4
+	LogicalRouterVrf                *lrvrf.PanoVrf
+	LoopbackInterface               *loopback.PanoLoopback
+	ManagementProfile               *mngtprof.PanoMngtProf
+	MonitorProfile                  *monitor.PanoMonitor
+	MulticastConfig                 *multicast.PanoMulticast
+	MulticastExternalRp             *mcastrp.PanoExternal
+	MulticastInterfaceGroup         *mcastiface.PanoInterfaceGroup
+	MulticastSptThreshold           *spt.PanoSpt
+	OspfArea                        *ospfarea.PanoArea
+	OspfAreaInterface               *ospfiface.PanoInterface
+	OspfAreaVirtualLink             *ospfvlink.PanoVirtualLink
+	OspfAuthProfile                 *ospfauth.PanoAuth
+	OspfConfig                      *ospf.PanoOspf
+	OspfExport                      *ospfexp.PanoExp
+	Ospfv3Area                      *ospfv3area.PanoArea
+	Ospfv3AreaInterface             *ospfv3iface.PanoInterface
+	Ospfv3AreaVirtualLink           *ospfv3vlink.PanoVirtualLink
+	Ospfv3AuthProfile               *ospfv3auth.PanoAuth
+	Ospfv3Config                    *ospfv3.PanoOspfv3
+	Ospfv3Export                    *ospfv3exp.PanoExp
+	QosInterface                    *qosiface.PanoInterface
+	QosProfile                      *qosprof.PanoProfile
+	RedistributionProfile           *redist4.PanoIpv4
+	RipAuthProfile                  *ripauth.PanoAuth
+	RipConfig                       *rip.PanoRip
+	RipExport                       *ripexp.PanoExp
+	RipInterface                    *ripiface.PanoInterface
+	SdwanInterfaceProfile           *sdwan.PanoSdwan
+	StaticRoute                     *ipv4.PanoIpv4
+	TunnelInterface                 *tunnel.PanoTunnel
+	VirtualRouter                   *router.PanoRouter
+	VirtualWire                     *vwire.PanoVwire
+	Vlan                            *vlan.PanoVlan
+	VlanInterface                   *vli.PanoVlan
+	Zone                            *zone.PanoZone
+	ZoneProtectionProfile           *zoneprot.PanoZoneProt
 }
 
 // Initialize is invoked on client.Initialize().
@@ -133,9 +233,48 @@ func (c *PanoNetw) Initialize(i util.XapiClient) {
 	c.BgpRedistRule = &bgpredist.PanoRedist{}
 	c.BgpRedistRule.Initialize(i)
 
+	c.BgpRedistRoutingProfile = &bgpredistprof.PanoRedist{}
+	c.BgpRedistRoutingProfile.Initialize(i)
+
+	c.BgpTimerRoutingProfile = &bgptimer.PanoTimer{}
+	c.BgpTimerRoutingProfile.Initialize(i)
+
+	c.Dhcp = &dhcp.PanoDhcp{}
+	c.Dhcp.Initialize(i)
+
+	c.DnsProxy = &dnsproxy.PanoDnsProxy{}
+	c.DnsProxy.Initialize(i)
+
 	c.EthernetInterface = &eth.PanoEth{}
 	c.EthernetInterface.Initialize(i)
 
+	c.GlobalProtectClientlessApp = &gpcvapp.PanoApp{}
+	c.GlobalProtectClientlessApp.Initialize(i)
+
+	c.GlobalProtectClientlessAppGroup = &gpcvappgroup.PanoAppGroup{}
+	c.GlobalProtectClientlessAppGroup.Initialize(i)
+
+	c.GlobalProtectGateway = &gpgw.PanoGateway{}
+	c.GlobalProtectGateway.Initialize(i)
+
+	c.GlobalProtectGatewayAgent = &gpgwagent.PanoAgent{}
+	c.GlobalProtectGatewayAgent.Initialize(i)
+
+	c.GlobalProtectGatewaySat = &gpgwsat.PanoSatellite{}
+	c.GlobalProtectGatewaySat.Initialize(i)
+
+	c.GlobalProtectPortal = &portal.PanoPortal{}
+	c.GlobalProtectPortal.Initialize(i)
+
+	c.GlobalProtectPortalAgent = &gpagent.PanoAgent{}
+	c.GlobalProtectPortalAgent.Initialize(i)
+
+	c.GlobalProtectPortalSat = &gpportalsat.PanoSatellite{}
+	c.GlobalProtectPortalSat.Initialize(i)
+
+	c.GlobalProtectSatellite = &gpsatellite.PanoSatellite{}
+	c.GlobalProtectSatellite.Initialize(i)
+
 	c.GreTunnel = &gre.PanoGre{}
 	c.GreTunnel.Initialize(i)
 
@@ -154,12 +293,48 @@ func (c *PanoNetw) Initialize(i util.XapiClient) {
 	c.IpsecTunnelProxyId = &tpiv4.PanoIpv4{}
 	c.IpsecTunnelProxyId.Initialize(i)
 
+	c.Ipv6Address = &ipv6addr.PanoAddress{}
+	c.Ipv6Address.Initialize(i)
+
+	c.Ipv6DhcpClient = &ipv6dhcp.PanoDhcpClient{}
+	c.Ipv6DhcpClient.Initialize(i)
+
+	c.Ipv6InheritedAddress = &ipv6inh.PanoInherited{}
+	c.Ipv6InheritedAddress.Initialize(i)
+
+	c.Ipv6Neighbor = &ipv6neighbor.PanoNeighbor{}
+	c.Ipv6Neighbor.Initialize(i)
+
+	c.Ipv6NeighborDiscovery = &ipv6nd.PanoNd{}
+	c.Ipv6NeighborDiscovery.Initialize(i)
+
+	c.Ipv6RedistributionProfile = &redist6.PanoIpv6{}
+	c.Ipv6RedistributionProfile.Initialize(i)
+
+	c.Ipv6StaticRoute = &ipv6.PanoIpv6{}
+	c.Ipv6StaticRoute.Initialize(i)
+
 	c.Layer2Subinterface = &layer2.PanoLayer2{}
 	c.Layer2Subinterface.Initialize(i)
 
 	c.Layer3Subinterface = &layer3.PanoLayer3{}
 	c.Layer3Subinterface.Initialize(i)
 
+	c.LogicalRouter = &logical.PanoLogical{}
+	c.LogicalRouter.Initialize(i)
+
+	c.LogicalRouterBgp = &lrbgp.PanoBgp{}
+	c.LogicalRouterBgp.Initialize(i)
+
+	c.LogicalRouterOspf = &lrospf.PanoOspf{}
+	c.LogicalRouterOspf.Initialize(i)
+
+	c.LogicalRouterStaticRoute = &lrstatic.PanoIpv4{}
+	c.LogicalRouterStaticRoute.Initialize(i)
+
+	c.LogicalRouterVrf = &lrvrf.PanoVrf{}
+	c.LogicalRouterVrf.Initialize(i)
+
 	c.LoopbackInterface = &loopback.PanoLoopback{}
 	c.LoopbackInterface.Initialize(i)
 
@@ -169,9 +344,78 @@ func (c *PanoNetw) Initialize(i util.XapiClient) {
 	c.MonitorProfile = &monitor.PanoMonitor{}
 	c.MonitorProfile.Initialize(i)
 
+	c.MulticastConfig = &multicast.PanoMulticast{}
+	c.MulticastConfig.Initialize(i)
+
+	c.MulticastExternalRp = &mcastrp.PanoExternal{}
+	c.MulticastExternalRp.Initialize(i)
+
+	c.MulticastInterfaceGroup = &mcastiface.PanoInterfaceGroup{}
+	c.MulticastInterfaceGroup.Initialize(i)
+
+	c.MulticastSptThreshold = &spt.PanoSpt{}
+	c.MulticastSptThreshold.Initialize(i)
+
+	c.OspfArea = &ospfarea.PanoArea{}
+	c.OspfArea.Initialize(i)
+
+	c.OspfAreaInterface = &ospfiface.PanoInterface{}
+	c.OspfAreaInterface.Initialize(i)
+
+	c.OspfAreaVirtualLink = &ospfvlink.PanoVirtualLink{}
+	c.OspfAreaVirtualLink.Initialize(i)
+
+	c.OspfAuthProfile = &ospfauth.PanoAuth{}
+	c.OspfAuthProfile.Initialize(i)
+
+	c.OspfConfig = &ospf.PanoOspf{}
+	c.OspfConfig.Initialize(i)
+
+	c.OspfExport = &ospfexp.PanoExp{}
+	c.OspfExport.Initialize(i)
+
+	c.Ospfv3Area = &ospfv3area.PanoArea{}
+	c.Ospfv3Area.Initialize(i)
+
+	c.Ospfv3AreaInterface = &ospfv3iface.PanoInterface{}
+	c.Ospfv3AreaInterface.Initialize(i)
+
+	c.Ospfv3AreaVirtualLink = &ospfv3vlink.PanoVirtualLink{}
+	c.Ospfv3AreaVirtualLink.Initialize(i)
+
+	c.Ospfv3AuthProfile = &ospfv3auth.PanoAuth{}
+	c.Ospfv3AuthProfile.Initialize(i)
+
+	c.Ospfv3Config = &ospfv3.PanoOspfv3{}
+	c.Ospfv3Config.Initialize(i)
+
+	c.Ospfv3Export = &ospfv3exp.PanoExp{}
+	c.Ospfv3Export.Initialize(i)
+
+	c.QosInterface = &qosiface.PanoInterface{}
+	c.QosInterface.Initialize(i)
+
+	c.QosProfile = &qosprof.PanoProfile{}
+	c.QosProfile.Initialize(i)
+
 	c.RedistributionProfile = &redist4.PanoIpv4{}
 	c.RedistributionProfile.Initialize(i)
 
+	c.RipAuthProfile = &ripauth.PanoAuth{}
+	c.RipAuthProfile.Initialize(i)
+
+	c.RipConfig = &rip.PanoRip{}
+	c.RipConfig.Initialize(i)
+
+	c.RipExport = &ripexp.PanoExp{}
+	c.RipExport.Initialize(i)
+
+	c.RipInterface = &ripiface.PanoInterface{}
+	c.RipInterface.Initialize(i)
+
+	c.SdwanInterfaceProfile = &sdwan.PanoSdwan{}
+	c.SdwanInterfaceProfile.Initialize(i)
+
 	c.StaticRoute = &ipv4.PanoIpv4{}
 	c.StaticRoute.Initialize(i)
 
@@ -181,6 +425,9 @@ func (c *PanoNetw) Initialize(i util.XapiClient) {
 	c.VirtualRouter = &router.PanoRouter{}
 	c.VirtualRouter.Initialize(i)
 
+	c.VirtualWire = &vwire.PanoVwire{}
+	c.VirtualWire.Initialize(i)
+
 	c.Vlan = &vlan.PanoVlan{}
 	c.Vlan.Initialize(i)
 
@@ -189,4 +436,7 @@ func (c *PanoNetw) Initialize(i util.XapiClient) {
 
 	c.Zone = &zone.PanoZone{}
 	c.Zone.Initialize(i)
+
+	c.ZoneProtectionProfile = &zoneprot.PanoZoneProt{}
+	c.ZoneProtectionProfile.Initialize(i)
 }
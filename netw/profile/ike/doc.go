@@ -1,4 +1,12 @@
-// Package ike is the client.Network.IkeCryptoProfile namespace.
-//
-// Normalized object:  Entry
+/*
+Package ike is the client.Network.IkeCryptoProfile namespace.
+
+IKE crypto profiles define the DH groups, hashes, encryption algorithms, and
+key lifetime used for IKE phase 1.  The Encryption field should be populated
+with the Encryption constants in this package; the values are converted to
+the PAN-OS version specific values when the profile is sent to PAN-OS, and
+normalized again when it is retrieved.
+
+Normalized object:  Entry
+*/
 package ike
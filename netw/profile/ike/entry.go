@@ -7,11 +7,32 @@ import (
 )
 
 const (
-	EncryptionDes    = "des"
-	Encryption3des   = "3des"
-	EncryptionAes128 = "aes-128-cbc"
-	EncryptionAes192 = "aes-192-cbc"
-	EncryptionAes256 = "aes-256-cbc"
+	EncryptionDes       = "des"
+	Encryption3des      = "3des"
+	EncryptionAes128    = "aes-128-cbc"
+	EncryptionAes192    = "aes-192-cbc"
+	EncryptionAes256    = "aes-256-cbc"
+	EncryptionAes128Gcm = "aes-128-gcm"
+	EncryptionAes256Gcm = "aes-256-gcm"
+)
+
+const (
+	AuthenticationMd5     = "md5"
+	AuthenticationSha1    = "sha1"
+	AuthenticationSha256  = "sha256"
+	AuthenticationSha384  = "sha384"
+	AuthenticationSha512  = "sha512"
+	AuthenticationNonAuth = "non-auth"
+)
+
+const (
+	DhGroup1  = "group1"
+	DhGroup2  = "group2"
+	DhGroup5  = "group5"
+	DhGroup14 = "group14"
+	DhGroup19 = "group19"
+	DhGroup20 = "group20"
+	DhGroup21 = "group21"
 )
 
 const (
@@ -21,8 +42,11 @@ const (
 	TimeDays    = "days"
 )
 
-// Entry is a normalized, version independent representation of an interface
-// management profile.
+// Entry is a normalized, version independent representation of an IKE crypto
+// profile.
+//
+// The AES-GCM encryption algorithms and the non-auth authentication value are
+// only valid for PAN-OS 10.0+.
 type Entry struct {
 	Name                   string
 	DhGroup                []string
@@ -69,6 +93,10 @@ func (o *Entry) SpecifyEncryption(v int) {
 				nv[i] = "aes-192-cbc"
 			case EncryptionAes256:
 				nv[i] = "aes-256-cbc"
+			case EncryptionAes128Gcm:
+				nv[i] = "aes-128-gcm"
+			case EncryptionAes256Gcm:
+				nv[i] = "aes-256-gcm"
 			default:
 				nv[i] = o.Encryption[i]
 			}
@@ -114,6 +142,10 @@ func (o *Entry) NormalizeEncryption() {
 			nv[i] = EncryptionAes192
 		case "aes-256-cbc", "aes256":
 			nv[i] = EncryptionAes256
+		case "aes-128-gcm":
+			nv[i] = EncryptionAes128Gcm
+		case "aes-256-gcm":
+			nv[i] = EncryptionAes256Gcm
 		default:
 			nv[i] = o.Encryption[i]
 		}
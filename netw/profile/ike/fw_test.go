@@ -80,6 +80,14 @@ func TestFwNormalization(t *testing.T) {
 			LifetimeValue:          4,
 			AuthenticationMultiple: 7,
 		}},
+		{"v2 sha512 aes gcm 8hr", version.Number{10, 0, 0, ""}, Entry{
+			Name:           "test5",
+			DhGroup:        []string{DhGroup19, DhGroup20},
+			Encryption:     []string{EncryptionAes128Gcm, EncryptionAes256Gcm},
+			Authentication: []string{AuthenticationSha512, AuthenticationNonAuth},
+			LifetimeType:   TimeHours,
+			LifetimeValue:  8,
+		}},
 	}
 
 	mc := &testdata.MockClient{}
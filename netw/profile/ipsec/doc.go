@@ -1,4 +1,12 @@
-// Package ipsec is the client.Network.IpsecCryptoProfile namespace.
-//
-// Normalized object:  Entry
+/*
+Package ipsec is the client.Network.IpsecCryptoProfile namespace.
+
+IPSec crypto profiles define the protocol, encryption and authentication
+algorithms, DH group, lifetime, and lifesize used for IPSec phase 2.  The
+Encryption field should be populated with the Encryption constants in this
+package; the values are converted to the PAN-OS version specific values when
+the profile is sent to PAN-OS, and normalized again when it is retrieved.
+
+Normalized object:  Entry
+*/
 package ipsec
@@ -22,6 +22,26 @@ const (
 	EncryptionNull      = "null"
 )
 
+const (
+	AuthenticationNone   = "none"
+	AuthenticationMd5    = "md5"
+	AuthenticationSha1   = "sha1"
+	AuthenticationSha256 = "sha256"
+	AuthenticationSha384 = "sha384"
+	AuthenticationSha512 = "sha512"
+)
+
+const (
+	DhGroupNoPfs = "no-pfs"
+	DhGroup1     = "group1"
+	DhGroup2     = "group2"
+	DhGroup5     = "group5"
+	DhGroup14    = "group14"
+	DhGroup19    = "group19"
+	DhGroup20    = "group20"
+	DhGroup21    = "group21"
+)
+
 const (
 	TimeSeconds = "seconds"
 	TimeMinutes = "minutes"
@@ -36,8 +56,11 @@ const (
 	SizeTb = "tb"
 )
 
-// Entry is a normalized, version independent representation of an interface
-// management profile.
+// Entry is a normalized, version independent representation of an IPSec
+// crypto profile.
+//
+// The Encryption field is only used when Protocol is ProtocolEsp.  The
+// AES-GCM encryption algorithms are only valid for PAN-OS 7.0+.
 type Entry struct {
 	Name           string
 	Protocol       string
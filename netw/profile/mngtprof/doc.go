@@ -1,4 +1,9 @@
 // Package mngtprof is the client.Network.ManagementProfile namespace.
 //
+// Interface management profiles are attached to layer3 interfaces by setting
+// the ManagementProfile field of the interface's Entry, such as for
+// ethernet, aggregate ethernet, layer3 subinterfaces, vlan, loopback, and
+// tunnel interfaces.
+//
 // Normalized object:  Entry
 package mngtprof
@@ -1,6 +1,11 @@
 /*
 Package monitor is the client.Network.MonitorProfile namespace.
 
+Monitor profiles define how often a tunnel monitor probes its destination,
+how many missed probes mark the tunnel as down, and whether the tunnel then
+waits to recover or fails over.  They are attached to IPSec tunnels with the
+ipsectunnel.Entry TunnelMonitorProfile field.
+
 Normalized object:  Entry
 */
 package monitor
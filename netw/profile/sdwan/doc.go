@@ -0,0 +1,11 @@
+/*
+Package sdwan is the client.Network.SdwanInterfaceProfile namespace.
+
+SD-WAN interface profiles describe the type and capacity of the links that
+SD-WAN balances traffic across, and how aggressively those links are probed.
+These profiles are configured natively in the vsys, and are then attached
+to layer3 interfaces using the eth.Entry SdwanInterfaceProfile field.
+
+Normalized object:  Entry
+*/
+package sdwan
@@ -0,0 +1,126 @@
+package sdwan
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an SD-WAN
+// interface profile.
+//
+// The maximum download and upload speeds are in Mbps.
+//
+// PAN-OS 9.1+.
+type Entry struct {
+	Name                 string
+	LinkTag              string
+	Comment              string
+	LinkType             string
+	MaximumDownload      int
+	MaximumUpload        int
+	ErrorCorrection      bool
+	VpnDataTunnelSupport bool
+	PathMonitoring       string
+	ProbeFrequency       int
+	ProbeIdleTime        int
+	FailbackHoldTime     int
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.LinkTag = s.LinkTag
+	o.Comment = s.Comment
+	o.LinkType = s.LinkType
+	o.MaximumDownload = s.MaximumDownload
+	o.MaximumUpload = s.MaximumUpload
+	o.ErrorCorrection = s.ErrorCorrection
+	o.VpnDataTunnelSupport = s.VpnDataTunnelSupport
+	o.PathMonitoring = s.PathMonitoring
+	o.ProbeFrequency = s.ProbeFrequency
+	o.ProbeIdleTime = s.ProbeIdleTime
+	o.FailbackHoldTime = s.FailbackHoldTime
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() []Entry
+	Names() []string
+}
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *container_v1) Normalize() []Entry {
+	arr := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		arr = append(arr, o.Answer[i].normalize())
+	}
+	return arr
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:                 o.Name,
+		LinkTag:              o.LinkTag,
+		Comment:              o.Comment,
+		LinkType:             o.LinkType,
+		MaximumDownload:      o.MaximumDownload,
+		MaximumUpload:        o.MaximumUpload,
+		ErrorCorrection:      util.AsBool(o.ErrorCorrection),
+		VpnDataTunnelSupport: util.AsBool(o.VpnDataTunnelSupport),
+		PathMonitoring:       o.PathMonitoring,
+		ProbeFrequency:       o.ProbeFrequency,
+		ProbeIdleTime:        o.ProbeIdleTime,
+		FailbackHoldTime:     o.FailbackHoldTime,
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName              xml.Name `xml:"entry"`
+	Name                 string   `xml:"name,attr"`
+	LinkTag              string   `xml:"link-tag,omitempty"`
+	Comment              string   `xml:"comment,omitempty"`
+	LinkType             string   `xml:"link-type,omitempty"`
+	MaximumDownload      int      `xml:"maximum-download,omitempty"`
+	MaximumUpload        int      `xml:"maximum-upload,omitempty"`
+	ErrorCorrection      string   `xml:"error-correction"`
+	VpnDataTunnelSupport string   `xml:"vpn-data-tunnel-support"`
+	PathMonitoring       string   `xml:"path-monitoring,omitempty"`
+	ProbeFrequency       int      `xml:"probe-frequency,omitempty"`
+	ProbeIdleTime        int      `xml:"probe-idle-time,omitempty"`
+	FailbackHoldTime     int      `xml:"failback-hold-time,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:                 e.Name,
+		LinkTag:              e.LinkTag,
+		Comment:              e.Comment,
+		LinkType:             e.LinkType,
+		MaximumDownload:      e.MaximumDownload,
+		MaximumUpload:        e.MaximumUpload,
+		ErrorCorrection:      util.YesNo(e.ErrorCorrection),
+		VpnDataTunnelSupport: util.YesNo(e.VpnDataTunnelSupport),
+		PathMonitoring:       e.PathMonitoring,
+		ProbeFrequency:       e.ProbeFrequency,
+		ProbeIdleTime:        e.ProbeIdleTime,
+		FailbackHoldTime:     e.FailbackHoldTime,
+	}
+
+	return ans
+}
@@ -0,0 +1,142 @@
+package sdwan
+
+import (
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwSdwan is the client.Network.SdwanInterfaceProfile namespace.
+type FwSdwan struct {
+	con util.XapiClient
+	ns  *namespace.Namespace
+}
+
+// Initialize is invoked when Initialize on the pango.Client is called.
+func (c *FwSdwan) Initialize(con util.XapiClient) {
+	c.con = con
+	c.ns = namespace.New(singular, plural, con)
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *FwSdwan) GetList(vsys string) ([]string, error) {
+	result, _ := c.versioning()
+	return c.ns.Listing(util.Get, c.xpath(vsys, nil), result)
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *FwSdwan) ShowList(vsys string) ([]string, error) {
+	result, _ := c.versioning()
+	return c.ns.Listing(util.Show, c.xpath(vsys, nil), result)
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *FwSdwan) Get(vsys, name string) (Entry, error) {
+	result, _ := c.versioning()
+	if err := c.ns.Object(util.Get, c.xpath(vsys, []string{name}), name, result); err != nil {
+		return Entry{}, err
+	}
+
+	return result.Normalize()[0], nil
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *FwSdwan) GetAll(vsys string) ([]Entry, error) {
+	result, _ := c.versioning()
+	if err := c.ns.Objects(util.Get, c.xpath(vsys, nil), result); err != nil {
+		return nil, err
+	}
+
+	return result.Normalize(), nil
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *FwSdwan) Show(vsys, name string) (Entry, error) {
+	result, _ := c.versioning()
+	if err := c.ns.Object(util.Show, c.xpath(vsys, []string{name}), name, result); err != nil {
+		return Entry{}, err
+	}
+
+	return result.Normalize()[0], nil
+}
+
+// ShowAll performs SHOW to retrieve information for all objects.
+func (c *FwSdwan) ShowAll(vsys string) ([]Entry, error) {
+	result, _ := c.versioning()
+	if err := c.ns.Objects(util.Show, c.xpath(vsys, nil), result); err != nil {
+		return nil, err
+	}
+
+	return result.Normalize(), nil
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *FwSdwan) Set(vsys string, e ...Entry) error {
+	_, fn := c.versioning()
+	data := make([]interface{}, 0, len(e))
+	names := make([]string, 0, len(e))
+
+	for i := range e {
+		data = append(data, fn(e[i]))
+		names = append(names, e[i].Name)
+	}
+	path := c.xpath(vsys, names)
+
+	return c.ns.Set(names, path, data)
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *FwSdwan) Edit(vsys string, e Entry) error {
+	_, fn := c.versioning()
+	path := c.xpath(vsys, []string{e.Name})
+	data := fn(e)
+
+	return c.ns.Edit(e.Name, path, data)
+}
+
+// Delete removes the given objects.
+//
+// Objects can be either a string or an Entry object.
+func (c *FwSdwan) Delete(vsys string, e ...interface{}) error {
+	if len(e) == 0 {
+		return nil
+	}
+
+	names := make([]string, len(e))
+	for i := range e {
+		switch v := e[i].(type) {
+		case string:
+			names[i] = v
+		case Entry:
+			names[i] = v.Name
+		default:
+			return fmt.Errorf("Unsupported type to delete: %s", v)
+		}
+	}
+
+	path := c.xpath(vsys, names)
+	return c.ns.Delete(names, path)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *FwSdwan) versioning() (normalizer, func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwSdwan) xpath(vsys string, vals []string) []string {
+	if vsys == "" {
+		vsys = "vsys1"
+	}
+
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"vsys",
+		util.AsEntryXpath([]string{vsys}),
+		"sdwan-interface-profile",
+		util.AsEntryXpath(vals),
+	}
+}
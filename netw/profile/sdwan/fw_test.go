@@ -0,0 +1,57 @@
+package sdwan
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestFwNormalization(t *testing.T) {
+	testCases := []struct {
+		desc string
+		conf Entry
+	}{
+		{"basic", Entry{
+			Name:     "t1",
+			LinkTag:  "broadband",
+			LinkType: LinkTypeCable,
+		}},
+		{"full", Entry{
+			Name:                 "t2",
+			LinkTag:              "mpls",
+			Comment:              "primary circuit",
+			LinkType:             LinkTypeMpls,
+			MaximumDownload:      100,
+			MaximumUpload:        50,
+			ErrorCorrection:      true,
+			VpnDataTunnelSupport: true,
+			PathMonitoring:       PathMonitoringAggressive,
+			ProbeFrequency:       5,
+			ProbeIdleTime:        60,
+			FailbackHoldTime:     120,
+		}},
+	}
+
+	mc := &testdata.MockClient{}
+	ns := &FwSdwan{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.AddResp("")
+			err := ns.Set("", tc.conf)
+			if err != nil {
+				t.Errorf("Error in set: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get("", tc.conf.Name)
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				} else if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
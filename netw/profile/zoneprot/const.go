@@ -0,0 +1,39 @@
+package zoneprot
+
+// Valid values for SynFloodAction.
+const (
+	SynFloodActionRed        = "red"
+	SynFloodActionSynCookies = "syn-cookies"
+)
+
+// Valid values for the names of Scan.
+const (
+	ScanTcpPortScan = "8001"
+	ScanHostSweep   = "8002"
+	ScanUdpPortScan = "8003"
+)
+
+// Valid values for Scan.Action.
+const (
+	ScanActionAllow   = "allow"
+	ScanActionAlert   = "alert"
+	ScanActionBlock   = "block"
+	ScanActionBlockIp = "block-ip"
+)
+
+// Valid values for Scan.TrackBy.
+const (
+	TrackBySource               = "source"
+	TrackBySourceAndDestination = "source-and-destination"
+)
+
+// Valid values for NonIpProtocolMode.
+const (
+	NonIpProtocolModeExclude = "exclude"
+	NonIpProtocolModeInclude = "include"
+)
+
+const (
+	singular = "zone protection profile"
+	plural   = "zone protection profiles"
+)
@@ -0,0 +1,9 @@
+/*
+Package zoneprot is the client.Network.ZoneProtectionProfile namespace.
+
+Zone protection profiles are attached to zones by setting the ZoneProfile
+field of the zone's Entry.
+
+Normalized object:  Entry
+*/
+package zoneprot
@@ -0,0 +1,544 @@
+package zoneprot
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+// Entry is a normalized, version independent representation of a zone
+// protection profile.
+//
+// The *Flood* fields are flood protection, Scans is reconnaissance
+// protection, the Discard*, Strip*, RemoveTcpTimestamp, StrictIpCheck, and
+// Suppress* fields are packet based attack protection, and the NonIp* fields
+// are protocol protection.
+//
+// The flood alarm, activate, and maximal rates are in connections per second.
+// The scan white list and IPv6 packet based attack protection settings are
+// preserved as-is when the profile is updated.
+type Entry struct {
+	Name                                 string
+	Description                          string
+	SynFloodEnable                       bool
+	SynFloodAction                       string
+	SynFloodAlarmRate                    int
+	SynFloodActivateRate                 int
+	SynFloodMaximalRate                  int
+	UdpFloodEnable                       bool
+	UdpFloodAlarmRate                    int
+	UdpFloodActivateRate                 int
+	UdpFloodMaximalRate                  int
+	IcmpFloodEnable                      bool
+	IcmpFloodAlarmRate                   int
+	IcmpFloodActivateRate                int
+	IcmpFloodMaximalRate                 int
+	Icmpv6FloodEnable                    bool
+	Icmpv6FloodAlarmRate                 int
+	Icmpv6FloodActivateRate              int
+	Icmpv6FloodMaximalRate               int
+	OtherIpFloodEnable                   bool
+	OtherIpFloodAlarmRate                int
+	OtherIpFloodActivateRate             int
+	OtherIpFloodMaximalRate              int
+	Scans                                []Scan
+	DiscardIpSpoof                       bool
+	DiscardIpFrag                        bool
+	DiscardIcmpPingZeroId                bool
+	DiscardIcmpFrag                      bool
+	DiscardIcmpLargePacket               bool
+	DiscardIcmpError                     bool
+	DiscardStrictSourceRouting           bool
+	DiscardLooseSourceRouting            bool
+	DiscardTimestamp                     bool
+	DiscardRecordRoute                   bool
+	DiscardSecurity                      bool
+	DiscardStreamId                      bool
+	DiscardUnknownOption                 bool
+	DiscardMalformedOption               bool
+	DiscardOverlappingTcpSegmentMismatch bool
+	DiscardTcpSplitHandshake             bool
+	DiscardTcpSynWithData                bool // 8.0+
+	DiscardTcpSynackWithData             bool // 8.0+
+	StripTcpFastOpenAndData              bool // 8.1+
+	RemoveTcpTimestamp                   bool
+	StrictIpCheck                        bool
+	SuppressIcmpTimeExceeded             bool // XML: suppress-icmp-timeexceeded
+	SuppressIcmpNeedFrag                 bool
+	NonIpProtocolMode                    string          // 8.0+
+	NonIpProtocols                       []NonIpProtocol // 8.0+
+
+	raw map[string]string
+}
+
+// Scan is a reconnaissance protection setting.  The Name is the threat ID
+// of the scan type, and Interval is in seconds.
+//
+// TrackBy and Duration are only used with an Action of "block-ip".
+type Scan struct {
+	Name      string
+	Action    string
+	TrackBy   string
+	Duration  int
+	Interval  int
+	Threshold int
+}
+
+// NonIpProtocol is a protocol matched by protocol protection.  The EtherType
+// is a hex string, such as "0x88cc".
+type NonIpProtocol struct {
+	Name      string
+	EtherType string
+	Enable    bool
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Description = s.Description
+	o.SynFloodEnable = s.SynFloodEnable
+	o.SynFloodAction = s.SynFloodAction
+	o.SynFloodAlarmRate = s.SynFloodAlarmRate
+	o.SynFloodActivateRate = s.SynFloodActivateRate
+	o.SynFloodMaximalRate = s.SynFloodMaximalRate
+	o.UdpFloodEnable = s.UdpFloodEnable
+	o.UdpFloodAlarmRate = s.UdpFloodAlarmRate
+	o.UdpFloodActivateRate = s.UdpFloodActivateRate
+	o.UdpFloodMaximalRate = s.UdpFloodMaximalRate
+	o.IcmpFloodEnable = s.IcmpFloodEnable
+	o.IcmpFloodAlarmRate = s.IcmpFloodAlarmRate
+	o.IcmpFloodActivateRate = s.IcmpFloodActivateRate
+	o.IcmpFloodMaximalRate = s.IcmpFloodMaximalRate
+	o.Icmpv6FloodEnable = s.Icmpv6FloodEnable
+	o.Icmpv6FloodAlarmRate = s.Icmpv6FloodAlarmRate
+	o.Icmpv6FloodActivateRate = s.Icmpv6FloodActivateRate
+	o.Icmpv6FloodMaximalRate = s.Icmpv6FloodMaximalRate
+	o.OtherIpFloodEnable = s.OtherIpFloodEnable
+	o.OtherIpFloodAlarmRate = s.OtherIpFloodAlarmRate
+	o.OtherIpFloodActivateRate = s.OtherIpFloodActivateRate
+	o.OtherIpFloodMaximalRate = s.OtherIpFloodMaximalRate
+	if s.Scans == nil {
+		o.Scans = nil
+	} else {
+		o.Scans = make([]Scan, len(s.Scans))
+		copy(o.Scans, s.Scans)
+	}
+	o.DiscardIpSpoof = s.DiscardIpSpoof
+	o.DiscardIpFrag = s.DiscardIpFrag
+	o.DiscardIcmpPingZeroId = s.DiscardIcmpPingZeroId
+	o.DiscardIcmpFrag = s.DiscardIcmpFrag
+	o.DiscardIcmpLargePacket = s.DiscardIcmpLargePacket
+	o.DiscardIcmpError = s.DiscardIcmpError
+	o.DiscardStrictSourceRouting = s.DiscardStrictSourceRouting
+	o.DiscardLooseSourceRouting = s.DiscardLooseSourceRouting
+	o.DiscardTimestamp = s.DiscardTimestamp
+	o.DiscardRecordRoute = s.DiscardRecordRoute
+	o.DiscardSecurity = s.DiscardSecurity
+	o.DiscardStreamId = s.DiscardStreamId
+	o.DiscardUnknownOption = s.DiscardUnknownOption
+	o.DiscardMalformedOption = s.DiscardMalformedOption
+	o.DiscardOverlappingTcpSegmentMismatch = s.DiscardOverlappingTcpSegmentMismatch
+	o.DiscardTcpSplitHandshake = s.DiscardTcpSplitHandshake
+	o.DiscardTcpSynWithData = s.DiscardTcpSynWithData
+	o.DiscardTcpSynackWithData = s.DiscardTcpSynackWithData
+	o.StripTcpFastOpenAndData = s.StripTcpFastOpenAndData
+	o.RemoveTcpTimestamp = s.RemoveTcpTimestamp
+	o.StrictIpCheck = s.StrictIpCheck
+	o.SuppressIcmpTimeExceeded = s.SuppressIcmpTimeExceeded
+	o.SuppressIcmpNeedFrag = s.SuppressIcmpNeedFrag
+	o.NonIpProtocolMode = s.NonIpProtocolMode
+	if s.NonIpProtocols == nil {
+		o.NonIpProtocols = nil
+	} else {
+		o.NonIpProtocols = make([]NonIpProtocol, len(s.NonIpProtocols))
+		copy(o.NonIpProtocols, s.NonIpProtocols)
+	}
+}
+
+// Audit returns an error if this object uses fields that the given PAN-OS
+// version does not support.
+func (o *Entry) Audit(v version.Number) error {
+	v80 := version.Number{8, 0, 0, ""}
+	v81 := version.Number{8, 1, 0, ""}
+
+	a := util.NewAuditor(o.Name, v)
+	a.Since("DiscardTcpSynWithData", o.DiscardTcpSynWithData, v80)
+	a.Since("DiscardTcpSynackWithData", o.DiscardTcpSynackWithData, v80)
+	a.Since("StripTcpFastOpenAndData", o.StripTcpFastOpenAndData, v81)
+	a.Since("NonIpProtocolMode", o.NonIpProtocolMode != "", v80)
+	a.Since("NonIpProtocols", len(o.NonIpProtocols) != 0, v80)
+
+	return a.Err()
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:                                 o.Answer.Name,
+		Description:                          o.Answer.Description,
+		DiscardIpSpoof:                       util.AsBool(o.Answer.DiscardIpSpoof),
+		DiscardIpFrag:                        util.AsBool(o.Answer.DiscardIpFrag),
+		DiscardIcmpPingZeroId:                util.AsBool(o.Answer.DiscardIcmpPingZeroId),
+		DiscardIcmpFrag:                      util.AsBool(o.Answer.DiscardIcmpFrag),
+		DiscardIcmpLargePacket:               util.AsBool(o.Answer.DiscardIcmpLargePacket),
+		DiscardIcmpError:                     util.AsBool(o.Answer.DiscardIcmpError),
+		DiscardStrictSourceRouting:           util.AsBool(o.Answer.DiscardStrictSourceRouting),
+		DiscardLooseSourceRouting:            util.AsBool(o.Answer.DiscardLooseSourceRouting),
+		DiscardTimestamp:                     util.AsBool(o.Answer.DiscardTimestamp),
+		DiscardRecordRoute:                   util.AsBool(o.Answer.DiscardRecordRoute),
+		DiscardSecurity:                      util.AsBool(o.Answer.DiscardSecurity),
+		DiscardStreamId:                      util.AsBool(o.Answer.DiscardStreamId),
+		DiscardUnknownOption:                 util.AsBool(o.Answer.DiscardUnknownOption),
+		DiscardMalformedOption:               util.AsBool(o.Answer.DiscardMalformedOption),
+		DiscardOverlappingTcpSegmentMismatch: util.AsBool(o.Answer.DiscardOverlappingTcpSegmentMismatch),
+		DiscardTcpSplitHandshake:             util.AsBool(o.Answer.DiscardTcpSplitHandshake),
+		DiscardTcpSynWithData:                util.AsBool(o.Answer.DiscardTcpSynWithData),
+		DiscardTcpSynackWithData:             util.AsBool(o.Answer.DiscardTcpSynackWithData),
+		StripTcpFastOpenAndData:              util.AsBool(o.Answer.StripTcpFastOpenAndData),
+		RemoveTcpTimestamp:                   util.AsBool(o.Answer.RemoveTcpTimestamp),
+		StrictIpCheck:                        util.AsBool(o.Answer.StrictIpCheck),
+		SuppressIcmpTimeExceeded:             util.AsBool(o.Answer.SuppressIcmpTimeExceeded),
+		SuppressIcmpNeedFrag:                 util.AsBool(o.Answer.SuppressIcmpNeedFrag),
+	}
+
+	if o.Answer.Flood != nil {
+		f := o.Answer.Flood
+		if f.Syn != nil {
+			ans.SynFloodEnable = util.AsBool(f.Syn.Enable)
+			switch {
+			case f.Syn.Red != nil:
+				ans.SynFloodAction = SynFloodActionRed
+				ans.SynFloodAlarmRate, ans.SynFloodActivateRate, ans.SynFloodMaximalRate = f.Syn.Red.normalize()
+			case f.Syn.SynCookies != nil:
+				ans.SynFloodAction = SynFloodActionSynCookies
+				ans.SynFloodAlarmRate, ans.SynFloodActivateRate, ans.SynFloodMaximalRate = f.Syn.SynCookies.normalize()
+			}
+		}
+		ans.UdpFloodEnable, ans.UdpFloodAlarmRate, ans.UdpFloodActivateRate, ans.UdpFloodMaximalRate = f.Udp.normalize()
+		ans.IcmpFloodEnable, ans.IcmpFloodAlarmRate, ans.IcmpFloodActivateRate, ans.IcmpFloodMaximalRate = f.Icmp.normalize()
+		ans.Icmpv6FloodEnable, ans.Icmpv6FloodAlarmRate, ans.Icmpv6FloodActivateRate, ans.Icmpv6FloodMaximalRate = f.Icmpv6.normalize()
+		ans.OtherIpFloodEnable, ans.OtherIpFloodAlarmRate, ans.OtherIpFloodActivateRate, ans.OtherIpFloodMaximalRate = f.OtherIp.normalize()
+	}
+
+	if o.Answer.Scan != nil {
+		list := make([]Scan, 0, len(o.Answer.Scan.Entries))
+		for _, x := range o.Answer.Scan.Entries {
+			item := Scan{
+				Name:      x.Name,
+				Interval:  x.Interval,
+				Threshold: x.Threshold,
+			}
+			if x.Action != nil {
+				switch {
+				case x.Action.Allow != nil:
+					item.Action = ScanActionAllow
+				case x.Action.Alert != nil:
+					item.Action = ScanActionAlert
+				case x.Action.Block != nil:
+					item.Action = ScanActionBlock
+				case x.Action.BlockIp != nil:
+					item.Action = ScanActionBlockIp
+					item.TrackBy = x.Action.BlockIp.TrackBy
+					item.Duration = x.Action.BlockIp.Duration
+				}
+			}
+			list = append(list, item)
+		}
+		ans.Scans = list
+	}
+
+	if o.Answer.NonIp != nil {
+		ans.NonIpProtocolMode = o.Answer.NonIp.ListType
+		if o.Answer.NonIp.Protocols != nil {
+			list := make([]NonIpProtocol, 0, len(o.Answer.NonIp.Protocols.Entries))
+			for _, x := range o.Answer.NonIp.Protocols.Entries {
+				list = append(list, NonIpProtocol{
+					Name:      x.Name,
+					EtherType: x.EtherType,
+					Enable:    util.AsBool(x.Enable),
+				})
+			}
+			ans.NonIpProtocols = list
+		}
+	}
+
+	raw := make(map[string]string)
+	if o.Answer.ScanWhiteList != nil {
+		raw["swl"] = util.CleanRawXml(o.Answer.ScanWhiteList.Text)
+	}
+	if o.Answer.Ipv6 != nil {
+		raw["ipv6"] = util.CleanRawXml(o.Answer.Ipv6.Text)
+	}
+	if len(raw) != 0 {
+		ans.raw = raw
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName                              xml.Name     `xml:"entry"`
+	Name                                 string       `xml:"name,attr"`
+	Description                          string       `xml:"description,omitempty"`
+	Flood                                *flood       `xml:"flood"`
+	Scan                                 *scans       `xml:"scan"`
+	ScanWhiteList                        *util.RawXml `xml:"scan-white-list"`
+	DiscardIpSpoof                       string       `xml:"discard-ip-spoof"`
+	DiscardIpFrag                        string       `xml:"discard-ip-frag"`
+	DiscardIcmpPingZeroId                string       `xml:"discard-icmp-ping-zero-id"`
+	DiscardIcmpFrag                      string       `xml:"discard-icmp-frag"`
+	DiscardIcmpLargePacket               string       `xml:"discard-icmp-large-packet"`
+	DiscardIcmpError                     string       `xml:"discard-icmp-error"`
+	DiscardStrictSourceRouting           string       `xml:"discard-strict-source-routing"`
+	DiscardLooseSourceRouting            string       `xml:"discard-loose-source-routing"`
+	DiscardTimestamp                     string       `xml:"discard-timestamp"`
+	DiscardRecordRoute                   string       `xml:"discard-record-route"`
+	DiscardSecurity                      string       `xml:"discard-security"`
+	DiscardStreamId                      string       `xml:"discard-stream-id"`
+	DiscardUnknownOption                 string       `xml:"discard-unknown-option"`
+	DiscardMalformedOption               string       `xml:"discard-malformed-option"`
+	DiscardOverlappingTcpSegmentMismatch string       `xml:"discard-overlapping-tcp-segment-mismatch"`
+	DiscardTcpSplitHandshake             string       `xml:"discard-tcp-split-handshake"`
+	DiscardTcpSynWithData                string       `xml:"discard-tcp-syn-with-data,omitempty"`
+	DiscardTcpSynackWithData             string       `xml:"discard-tcp-synack-with-data,omitempty"`
+	StripTcpFastOpenAndData              string       `xml:"strip-tcp-fast-open-and-data,omitempty"`
+	RemoveTcpTimestamp                   string       `xml:"remove-tcp-timestamp"`
+	StrictIpCheck                        string       `xml:"strict-ip-check"`
+	SuppressIcmpTimeExceeded             string       `xml:"suppress-icmp-timeexceeded"`
+	SuppressIcmpNeedFrag                 string       `xml:"suppress-icmp-needfrag"`
+	Ipv6                                 *util.RawXml `xml:"ipv6"`
+	NonIp                                *nonIp       `xml:"non-ip-protocol"`
+}
+
+type flood struct {
+	Syn     *synFlood `xml:"tcp-syn"`
+	Udp     *ipFlood  `xml:"udp"`
+	Icmp    *ipFlood  `xml:"icmp"`
+	Icmpv6  *ipFlood  `xml:"icmpv6"`
+	OtherIp *ipFlood  `xml:"other-ip"`
+}
+
+type synFlood struct {
+	Enable     string `xml:"enable"`
+	Red        *rates `xml:"red"`
+	SynCookies *rates `xml:"syn-cookies"`
+}
+
+type ipFlood struct {
+	Enable string `xml:"enable"`
+	Red    *rates `xml:"red"`
+}
+
+func (o *ipFlood) normalize() (bool, int, int, int) {
+	if o == nil {
+		return false, 0, 0, 0
+	}
+
+	alarm, activate, maximal := o.Red.normalize()
+	return util.AsBool(o.Enable), alarm, activate, maximal
+}
+
+func specifyIpFlood(enable bool, alarm, activate, maximal int) *ipFlood {
+	if !enable && alarm == 0 && activate == 0 && maximal == 0 {
+		return nil
+	}
+
+	return &ipFlood{
+		Enable: util.YesNo(enable),
+		Red:    specifyRates(alarm, activate, maximal),
+	}
+}
+
+type rates struct {
+	AlarmRate    int `xml:"alarm-rate,omitempty"`
+	ActivateRate int `xml:"activate-rate,omitempty"`
+	MaximalRate  int `xml:"maximal-rate,omitempty"`
+}
+
+func (o *rates) normalize() (int, int, int) {
+	if o == nil {
+		return 0, 0, 0
+	}
+
+	return o.AlarmRate, o.ActivateRate, o.MaximalRate
+}
+
+func specifyRates(alarm, activate, maximal int) *rates {
+	if alarm == 0 && activate == 0 && maximal == 0 {
+		return nil
+	}
+
+	return &rates{
+		AlarmRate:    alarm,
+		ActivateRate: activate,
+		MaximalRate:  maximal,
+	}
+}
+
+type scans struct {
+	Entries []scan `xml:"entry"`
+}
+
+type scan struct {
+	Name      string      `xml:"name,attr"`
+	Action    *scanAction `xml:"action"`
+	Interval  int         `xml:"interval,omitempty"`
+	Threshold int         `xml:"threshold,omitempty"`
+}
+
+type scanAction struct {
+	Allow   *string  `xml:"allow"`
+	Alert   *string  `xml:"alert"`
+	Block   *string  `xml:"block"`
+	BlockIp *blockIp `xml:"block-ip"`
+}
+
+type blockIp struct {
+	TrackBy  string `xml:"track-by,omitempty"`
+	Duration int    `xml:"duration,omitempty"`
+}
+
+type nonIp struct {
+	ListType  string        `xml:"list-type,omitempty"`
+	Protocols *nonIpEntries `xml:"protocol"`
+}
+
+type nonIpEntries struct {
+	Entries []nonIpEntry `xml:"entry"`
+}
+
+type nonIpEntry struct {
+	Name      string `xml:"name,attr"`
+	EtherType string `xml:"ether-type"`
+	Enable    string `xml:"enable"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:                                 e.Name,
+		Description:                          e.Description,
+		DiscardIpSpoof:                       util.YesNo(e.DiscardIpSpoof),
+		DiscardIpFrag:                        util.YesNo(e.DiscardIpFrag),
+		DiscardIcmpPingZeroId:                util.YesNo(e.DiscardIcmpPingZeroId),
+		DiscardIcmpFrag:                      util.YesNo(e.DiscardIcmpFrag),
+		DiscardIcmpLargePacket:               util.YesNo(e.DiscardIcmpLargePacket),
+		DiscardIcmpError:                     util.YesNo(e.DiscardIcmpError),
+		DiscardStrictSourceRouting:           util.YesNo(e.DiscardStrictSourceRouting),
+		DiscardLooseSourceRouting:            util.YesNo(e.DiscardLooseSourceRouting),
+		DiscardTimestamp:                     util.YesNo(e.DiscardTimestamp),
+		DiscardRecordRoute:                   util.YesNo(e.DiscardRecordRoute),
+		DiscardSecurity:                      util.YesNo(e.DiscardSecurity),
+		DiscardStreamId:                      util.YesNo(e.DiscardStreamId),
+		DiscardUnknownOption:                 util.YesNo(e.DiscardUnknownOption),
+		DiscardMalformedOption:               util.YesNo(e.DiscardMalformedOption),
+		DiscardOverlappingTcpSegmentMismatch: util.YesNo(e.DiscardOverlappingTcpSegmentMismatch),
+		DiscardTcpSplitHandshake:             util.YesNo(e.DiscardTcpSplitHandshake),
+		RemoveTcpTimestamp:                   util.YesNo(e.RemoveTcpTimestamp),
+		StrictIpCheck:                        util.YesNo(e.StrictIpCheck),
+		SuppressIcmpTimeExceeded:             util.YesNo(e.SuppressIcmpTimeExceeded),
+		SuppressIcmpNeedFrag:                 util.YesNo(e.SuppressIcmpNeedFrag),
+	}
+
+	// These are only sent when enabled, as older PAN-OS versions do not
+	// support them.
+	if e.DiscardTcpSynWithData {
+		ans.DiscardTcpSynWithData = util.YesNo(true)
+	}
+	if e.DiscardTcpSynackWithData {
+		ans.DiscardTcpSynackWithData = util.YesNo(true)
+	}
+	if e.StripTcpFastOpenAndData {
+		ans.StripTcpFastOpenAndData = util.YesNo(true)
+	}
+
+	f := flood{
+		Udp:     specifyIpFlood(e.UdpFloodEnable, e.UdpFloodAlarmRate, e.UdpFloodActivateRate, e.UdpFloodMaximalRate),
+		Icmp:    specifyIpFlood(e.IcmpFloodEnable, e.IcmpFloodAlarmRate, e.IcmpFloodActivateRate, e.IcmpFloodMaximalRate),
+		Icmpv6:  specifyIpFlood(e.Icmpv6FloodEnable, e.Icmpv6FloodAlarmRate, e.Icmpv6FloodActivateRate, e.Icmpv6FloodMaximalRate),
+		OtherIp: specifyIpFlood(e.OtherIpFloodEnable, e.OtherIpFloodAlarmRate, e.OtherIpFloodActivateRate, e.OtherIpFloodMaximalRate),
+	}
+	if e.SynFloodEnable || e.SynFloodAction != "" {
+		f.Syn = &synFlood{
+			Enable: util.YesNo(e.SynFloodEnable),
+		}
+		r := &rates{
+			AlarmRate:    e.SynFloodAlarmRate,
+			ActivateRate: e.SynFloodActivateRate,
+			MaximalRate:  e.SynFloodMaximalRate,
+		}
+		switch e.SynFloodAction {
+		case SynFloodActionRed:
+			f.Syn.Red = r
+		case SynFloodActionSynCookies:
+			f.Syn.SynCookies = r
+		}
+	}
+	if f.Syn != nil || f.Udp != nil || f.Icmp != nil || f.Icmpv6 != nil || f.OtherIp != nil {
+		ans.Flood = &f
+	}
+
+	if len(e.Scans) > 0 {
+		list := make([]scan, 0, len(e.Scans))
+		for _, x := range e.Scans {
+			item := scan{
+				Name:      x.Name,
+				Interval:  x.Interval,
+				Threshold: x.Threshold,
+			}
+			s := ""
+			switch x.Action {
+			case ScanActionAllow:
+				item.Action = &scanAction{Allow: &s}
+			case ScanActionAlert:
+				item.Action = &scanAction{Alert: &s}
+			case ScanActionBlock:
+				item.Action = &scanAction{Block: &s}
+			case ScanActionBlockIp:
+				item.Action = &scanAction{
+					BlockIp: &blockIp{
+						TrackBy:  x.TrackBy,
+						Duration: x.Duration,
+					},
+				}
+			}
+			list = append(list, item)
+		}
+		ans.Scan = &scans{Entries: list}
+	}
+
+	if e.NonIpProtocolMode != "" || len(e.NonIpProtocols) > 0 {
+		ans.NonIp = &nonIp{
+			ListType: e.NonIpProtocolMode,
+		}
+		if len(e.NonIpProtocols) > 0 {
+			list := make([]nonIpEntry, 0, len(e.NonIpProtocols))
+			for _, x := range e.NonIpProtocols {
+				list = append(list, nonIpEntry{
+					Name:      x.Name,
+					EtherType: x.EtherType,
+					Enable:    util.YesNo(x.Enable),
+				})
+			}
+			ans.NonIp.Protocols = &nonIpEntries{Entries: list}
+		}
+	}
+
+	if text, present := e.raw["swl"]; present {
+		ans.ScanWhiteList = &util.RawXml{text}
+	}
+	if text, present := e.raw["ipv6"]; present {
+		ans.Ipv6 = &util.RawXml{text}
+	}
+
+	return ans
+}
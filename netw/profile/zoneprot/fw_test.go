@@ -0,0 +1,57 @@
+package zoneprot
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+func TestFwNormalization(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &FwZoneProt{}
+	ns.Initialize(mc)
+
+	for _, tc := range getTests() {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.Version = tc.version
+			mc.AddResp("")
+			err := ns.Set(tc.conf)
+			if err != nil {
+				t.Errorf("Error in set: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get(tc.conf.Name)
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				}
+				if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
+
+func TestFwAuditUnsupportedFields(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.Version = version.Number{7, 1, 0, ""}
+
+	ns := &FwZoneProt{}
+	ns.Initialize(mc)
+
+	err := ns.Set(Entry{
+		Name:                  "zp",
+		DiscardTcpSynWithData: true,
+	})
+	if err == nil {
+		t.Fatalf("Expected an audit error")
+	} else if _, ok := err.(util.AuditError); !ok {
+		t.Errorf("Expected util.AuditError, got %T: %s", err, err)
+	} else if mc.Function != "" {
+		t.Errorf("API call made despite failed audit: %s", mc.Function)
+	}
+}
@@ -0,0 +1,98 @@
+package zoneprot
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type testCase struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
+
+func getTests() []testCase {
+	v71 := version.Number{7, 1, 0, ""}
+	v81 := version.Number{8, 1, 0, ""}
+
+	return []testCase{
+		{"flood red", v71, Entry{
+			Name:                     "zp1",
+			Description:              "flood",
+			SynFloodEnable:           true,
+			SynFloodAction:           SynFloodActionRed,
+			SynFloodAlarmRate:        10000,
+			SynFloodActivateRate:     10000,
+			SynFloodMaximalRate:      40000,
+			UdpFloodEnable:           true,
+			UdpFloodAlarmRate:        10000,
+			UdpFloodActivateRate:     10000,
+			UdpFloodMaximalRate:      40000,
+			IcmpFloodEnable:          true,
+			IcmpFloodAlarmRate:       100,
+			Icmpv6FloodEnable:        true,
+			Icmpv6FloodMaximalRate:   200,
+			OtherIpFloodEnable:       true,
+			OtherIpFloodActivateRate: 300,
+		}},
+		{"flood syn cookies", v71, Entry{
+			Name:                 "zp2",
+			SynFloodEnable:       true,
+			SynFloodAction:       SynFloodActionSynCookies,
+			SynFloodAlarmRate:    5000,
+			SynFloodActivateRate: 6000,
+			SynFloodMaximalRate:  7000,
+		}},
+		{"reconnaissance", v71, Entry{
+			Name: "zp3",
+			Scans: []Scan{
+				{Name: ScanTcpPortScan, Action: ScanActionAlert, Interval: 2, Threshold: 100},
+				{Name: ScanHostSweep, Action: ScanActionBlockIp, TrackBy: TrackBySource, Duration: 3600, Interval: 10, Threshold: 100},
+				{Name: ScanUdpPortScan, Action: ScanActionBlock},
+			},
+			raw: map[string]string{
+				"swl": "<entry name=\"scanner\"><ipv4>10.1.1.1</ipv4></entry>",
+			},
+		}},
+		{"packet based", v71, Entry{
+			Name:                                 "zp4",
+			DiscardIpSpoof:                       true,
+			DiscardIpFrag:                        true,
+			DiscardIcmpPingZeroId:                true,
+			DiscardIcmpFrag:                      true,
+			DiscardIcmpLargePacket:               true,
+			DiscardIcmpError:                     true,
+			DiscardStrictSourceRouting:           true,
+			DiscardLooseSourceRouting:            true,
+			DiscardTimestamp:                     true,
+			DiscardRecordRoute:                   true,
+			DiscardSecurity:                      true,
+			DiscardStreamId:                      true,
+			DiscardUnknownOption:                 true,
+			DiscardMalformedOption:               true,
+			DiscardOverlappingTcpSegmentMismatch: true,
+			DiscardTcpSplitHandshake:             true,
+			RemoveTcpTimestamp:                   true,
+			StrictIpCheck:                        true,
+			SuppressIcmpTimeExceeded:             true,
+			SuppressIcmpNeedFrag:                 true,
+			raw: map[string]string{
+				"ipv6": "<anycast-source>yes</anycast-source>",
+			},
+		}},
+		{"8.1 packet based", v81, Entry{
+			Name:                     "zp5",
+			DiscardIpSpoof:           true,
+			DiscardTcpSynWithData:    true,
+			DiscardTcpSynackWithData: true,
+			StripTcpFastOpenAndData:  true,
+		}},
+		{"8.1 protocol protection", v81, Entry{
+			Name:              "zp6",
+			NonIpProtocolMode: NonIpProtocolModeExclude,
+			NonIpProtocols: []NonIpProtocol{
+				{Name: "lldp", EtherType: "0x88cc", Enable: true},
+				{Name: "ipx", EtherType: "0x8137"},
+			},
+		}},
+	}
+}
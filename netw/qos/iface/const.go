@@ -0,0 +1,12 @@
+package iface
+
+// Default group names for ClearTextRule.Group and TunnelRule.Group.
+const (
+	ClearTextGroup = "regular-traffic-group"
+	TunnelGroup    = "tunnel-traffic-group"
+)
+
+const (
+	singular = "qos interface"
+	plural   = "qos interfaces"
+)
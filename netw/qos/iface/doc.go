@@ -0,0 +1,10 @@
+/*
+Package iface is the client.Network.QosInterface namespace.
+
+Each entry is named after the interface that QoS is enabled on.  Clear text
+rules assign a QoS profile to traffic from a specific source interface, such
+as a subinterface, overriding the default clear text profile.
+
+Normalized object:  Entry
+*/
+package iface
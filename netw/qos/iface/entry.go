@@ -0,0 +1,339 @@
+package iface
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of the QoS
+// config of an interface.
+//
+// All bandwidth fields are in Mbps.  ClearTextProfile and TunnelProfile are
+// the default QoS profiles for clear text and tunneled traffic.
+type Entry struct {
+	Name                      string
+	Enabled                   bool
+	EgressMax                 float64 // XML: interface-bandwidth/egress-max
+	ClearTextProfile          string
+	ClearTextEgressMax        float64
+	ClearTextEgressGuaranteed float64
+	ClearTextRules            []ClearTextRule
+	TunnelProfile             string
+	TunnelEgressMax           float64
+	TunnelEgressGuaranteed    float64
+	TunnelRules               []TunnelRule
+}
+
+// ClearTextRule applies a QoS profile to clear text traffic sourced from the
+// given interface and addresses.
+type ClearTextRule struct {
+	Group           string
+	Name            string
+	QosProfile      string
+	SourceInterface string
+	SourceSubnets   []string
+}
+
+// TunnelRule applies a QoS profile to the tunnel interface given as Name.
+type TunnelRule struct {
+	Group      string
+	Name       string
+	QosProfile string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Enabled = s.Enabled
+	o.EgressMax = s.EgressMax
+	o.ClearTextProfile = s.ClearTextProfile
+	o.ClearTextEgressMax = s.ClearTextEgressMax
+	o.ClearTextEgressGuaranteed = s.ClearTextEgressGuaranteed
+	if s.ClearTextRules == nil {
+		o.ClearTextRules = nil
+	} else {
+		o.ClearTextRules = make([]ClearTextRule, len(s.ClearTextRules))
+		copy(o.ClearTextRules, s.ClearTextRules)
+	}
+	o.TunnelProfile = s.TunnelProfile
+	o.TunnelEgressMax = s.TunnelEgressMax
+	o.TunnelEgressGuaranteed = s.TunnelEgressGuaranteed
+	if s.TunnelRules == nil {
+		o.TunnelRules = nil
+	} else {
+		o.TunnelRules = make([]TunnelRule, len(s.TunnelRules))
+		copy(o.TunnelRules, s.TunnelRules)
+	}
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:    o.Answer.Name,
+		Enabled: util.AsBool(o.Answer.Enabled),
+	}
+
+	if o.Answer.Bandwidth != nil {
+		ans.EgressMax = o.Answer.Bandwidth.EgressMax
+	}
+
+	if o.Answer.ClearText != nil {
+		ct := o.Answer.ClearText
+		if ct.Default != nil {
+			ans.ClearTextProfile = ct.Default.QosProfile
+		}
+		ans.ClearTextEgressMax, ans.ClearTextEgressGuaranteed = ct.Bandwidth.normalize()
+		if ct.Groups != nil {
+			for _, g := range ct.Groups.Entries {
+				if g.Members == nil {
+					continue
+				}
+				for _, x := range g.Members.Entries {
+					item := ClearTextRule{
+						Group:      g.Name,
+						Name:       x.Name,
+						QosProfile: x.QosProfile,
+					}
+					if x.Match != nil && x.Match.Local != nil {
+						item.SourceInterface = x.Match.Local.Interface
+						item.SourceSubnets = util.MemToStr(x.Match.Local.Addresses)
+					}
+					ans.ClearTextRules = append(ans.ClearTextRules, item)
+				}
+			}
+		}
+	}
+
+	if o.Answer.Tunnel != nil {
+		tt := o.Answer.Tunnel
+		if tt.Default != nil {
+			ans.TunnelProfile = tt.Default.QosProfile
+		}
+		ans.TunnelEgressMax, ans.TunnelEgressGuaranteed = tt.Bandwidth.normalize()
+		if tt.Groups != nil {
+			for _, g := range tt.Groups.Entries {
+				if g.Members == nil {
+					continue
+				}
+				for _, x := range g.Members.Entries {
+					ans.TunnelRules = append(ans.TunnelRules, TunnelRule{
+						Group:      g.Name,
+						Name:       x.Name,
+						QosProfile: x.QosProfile,
+					})
+				}
+			}
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName   xml.Name       `xml:"entry"`
+	Name      string         `xml:"name,attr"`
+	Enabled   string         `xml:"enabled"`
+	Bandwidth *ifaceBw       `xml:"interface-bandwidth"`
+	ClearText *clearText     `xml:"regular-traffic"`
+	Tunnel    *tunnelTraffic `xml:"tunnel-traffic"`
+}
+
+type ifaceBw struct {
+	EgressMax float64 `xml:"egress-max,omitempty"`
+}
+
+type bandwidth struct {
+	EgressMax        float64 `xml:"egress-max,omitempty"`
+	EgressGuaranteed float64 `xml:"egress-guaranteed,omitempty"`
+}
+
+func (o *bandwidth) normalize() (float64, float64) {
+	if o == nil {
+		return 0, 0
+	}
+
+	return o.EgressMax, o.EgressGuaranteed
+}
+
+func specifyBandwidth(max, guaranteed float64) *bandwidth {
+	if max == 0 && guaranteed == 0 {
+		return nil
+	}
+
+	return &bandwidth{
+		EgressMax:        max,
+		EgressGuaranteed: guaranteed,
+	}
+}
+
+type clearText struct {
+	Default   *ctDefault `xml:"default-group"`
+	Bandwidth *bandwidth `xml:"bandwidth"`
+	Groups    *ctGroups  `xml:"groups"`
+}
+
+type ctDefault struct {
+	QosProfile string `xml:"qos-profile"`
+}
+
+type ctGroups struct {
+	Entries []ctGroup `xml:"entry"`
+}
+
+type ctGroup struct {
+	Name    string     `xml:"name,attr"`
+	Members *ctMembers `xml:"members"`
+}
+
+type ctMembers struct {
+	Entries []ctMember `xml:"entry"`
+}
+
+type ctMember struct {
+	Name       string   `xml:"name,attr"`
+	QosProfile string   `xml:"qos-profile,omitempty"`
+	Match      *ctMatch `xml:"match"`
+}
+
+type ctMatch struct {
+	Local *ctLocal `xml:"local-address"`
+}
+
+type ctLocal struct {
+	Interface string           `xml:"interface,omitempty"`
+	Addresses *util.MemberType `xml:"address"`
+}
+
+type tunnelTraffic struct {
+	Default   *ttDefault `xml:"default-group"`
+	Bandwidth *bandwidth `xml:"bandwidth"`
+	Groups    *ttGroups  `xml:"groups"`
+}
+
+type ttDefault struct {
+	QosProfile string `xml:"per-tunnel-qos-profile"`
+}
+
+type ttGroups struct {
+	Entries []ttGroup `xml:"entry"`
+}
+
+type ttGroup struct {
+	Name    string     `xml:"name,attr"`
+	Members *ttMembers `xml:"members"`
+}
+
+type ttMembers struct {
+	Entries []ttMember `xml:"entry"`
+}
+
+type ttMember struct {
+	Name       string `xml:"name,attr"`
+	QosProfile string `xml:"qos-profile,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:    e.Name,
+		Enabled: util.YesNo(e.Enabled),
+	}
+
+	if e.EgressMax != 0 {
+		ans.Bandwidth = &ifaceBw{
+			EgressMax: e.EgressMax,
+		}
+	}
+
+	if e.ClearTextProfile != "" || e.ClearTextEgressMax != 0 || e.ClearTextEgressGuaranteed != 0 || len(e.ClearTextRules) > 0 {
+		ct := clearText{
+			Bandwidth: specifyBandwidth(e.ClearTextEgressMax, e.ClearTextEgressGuaranteed),
+		}
+
+		if e.ClearTextProfile != "" {
+			ct.Default = &ctDefault{
+				QosProfile: e.ClearTextProfile,
+			}
+		}
+
+		if len(e.ClearTextRules) > 0 {
+			// Rules are grouped in the order that each group first appears.
+			groups := make([]ctGroup, 0)
+			idx := make(map[string]int)
+			for _, x := range e.ClearTextRules {
+				i, ok := idx[x.Group]
+				if !ok {
+					i = len(groups)
+					idx[x.Group] = i
+					groups = append(groups, ctGroup{
+						Name:    x.Group,
+						Members: &ctMembers{},
+					})
+				}
+				item := ctMember{
+					Name:       x.Name,
+					QosProfile: x.QosProfile,
+				}
+				if x.SourceInterface != "" || len(x.SourceSubnets) > 0 {
+					item.Match = &ctMatch{
+						Local: &ctLocal{
+							Interface: x.SourceInterface,
+							Addresses: util.StrToMem(x.SourceSubnets),
+						},
+					}
+				}
+				groups[i].Members.Entries = append(groups[i].Members.Entries, item)
+			}
+			ct.Groups = &ctGroups{Entries: groups}
+		}
+
+		ans.ClearText = &ct
+	}
+
+	if e.TunnelProfile != "" || e.TunnelEgressMax != 0 || e.TunnelEgressGuaranteed != 0 || len(e.TunnelRules) > 0 {
+		tt := tunnelTraffic{
+			Bandwidth: specifyBandwidth(e.TunnelEgressMax, e.TunnelEgressGuaranteed),
+		}
+
+		if e.TunnelProfile != "" {
+			tt.Default = &ttDefault{
+				QosProfile: e.TunnelProfile,
+			}
+		}
+
+		if len(e.TunnelRules) > 0 {
+			// Rules are grouped in the order that each group first appears.
+			groups := make([]ttGroup, 0)
+			idx := make(map[string]int)
+			for _, x := range e.TunnelRules {
+				i, ok := idx[x.Group]
+				if !ok {
+					i = len(groups)
+					idx[x.Group] = i
+					groups = append(groups, ttGroup{
+						Name:    x.Group,
+						Members: &ttMembers{},
+					})
+				}
+				groups[i].Members.Entries = append(groups[i].Members.Entries, ttMember{
+					Name:       x.Name,
+					QosProfile: x.QosProfile,
+				})
+			}
+			tt.Groups = &ttGroups{Entries: groups}
+		}
+
+		ans.Tunnel = &tt
+	}
+
+	return ans
+}
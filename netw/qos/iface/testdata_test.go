@@ -0,0 +1,47 @@
+package iface
+
+func getTests() []testCase {
+	return []testCase{
+		{"v1 basic", Entry{
+			Name:             "ethernet1/1",
+			Enabled:          true,
+			EgressMax:        1000,
+			ClearTextProfile: "default",
+			TunnelProfile:    "default",
+		}},
+		{"v1 bandwidth", Entry{
+			Name:                      "ethernet1/2",
+			Enabled:                   true,
+			EgressMax:                 500,
+			ClearTextProfile:          "branch",
+			ClearTextEgressMax:        400,
+			ClearTextEgressGuaranteed: 200.5,
+			TunnelProfile:             "tunnels",
+			TunnelEgressMax:           100,
+			TunnelEgressGuaranteed:    50,
+		}},
+		{"v1 subinterface overrides", Entry{
+			Name:             "ethernet1/3",
+			Enabled:          true,
+			ClearTextProfile: "default",
+			ClearTextRules: []ClearTextRule{
+				{Group: ClearTextGroup, Name: "voice", QosProfile: "voip", SourceInterface: "ethernet1/3.10", SourceSubnets: []string{"10.10.0.0/16"}},
+				{Group: ClearTextGroup, Name: "guest", QosProfile: "guest", SourceInterface: "ethernet1/3.20"},
+				{Group: "other", Name: "any", QosProfile: "bulk", SourceSubnets: []string{"any"}},
+			},
+		}},
+		{"v1 tunnel rules", Entry{
+			Name:          "ethernet1/4",
+			TunnelProfile: "default",
+			TunnelRules: []TunnelRule{
+				{Group: TunnelGroup, Name: "tunnel.1", QosProfile: "gold"},
+				{Group: TunnelGroup, Name: "tunnel.2", QosProfile: "silver"},
+			},
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
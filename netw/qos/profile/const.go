@@ -0,0 +1,20 @@
+package profile
+
+// Valid values for ClassBandwidthType.
+const (
+	ClassBandwidthTypeMbps       = "mbps"
+	ClassBandwidthTypePercentage = "percentage"
+)
+
+// Valid values for Class.Priority.
+const (
+	PriorityRealTime = "real-time"
+	PriorityHigh     = "high"
+	PriorityMedium   = "medium"
+	PriorityLow      = "low"
+)
+
+const (
+	singular = "qos profile"
+	plural   = "qos profiles"
+)
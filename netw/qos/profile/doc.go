@@ -0,0 +1,6 @@
+/*
+Package profile is the client.Network.QosProfile namespace.
+
+Normalized object:  Entry
+*/
+package profile
@@ -0,0 +1,226 @@
+package profile
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+// Entry is a normalized, version independent representation of a QoS
+// profile.
+//
+// EgressMax and EgressGuaranteed are the aggregate bandwidth in Mbps.  The
+// bandwidth of each class is in Mbps, or in percent of the aggregate
+// bandwidth if ClassBandwidthType is "percentage" (PAN-OS 8.1+).
+type Entry struct {
+	Name               string
+	EgressMax          float64
+	EgressGuaranteed   float64
+	ClassBandwidthType string // 8.1+
+	Classes            []Class
+}
+
+// Class is a QoS class, named "class1" through "class8".
+type Class struct {
+	Name             string
+	Priority         string
+	EgressMax        float64
+	EgressGuaranteed float64
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.EgressMax = s.EgressMax
+	o.EgressGuaranteed = s.EgressGuaranteed
+	o.ClassBandwidthType = s.ClassBandwidthType
+	if s.Classes == nil {
+		o.Classes = nil
+	} else {
+		o.Classes = make([]Class, len(s.Classes))
+		copy(o.Classes, s.Classes)
+	}
+}
+
+// Audit returns an error if this object uses fields that the given PAN-OS
+// version does not support.
+func (o *Entry) Audit(v version.Number) error {
+	a := util.NewAuditor(o.Name, v)
+	a.Since("ClassBandwidthType", o.ClassBandwidthType != "", version.Number{8, 1, 0, ""})
+
+	return a.Err()
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name: o.Answer.Name,
+	}
+
+	ans.EgressMax, ans.EgressGuaranteed = o.Answer.Bandwidth.normalize()
+	ans.Classes = o.Answer.Classes.normalize()
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName   xml.Name   `xml:"entry"`
+	Name      string     `xml:"name,attr"`
+	Bandwidth *bandwidth `xml:"aggregate-bandwidth"`
+	Classes   *classes   `xml:"class"`
+}
+
+type bandwidth struct {
+	EgressMax        float64 `xml:"egress-max,omitempty"`
+	EgressGuaranteed float64 `xml:"egress-guaranteed,omitempty"`
+}
+
+func (o *bandwidth) normalize() (float64, float64) {
+	if o == nil {
+		return 0, 0
+	}
+
+	return o.EgressMax, o.EgressGuaranteed
+}
+
+func specifyBandwidth(max, guaranteed float64) *bandwidth {
+	if max == 0 && guaranteed == 0 {
+		return nil
+	}
+
+	return &bandwidth{
+		EgressMax:        max,
+		EgressGuaranteed: guaranteed,
+	}
+}
+
+type classes struct {
+	Entries []class `xml:"entry"`
+}
+
+func (o *classes) normalize() []Class {
+	if o == nil {
+		return nil
+	}
+
+	ans := make([]Class, 0, len(o.Entries))
+	for _, x := range o.Entries {
+		item := Class{
+			Name:     x.Name,
+			Priority: x.Priority,
+		}
+		item.EgressMax, item.EgressGuaranteed = x.Bandwidth.normalize()
+		ans = append(ans, item)
+	}
+
+	return ans
+}
+
+func specifyClasses(list []Class) *classes {
+	if len(list) == 0 {
+		return nil
+	}
+
+	ans := make([]class, 0, len(list))
+	for _, x := range list {
+		ans = append(ans, class{
+			Name:      x.Name,
+			Priority:  x.Priority,
+			Bandwidth: specifyBandwidth(x.EgressMax, x.EgressGuaranteed),
+		})
+	}
+
+	return &classes{Entries: ans}
+}
+
+type class struct {
+	Name      string     `xml:"name,attr"`
+	Priority  string     `xml:"priority,omitempty"`
+	Bandwidth *bandwidth `xml:"class-bandwidth"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:      e.Name,
+		Bandwidth: specifyBandwidth(e.EgressMax, e.EgressGuaranteed),
+		Classes:   specifyClasses(e.Classes),
+	}
+
+	return ans
+}
+
+// 8.1+
+type container_v2 struct {
+	Answer entry_v2 `xml:"result>entry"`
+}
+
+func (o *container_v2) Normalize() Entry {
+	ans := Entry{
+		Name: o.Answer.Name,
+	}
+
+	ans.EgressMax, ans.EgressGuaranteed = o.Answer.Bandwidth.normalize()
+
+	if o.Answer.Type != nil {
+		switch {
+		case o.Answer.Type.Mbps != nil:
+			ans.ClassBandwidthType = ClassBandwidthTypeMbps
+			ans.Classes = o.Answer.Type.Mbps.Classes.normalize()
+		case o.Answer.Type.Percentage != nil:
+			ans.ClassBandwidthType = ClassBandwidthTypePercentage
+			ans.Classes = o.Answer.Type.Percentage.Classes.normalize()
+		}
+	}
+
+	return ans
+}
+
+type entry_v2 struct {
+	XMLName   xml.Name   `xml:"entry"`
+	Name      string     `xml:"name,attr"`
+	Bandwidth *bandwidth `xml:"aggregate-bandwidth"`
+	Type      *bwType    `xml:"class-bandwidth-type"`
+}
+
+type bwType struct {
+	Mbps       *bwClasses `xml:"mbps"`
+	Percentage *bwClasses `xml:"percentage"`
+}
+
+type bwClasses struct {
+	Classes *classes `xml:"class"`
+}
+
+func specify_v2(e Entry) interface{} {
+	ans := entry_v2{
+		Name:      e.Name,
+		Bandwidth: specifyBandwidth(e.EgressMax, e.EgressGuaranteed),
+	}
+
+	switch e.ClassBandwidthType {
+	case ClassBandwidthTypeMbps:
+		ans.Type = &bwType{
+			Mbps: &bwClasses{
+				Classes: specifyClasses(e.Classes),
+			},
+		}
+	case ClassBandwidthTypePercentage:
+		ans.Type = &bwType{
+			Percentage: &bwClasses{
+				Classes: specifyClasses(e.Classes),
+			},
+		}
+	}
+
+	return ans
+}
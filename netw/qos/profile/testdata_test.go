@@ -0,0 +1,52 @@
+package profile
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type testCase struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
+
+func getTests() []testCase {
+	v80 := version.Number{8, 0, 0, ""}
+	v81 := version.Number{8, 1, 0, ""}
+
+	return []testCase{
+		{"v1 aggregate only", v80, Entry{
+			Name:             "p1",
+			EgressMax:        100,
+			EgressGuaranteed: 50.5,
+		}},
+		{"v1 classes", v80, Entry{
+			Name:      "p2",
+			EgressMax: 1000,
+			Classes: []Class{
+				{Name: "class1", Priority: PriorityRealTime, EgressMax: 100, EgressGuaranteed: 50},
+				{Name: "class4", Priority: PriorityMedium, EgressMax: 500},
+				{Name: "class8", Priority: PriorityLow},
+			},
+		}},
+		{"v2 mbps classes", v81, Entry{
+			Name:               "p3",
+			EgressMax:          1000,
+			ClassBandwidthType: ClassBandwidthTypeMbps,
+			Classes: []Class{
+				{Name: "class1", Priority: PriorityHigh, EgressMax: 200, EgressGuaranteed: 100},
+				{Name: "class2", Priority: PriorityMedium, EgressMax: 300},
+			},
+		}},
+		{"v2 percentage classes", v81, Entry{
+			Name:               "p4",
+			EgressMax:          1000,
+			EgressGuaranteed:   500,
+			ClassBandwidthType: ClassBandwidthTypePercentage,
+			Classes: []Class{
+				{Name: "class1", Priority: PriorityRealTime, EgressMax: 20, EgressGuaranteed: 10},
+				{Name: "class3", Priority: PriorityLow, EgressMax: 80},
+			},
+		}},
+	}
+}
@@ -0,0 +1,6 @@
+package logical
+
+const (
+	singular = "logical router"
+	plural   = "logical routers"
+)
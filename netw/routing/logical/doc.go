@@ -0,0 +1,15 @@
+/*
+Package logical is the client.Network.LogicalRouter namespace.
+
+Logical routers are part of the advanced routing engine, introduced in
+PAN-OS 10.2.  Firewalls using the legacy routing engine should use the
+client.Network.VirtualRouter namespace instead.
+
+The configuration for a logical router lives in its VRFs, which are in the
+client.Network.LogicalRouterVrf namespace.  Static routes, BGP, and OSPF for
+each VRF are configured in the LogicalRouterStaticRoute, LogicalRouterBgp,
+and LogicalRouterOspf namespaces respectively.
+
+Normalized object:  Entry
+*/
+package logical
@@ -0,0 +1,64 @@
+package logical
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a logical
+// router.
+//
+// VRFs are managed through their own namespace and are preserved as-is when
+// the logical router is updated.
+type Entry struct {
+	Name string
+
+	raw map[string]string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name: o.Answer.Name,
+	}
+
+	if o.Answer.Vrf != nil {
+		ans.raw = map[string]string{
+			"vrf": util.CleanRawXml(o.Answer.Vrf.Text),
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName xml.Name     `xml:"entry"`
+	Name    string       `xml:"name,attr"`
+	Vrf     *util.RawXml `xml:"vrf"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name: e.Name,
+	}
+
+	if text, present := e.raw["vrf"]; present {
+		ans.Vrf = &util.RawXml{text}
+	}
+
+	return ans
+}
@@ -0,0 +1,6 @@
+package redist
+
+const (
+	singular = "bgp redistribution routing profile"
+	plural   = "bgp redistribution routing profiles"
+)
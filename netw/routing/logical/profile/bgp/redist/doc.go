@@ -0,0 +1,6 @@
+/*
+Package redist is the client.Network.BgpRedistRoutingProfile namespace.
+
+Normalized object:  Entry
+*/
+package redist
@@ -0,0 +1,120 @@
+package redist
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a BGP
+// redistribution routing profile for IPv4 unicast.
+type Entry struct {
+	Name              string
+	StaticEnable      bool
+	StaticMetric      int
+	StaticRouteMap    string
+	ConnectedEnable   bool
+	ConnectedMetric   int
+	ConnectedRouteMap string
+	OspfEnable        bool
+	OspfMetric        int
+	OspfRouteMap      string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.StaticEnable = s.StaticEnable
+	o.StaticMetric = s.StaticMetric
+	o.StaticRouteMap = s.StaticRouteMap
+	o.ConnectedEnable = s.ConnectedEnable
+	o.ConnectedMetric = s.ConnectedMetric
+	o.ConnectedRouteMap = s.ConnectedRouteMap
+	o.OspfEnable = s.OspfEnable
+	o.OspfMetric = s.OspfMetric
+	o.OspfRouteMap = s.OspfRouteMap
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name: o.Answer.Name,
+	}
+
+	if o.Answer.Ipv4 != nil && o.Answer.Ipv4.Unicast != nil {
+		u := o.Answer.Ipv4.Unicast
+		ans.StaticEnable, ans.StaticMetric, ans.StaticRouteMap = u.Static.normalize()
+		ans.ConnectedEnable, ans.ConnectedMetric, ans.ConnectedRouteMap = u.Connected.normalize()
+		ans.OspfEnable, ans.OspfMetric, ans.OspfRouteMap = u.Ospf.normalize()
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName xml.Name `xml:"entry"`
+	Name    string   `xml:"name,attr"`
+	Ipv4    *ipv4    `xml:"ipv4"`
+}
+
+type ipv4 struct {
+	Unicast *unicast `xml:"unicast"`
+}
+
+type unicast struct {
+	Static    *source `xml:"static"`
+	Connected *source `xml:"connected"`
+	Ospf      *source `xml:"ospf"`
+}
+
+type source struct {
+	Enable   string `xml:"enable"`
+	Metric   int    `xml:"metric,omitempty"`
+	RouteMap string `xml:"route-map,omitempty"`
+}
+
+func (o *source) normalize() (bool, int, string) {
+	if o == nil {
+		return false, 0, ""
+	}
+
+	return util.AsBool(o.Enable), o.Metric, o.RouteMap
+}
+
+func specifySource(enable bool, metric int, routeMap string) *source {
+	if !enable && metric == 0 && routeMap == "" {
+		return nil
+	}
+
+	return &source{
+		Enable:   util.YesNo(enable),
+		Metric:   metric,
+		RouteMap: routeMap,
+	}
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name: e.Name,
+	}
+
+	u := unicast{
+		Static:    specifySource(e.StaticEnable, e.StaticMetric, e.StaticRouteMap),
+		Connected: specifySource(e.ConnectedEnable, e.ConnectedMetric, e.ConnectedRouteMap),
+		Ospf:      specifySource(e.OspfEnable, e.OspfMetric, e.OspfRouteMap),
+	}
+	if u.Static != nil || u.Connected != nil || u.Ospf != nil {
+		ans.Ipv4 = &ipv4{Unicast: &u}
+	}
+
+	return ans
+}
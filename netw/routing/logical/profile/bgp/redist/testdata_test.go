@@ -0,0 +1,27 @@
+package redist
+
+func getTests() []testCase {
+	return []testCase{
+		{"nothing redistributed", Entry{
+			Name: "r1",
+		}},
+		{"static and connected", Entry{
+			Name:            "r2",
+			StaticEnable:    true,
+			StaticMetric:    10,
+			StaticRouteMap:  "rm",
+			ConnectedEnable: true,
+		}},
+		{"ospf", Entry{
+			Name:         "r3",
+			OspfEnable:   true,
+			OspfMetric:   20,
+			OspfRouteMap: "ospf-rm",
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
@@ -0,0 +1,6 @@
+package timer
+
+const (
+	singular = "bgp timer routing profile"
+	plural   = "bgp timer routing profiles"
+)
@@ -0,0 +1,6 @@
+/*
+Package timer is the client.Network.BgpTimerRoutingProfile namespace.
+
+Normalized object:  Entry
+*/
+package timer
@@ -0,0 +1,72 @@
+package timer
+
+import (
+	"encoding/xml"
+)
+
+// Entry is a normalized, version independent representation of a BGP timer
+// routing profile.
+type Entry struct {
+	Name                   string
+	KeepAliveInterval      int
+	HoldTime               int
+	ReconnectRetryInterval int
+	OpenDelayTime          int
+	MinRouteAdvInterval    int
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.KeepAliveInterval = s.KeepAliveInterval
+	o.HoldTime = s.HoldTime
+	o.ReconnectRetryInterval = s.ReconnectRetryInterval
+	o.OpenDelayTime = s.OpenDelayTime
+	o.MinRouteAdvInterval = s.MinRouteAdvInterval
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:                   o.Answer.Name,
+		KeepAliveInterval:      o.Answer.KeepAliveInterval,
+		HoldTime:               o.Answer.HoldTime,
+		ReconnectRetryInterval: o.Answer.ReconnectRetryInterval,
+		OpenDelayTime:          o.Answer.OpenDelayTime,
+		MinRouteAdvInterval:    o.Answer.MinRouteAdvInterval,
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName                xml.Name `xml:"entry"`
+	Name                   string   `xml:"name,attr"`
+	KeepAliveInterval      int      `xml:"keep-alive-interval,omitempty"`
+	HoldTime               int      `xml:"hold-time,omitempty"`
+	ReconnectRetryInterval int      `xml:"reconnect-retry-interval,omitempty"`
+	OpenDelayTime          int      `xml:"open-delay-time,omitempty"`
+	MinRouteAdvInterval    int      `xml:"min-route-adv-interval,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:                   e.Name,
+		KeepAliveInterval:      e.KeepAliveInterval,
+		HoldTime:               e.HoldTime,
+		ReconnectRetryInterval: e.ReconnectRetryInterval,
+		OpenDelayTime:          e.OpenDelayTime,
+		MinRouteAdvInterval:    e.MinRouteAdvInterval,
+	}
+
+	return ans
+}
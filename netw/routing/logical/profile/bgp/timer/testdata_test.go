@@ -0,0 +1,22 @@
+package timer
+
+func getTests() []testCase {
+	return []testCase{
+		{"defaults", Entry{
+			Name: "t1",
+		}},
+		{"all timers", Entry{
+			Name:                   "t2",
+			KeepAliveInterval:      30,
+			HoldTime:               90,
+			ReconnectRetryInterval: 15,
+			OpenDelayTime:          5,
+			MinRouteAdvInterval:    30,
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
@@ -0,0 +1,20 @@
+package logical
+
+func getTests() []testCase {
+	return []testCase{
+		{"no vrfs", Entry{
+			Name: "lr1",
+		}},
+		{"with vrfs", Entry{
+			Name: "lr2",
+			raw: map[string]string{
+				"vrf": "<entry name=\"default\"><interface><member>ethernet1/1</member></interface></entry>",
+			},
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
@@ -0,0 +1,228 @@
+package bgp
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Config is a normalized, version independent representation of a logical
+// router VRF's BGP configuration.
+//
+// Peer groups, aggregate routes, and advertised networks are preserved as-is
+// when the config is updated.
+type Config struct {
+	Enable                      bool
+	RouterId                    string
+	LocalAs                     string
+	InstallRoute                bool
+	EnforceFirstAs              bool
+	FastExternalFailover        bool
+	EcmpMultiAs                 bool
+	DefaultLocalPreference      int
+	AlwaysAdvertiseNetworkRoute bool
+	AlwaysCompareMed            bool
+	DeterministicMedComparison  bool
+	EnableGracefulRestart       bool
+	StaleRouteTime              int
+	MaxPeerRestartTime          int
+	LocalRestartTime            int
+	BfdProfile                  string // XML: global-bfd/profile
+	Ipv4RedistributionProfile   string
+	Ipv6RedistributionProfile   string
+
+	raw map[string]string
+}
+
+// Copy copies the information from source Config `s` to this object.
+func (o *Config) Copy(s Config) {
+	o.Enable = s.Enable
+	o.RouterId = s.RouterId
+	o.LocalAs = s.LocalAs
+	o.InstallRoute = s.InstallRoute
+	o.EnforceFirstAs = s.EnforceFirstAs
+	o.FastExternalFailover = s.FastExternalFailover
+	o.EcmpMultiAs = s.EcmpMultiAs
+	o.DefaultLocalPreference = s.DefaultLocalPreference
+	o.AlwaysAdvertiseNetworkRoute = s.AlwaysAdvertiseNetworkRoute
+	o.AlwaysCompareMed = s.AlwaysCompareMed
+	o.DeterministicMedComparison = s.DeterministicMedComparison
+	o.EnableGracefulRestart = s.EnableGracefulRestart
+	o.StaleRouteTime = s.StaleRouteTime
+	o.MaxPeerRestartTime = s.MaxPeerRestartTime
+	o.LocalRestartTime = s.LocalRestartTime
+	o.BfdProfile = s.BfdProfile
+	o.Ipv4RedistributionProfile = s.Ipv4RedistributionProfile
+	o.Ipv6RedistributionProfile = s.Ipv6RedistributionProfile
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Config
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>bgp"`
+}
+
+func (o *container_v1) Normalize() Config {
+	ans := Config{
+		Enable:                      util.AsBool(o.Answer.Enable),
+		RouterId:                    o.Answer.RouterId,
+		LocalAs:                     o.Answer.LocalAs,
+		InstallRoute:                util.AsBool(o.Answer.InstallRoute),
+		EnforceFirstAs:              util.AsBool(o.Answer.EnforceFirstAs),
+		FastExternalFailover:        util.AsBool(o.Answer.FastExternalFailover),
+		EcmpMultiAs:                 util.AsBool(o.Answer.EcmpMultiAs),
+		DefaultLocalPreference:      o.Answer.DefaultLocalPreference,
+		AlwaysAdvertiseNetworkRoute: util.AsBool(o.Answer.AlwaysAdvertiseNetworkRoute),
+	}
+
+	if o.Answer.Med != nil {
+		ans.AlwaysCompareMed = util.AsBool(o.Answer.Med.AlwaysCompareMed)
+		ans.DeterministicMedComparison = util.AsBool(o.Answer.Med.DeterministicMedComparison)
+	}
+
+	if o.Answer.GracefulRestart != nil {
+		ans.EnableGracefulRestart = util.AsBool(o.Answer.GracefulRestart.Enable)
+		ans.StaleRouteTime = o.Answer.GracefulRestart.StaleRouteTime
+		ans.MaxPeerRestartTime = o.Answer.GracefulRestart.MaxPeerRestartTime
+		ans.LocalRestartTime = o.Answer.GracefulRestart.LocalRestartTime
+	}
+
+	if o.Answer.Bfd != nil {
+		ans.BfdProfile = o.Answer.Bfd.Profile
+	}
+
+	if o.Answer.Redist != nil {
+		if o.Answer.Redist.Ipv4 != nil {
+			ans.Ipv4RedistributionProfile = o.Answer.Redist.Ipv4.Unicast
+		}
+		if o.Answer.Redist.Ipv6 != nil {
+			ans.Ipv6RedistributionProfile = o.Answer.Redist.Ipv6.Unicast
+		}
+	}
+
+	raw := make(map[string]string)
+
+	if o.Answer.AdvertiseNetwork != nil {
+		raw["an"] = util.CleanRawXml(o.Answer.AdvertiseNetwork.Text)
+	}
+	if o.Answer.PeerGroup != nil {
+		raw["pg"] = util.CleanRawXml(o.Answer.PeerGroup.Text)
+	}
+	if o.Answer.AggregateRoutes != nil {
+		raw["ar"] = util.CleanRawXml(o.Answer.AggregateRoutes.Text)
+	}
+
+	if len(raw) != 0 {
+		ans.raw = raw
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName                     xml.Name         `xml:"bgp"`
+	Enable                      string           `xml:"enable"`
+	RouterId                    string           `xml:"router-id,omitempty"`
+	LocalAs                     string           `xml:"local-as,omitempty"`
+	InstallRoute                string           `xml:"install-route"`
+	EnforceFirstAs              string           `xml:"enforce-first-as"`
+	FastExternalFailover        string           `xml:"fast-external-failover"`
+	EcmpMultiAs                 string           `xml:"ecmp-multi-as"`
+	DefaultLocalPreference      int              `xml:"default-local-preference,omitempty"`
+	AlwaysAdvertiseNetworkRoute string           `xml:"always-advertise-network-route"`
+	Med                         *med             `xml:"med"`
+	GracefulRestart             *gracefulRestart `xml:"graceful-restart"`
+	Bfd                         *bfd             `xml:"global-bfd"`
+	Redist                      *redist          `xml:"redistribution-profile"`
+
+	AdvertiseNetwork *util.RawXml `xml:"advertise-network"`
+	PeerGroup        *util.RawXml `xml:"peer-group"`
+	AggregateRoutes  *util.RawXml `xml:"aggregate-routes"`
+}
+
+type med struct {
+	AlwaysCompareMed           string `xml:"always-compare-med"`
+	DeterministicMedComparison string `xml:"deterministic-med-comparison"`
+}
+
+type gracefulRestart struct {
+	Enable             string `xml:"enable"`
+	StaleRouteTime     int    `xml:"stale-route-time,omitempty"`
+	MaxPeerRestartTime int    `xml:"max-peer-restart-time,omitempty"`
+	LocalRestartTime   int    `xml:"local-restart-time,omitempty"`
+}
+
+type bfd struct {
+	Profile string `xml:"profile"`
+}
+
+type redist struct {
+	Ipv4 *redistUnicast `xml:"ipv4"`
+	Ipv6 *redistUnicast `xml:"ipv6"`
+}
+
+type redistUnicast struct {
+	Unicast string `xml:"unicast"`
+}
+
+func specify_v1(e Config) interface{} {
+	ans := entry_v1{
+		Enable:                      util.YesNo(e.Enable),
+		RouterId:                    e.RouterId,
+		LocalAs:                     e.LocalAs,
+		InstallRoute:                util.YesNo(e.InstallRoute),
+		EnforceFirstAs:              util.YesNo(e.EnforceFirstAs),
+		FastExternalFailover:        util.YesNo(e.FastExternalFailover),
+		EcmpMultiAs:                 util.YesNo(e.EcmpMultiAs),
+		DefaultLocalPreference:      e.DefaultLocalPreference,
+		AlwaysAdvertiseNetworkRoute: util.YesNo(e.AlwaysAdvertiseNetworkRoute),
+	}
+
+	if e.AlwaysCompareMed || e.DeterministicMedComparison {
+		ans.Med = &med{
+			AlwaysCompareMed:           util.YesNo(e.AlwaysCompareMed),
+			DeterministicMedComparison: util.YesNo(e.DeterministicMedComparison),
+		}
+	}
+
+	if e.EnableGracefulRestart || e.StaleRouteTime != 0 || e.MaxPeerRestartTime != 0 || e.LocalRestartTime != 0 {
+		ans.GracefulRestart = &gracefulRestart{
+			Enable:             util.YesNo(e.EnableGracefulRestart),
+			StaleRouteTime:     e.StaleRouteTime,
+			MaxPeerRestartTime: e.MaxPeerRestartTime,
+			LocalRestartTime:   e.LocalRestartTime,
+		}
+	}
+
+	if e.BfdProfile != "" {
+		ans.Bfd = &bfd{
+			Profile: e.BfdProfile,
+		}
+	}
+
+	if e.Ipv4RedistributionProfile != "" || e.Ipv6RedistributionProfile != "" {
+		ans.Redist = &redist{}
+		if e.Ipv4RedistributionProfile != "" {
+			ans.Redist.Ipv4 = &redistUnicast{Unicast: e.Ipv4RedistributionProfile}
+		}
+		if e.Ipv6RedistributionProfile != "" {
+			ans.Redist.Ipv6 = &redistUnicast{Unicast: e.Ipv6RedistributionProfile}
+		}
+	}
+
+	if text, present := e.raw["an"]; present {
+		ans.AdvertiseNetwork = &util.RawXml{text}
+	}
+	if text, present := e.raw["pg"]; present {
+		ans.PeerGroup = &util.RawXml{text}
+	}
+	if text, present := e.raw["ar"]; present {
+		ans.AggregateRoutes = &util.RawXml{text}
+	}
+
+	return ans
+}
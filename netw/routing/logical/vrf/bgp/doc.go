@@ -0,0 +1,6 @@
+/*
+Package bgp is the client.Network.LogicalRouterBgp namespace.
+
+Normalized object:  Config
+*/
+package bgp
@@ -0,0 +1,125 @@
+package bgp
+
+import (
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PanoBgp is the client.Network.LogicalRouterBgp namespace.
+type PanoBgp struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *PanoBgp) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Get performs GET to retrieve the BGP config.
+func (c *PanoBgp) Get(tmpl, ts, lr, vrf string) (Config, error) {
+	c.con.LogQuery("(get) bgp config for %q vrf %q", lr, vrf)
+	return c.details(c.con.Get, tmpl, ts, lr, vrf)
+}
+
+// Show performs SHOW to retrieve the BGP config.
+func (c *PanoBgp) Show(tmpl, ts, lr, vrf string) (Config, error) {
+	c.con.LogQuery("(show) bgp config for %q vrf %q", lr, vrf)
+	return c.details(c.con.Show, tmpl, ts, lr, vrf)
+}
+
+// Set performs SET to create / update the BGP config.
+func (c *PanoBgp) Set(tmpl, ts, lr, vrf string, e Config) error {
+	var err error
+
+	if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	} else if lr == "" {
+		return fmt.Errorf("lr must be specified")
+	} else if vrf == "" {
+		return fmt.Errorf("vrf must be specified")
+	}
+
+	_, fn := c.versioning()
+	c.con.LogAction("(set) bgp config for %q vrf %q", lr, vrf)
+	path := c.xpath(tmpl, ts, lr, vrf)
+	path = path[:len(path)-1]
+
+	_, err = c.con.Set(path, fn(e), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to create / update the BGP config.
+func (c *PanoBgp) Edit(tmpl, ts, lr, vrf string, e Config) error {
+	var err error
+
+	if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	} else if lr == "" {
+		return fmt.Errorf("lr must be specified")
+	} else if vrf == "" {
+		return fmt.Errorf("vrf must be specified")
+	}
+
+	_, fn := c.versioning()
+	c.con.LogAction("(edit) bgp config for %q vrf %q", lr, vrf)
+	path := c.xpath(tmpl, ts, lr, vrf)
+
+	_, err = c.con.Edit(path, fn(e), nil, nil)
+	return err
+}
+
+// Delete removes the BGP config for the given VRF.
+func (c *PanoBgp) Delete(tmpl, ts, lr, vrf string) error {
+	var err error
+
+	if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	} else if lr == "" {
+		return fmt.Errorf("lr must be specified")
+	} else if vrf == "" {
+		return fmt.Errorf("vrf must be specified")
+	}
+
+	c.con.LogAction("(delete) bgp config for %q vrf %q", lr, vrf)
+
+	// Remove the objects.
+	path := c.xpath(tmpl, ts, lr, vrf)
+	_, err = c.con.Delete(path, nil, nil)
+	return err
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *PanoBgp) versioning() (normalizer, func(Config) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *PanoBgp) details(fn util.Retriever, tmpl, ts, lr, vrf string) (Config, error) {
+	path := c.xpath(tmpl, ts, lr, vrf)
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Config{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *PanoBgp) xpath(tmpl, ts, lr, vrf string) []string {
+	ans := make([]string, 0, 13)
+	ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"network",
+		"logical-router",
+		util.AsEntryXpath([]string{lr}),
+		"vrf",
+		util.AsEntryXpath([]string{vrf}),
+		"bgp",
+	)
+
+	return ans
+}
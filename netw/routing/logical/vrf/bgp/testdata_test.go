@@ -0,0 +1,45 @@
+package bgp
+
+func getTests() []testCase {
+	return []testCase{
+		{"basic", Config{
+			Enable:                 true,
+			RouterId:               "10.1.1.1",
+			LocalAs:                "65001",
+			InstallRoute:           true,
+			EnforceFirstAs:         true,
+			FastExternalFailover:   true,
+			DefaultLocalPreference: 100,
+		}},
+		{"med graceful restart and redist", Config{
+			Enable:                      true,
+			RouterId:                    "10.1.1.2",
+			LocalAs:                     "65002",
+			EcmpMultiAs:                 true,
+			AlwaysAdvertiseNetworkRoute: true,
+			AlwaysCompareMed:            true,
+			DeterministicMedComparison:  true,
+			EnableGracefulRestart:       true,
+			StaleRouteTime:              120,
+			MaxPeerRestartTime:          120,
+			LocalRestartTime:            120,
+			BfdProfile:                  "default",
+			Ipv4RedistributionProfile:   "redist4",
+			Ipv6RedistributionProfile:   "redist6",
+		}},
+		{"with raw", Config{
+			Enable:  true,
+			LocalAs: "65003",
+			raw: map[string]string{
+				"an": "<ipv4><network><entry name=\"10.0.0.0/8\"/></network></ipv4>",
+				"pg": "<entry name=\"pg\"><enable>yes</enable></entry>",
+				"ar": "<entry name=\"ar\"><enable>yes</enable></entry>",
+			},
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Config
+}
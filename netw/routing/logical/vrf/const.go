@@ -0,0 +1,14 @@
+package vrf
+
+// Valid values for EcmpLoadBalanceMethod.
+const (
+	EcmpLoadBalanceMethodIpModulo           = "ip-modulo"
+	EcmpLoadBalanceMethodIpHash             = "ip-hash"
+	EcmpLoadBalanceMethodWeightedRoundRobin = "weighted-round-robin"
+	EcmpLoadBalanceMethodBalancedRoundRobin = "balanced-round-robin"
+)
+
+const (
+	singular = "logical router vrf"
+	plural   = "logical router vrfs"
+)
@@ -0,0 +1,6 @@
+/*
+Package vrf is the client.Network.LogicalRouterVrf namespace.
+
+Normalized object:  Entry
+*/
+package vrf
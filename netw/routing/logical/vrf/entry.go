@@ -0,0 +1,323 @@
+package vrf
+
+import (
+	"encoding/xml"
+	"sort"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a logical
+// router VRF.
+//
+// Static routes and routing protocols are managed through their own
+// namespaces and are preserved as-is when the VRF is updated.
+type Entry struct {
+	Name                             string
+	Interfaces                       []string
+	StaticDist                       int
+	StaticIpv6Dist                   int
+	OspfInterDist                    int
+	OspfIntraDist                    int
+	OspfExtDist                      int
+	Ospfv3InterDist                  int
+	Ospfv3IntraDist                  int
+	Ospfv3ExtDist                    int
+	BgpInternalDist                  int
+	BgpExternalDist                  int
+	BgpLocalDist                     int
+	RipDist                          int
+	EnableEcmp                       bool
+	EcmpMaxPath                      int
+	EcmpSymmetricReturn              bool
+	EcmpStrictSourcePath             bool
+	EcmpLoadBalanceMethod            string
+	EcmpHashSourceOnly               bool
+	EcmpHashUsePort                  bool
+	EcmpHashSeed                     int
+	EcmpWeightedRoundRobinInterfaces map[string]int
+
+	raw map[string]string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Interfaces = s.Interfaces
+	o.StaticDist = s.StaticDist
+	o.StaticIpv6Dist = s.StaticIpv6Dist
+	o.OspfInterDist = s.OspfInterDist
+	o.OspfIntraDist = s.OspfIntraDist
+	o.OspfExtDist = s.OspfExtDist
+	o.Ospfv3InterDist = s.Ospfv3InterDist
+	o.Ospfv3IntraDist = s.Ospfv3IntraDist
+	o.Ospfv3ExtDist = s.Ospfv3ExtDist
+	o.BgpInternalDist = s.BgpInternalDist
+	o.BgpExternalDist = s.BgpExternalDist
+	o.BgpLocalDist = s.BgpLocalDist
+	o.RipDist = s.RipDist
+	o.EnableEcmp = s.EnableEcmp
+	o.EcmpMaxPath = s.EcmpMaxPath
+	o.EcmpSymmetricReturn = s.EcmpSymmetricReturn
+	o.EcmpStrictSourcePath = s.EcmpStrictSourcePath
+	o.EcmpLoadBalanceMethod = s.EcmpLoadBalanceMethod
+	o.EcmpHashSourceOnly = s.EcmpHashSourceOnly
+	o.EcmpHashUsePort = s.EcmpHashUsePort
+	o.EcmpHashSeed = s.EcmpHashSeed
+	if s.EcmpWeightedRoundRobinInterfaces == nil {
+		o.EcmpWeightedRoundRobinInterfaces = nil
+	} else {
+		o.EcmpWeightedRoundRobinInterfaces = make(map[string]int, len(s.EcmpWeightedRoundRobinInterfaces))
+		for k, v := range s.EcmpWeightedRoundRobinInterfaces {
+			o.EcmpWeightedRoundRobinInterfaces[k] = v
+		}
+	}
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:       o.Answer.Name,
+		Interfaces: util.MemToStr(o.Answer.Interfaces),
+	}
+
+	if o.Answer.Dist != nil {
+		ans.StaticDist = o.Answer.Dist.StaticDist
+		ans.StaticIpv6Dist = o.Answer.Dist.StaticIpv6Dist
+		ans.OspfInterDist = o.Answer.Dist.OspfInterDist
+		ans.OspfIntraDist = o.Answer.Dist.OspfIntraDist
+		ans.OspfExtDist = o.Answer.Dist.OspfExtDist
+		ans.Ospfv3InterDist = o.Answer.Dist.Ospfv3InterDist
+		ans.Ospfv3IntraDist = o.Answer.Dist.Ospfv3IntraDist
+		ans.Ospfv3ExtDist = o.Answer.Dist.Ospfv3ExtDist
+		ans.BgpInternalDist = o.Answer.Dist.BgpInternalDist
+		ans.BgpExternalDist = o.Answer.Dist.BgpExternalDist
+		ans.BgpLocalDist = o.Answer.Dist.BgpLocalDist
+		ans.RipDist = o.Answer.Dist.RipDist
+	}
+
+	if o.Answer.Ecmp != nil {
+		ans.EnableEcmp = util.AsBool(o.Answer.Ecmp.Enable)
+		ans.EcmpMaxPath = o.Answer.Ecmp.MaxPath
+		ans.EcmpSymmetricReturn = util.AsBool(o.Answer.Ecmp.SymmetricReturn)
+		ans.EcmpStrictSourcePath = util.AsBool(o.Answer.Ecmp.StrictSourcePath)
+
+		if o.Answer.Ecmp.Algorithm != nil {
+			switch {
+			case o.Answer.Ecmp.Algorithm.IpModulo != nil:
+				ans.EcmpLoadBalanceMethod = EcmpLoadBalanceMethodIpModulo
+			case o.Answer.Ecmp.Algorithm.IpHash != nil:
+				ans.EcmpLoadBalanceMethod = EcmpLoadBalanceMethodIpHash
+				ans.EcmpHashSourceOnly = util.AsBool(o.Answer.Ecmp.Algorithm.IpHash.SourceOnly)
+				ans.EcmpHashUsePort = util.AsBool(o.Answer.Ecmp.Algorithm.IpHash.UsePort)
+				ans.EcmpHashSeed = o.Answer.Ecmp.Algorithm.IpHash.HashSeed
+			case o.Answer.Ecmp.Algorithm.Wrr != nil:
+				ans.EcmpLoadBalanceMethod = EcmpLoadBalanceMethodWeightedRoundRobin
+				if o.Answer.Ecmp.Algorithm.Wrr.Interfaces != nil {
+					ans.EcmpWeightedRoundRobinInterfaces = make(map[string]int)
+					for _, v := range o.Answer.Ecmp.Algorithm.Wrr.Interfaces.Entries {
+						ans.EcmpWeightedRoundRobinInterfaces[v.Interface] = v.Weight
+					}
+				}
+			case o.Answer.Ecmp.Algorithm.Brr != nil:
+				ans.EcmpLoadBalanceMethod = EcmpLoadBalanceMethodBalancedRoundRobin
+			}
+		}
+	}
+
+	raw := make(map[string]string)
+
+	if o.Answer.RoutingTable != nil {
+		raw["rt"] = util.CleanRawXml(o.Answer.RoutingTable.Text)
+	}
+	if o.Answer.Bgp != nil {
+		raw["bgp"] = util.CleanRawXml(o.Answer.Bgp.Text)
+	}
+	if o.Answer.Ospf != nil {
+		raw["ospf"] = util.CleanRawXml(o.Answer.Ospf.Text)
+	}
+	if o.Answer.Ospfv3 != nil {
+		raw["ospfv3"] = util.CleanRawXml(o.Answer.Ospfv3.Text)
+	}
+	if o.Answer.Rip != nil {
+		raw["rip"] = util.CleanRawXml(o.Answer.Rip.Text)
+	}
+	if o.Answer.Multicast != nil {
+		raw["multicast"] = util.CleanRawXml(o.Answer.Multicast.Text)
+	}
+	if o.Answer.RibFilter != nil {
+		raw["rf"] = util.CleanRawXml(o.Answer.RibFilter.Text)
+	}
+
+	if len(raw) != 0 {
+		ans.raw = raw
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName    xml.Name         `xml:"entry"`
+	Name       string           `xml:"name,attr"`
+	Interfaces *util.MemberType `xml:"interface"`
+	Dist       *dist            `xml:"admin-dists"`
+	Ecmp       *ecmp            `xml:"ecmp"`
+
+	RoutingTable *util.RawXml `xml:"routing-table"`
+	Bgp          *util.RawXml `xml:"bgp"`
+	Ospf         *util.RawXml `xml:"ospf"`
+	Ospfv3       *util.RawXml `xml:"ospfv3"`
+	Rip          *util.RawXml `xml:"rip"`
+	Multicast    *util.RawXml `xml:"multicast"`
+	RibFilter    *util.RawXml `xml:"rib-filter"`
+}
+
+type dist struct {
+	StaticDist      int `xml:"static,omitempty"`
+	StaticIpv6Dist  int `xml:"static-ipv6,omitempty"`
+	OspfInterDist   int `xml:"ospf-inter,omitempty"`
+	OspfIntraDist   int `xml:"ospf-intra,omitempty"`
+	OspfExtDist     int `xml:"ospf-ext,omitempty"`
+	Ospfv3InterDist int `xml:"ospfv3-inter,omitempty"`
+	Ospfv3IntraDist int `xml:"ospfv3-intra,omitempty"`
+	Ospfv3ExtDist   int `xml:"ospfv3-ext,omitempty"`
+	BgpInternalDist int `xml:"bgp-internal,omitempty"`
+	BgpExternalDist int `xml:"bgp-external,omitempty"`
+	BgpLocalDist    int `xml:"bgp-local,omitempty"`
+	RipDist         int `xml:"rip,omitempty"`
+}
+
+type ecmp struct {
+	Enable           string     `xml:"enable"`
+	MaxPath          int        `xml:"max-path,omitempty"`
+	SymmetricReturn  string     `xml:"symmetric-return"`
+	StrictSourcePath string     `xml:"strict-source-path"`
+	Algorithm        *algorithm `xml:"algorithm"`
+}
+
+type algorithm struct {
+	IpModulo *string `xml:"ip-modulo"`
+	IpHash   *ipHash `xml:"ip-hash"`
+	Wrr      *wrr    `xml:"weighted-round-robin"`
+	Brr      *string `xml:"balanced-round-robin"`
+}
+
+type ipHash struct {
+	SourceOnly string `xml:"src-only"`
+	UsePort    string `xml:"use-port"`
+	HashSeed   int    `xml:"hash-seed,omitempty"`
+}
+
+type wrr struct {
+	Interfaces *wrrInterfaces `xml:"interface"`
+}
+
+type wrrInterfaces struct {
+	Entries []wrrInterface `xml:"entry"`
+}
+
+type wrrInterface struct {
+	Interface string `xml:"name,attr"`
+	Weight    int    `xml:"weight,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:       e.Name,
+		Interfaces: util.StrToMem(e.Interfaces),
+	}
+
+	if e.StaticDist != 0 || e.StaticIpv6Dist != 0 || e.OspfInterDist != 0 || e.OspfIntraDist != 0 || e.OspfExtDist != 0 || e.Ospfv3InterDist != 0 || e.Ospfv3IntraDist != 0 || e.Ospfv3ExtDist != 0 || e.BgpInternalDist != 0 || e.BgpExternalDist != 0 || e.BgpLocalDist != 0 || e.RipDist != 0 {
+		ans.Dist = &dist{
+			StaticDist:      e.StaticDist,
+			StaticIpv6Dist:  e.StaticIpv6Dist,
+			OspfInterDist:   e.OspfInterDist,
+			OspfIntraDist:   e.OspfIntraDist,
+			OspfExtDist:     e.OspfExtDist,
+			Ospfv3InterDist: e.Ospfv3InterDist,
+			Ospfv3IntraDist: e.Ospfv3IntraDist,
+			Ospfv3ExtDist:   e.Ospfv3ExtDist,
+			BgpInternalDist: e.BgpInternalDist,
+			BgpExternalDist: e.BgpExternalDist,
+			BgpLocalDist:    e.BgpLocalDist,
+			RipDist:         e.RipDist,
+		}
+	}
+
+	if e.EnableEcmp || e.EcmpMaxPath != 0 || e.EcmpSymmetricReturn || e.EcmpStrictSourcePath || e.EcmpLoadBalanceMethod != "" {
+		s := ""
+		ans.Ecmp = &ecmp{
+			Enable:           util.YesNo(e.EnableEcmp),
+			MaxPath:          e.EcmpMaxPath,
+			SymmetricReturn:  util.YesNo(e.EcmpSymmetricReturn),
+			StrictSourcePath: util.YesNo(e.EcmpStrictSourcePath),
+		}
+
+		switch e.EcmpLoadBalanceMethod {
+		case EcmpLoadBalanceMethodIpModulo:
+			ans.Ecmp.Algorithm = &algorithm{IpModulo: &s}
+		case EcmpLoadBalanceMethodIpHash:
+			ans.Ecmp.Algorithm = &algorithm{
+				IpHash: &ipHash{
+					SourceOnly: util.YesNo(e.EcmpHashSourceOnly),
+					UsePort:    util.YesNo(e.EcmpHashUsePort),
+					HashSeed:   e.EcmpHashSeed,
+				},
+			}
+		case EcmpLoadBalanceMethodWeightedRoundRobin:
+			w := &wrr{}
+			if len(e.EcmpWeightedRoundRobinInterfaces) > 0 {
+				names := make([]string, 0, len(e.EcmpWeightedRoundRobinInterfaces))
+				for name := range e.EcmpWeightedRoundRobinInterfaces {
+					names = append(names, name)
+				}
+				sort.Strings(names)
+
+				list := make([]wrrInterface, 0, len(names))
+				for _, name := range names {
+					list = append(list, wrrInterface{
+						Interface: name,
+						Weight:    e.EcmpWeightedRoundRobinInterfaces[name],
+					})
+				}
+				w.Interfaces = &wrrInterfaces{Entries: list}
+			}
+			ans.Ecmp.Algorithm = &algorithm{Wrr: w}
+		case EcmpLoadBalanceMethodBalancedRoundRobin:
+			ans.Ecmp.Algorithm = &algorithm{Brr: &s}
+		}
+	}
+
+	if text, present := e.raw["rt"]; present {
+		ans.RoutingTable = &util.RawXml{text}
+	}
+	if text, present := e.raw["bgp"]; present {
+		ans.Bgp = &util.RawXml{text}
+	}
+	if text, present := e.raw["ospf"]; present {
+		ans.Ospf = &util.RawXml{text}
+	}
+	if text, present := e.raw["ospfv3"]; present {
+		ans.Ospfv3 = &util.RawXml{text}
+	}
+	if text, present := e.raw["rip"]; present {
+		ans.Rip = &util.RawXml{text}
+	}
+	if text, present := e.raw["multicast"]; present {
+		ans.Multicast = &util.RawXml{text}
+	}
+	if text, present := e.raw["rf"]; present {
+		ans.RibFilter = &util.RawXml{text}
+	}
+
+	return ans
+}
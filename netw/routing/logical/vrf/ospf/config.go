@@ -0,0 +1,146 @@
+package ospf
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Config is a normalized, version independent representation of a logical
+// router VRF's OSPF configuration.
+//
+// The SpfTimer, GlobalInterfaceTimer, and RedistributionProfile fields are
+// the names of OSPF routing profiles.  Areas are preserved as-is when the
+// config is updated.
+type Config struct {
+	Enable                 bool
+	RouterId               string
+	Rfc1583                bool
+	SpfTimer               string
+	GlobalInterfaceTimer   string // XML: global-if-timer
+	RedistributionProfile  string
+	BfdProfile             string // XML: global-bfd/profile
+	EnableGracefulRestart  bool
+	GracePeriod            int
+	HelperEnable           bool
+	StrictLsaChecking      bool
+	MaxNeighborRestartTime int
+
+	raw map[string]string
+}
+
+// Copy copies the information from source Config `s` to this object.
+func (o *Config) Copy(s Config) {
+	o.Enable = s.Enable
+	o.RouterId = s.RouterId
+	o.Rfc1583 = s.Rfc1583
+	o.SpfTimer = s.SpfTimer
+	o.GlobalInterfaceTimer = s.GlobalInterfaceTimer
+	o.RedistributionProfile = s.RedistributionProfile
+	o.BfdProfile = s.BfdProfile
+	o.EnableGracefulRestart = s.EnableGracefulRestart
+	o.GracePeriod = s.GracePeriod
+	o.HelperEnable = s.HelperEnable
+	o.StrictLsaChecking = s.StrictLsaChecking
+	o.MaxNeighborRestartTime = s.MaxNeighborRestartTime
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Config
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>ospf"`
+}
+
+func (o *container_v1) Normalize() Config {
+	ans := Config{
+		Enable:                util.AsBool(o.Answer.Enable),
+		RouterId:              o.Answer.RouterId,
+		Rfc1583:               util.AsBool(o.Answer.Rfc1583),
+		SpfTimer:              o.Answer.SpfTimer,
+		GlobalInterfaceTimer:  o.Answer.GlobalInterfaceTimer,
+		RedistributionProfile: o.Answer.RedistributionProfile,
+	}
+
+	if o.Answer.Bfd != nil {
+		ans.BfdProfile = o.Answer.Bfd.Profile
+	}
+
+	if o.Answer.GracefulRestart != nil {
+		ans.EnableGracefulRestart = util.AsBool(o.Answer.GracefulRestart.Enable)
+		ans.GracePeriod = o.Answer.GracefulRestart.GracePeriod
+		ans.HelperEnable = util.AsBool(o.Answer.GracefulRestart.HelperEnable)
+		ans.StrictLsaChecking = util.AsBool(o.Answer.GracefulRestart.StrictLsaChecking)
+		ans.MaxNeighborRestartTime = o.Answer.GracefulRestart.MaxNeighborRestartTime
+	}
+
+	if o.Answer.Area != nil {
+		ans.raw = map[string]string{
+			"area": util.CleanRawXml(o.Answer.Area.Text),
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName               xml.Name         `xml:"ospf"`
+	Enable                string           `xml:"enable"`
+	RouterId              string           `xml:"router-id,omitempty"`
+	Rfc1583               string           `xml:"rfc1583"`
+	SpfTimer              string           `xml:"spf-timer,omitempty"`
+	GlobalInterfaceTimer  string           `xml:"global-if-timer,omitempty"`
+	RedistributionProfile string           `xml:"redistribution-profile,omitempty"`
+	Bfd                   *bfd             `xml:"global-bfd"`
+	GracefulRestart       *gracefulRestart `xml:"graceful-restart"`
+
+	Area *util.RawXml `xml:"area"`
+}
+
+type bfd struct {
+	Profile string `xml:"profile"`
+}
+
+type gracefulRestart struct {
+	Enable                 string `xml:"enable"`
+	GracePeriod            int    `xml:"grace-period,omitempty"`
+	HelperEnable           string `xml:"helper-enable"`
+	StrictLsaChecking      string `xml:"strict-LSA-checking"`
+	MaxNeighborRestartTime int    `xml:"max-neighbor-restart-time,omitempty"`
+}
+
+func specify_v1(e Config) interface{} {
+	ans := entry_v1{
+		Enable:                util.YesNo(e.Enable),
+		RouterId:              e.RouterId,
+		Rfc1583:               util.YesNo(e.Rfc1583),
+		SpfTimer:              e.SpfTimer,
+		GlobalInterfaceTimer:  e.GlobalInterfaceTimer,
+		RedistributionProfile: e.RedistributionProfile,
+	}
+
+	if e.BfdProfile != "" {
+		ans.Bfd = &bfd{
+			Profile: e.BfdProfile,
+		}
+	}
+
+	if e.EnableGracefulRestart || e.GracePeriod != 0 || e.HelperEnable || e.StrictLsaChecking || e.MaxNeighborRestartTime != 0 {
+		ans.GracefulRestart = &gracefulRestart{
+			Enable:                 util.YesNo(e.EnableGracefulRestart),
+			GracePeriod:            e.GracePeriod,
+			HelperEnable:           util.YesNo(e.HelperEnable),
+			StrictLsaChecking:      util.YesNo(e.StrictLsaChecking),
+			MaxNeighborRestartTime: e.MaxNeighborRestartTime,
+		}
+	}
+
+	if text, present := e.raw["area"]; present {
+		ans.Area = &util.RawXml{text}
+	}
+
+	return ans
+}
@@ -0,0 +1,6 @@
+/*
+Package ospf is the client.Network.LogicalRouterOspf namespace.
+
+Normalized object:  Config
+*/
+package ospf
@@ -0,0 +1,125 @@
+package ospf
+
+import (
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PanoOspf is the client.Network.LogicalRouterOspf namespace.
+type PanoOspf struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *PanoOspf) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Get performs GET to retrieve the OSPF config.
+func (c *PanoOspf) Get(tmpl, ts, lr, vrf string) (Config, error) {
+	c.con.LogQuery("(get) ospf config for %q vrf %q", lr, vrf)
+	return c.details(c.con.Get, tmpl, ts, lr, vrf)
+}
+
+// Show performs SHOW to retrieve the OSPF config.
+func (c *PanoOspf) Show(tmpl, ts, lr, vrf string) (Config, error) {
+	c.con.LogQuery("(show) ospf config for %q vrf %q", lr, vrf)
+	return c.details(c.con.Show, tmpl, ts, lr, vrf)
+}
+
+// Set performs SET to create / update the OSPF config.
+func (c *PanoOspf) Set(tmpl, ts, lr, vrf string, e Config) error {
+	var err error
+
+	if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	} else if lr == "" {
+		return fmt.Errorf("lr must be specified")
+	} else if vrf == "" {
+		return fmt.Errorf("vrf must be specified")
+	}
+
+	_, fn := c.versioning()
+	c.con.LogAction("(set) ospf config for %q vrf %q", lr, vrf)
+	path := c.xpath(tmpl, ts, lr, vrf)
+	path = path[:len(path)-1]
+
+	_, err = c.con.Set(path, fn(e), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to create / update the OSPF config.
+func (c *PanoOspf) Edit(tmpl, ts, lr, vrf string, e Config) error {
+	var err error
+
+	if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	} else if lr == "" {
+		return fmt.Errorf("lr must be specified")
+	} else if vrf == "" {
+		return fmt.Errorf("vrf must be specified")
+	}
+
+	_, fn := c.versioning()
+	c.con.LogAction("(edit) ospf config for %q vrf %q", lr, vrf)
+	path := c.xpath(tmpl, ts, lr, vrf)
+
+	_, err = c.con.Edit(path, fn(e), nil, nil)
+	return err
+}
+
+// Delete removes the OSPF config for the given VRF.
+func (c *PanoOspf) Delete(tmpl, ts, lr, vrf string) error {
+	var err error
+
+	if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	} else if lr == "" {
+		return fmt.Errorf("lr must be specified")
+	} else if vrf == "" {
+		return fmt.Errorf("vrf must be specified")
+	}
+
+	c.con.LogAction("(delete) ospf config for %q vrf %q", lr, vrf)
+
+	// Remove the objects.
+	path := c.xpath(tmpl, ts, lr, vrf)
+	_, err = c.con.Delete(path, nil, nil)
+	return err
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *PanoOspf) versioning() (normalizer, func(Config) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *PanoOspf) details(fn util.Retriever, tmpl, ts, lr, vrf string) (Config, error) {
+	path := c.xpath(tmpl, ts, lr, vrf)
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Config{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *PanoOspf) xpath(tmpl, ts, lr, vrf string) []string {
+	ans := make([]string, 0, 13)
+	ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"network",
+		"logical-router",
+		util.AsEntryXpath([]string{lr}),
+		"vrf",
+		util.AsEntryXpath([]string{vrf}),
+		"ospf",
+	)
+
+	return ans
+}
@@ -0,0 +1,35 @@
+package ospf
+
+func getTests() []testCase {
+	return []testCase{
+		{"basic", Config{
+			Enable:   true,
+			RouterId: "10.1.1.1",
+			Rfc1583:  true,
+		}},
+		{"profiles and graceful restart", Config{
+			Enable:                 true,
+			RouterId:               "10.1.1.2",
+			SpfTimer:               "spf",
+			GlobalInterfaceTimer:   "ift",
+			RedistributionProfile:  "redist",
+			BfdProfile:             "default",
+			EnableGracefulRestart:  true,
+			GracePeriod:            120,
+			HelperEnable:           true,
+			StrictLsaChecking:      true,
+			MaxNeighborRestartTime: 140,
+		}},
+		{"with raw", Config{
+			Enable: true,
+			raw: map[string]string{
+				"area": "<entry name=\"0.0.0.0\"><type><normal/></type></entry>",
+			},
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Config
+}
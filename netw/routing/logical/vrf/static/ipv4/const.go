@@ -0,0 +1,19 @@
+package ipv4
+
+// Valid values for Type.
+const (
+	NextHopDiscard   = "discard"
+	NextHopIpAddress = "ip-address"
+	NextHopFqdn      = "fqdn"
+	NextHopNextLr    = "next-lr"
+)
+
+// Valid values for BfdProfile, besides an actual BFD profile's name.
+const (
+	BfdProfileNone = "None"
+)
+
+const (
+	singular = "logical router ipv4 static route"
+	plural   = "logical router ipv4 static routes"
+)
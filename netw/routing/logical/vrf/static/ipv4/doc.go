@@ -0,0 +1,6 @@
+/*
+Package ipv4 is the client.Network.LogicalRouterStaticRoute namespace.
+
+Normalized object:  Entry
+*/
+package ipv4
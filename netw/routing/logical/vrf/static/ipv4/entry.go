@@ -0,0 +1,123 @@
+package ipv4
+
+import (
+	"encoding/xml"
+)
+
+// Entry is a normalized, version independent representation of an IPv4
+// static route in a logical router VRF.
+type Entry struct {
+	Name          string
+	Destination   string
+	Interface     string
+	Type          string
+	NextHop       string
+	AdminDistance int
+	Metric        int
+	BfdProfile    string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Destination = s.Destination
+	o.Interface = s.Interface
+	o.Type = s.Type
+	o.NextHop = s.NextHop
+	o.AdminDistance = s.AdminDistance
+	o.Metric = s.Metric
+	o.BfdProfile = s.BfdProfile
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:          o.Answer.Name,
+		Destination:   o.Answer.Destination,
+		Interface:     o.Answer.Interface,
+		AdminDistance: o.Answer.AdminDistance,
+		Metric:        o.Answer.Metric,
+	}
+
+	if o.Answer.NextHop != nil {
+		switch {
+		case o.Answer.NextHop.Discard != nil:
+			ans.Type = NextHopDiscard
+		case o.Answer.NextHop.IpAddress != nil:
+			ans.Type = NextHopIpAddress
+			ans.NextHop = *o.Answer.NextHop.IpAddress
+		case o.Answer.NextHop.Fqdn != nil:
+			ans.Type = NextHopFqdn
+			ans.NextHop = *o.Answer.NextHop.Fqdn
+		case o.Answer.NextHop.NextLr != nil:
+			ans.Type = NextHopNextLr
+			ans.NextHop = *o.Answer.NextHop.NextLr
+		}
+	}
+
+	if o.Answer.Bfd != nil {
+		ans.BfdProfile = o.Answer.Bfd.Profile
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName       xml.Name `xml:"entry"`
+	Name          string   `xml:"name,attr"`
+	Destination   string   `xml:"destination"`
+	Interface     string   `xml:"interface,omitempty"`
+	NextHop       *nextHop `xml:"nexthop"`
+	AdminDistance int      `xml:"admin-dist,omitempty"`
+	Metric        int      `xml:"metric,omitempty"`
+	Bfd           *bfd     `xml:"bfd"`
+}
+
+type nextHop struct {
+	Discard   *string `xml:"discard"`
+	IpAddress *string `xml:"ip-address"`
+	Fqdn      *string `xml:"fqdn"`
+	NextLr    *string `xml:"next-lr"`
+}
+
+type bfd struct {
+	Profile string `xml:"profile"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:          e.Name,
+		Destination:   e.Destination,
+		Interface:     e.Interface,
+		AdminDistance: e.AdminDistance,
+		Metric:        e.Metric,
+	}
+
+	sp := e.NextHop
+	switch e.Type {
+	case NextHopDiscard:
+		s := ""
+		ans.NextHop = &nextHop{Discard: &s}
+	case NextHopIpAddress:
+		ans.NextHop = &nextHop{IpAddress: &sp}
+	case NextHopFqdn:
+		ans.NextHop = &nextHop{Fqdn: &sp}
+	case NextHopNextLr:
+		ans.NextHop = &nextHop{NextLr: &sp}
+	}
+
+	if e.BfdProfile != "" {
+		ans.Bfd = &bfd{Profile: e.BfdProfile}
+	}
+
+	return ans
+}
@@ -0,0 +1,38 @@
+package ipv4
+
+func getTests() []testCase {
+	return []testCase{
+		{"ip address nexthop", Entry{
+			Name:          "r1",
+			Destination:   "0.0.0.0/0",
+			Interface:     "ethernet1/1",
+			Type:          NextHopIpAddress,
+			NextHop:       "10.1.1.1",
+			AdminDistance: 10,
+			Metric:        10,
+			BfdProfile:    "default",
+		}},
+		{"discard", Entry{
+			Name:        "r2",
+			Destination: "10.0.0.0/8",
+			Type:        NextHopDiscard,
+		}},
+		{"fqdn nexthop", Entry{
+			Name:        "r3",
+			Destination: "10.2.0.0/16",
+			Type:        NextHopFqdn,
+			NextHop:     "gw.example.com",
+		}},
+		{"next lr", Entry{
+			Name:        "r4",
+			Destination: "10.3.0.0/16",
+			Type:        NextHopNextLr,
+			NextHop:     "lr2",
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
@@ -0,0 +1,61 @@
+package vrf
+
+func getTests() []testCase {
+	return []testCase{
+		{"interfaces and admin dists", Entry{
+			Name:            "default",
+			Interfaces:      []string{"ethernet1/1", "ethernet1/2"},
+			StaticDist:      10,
+			StaticIpv6Dist:  10,
+			OspfInterDist:   110,
+			OspfIntraDist:   110,
+			OspfExtDist:     110,
+			Ospfv3InterDist: 110,
+			Ospfv3IntraDist: 110,
+			Ospfv3ExtDist:   110,
+			BgpInternalDist: 200,
+			BgpExternalDist: 20,
+			BgpLocalDist:    20,
+			RipDist:         120,
+		}},
+		{"ecmp ip hash", Entry{
+			Name:                  "v2",
+			EnableEcmp:            true,
+			EcmpMaxPath:           4,
+			EcmpSymmetricReturn:   true,
+			EcmpLoadBalanceMethod: EcmpLoadBalanceMethodIpHash,
+			EcmpHashSourceOnly:    true,
+			EcmpHashUsePort:       true,
+			EcmpHashSeed:          7,
+		}},
+		{"ecmp wrr", Entry{
+			Name:                  "v3",
+			EnableEcmp:            true,
+			EcmpStrictSourcePath:  true,
+			EcmpLoadBalanceMethod: EcmpLoadBalanceMethodWeightedRoundRobin,
+			EcmpWeightedRoundRobinInterfaces: map[string]int{
+				"ethernet1/1": 1,
+				"ethernet1/2": 5,
+			},
+		}},
+		{"ecmp brr with raw", Entry{
+			Name:                  "v4",
+			EnableEcmp:            true,
+			EcmpLoadBalanceMethod: EcmpLoadBalanceMethodBalancedRoundRobin,
+			raw: map[string]string{
+				"rt":        "<ip><static-route><entry name=\"r\"><destination>0.0.0.0/0</destination></entry></static-route></ip>",
+				"bgp":       "<enable>yes</enable>",
+				"ospf":      "<enable>no</enable>",
+				"ospfv3":    "<enable>no</enable>",
+				"rip":       "<enable>no</enable>",
+				"multicast": "<enable>no</enable>",
+				"rf":        "<ipv4><static-route-map>rm</static-route-map></ipv4>",
+			},
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
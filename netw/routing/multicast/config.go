@@ -0,0 +1,197 @@
+package multicast
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Config is a normalized, version independent representation of a virtual
+// router's IP multicast configuration.
+//
+// The local rendezvous point is configured with the LocalRpType and Rp*
+// fields.  The RpOverride field only applies to static rendezvous points,
+// while RpPriority and RpAdvertisementInterval only apply to candidate
+// rendezvous points.
+//
+// External rendezvous points, SPT thresholds, interface groups, and the
+// source specific address space are preserved as-is when the config is
+// updated.
+type Config struct {
+	Enable                  bool
+	RpfLookupMode           string
+	RouteAgeoutTime         int
+	LocalRpType             string
+	RpInterface             string
+	RpAddress               string
+	RpOverride              bool
+	RpPriority              int
+	RpAdvertisementInterval int
+	RpGroupAddresses        []string // ordered
+
+	raw map[string]string
+}
+
+// Copy copies the information from source Config `s` to this object.
+func (o *Config) Copy(s Config) {
+	o.Enable = s.Enable
+	o.RpfLookupMode = s.RpfLookupMode
+	o.RouteAgeoutTime = s.RouteAgeoutTime
+	o.LocalRpType = s.LocalRpType
+	o.RpInterface = s.RpInterface
+	o.RpAddress = s.RpAddress
+	o.RpOverride = s.RpOverride
+	o.RpPriority = s.RpPriority
+	o.RpAdvertisementInterval = s.RpAdvertisementInterval
+	o.RpGroupAddresses = s.RpGroupAddresses
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Config
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>multicast"`
+}
+
+func (o *container_v1) Normalize() Config {
+	ans := Config{
+		Enable:          util.AsBool(o.Answer.Enable),
+		RpfLookupMode:   o.Answer.RpfLookupMode,
+		RouteAgeoutTime: o.Answer.RouteAgeoutTime,
+	}
+
+	raw := make(map[string]string)
+
+	if o.Answer.Rp != nil {
+		if o.Answer.Rp.Local != nil {
+			switch {
+			case o.Answer.Rp.Local.Static != nil:
+				ans.LocalRpType = LocalRpTypeStatic
+				ans.RpInterface = o.Answer.Rp.Local.Static.Interface
+				ans.RpAddress = o.Answer.Rp.Local.Static.Address
+				ans.RpOverride = util.AsBool(o.Answer.Rp.Local.Static.Override)
+				ans.RpGroupAddresses = util.MemToStr(o.Answer.Rp.Local.Static.GroupAddresses)
+			case o.Answer.Rp.Local.Candidate != nil:
+				ans.LocalRpType = LocalRpTypeCandidate
+				ans.RpInterface = o.Answer.Rp.Local.Candidate.Interface
+				ans.RpAddress = o.Answer.Rp.Local.Candidate.Address
+				ans.RpPriority = o.Answer.Rp.Local.Candidate.Priority
+				ans.RpAdvertisementInterval = o.Answer.Rp.Local.Candidate.AdvertisementInterval
+				ans.RpGroupAddresses = util.MemToStr(o.Answer.Rp.Local.Candidate.GroupAddresses)
+			}
+		}
+
+		if o.Answer.Rp.External != nil {
+			raw["erp"] = util.CleanRawXml(o.Answer.Rp.External.Text)
+		}
+	}
+
+	if o.Answer.SptThreshold != nil {
+		raw["spt"] = util.CleanRawXml(o.Answer.SptThreshold.Text)
+	}
+	if o.Answer.InterfaceGroup != nil {
+		raw["ig"] = util.CleanRawXml(o.Answer.InterfaceGroup.Text)
+	}
+	if o.Answer.SsmAddressSpace != nil {
+		raw["ssm"] = util.CleanRawXml(o.Answer.SsmAddressSpace.Text)
+	}
+
+	if len(raw) != 0 {
+		ans.raw = raw
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName         xml.Name `xml:"multicast"`
+	Enable          string   `xml:"enable"`
+	RpfLookupMode   string   `xml:"rpf-lookup-mode,omitempty"`
+	RouteAgeoutTime int      `xml:"route-ageout-time,omitempty"`
+	Rp              *rp      `xml:"rp"`
+
+	SptThreshold    *util.RawXml `xml:"spt-threshold"`
+	InterfaceGroup  *util.RawXml `xml:"interface-group"`
+	SsmAddressSpace *util.RawXml `xml:"ssm-address-space"`
+}
+
+type rp struct {
+	Local    *localRp     `xml:"local-rp"`
+	External *util.RawXml `xml:"external-rp"`
+}
+
+type localRp struct {
+	Static    *staticRp    `xml:"static-rp"`
+	Candidate *candidateRp `xml:"candidate-rp"`
+}
+
+type staticRp struct {
+	Interface      string           `xml:"interface,omitempty"`
+	Address        string           `xml:"address,omitempty"`
+	Override       string           `xml:"override"`
+	GroupAddresses *util.MemberType `xml:"group-addresses"`
+}
+
+type candidateRp struct {
+	Interface             string           `xml:"interface,omitempty"`
+	Address               string           `xml:"address,omitempty"`
+	Priority              int              `xml:"priority,omitempty"`
+	AdvertisementInterval int              `xml:"advertisement-interval,omitempty"`
+	GroupAddresses        *util.MemberType `xml:"group-addresses"`
+}
+
+func specify_v1(e Config) interface{} {
+	ans := entry_v1{
+		Enable:          util.YesNo(e.Enable),
+		RpfLookupMode:   e.RpfLookupMode,
+		RouteAgeoutTime: e.RouteAgeoutTime,
+	}
+
+	var local *localRp
+	switch e.LocalRpType {
+	case LocalRpTypeStatic:
+		local = &localRp{
+			Static: &staticRp{
+				Interface:      e.RpInterface,
+				Address:        e.RpAddress,
+				Override:       util.YesNo(e.RpOverride),
+				GroupAddresses: util.StrToMem(e.RpGroupAddresses),
+			},
+		}
+	case LocalRpTypeCandidate:
+		local = &localRp{
+			Candidate: &candidateRp{
+				Interface:             e.RpInterface,
+				Address:               e.RpAddress,
+				Priority:              e.RpPriority,
+				AdvertisementInterval: e.RpAdvertisementInterval,
+				GroupAddresses:        util.StrToMem(e.RpGroupAddresses),
+			},
+		}
+	}
+
+	text, present := e.raw["erp"]
+	if local != nil || present {
+		ans.Rp = &rp{
+			Local: local,
+		}
+		if present {
+			ans.Rp.External = &util.RawXml{text}
+		}
+	}
+
+	if text, present := e.raw["spt"]; present {
+		ans.SptThreshold = &util.RawXml{text}
+	}
+	if text, present := e.raw["ig"]; present {
+		ans.InterfaceGroup = &util.RawXml{text}
+	}
+	if text, present := e.raw["ssm"]; present {
+		ans.SsmAddressSpace = &util.RawXml{text}
+	}
+
+	return ans
+}
@@ -0,0 +1,14 @@
+package multicast
+
+// Valid values for RpfLookupMode.
+const (
+	RpfLookupModeMribThenUrib = "mrib-then-urib"
+	RpfLookupModeMribOnly     = "mrib-only"
+	RpfLookupModeUribOnly     = "urib-only"
+)
+
+// Valid values for LocalRpType.
+const (
+	LocalRpTypeStatic    = "static"
+	LocalRpTypeCandidate = "candidate"
+)
@@ -0,0 +1,6 @@
+/*
+Package multicast is the client.Network.MulticastConfig namespace.
+
+Normalized object:  Config
+*/
+package multicast
@@ -0,0 +1,15 @@
+package iface
+
+// Valid values for IgmpVersion.
+const (
+	IgmpVersion2 = "2"
+	IgmpVersion3 = "3"
+)
+
+// Valid special value for IgmpMaxGroups and IgmpMaxSources.
+const Unlimited = "unlimited"
+
+const (
+	singular = "multicast interface group"
+	plural   = "multicast interface groups"
+)
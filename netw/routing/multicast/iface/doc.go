@@ -0,0 +1,6 @@
+/*
+Package iface is the client.Network.MulticastInterfaceGroup namespace.
+
+Normalized object:  Entry
+*/
+package iface
@@ -0,0 +1,283 @@
+package iface
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a multicast
+// interface group.
+//
+// The group permission lists control which multicast groups (and, for source
+// specific multicast, which sources) are accepted on the member interfaces.
+type Entry struct {
+	Name                        string
+	Description                 string
+	Interfaces                  []string // ordered
+	AnySourcePermissions        []AnySourcePermission
+	SourceSpecificPermissions   []SourceSpecificPermission
+	IgmpEnable                  bool
+	IgmpVersion                 string
+	IgmpMaxQueryResponseTime    float64
+	IgmpQueryInterval           int
+	IgmpLastMemberQueryInterval float64
+	IgmpImmediateLeave          bool
+	IgmpRobustness              string
+	IgmpMaxGroups               string
+	IgmpMaxSources              string
+	IgmpRouterAlertPolicing     bool
+	PimEnable                   bool
+	PimAssertInterval           int
+	PimHelloInterval            int
+	PimJoinPruneInterval        int
+	PimDrPriority               int
+	PimBsrBorder                bool
+	PimAllowedNeighbors         []string // ordered
+}
+
+// AnySourcePermission is an any-source multicast group permission.
+type AnySourcePermission struct {
+	Name         string
+	GroupAddress string
+	Included     bool
+}
+
+// SourceSpecificPermission is a source specific multicast group permission.
+type SourceSpecificPermission struct {
+	Name          string
+	GroupAddress  string
+	SourceAddress string
+	Included      bool
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Description = s.Description
+	o.Interfaces = s.Interfaces
+	if s.AnySourcePermissions == nil {
+		o.AnySourcePermissions = nil
+	} else {
+		o.AnySourcePermissions = make([]AnySourcePermission, len(s.AnySourcePermissions))
+		copy(o.AnySourcePermissions, s.AnySourcePermissions)
+	}
+	if s.SourceSpecificPermissions == nil {
+		o.SourceSpecificPermissions = nil
+	} else {
+		o.SourceSpecificPermissions = make([]SourceSpecificPermission, len(s.SourceSpecificPermissions))
+		copy(o.SourceSpecificPermissions, s.SourceSpecificPermissions)
+	}
+	o.IgmpEnable = s.IgmpEnable
+	o.IgmpVersion = s.IgmpVersion
+	o.IgmpMaxQueryResponseTime = s.IgmpMaxQueryResponseTime
+	o.IgmpQueryInterval = s.IgmpQueryInterval
+	o.IgmpLastMemberQueryInterval = s.IgmpLastMemberQueryInterval
+	o.IgmpImmediateLeave = s.IgmpImmediateLeave
+	o.IgmpRobustness = s.IgmpRobustness
+	o.IgmpMaxGroups = s.IgmpMaxGroups
+	o.IgmpMaxSources = s.IgmpMaxSources
+	o.IgmpRouterAlertPolicing = s.IgmpRouterAlertPolicing
+	o.PimEnable = s.PimEnable
+	o.PimAssertInterval = s.PimAssertInterval
+	o.PimHelloInterval = s.PimHelloInterval
+	o.PimJoinPruneInterval = s.PimJoinPruneInterval
+	o.PimDrPriority = s.PimDrPriority
+	o.PimBsrBorder = s.PimBsrBorder
+	o.PimAllowedNeighbors = s.PimAllowedNeighbors
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:        o.Answer.Name,
+		Description: o.Answer.Description,
+		Interfaces:  util.MemToStr(o.Answer.Interfaces),
+	}
+
+	if o.Answer.Permission != nil {
+		if o.Answer.Permission.AnySource != nil {
+			list := make([]AnySourcePermission, 0, len(o.Answer.Permission.AnySource.Entries))
+			for _, x := range o.Answer.Permission.AnySource.Entries {
+				list = append(list, AnySourcePermission{
+					Name:         x.Name,
+					GroupAddress: x.GroupAddress,
+					Included:     util.AsBool(x.Included),
+				})
+			}
+			ans.AnySourcePermissions = list
+		}
+
+		if o.Answer.Permission.SourceSpecific != nil {
+			list := make([]SourceSpecificPermission, 0, len(o.Answer.Permission.SourceSpecific.Entries))
+			for _, x := range o.Answer.Permission.SourceSpecific.Entries {
+				list = append(list, SourceSpecificPermission{
+					Name:          x.Name,
+					GroupAddress:  x.GroupAddress,
+					SourceAddress: x.SourceAddress,
+					Included:      util.AsBool(x.Included),
+				})
+			}
+			ans.SourceSpecificPermissions = list
+		}
+	}
+
+	if o.Answer.Igmp != nil {
+		ans.IgmpEnable = util.AsBool(o.Answer.Igmp.Enable)
+		ans.IgmpVersion = o.Answer.Igmp.Version
+		ans.IgmpMaxQueryResponseTime = o.Answer.Igmp.MaxQueryResponseTime
+		ans.IgmpQueryInterval = o.Answer.Igmp.QueryInterval
+		ans.IgmpLastMemberQueryInterval = o.Answer.Igmp.LastMemberQueryInterval
+		ans.IgmpImmediateLeave = util.AsBool(o.Answer.Igmp.ImmediateLeave)
+		ans.IgmpRobustness = o.Answer.Igmp.Robustness
+		ans.IgmpMaxGroups = o.Answer.Igmp.MaxGroups
+		ans.IgmpMaxSources = o.Answer.Igmp.MaxSources
+		ans.IgmpRouterAlertPolicing = util.AsBool(o.Answer.Igmp.RouterAlertPolicing)
+	}
+
+	if o.Answer.Pim != nil {
+		ans.PimEnable = util.AsBool(o.Answer.Pim.Enable)
+		ans.PimAssertInterval = o.Answer.Pim.AssertInterval
+		ans.PimHelloInterval = o.Answer.Pim.HelloInterval
+		ans.PimJoinPruneInterval = o.Answer.Pim.JoinPruneInterval
+		ans.PimDrPriority = o.Answer.Pim.DrPriority
+		ans.PimBsrBorder = util.AsBool(o.Answer.Pim.BsrBorder)
+		ans.PimAllowedNeighbors = util.EntToStr(o.Answer.Pim.AllowedNeighbors)
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName     xml.Name         `xml:"entry"`
+	Name        string           `xml:"name,attr"`
+	Description string           `xml:"description,omitempty"`
+	Interfaces  *util.MemberType `xml:"interface"`
+	Permission  *permission      `xml:"group-permission"`
+	Igmp        *igmp            `xml:"igmp"`
+	Pim         *pim             `xml:"pim"`
+}
+
+type permission struct {
+	AnySource      *anySource      `xml:"any-source-multicast"`
+	SourceSpecific *sourceSpecific `xml:"source-specific-multicast"`
+}
+
+type anySource struct {
+	Entries []anySourceEntry `xml:"entry"`
+}
+
+type anySourceEntry struct {
+	Name         string `xml:"name,attr"`
+	GroupAddress string `xml:"group-address,omitempty"`
+	Included     string `xml:"included"`
+}
+
+type sourceSpecific struct {
+	Entries []sourceSpecificEntry `xml:"entry"`
+}
+
+type sourceSpecificEntry struct {
+	Name          string `xml:"name,attr"`
+	GroupAddress  string `xml:"group-address,omitempty"`
+	SourceAddress string `xml:"source-address,omitempty"`
+	Included      string `xml:"included"`
+}
+
+type igmp struct {
+	Enable                  string  `xml:"enable"`
+	Version                 string  `xml:"version,omitempty"`
+	MaxQueryResponseTime    float64 `xml:"max-query-response-time,omitempty"`
+	QueryInterval           int     `xml:"query-interval,omitempty"`
+	LastMemberQueryInterval float64 `xml:"last-member-query-interval,omitempty"`
+	ImmediateLeave          string  `xml:"immediate-leave"`
+	Robustness              string  `xml:"robustness,omitempty"`
+	MaxGroups               string  `xml:"max-groups,omitempty"`
+	MaxSources              string  `xml:"max-sources,omitempty"`
+	RouterAlertPolicing     string  `xml:"router-alert-policing"`
+}
+
+type pim struct {
+	Enable            string          `xml:"enable"`
+	AssertInterval    int             `xml:"assert-interval,omitempty"`
+	HelloInterval     int             `xml:"hello-interval,omitempty"`
+	JoinPruneInterval int             `xml:"join-prune-interval,omitempty"`
+	DrPriority        int             `xml:"dr-priority,omitempty"`
+	BsrBorder         string          `xml:"bsr-border"`
+	AllowedNeighbors  *util.EntryType `xml:"allowed-neighbors"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:        e.Name,
+		Description: e.Description,
+		Interfaces:  util.StrToMem(e.Interfaces),
+	}
+
+	if len(e.AnySourcePermissions) > 0 || len(e.SourceSpecificPermissions) > 0 {
+		ans.Permission = &permission{}
+
+		if len(e.AnySourcePermissions) > 0 {
+			list := make([]anySourceEntry, 0, len(e.AnySourcePermissions))
+			for _, x := range e.AnySourcePermissions {
+				list = append(list, anySourceEntry{
+					Name:         x.Name,
+					GroupAddress: x.GroupAddress,
+					Included:     util.YesNo(x.Included),
+				})
+			}
+			ans.Permission.AnySource = &anySource{Entries: list}
+		}
+
+		if len(e.SourceSpecificPermissions) > 0 {
+			list := make([]sourceSpecificEntry, 0, len(e.SourceSpecificPermissions))
+			for _, x := range e.SourceSpecificPermissions {
+				list = append(list, sourceSpecificEntry{
+					Name:          x.Name,
+					GroupAddress:  x.GroupAddress,
+					SourceAddress: x.SourceAddress,
+					Included:      util.YesNo(x.Included),
+				})
+			}
+			ans.Permission.SourceSpecific = &sourceSpecific{Entries: list}
+		}
+	}
+
+	if e.IgmpEnable || e.IgmpVersion != "" || e.IgmpMaxQueryResponseTime != 0 || e.IgmpQueryInterval != 0 || e.IgmpLastMemberQueryInterval != 0 || e.IgmpImmediateLeave || e.IgmpRobustness != "" || e.IgmpMaxGroups != "" || e.IgmpMaxSources != "" || e.IgmpRouterAlertPolicing {
+		ans.Igmp = &igmp{
+			Enable:                  util.YesNo(e.IgmpEnable),
+			Version:                 e.IgmpVersion,
+			MaxQueryResponseTime:    e.IgmpMaxQueryResponseTime,
+			QueryInterval:           e.IgmpQueryInterval,
+			LastMemberQueryInterval: e.IgmpLastMemberQueryInterval,
+			ImmediateLeave:          util.YesNo(e.IgmpImmediateLeave),
+			Robustness:              e.IgmpRobustness,
+			MaxGroups:               e.IgmpMaxGroups,
+			MaxSources:              e.IgmpMaxSources,
+			RouterAlertPolicing:     util.YesNo(e.IgmpRouterAlertPolicing),
+		}
+	}
+
+	if e.PimEnable || e.PimAssertInterval != 0 || e.PimHelloInterval != 0 || e.PimJoinPruneInterval != 0 || e.PimDrPriority != 0 || e.PimBsrBorder || len(e.PimAllowedNeighbors) > 0 {
+		ans.Pim = &pim{
+			Enable:            util.YesNo(e.PimEnable),
+			AssertInterval:    e.PimAssertInterval,
+			HelloInterval:     e.PimHelloInterval,
+			JoinPruneInterval: e.PimJoinPruneInterval,
+			DrPriority:        e.PimDrPriority,
+			BsrBorder:         util.YesNo(e.PimBsrBorder),
+			AllowedNeighbors:  util.StrToEnt(e.PimAllowedNeighbors),
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,49 @@
+package iface
+
+func getTests() []testCase {
+	return []testCase{
+		{"v1 basic", Entry{
+			Name:        "grp1",
+			Description: "my group",
+			Interfaces:  []string{"ethernet1/1", "ethernet1/2"},
+		}},
+		{"v1 group permissions", Entry{
+			Name: "grp2",
+			AnySourcePermissions: []AnySourcePermission{
+				{Name: "asm1", GroupAddress: "224.1.0.0/16", Included: true},
+				{Name: "asm2", GroupAddress: "224.2.0.0/16"},
+			},
+			SourceSpecificPermissions: []SourceSpecificPermission{
+				{Name: "ssm1", GroupAddress: "232.1.1.1", SourceAddress: "10.1.1.1", Included: true},
+			},
+		}},
+		{"v1 igmp", Entry{
+			Name:                        "grp3",
+			IgmpEnable:                  true,
+			IgmpVersion:                 IgmpVersion3,
+			IgmpMaxQueryResponseTime:    10.5,
+			IgmpQueryInterval:           125,
+			IgmpLastMemberQueryInterval: 1.5,
+			IgmpImmediateLeave:          true,
+			IgmpRobustness:              "2",
+			IgmpMaxGroups:               Unlimited,
+			IgmpMaxSources:              "100",
+			IgmpRouterAlertPolicing:     true,
+		}},
+		{"v1 pim", Entry{
+			Name:                 "grp4",
+			PimEnable:            true,
+			PimAssertInterval:    177,
+			PimHelloInterval:     30,
+			PimJoinPruneInterval: 60,
+			PimDrPriority:        1,
+			PimBsrBorder:         true,
+			PimAllowedNeighbors:  []string{"10.1.1.5", "10.1.1.6"},
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
@@ -0,0 +1,6 @@
+package external
+
+const (
+	singular = "multicast external rp"
+	plural   = "multicast external rps"
+)
@@ -0,0 +1,6 @@
+/*
+Package external is the client.Network.MulticastExternalRp namespace.
+
+Normalized object:  Entry
+*/
+package external
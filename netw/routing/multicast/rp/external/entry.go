@@ -0,0 +1,61 @@
+package external
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an external
+// multicast rendezvous point.
+//
+// The Name field is the IP address of the rendezvous point.
+type Entry struct {
+	Name           string
+	GroupAddresses []string // ordered
+	Override       bool
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.GroupAddresses = s.GroupAddresses
+	o.Override = s.Override
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:           o.Answer.Name,
+		GroupAddresses: util.MemToStr(o.Answer.GroupAddresses),
+		Override:       util.AsBool(o.Answer.Override),
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName        xml.Name         `xml:"entry"`
+	Name           string           `xml:"name,attr"`
+	GroupAddresses *util.MemberType `xml:"group-addresses"`
+	Override       string           `xml:"override"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:           e.Name,
+		GroupAddresses: util.StrToMem(e.GroupAddresses),
+		Override:       util.YesNo(e.Override),
+	}
+
+	return ans
+}
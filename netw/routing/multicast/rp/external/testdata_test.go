@@ -0,0 +1,19 @@
+package external
+
+func getTests() []testCase {
+	return []testCase{
+		{"v1 basic", Entry{
+			Name: "10.1.1.1",
+		}},
+		{"v1 with groups", Entry{
+			Name:           "10.1.1.2",
+			GroupAddresses: []string{"224.0.0.0/4", "239.0.0.0/8"},
+			Override:       true,
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
@@ -0,0 +1,13 @@
+package spt
+
+// Valid special values for Threshold.  Any other value is the rate in kbps
+// at which to switch to the shortest path tree.
+const (
+	ThresholdZero  = "0"
+	ThresholdNever = "never"
+)
+
+const (
+	singular = "multicast spt threshold"
+	plural   = "multicast spt thresholds"
+)
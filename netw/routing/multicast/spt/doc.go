@@ -0,0 +1,6 @@
+/*
+Package spt is the client.Network.MulticastSptThreshold namespace.
+
+Normalized object:  Entry
+*/
+package spt
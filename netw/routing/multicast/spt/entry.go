@@ -0,0 +1,54 @@
+package spt
+
+import (
+	"encoding/xml"
+)
+
+// Entry is a normalized, version independent representation of a multicast
+// shortest path tree switchover threshold.
+//
+// The Name field is the multicast group prefix the threshold applies to.
+type Entry struct {
+	Name      string
+	Threshold string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Threshold = s.Threshold
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:      o.Answer.Name,
+		Threshold: o.Answer.Threshold,
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName   xml.Name `xml:"entry"`
+	Name      string   `xml:"name,attr"`
+	Threshold string   `xml:"threshold,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:      e.Name,
+		Threshold: e.Threshold,
+	}
+
+	return ans
+}
@@ -0,0 +1,23 @@
+package spt
+
+func getTests() []testCase {
+	return []testCase{
+		{"v1 zero", Entry{
+			Name:      "224.0.0.0/4",
+			Threshold: ThresholdZero,
+		}},
+		{"v1 never", Entry{
+			Name:      "239.0.0.0/8",
+			Threshold: ThresholdNever,
+		}},
+		{"v1 rate", Entry{
+			Name:      "232.0.0.0/8",
+			Threshold: "1024",
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
@@ -0,0 +1,42 @@
+package multicast
+
+func getTests() []testCase {
+	return []testCase{
+		{"basic config", Config{
+			Enable:          true,
+			RpfLookupMode:   RpfLookupModeMribThenUrib,
+			RouteAgeoutTime: 210,
+		}},
+		{"static rp", Config{
+			Enable:           true,
+			LocalRpType:      LocalRpTypeStatic,
+			RpInterface:      "ethernet1/1",
+			RpAddress:        "10.1.1.1/24",
+			RpOverride:       true,
+			RpGroupAddresses: []string{"224.0.0.0/4", "239.1.0.0/16"},
+		}},
+		{"candidate rp", Config{
+			Enable:                  true,
+			LocalRpType:             LocalRpTypeCandidate,
+			RpInterface:             "ethernet1/2",
+			RpAddress:               "10.2.2.2/24",
+			RpPriority:              192,
+			RpAdvertisementInterval: 60,
+			RpGroupAddresses:        []string{"224.0.0.0/4"},
+		}},
+		{"with raw", Config{
+			Enable: true,
+			raw: map[string]string{
+				"erp": "<entry name=\"10.3.3.3\"><override>no</override></entry>",
+				"spt": "<entry name=\"224.0.0.0/4\"><threshold>never</threshold></entry>",
+				"ig":  "<entry name=\"grp\"><description>desc</description></entry>",
+				"ssm": "<entry name=\"ssm\"><group-address>232.0.0.0/8</group-address></entry>",
+			},
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Config
+}
@@ -0,0 +1,28 @@
+package ipv6
+
+// These are valid values for the Action param.
+const (
+	ActionRedist   = "redist"
+	ActionNoRedist = "no-redist"
+)
+
+// These are valid values for Types.
+const (
+	TypeBgp     = "bgp"
+	TypeConnect = "connect"
+	TypeOspfv3  = "ospfv3"
+	TypeStatic  = "static"
+)
+
+// These are valid values for Ospfv3PathTypes.
+const (
+	Ospfv3PathTypeIntraArea = "intra-area"
+	Ospfv3PathTypeInterArea = "inter-area"
+	Ospfv3PathTypeExt1      = "ext-1"
+	Ospfv3PathTypeExt2      = "ext-2"
+)
+
+const (
+	singular = "ipv6 redistribution profile"
+	plural   = "ipv6 redistribution profiles"
+)
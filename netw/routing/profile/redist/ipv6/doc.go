@@ -0,0 +1,9 @@
+/*
+Package ipv6 is the client.Network.Ipv6RedistributionProfile namespace.
+
+IPv6 redistribution profiles are referenced by name from OSPFv3 export rules
+and from BGP redistribution rules with an IPv6 address family.
+
+Normalized object:  Entry
+*/
+package ipv6
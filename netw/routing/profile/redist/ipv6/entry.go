@@ -0,0 +1,159 @@
+package ipv6
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an IPv6
+// redistribution profile.
+type Entry struct {
+	Name                   string
+	Priority               int
+	Action                 string
+	Types                  []string
+	Interfaces             []string
+	Destinations           []string
+	NextHops               []string
+	Ospfv3PathTypes        []string
+	Ospfv3Areas            []string
+	Ospfv3Tags             []string
+	BgpCommunities         []string
+	BgpExtendedCommunities []string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Priority = s.Priority
+	o.Action = s.Action
+	o.Types = s.Types
+	o.Interfaces = s.Interfaces
+	o.Destinations = s.Destinations
+	o.NextHops = s.NextHops
+	o.Ospfv3PathTypes = s.Ospfv3PathTypes
+	o.Ospfv3Areas = s.Ospfv3Areas
+	o.Ospfv3Tags = s.Ospfv3Tags
+	o.BgpCommunities = s.BgpCommunities
+	o.BgpExtendedCommunities = s.BgpExtendedCommunities
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:     o.Answer.Name,
+		Priority: o.Answer.Priority,
+	}
+
+	if o.Answer.Action.Redist != nil {
+		ans.Action = ActionRedist
+	} else if o.Answer.Action.NoRedist != nil {
+		ans.Action = ActionNoRedist
+	}
+
+	if o.Answer.Filter != nil {
+		ans.Types = util.MemToStr(o.Answer.Filter.Types)
+		ans.Interfaces = util.MemToStr(o.Answer.Filter.Interfaces)
+		ans.Destinations = util.MemToStr(o.Answer.Filter.Destinations)
+		ans.NextHops = util.MemToStr(o.Answer.Filter.NextHops)
+
+		if o.Answer.Filter.Ospfv3 != nil {
+			ans.Ospfv3PathTypes = util.MemToStr(o.Answer.Filter.Ospfv3.Ospfv3PathTypes)
+			ans.Ospfv3Areas = util.MemToStr(o.Answer.Filter.Ospfv3.Ospfv3Areas)
+			ans.Ospfv3Tags = util.MemToStr(o.Answer.Filter.Ospfv3.Ospfv3Tags)
+		}
+
+		if o.Answer.Filter.Bgp != nil {
+			ans.BgpCommunities = util.MemToStr(o.Answer.Filter.Bgp.BgpCommunities)
+			ans.BgpExtendedCommunities = util.MemToStr(o.Answer.Filter.Bgp.BgpExtendedCommunities)
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName  xml.Name `xml:"entry"`
+	Name     string   `xml:"name,attr"`
+	Priority int      `xml:"priority"`
+	Action   act      `xml:"action"`
+	Filter   *filter  `xml:"filter"`
+}
+
+type act struct {
+	Redist   *string `xml:"redist"`
+	NoRedist *string `xml:"no-redist"`
+}
+
+type filter struct {
+	Types        *util.MemberType `xml:"type"`
+	Interfaces   *util.MemberType `xml:"interface"`
+	Destinations *util.MemberType `xml:"destination"`
+	NextHops     *util.MemberType `xml:"nexthop"`
+	Ospfv3       *ospfv3          `xml:"ospfv3"`
+	Bgp          *bgp             `xml:"bgp"`
+}
+
+type ospfv3 struct {
+	Ospfv3PathTypes *util.MemberType `xml:"path-type"`
+	Ospfv3Areas     *util.MemberType `xml:"area"`
+	Ospfv3Tags      *util.MemberType `xml:"tag"`
+}
+
+type bgp struct {
+	BgpCommunities         *util.MemberType `xml:"community"`
+	BgpExtendedCommunities *util.MemberType `xml:"extended-community"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:     e.Name,
+		Priority: e.Priority,
+	}
+
+	s := ""
+	switch e.Action {
+	case ActionRedist:
+		ans.Action.Redist = &s
+	case ActionNoRedist:
+		ans.Action.NoRedist = &s
+	}
+
+	if len(e.Types) != 0 || len(e.Interfaces) != 0 || len(e.Destinations) != 0 || len(e.NextHops) != 0 || len(e.Ospfv3PathTypes) != 0 || len(e.Ospfv3Areas) != 0 || len(e.Ospfv3Tags) != 0 || len(e.BgpCommunities) != 0 || len(e.BgpExtendedCommunities) != 0 {
+		f := &filter{
+			Types:        util.StrToMem(e.Types),
+			Interfaces:   util.StrToMem(e.Interfaces),
+			Destinations: util.StrToMem(e.Destinations),
+			NextHops:     util.StrToMem(e.NextHops),
+		}
+
+		if len(e.Ospfv3PathTypes) != 0 || len(e.Ospfv3Areas) != 0 || len(e.Ospfv3Tags) != 0 {
+			f.Ospfv3 = &ospfv3{
+				Ospfv3PathTypes: util.StrToMem(e.Ospfv3PathTypes),
+				Ospfv3Areas:     util.StrToMem(e.Ospfv3Areas),
+				Ospfv3Tags:      util.StrToMem(e.Ospfv3Tags),
+			}
+		}
+
+		if len(e.BgpCommunities) != 0 || len(e.BgpExtendedCommunities) != 0 {
+			f.Bgp = &bgp{
+				BgpCommunities:         util.StrToMem(e.BgpCommunities),
+				BgpExtendedCommunities: util.StrToMem(e.BgpExtendedCommunities),
+			}
+		}
+
+		ans.Filter = f
+	}
+
+	return ans
+}
@@ -2,6 +2,7 @@ package advertise
 
 import (
 	"encoding/xml"
+	"sort"
 
 	"github.com/PaloAltoNetworks/pango/util"
 )
@@ -182,11 +183,17 @@ func specify_v1(e Entry) interface{} {
 		}
 
 		if len(e.AddressPrefix) > 0 {
-			apList := make([]apEntry, 0, len(e.AddressPrefix))
-			for k, v := range e.AddressPrefix {
+			keys := make([]string, 0, len(e.AddressPrefix))
+			for k := range e.AddressPrefix {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+
+			apList := make([]apEntry, 0, len(keys))
+			for _, k := range keys {
 				apList = append(apList, apEntry{
 					Name:  k,
-					Exact: util.YesNo(v),
+					Exact: util.YesNo(e.AddressPrefix[k]),
 				})
 			}
 			ans.Match.AddressPrefix = &addPre{apList}
@@ -247,11 +254,17 @@ func specify_v2(e Entry) interface{} {
 		}
 
 		if len(e.AddressPrefix) > 0 {
-			apList := make([]apEntry, 0, len(e.AddressPrefix))
-			for k, v := range e.AddressPrefix {
+			keys := make([]string, 0, len(e.AddressPrefix))
+			for k := range e.AddressPrefix {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+
+			apList := make([]apEntry, 0, len(keys))
+			for _, k := range keys {
 				apList = append(apList, apEntry{
 					Name:  k,
-					Exact: util.YesNo(v),
+					Exact: util.YesNo(e.AddressPrefix[k]),
 				})
 			}
 			ans.Match.AddressPrefix = &addPre{apList}
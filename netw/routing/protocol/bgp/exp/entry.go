@@ -2,6 +2,7 @@ package exp
 
 import (
 	"encoding/xml"
+	"sort"
 
 	"github.com/PaloAltoNetworks/pango/util"
 )
@@ -42,7 +43,7 @@ func (o *Entry) Copy(s Entry) {
 	o.MatchAsPathRegex = s.MatchAsPathRegex
 	o.MatchCommunityRegex = s.MatchCommunityRegex
 	o.MatchExtendedCommunityRegex = s.MatchExtendedCommunityRegex
-	s.MatchMed = o.MatchMed
+	o.MatchMed = s.MatchMed
 	o.MatchRouteTable = s.MatchRouteTable
 	o.MatchAddressPrefix = s.MatchAddressPrefix
 	o.MatchNextHop = s.MatchNextHop
@@ -377,11 +378,17 @@ func specify_v1(e Entry) interface{} {
 		}
 
 		if len(e.MatchAddressPrefix) > 0 {
-			apList := make([]apEntry, 0, len(e.MatchAddressPrefix))
-			for k, v := range e.MatchAddressPrefix {
+			keys := make([]string, 0, len(e.MatchAddressPrefix))
+			for k := range e.MatchAddressPrefix {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+
+			apList := make([]apEntry, 0, len(keys))
+			for _, k := range keys {
 				apList = append(apList, apEntry{
 					Name:  k,
-					Exact: util.YesNo(v),
+					Exact: util.YesNo(e.MatchAddressPrefix[k]),
 				})
 			}
 			ans.Match.MatchAddressPrefix = &addPre{apList}
@@ -534,11 +541,17 @@ func specify_v2(e Entry) interface{} {
 		}
 
 		if len(e.MatchAddressPrefix) > 0 {
-			apList := make([]apEntry, 0, len(e.MatchAddressPrefix))
-			for k, v := range e.MatchAddressPrefix {
+			keys := make([]string, 0, len(e.MatchAddressPrefix))
+			for k := range e.MatchAddressPrefix {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+
+			apList := make([]apEntry, 0, len(keys))
+			for _, k := range keys {
 				apList = append(apList, apEntry{
 					Name:  k,
-					Exact: util.YesNo(v),
+					Exact: util.YesNo(e.MatchAddressPrefix[k]),
 				})
 			}
 			ans.Match.MatchAddressPrefix = &addPre{apList}
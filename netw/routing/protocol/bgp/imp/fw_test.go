@@ -2,6 +2,7 @@ package imp
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/PaloAltoNetworks/pango/testdata"
@@ -328,3 +329,46 @@ func TestFwNormalization(t *testing.T) {
 		})
 	}
 }
+
+func TestCopy(t *testing.T) {
+	src := Entry{
+		Name:               "src",
+		MatchMed:           "100",
+		MatchAddressPrefix: map[string]bool{"10.1.0.0/16": true},
+		Action:             ActionDeny,
+	}
+
+	o := Entry{Name: "dst"}
+	o.Copy(src)
+	if o.Name != "dst" || o.MatchMed != "100" || o.Action != ActionDeny || !o.MatchAddressPrefix["10.1.0.0/16"] {
+		t.Errorf("Bad copy: %#v", o)
+	}
+}
+
+func TestAddressPrefixOrder(t *testing.T) {
+	e := Entry{
+		Name: "order",
+		MatchAddressPrefix: map[string]bool{
+			"10.3.0.0/16": false,
+			"10.1.0.0/16": true,
+			"10.2.0.0/16": false,
+		},
+	}
+
+	mc := &testdata.MockClient{}
+	ns := &FwImp{}
+	ns.Initialize(mc)
+
+	mc.Version = version.Number{8, 0, 0, ""}
+	mc.AddResp("")
+	if err := ns.Set("vr", e); err != nil {
+		t.Fatalf("Error in set: %s", err)
+	}
+
+	a := strings.Index(mc.Elm, "10.1.0.0/16")
+	b := strings.Index(mc.Elm, "10.2.0.0/16")
+	c := strings.Index(mc.Elm, "10.3.0.0/16")
+	if a == -1 || !(a < b && b < c) {
+		t.Errorf("Address prefixes not in order: %s", mc.Elm)
+	}
+}
@@ -0,0 +1,19 @@
+package area
+
+// Valid values for Type.
+const (
+	TypeNormal = "normal"
+	TypeStub   = "stub"
+	TypeNssa   = "nssa"
+)
+
+// Valid values for DefaultRouteType.
+const (
+	DefaultRouteTypeExt1 = "ext-1"
+	DefaultRouteTypeExt2 = "ext-2"
+)
+
+const (
+	singular = "ospf area"
+	plural   = "ospf areas"
+)
@@ -0,0 +1,6 @@
+/*
+Package area is the client.Network.OspfArea namespace.
+
+Normalized object:  Entry
+*/
+package area
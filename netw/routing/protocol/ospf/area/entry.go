@@ -0,0 +1,183 @@
+package area
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an
+// OSPF area.
+//
+// The DefaultRoute* fields only apply to stub and NSSA areas, and
+// DefaultRouteType is only valid for NSSA areas.
+//
+// Interfaces and virtual links are managed through their own namespaces and
+// are preserved as-is when the area is updated.
+type Entry struct {
+	Name                  string
+	Type                  string
+	AcceptSummary         bool
+	DefaultRouteAdvertise bool
+	DefaultRouteMetric    int
+	DefaultRouteType      string
+
+	raw map[string]string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Type = s.Type
+	o.AcceptSummary = s.AcceptSummary
+	o.DefaultRouteAdvertise = s.DefaultRouteAdvertise
+	o.DefaultRouteMetric = s.DefaultRouteMetric
+	o.DefaultRouteType = s.DefaultRouteType
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name: o.Answer.Name,
+	}
+
+	raw := make(map[string]string)
+
+	switch {
+	case o.Answer.Type.Normal != nil:
+		ans.Type = TypeNormal
+	case o.Answer.Type.Stub != nil:
+		ans.Type = TypeStub
+		ans.AcceptSummary = util.AsBool(o.Answer.Type.Stub.AcceptSummary)
+		if o.Answer.Type.Stub.DefaultRoute != nil && o.Answer.Type.Stub.DefaultRoute.Advertise != nil {
+			ans.DefaultRouteAdvertise = true
+			ans.DefaultRouteMetric = o.Answer.Type.Stub.DefaultRoute.Advertise.Metric
+		}
+	case o.Answer.Type.Nssa != nil:
+		ans.Type = TypeNssa
+		ans.AcceptSummary = util.AsBool(o.Answer.Type.Nssa.AcceptSummary)
+		if o.Answer.Type.Nssa.DefaultRoute != nil && o.Answer.Type.Nssa.DefaultRoute.Advertise != nil {
+			ans.DefaultRouteAdvertise = true
+			ans.DefaultRouteMetric = o.Answer.Type.Nssa.DefaultRoute.Advertise.Metric
+			ans.DefaultRouteType = o.Answer.Type.Nssa.DefaultRoute.Advertise.Type
+		}
+		if o.Answer.Type.Nssa.ExtRange != nil {
+			raw["ext"] = util.CleanRawXml(o.Answer.Type.Nssa.ExtRange.Text)
+		}
+	}
+
+	if o.Answer.Range != nil {
+		raw["range"] = util.CleanRawXml(o.Answer.Range.Text)
+	}
+	if o.Answer.Interface != nil {
+		raw["iface"] = util.CleanRawXml(o.Answer.Interface.Text)
+	}
+	if o.Answer.VirtualLink != nil {
+		raw["vlink"] = util.CleanRawXml(o.Answer.VirtualLink.Text)
+	}
+
+	if len(raw) != 0 {
+		ans.raw = raw
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName xml.Name `xml:"entry"`
+	Name    string   `xml:"name,attr"`
+	Type    areaType `xml:"type"`
+
+	Range       *util.RawXml `xml:"range"`
+	Interface   *util.RawXml `xml:"interface"`
+	VirtualLink *util.RawXml `xml:"virtual-link"`
+}
+
+type areaType struct {
+	Normal *string `xml:"normal"`
+	Stub   *stub   `xml:"stub"`
+	Nssa   *nssa   `xml:"nssa"`
+}
+
+type stub struct {
+	AcceptSummary string        `xml:"accept-summary"`
+	DefaultRoute  *defaultRoute `xml:"default-route"`
+}
+
+type nssa struct {
+	AcceptSummary string        `xml:"accept-summary"`
+	DefaultRoute  *defaultRoute `xml:"default-route"`
+	ExtRange      *util.RawXml  `xml:"nssa-ext-range"`
+}
+
+type defaultRoute struct {
+	Disable   *string    `xml:"disable"`
+	Advertise *advertise `xml:"advertise"`
+}
+
+type advertise struct {
+	Metric int    `xml:"metric,omitempty"`
+	Type   string `xml:"type,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name: e.Name,
+	}
+
+	s := ""
+	var dr *defaultRoute
+	if e.DefaultRouteAdvertise {
+		dr = &defaultRoute{
+			Advertise: &advertise{
+				Metric: e.DefaultRouteMetric,
+			},
+		}
+	} else {
+		dr = &defaultRoute{
+			Disable: &s,
+		}
+	}
+
+	switch e.Type {
+	case TypeNormal:
+		ans.Type.Normal = &s
+	case TypeStub:
+		ans.Type.Stub = &stub{
+			AcceptSummary: util.YesNo(e.AcceptSummary),
+			DefaultRoute:  dr,
+		}
+	case TypeNssa:
+		if dr.Advertise != nil {
+			dr.Advertise.Type = e.DefaultRouteType
+		}
+		ans.Type.Nssa = &nssa{
+			AcceptSummary: util.YesNo(e.AcceptSummary),
+			DefaultRoute:  dr,
+		}
+		if text, present := e.raw["ext"]; present {
+			ans.Type.Nssa.ExtRange = &util.RawXml{text}
+		}
+	}
+
+	if text, present := e.raw["range"]; present {
+		ans.Range = &util.RawXml{text}
+	}
+	if text, present := e.raw["iface"]; present {
+		ans.Interface = &util.RawXml{text}
+	}
+	if text, present := e.raw["vlink"]; present {
+		ans.VirtualLink = &util.RawXml{text}
+	}
+
+	return ans
+}
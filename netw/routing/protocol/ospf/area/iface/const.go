@@ -0,0 +1,19 @@
+package iface
+
+// Valid values for LinkType.
+const (
+	LinkTypeBroadcast = "broadcast"
+	LinkTypeP2p       = "p2p"
+	LinkTypeP2mp      = "p2mp"
+)
+
+// Valid values for BfdProfile, besides an actual BFD profile's name.
+const (
+	BfdProfileInherit = "Inherit-vr-global-setting"
+	BfdProfileNone    = "None"
+)
+
+const (
+	singular = "ospf area interface"
+	plural   = "ospf area interfaces"
+)
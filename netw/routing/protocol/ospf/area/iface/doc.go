@@ -0,0 +1,6 @@
+/*
+Package iface is the client.Network.OspfAreaInterface namespace.
+
+Normalized object:  Entry
+*/
+package iface
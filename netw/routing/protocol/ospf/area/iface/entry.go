@@ -0,0 +1,151 @@
+package iface
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an
+// interface participating in an OSPF area.
+type Entry struct {
+	Name               string
+	Enable             bool
+	Passive            bool
+	LinkType           string
+	Metric             int
+	Priority           int
+	HelloInterval      int
+	DeadCounts         int
+	RetransmitInterval int
+	TransitDelay       int
+	GraceRestartDelay  int    // XML: gr-delay
+	AuthProfile        string // XML: authentication
+	BfdProfile         string // XML: bfd/profile
+	Neighbors          []string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Enable = s.Enable
+	o.Passive = s.Passive
+	o.LinkType = s.LinkType
+	o.Metric = s.Metric
+	o.Priority = s.Priority
+	o.HelloInterval = s.HelloInterval
+	o.DeadCounts = s.DeadCounts
+	o.RetransmitInterval = s.RetransmitInterval
+	o.TransitDelay = s.TransitDelay
+	o.GraceRestartDelay = s.GraceRestartDelay
+	o.AuthProfile = s.AuthProfile
+	o.BfdProfile = s.BfdProfile
+	o.Neighbors = s.Neighbors
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:               o.Answer.Name,
+		Enable:             util.AsBool(o.Answer.Enable),
+		Passive:            util.AsBool(o.Answer.Passive),
+		Metric:             o.Answer.Metric,
+		Priority:           o.Answer.Priority,
+		HelloInterval:      o.Answer.HelloInterval,
+		DeadCounts:         o.Answer.DeadCounts,
+		RetransmitInterval: o.Answer.RetransmitInterval,
+		TransitDelay:       o.Answer.TransitDelay,
+		GraceRestartDelay:  o.Answer.GraceRestartDelay,
+		AuthProfile:        o.Answer.AuthProfile,
+		Neighbors:          util.EntToStr(o.Answer.Neighbors),
+	}
+
+	if o.Answer.LinkType != nil {
+		switch {
+		case o.Answer.LinkType.Broadcast != nil:
+			ans.LinkType = LinkTypeBroadcast
+		case o.Answer.LinkType.P2p != nil:
+			ans.LinkType = LinkTypeP2p
+		case o.Answer.LinkType.P2mp != nil:
+			ans.LinkType = LinkTypeP2mp
+		}
+	}
+
+	if o.Answer.Bfd != nil {
+		ans.BfdProfile = o.Answer.Bfd.Profile
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName            xml.Name        `xml:"entry"`
+	Name               string          `xml:"name,attr"`
+	Enable             string          `xml:"enable"`
+	Passive            string          `xml:"passive"`
+	LinkType           *linkType       `xml:"link-type"`
+	Metric             int             `xml:"metric,omitempty"`
+	Priority           int             `xml:"priority,omitempty"`
+	HelloInterval      int             `xml:"hello-interval,omitempty"`
+	DeadCounts         int             `xml:"dead-counts,omitempty"`
+	RetransmitInterval int             `xml:"retransmit-interval,omitempty"`
+	TransitDelay       int             `xml:"transit-delay,omitempty"`
+	GraceRestartDelay  int             `xml:"gr-delay,omitempty"`
+	AuthProfile        string          `xml:"authentication,omitempty"`
+	Bfd                *bfd            `xml:"bfd"`
+	Neighbors          *util.EntryType `xml:"neighbor"`
+}
+
+type linkType struct {
+	Broadcast *string `xml:"broadcast"`
+	P2p       *string `xml:"p2p"`
+	P2mp      *string `xml:"p2mp"`
+}
+
+type bfd struct {
+	Profile string `xml:"profile"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:               e.Name,
+		Enable:             util.YesNo(e.Enable),
+		Passive:            util.YesNo(e.Passive),
+		Metric:             e.Metric,
+		Priority:           e.Priority,
+		HelloInterval:      e.HelloInterval,
+		DeadCounts:         e.DeadCounts,
+		RetransmitInterval: e.RetransmitInterval,
+		TransitDelay:       e.TransitDelay,
+		GraceRestartDelay:  e.GraceRestartDelay,
+		AuthProfile:        e.AuthProfile,
+		Neighbors:          util.StrToEnt(e.Neighbors),
+	}
+
+	s := ""
+	switch e.LinkType {
+	case LinkTypeBroadcast:
+		ans.LinkType = &linkType{Broadcast: &s}
+	case LinkTypeP2p:
+		ans.LinkType = &linkType{P2p: &s}
+	case LinkTypeP2mp:
+		ans.LinkType = &linkType{P2mp: &s}
+	}
+
+	if e.BfdProfile != "" {
+		ans.Bfd = &bfd{
+			Profile: e.BfdProfile,
+		}
+	}
+
+	return ans
+}
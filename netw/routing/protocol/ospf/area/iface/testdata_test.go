@@ -0,0 +1,41 @@
+package iface
+
+func getTests() []testCase {
+	return []testCase{
+		{"broadcast interface", Entry{
+			Name:               "ethernet1/1",
+			Enable:             true,
+			LinkType:           LinkTypeBroadcast,
+			Metric:             10,
+			Priority:           1,
+			HelloInterval:      10,
+			DeadCounts:         4,
+			RetransmitInterval: 5,
+			TransitDelay:       1,
+			GraceRestartDelay:  10,
+			AuthProfile:        "auth",
+			BfdProfile:         "default",
+		}},
+		{"passive p2p interface", Entry{
+			Name:     "ethernet1/2",
+			Enable:   true,
+			Passive:  true,
+			LinkType: LinkTypeP2p,
+		}},
+		{"p2mp interface with neighbors", Entry{
+			Name:      "ethernet1/3",
+			LinkType:  LinkTypeP2mp,
+			Neighbors: []string{"10.1.1.1", "10.1.1.2"},
+		}},
+		{"bfd inherited from virtual router", Entry{
+			Name:       "ethernet1/4",
+			Enable:     true,
+			BfdProfile: BfdProfileInherit,
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
@@ -0,0 +1,12 @@
+package vlink
+
+// Valid values for BfdProfile, besides an actual BFD profile's name.
+const (
+	BfdProfileInherit = "Inherit-vr-global-setting"
+	BfdProfileNone    = "None"
+)
+
+const (
+	singular = "ospf virtual link"
+	plural   = "ospf virtual links"
+)
@@ -0,0 +1,6 @@
+/*
+Package vlink is the client.Network.OspfAreaVirtualLink namespace.
+
+Normalized object:  Entry
+*/
+package vlink
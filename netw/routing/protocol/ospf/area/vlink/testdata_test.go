@@ -0,0 +1,28 @@
+package vlink
+
+func getTests() []testCase {
+	return []testCase{
+		{"basic virtual link", Entry{
+			Name:          "vl1",
+			Enable:        true,
+			NeighborId:    "10.1.1.1",
+			TransitAreaId: "0.0.0.1",
+		}},
+		{"with timers and profiles", Entry{
+			Name:               "vl2",
+			NeighborId:         "10.1.1.2",
+			TransitAreaId:      "0.0.0.2",
+			HelloInterval:      10,
+			DeadCounts:         4,
+			RetransmitInterval: 5,
+			TransitDelay:       1,
+			AuthProfile:        "auth",
+			BfdProfile:         "default",
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
@@ -0,0 +1,180 @@
+package ospf
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Config is a normalized, version independent representation of a virtual
+// router's OSPF configuration.
+//
+// Areas, auth profiles, and export rules are managed through their own
+// namespaces and are preserved as-is when the config is updated.
+type Config struct {
+	Enable                        bool
+	RouterId                      string
+	RejectDefaultRoute            bool
+	AllowRedistributeDefaultRoute bool
+	Rfc1583                       bool
+	BfdProfile                    string // XML: global-bfd/profile
+	SpfCalculationDelay           float64
+	LsaInterval                   float64
+	EnableGracefulRestart         bool
+	GracePeriod                   int
+	HelperEnable                  bool
+	StrictLsaChecking             bool
+	MaxNeighborRestartTime        int
+
+	raw map[string]string
+}
+
+// Copy copies the information from source Config `s` to this object.
+func (o *Config) Copy(s Config) {
+	o.Enable = s.Enable
+	o.RouterId = s.RouterId
+	o.RejectDefaultRoute = s.RejectDefaultRoute
+	o.AllowRedistributeDefaultRoute = s.AllowRedistributeDefaultRoute
+	o.Rfc1583 = s.Rfc1583
+	o.BfdProfile = s.BfdProfile
+	o.SpfCalculationDelay = s.SpfCalculationDelay
+	o.LsaInterval = s.LsaInterval
+	o.EnableGracefulRestart = s.EnableGracefulRestart
+	o.GracePeriod = s.GracePeriod
+	o.HelperEnable = s.HelperEnable
+	o.StrictLsaChecking = s.StrictLsaChecking
+	o.MaxNeighborRestartTime = s.MaxNeighborRestartTime
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Config
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>ospf"`
+}
+
+func (o *container_v1) Normalize() Config {
+	ans := Config{
+		Enable:                        util.AsBool(o.Answer.Enable),
+		RouterId:                      o.Answer.RouterId,
+		RejectDefaultRoute:            util.AsBool(o.Answer.RejectDefaultRoute),
+		AllowRedistributeDefaultRoute: util.AsBool(o.Answer.AllowRedistributeDefaultRoute),
+		Rfc1583:                       util.AsBool(o.Answer.Rfc1583),
+	}
+
+	if o.Answer.Bfd != nil {
+		ans.BfdProfile = o.Answer.Bfd.Profile
+	}
+
+	if o.Answer.Timers != nil {
+		ans.SpfCalculationDelay = o.Answer.Timers.SpfCalculationDelay
+		ans.LsaInterval = o.Answer.Timers.LsaInterval
+	}
+
+	if o.Answer.GracefulRestart != nil {
+		ans.EnableGracefulRestart = util.AsBool(o.Answer.GracefulRestart.Enable)
+		ans.GracePeriod = o.Answer.GracefulRestart.GracePeriod
+		ans.HelperEnable = util.AsBool(o.Answer.GracefulRestart.HelperEnable)
+		ans.StrictLsaChecking = util.AsBool(o.Answer.GracefulRestart.StrictLsaChecking)
+		ans.MaxNeighborRestartTime = o.Answer.GracefulRestart.MaxNeighborRestartTime
+	}
+
+	raw := make(map[string]string)
+
+	if o.Answer.Area != nil {
+		raw["area"] = util.CleanRawXml(o.Answer.Area.Text)
+	}
+	if o.Answer.AuthProfile != nil {
+		raw["ap"] = util.CleanRawXml(o.Answer.AuthProfile.Text)
+	}
+	if o.Answer.ExportRules != nil {
+		raw["exp"] = util.CleanRawXml(o.Answer.ExportRules.Text)
+	}
+
+	if len(raw) != 0 {
+		ans.raw = raw
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName                       xml.Name         `xml:"ospf"`
+	Enable                        string           `xml:"enable"`
+	RouterId                      string           `xml:"router-id,omitempty"`
+	RejectDefaultRoute            string           `xml:"reject-default-route"`
+	AllowRedistributeDefaultRoute string           `xml:"allow-redist-default-route"`
+	Rfc1583                       string           `xml:"rfc1583"`
+	Bfd                           *bfd             `xml:"global-bfd"`
+	Timers                        *timers          `xml:"timers"`
+	GracefulRestart               *gracefulRestart `xml:"graceful-restart"`
+
+	Area        *util.RawXml `xml:"area"`
+	AuthProfile *util.RawXml `xml:"auth-profile"`
+	ExportRules *util.RawXml `xml:"export-rules"`
+}
+
+type bfd struct {
+	Profile string `xml:"profile"`
+}
+
+type timers struct {
+	SpfCalculationDelay float64 `xml:"spf-calculation-delay,omitempty"`
+	LsaInterval         float64 `xml:"lsa-interval,omitempty"`
+}
+
+type gracefulRestart struct {
+	Enable                 string `xml:"enable"`
+	GracePeriod            int    `xml:"grace-period,omitempty"`
+	HelperEnable           string `xml:"helper-enable"`
+	StrictLsaChecking      string `xml:"strict-LSA-checking"`
+	MaxNeighborRestartTime int    `xml:"max-neighbor-restart-time,omitempty"`
+}
+
+func specify_v1(e Config) interface{} {
+	ans := entry_v1{
+		Enable:                        util.YesNo(e.Enable),
+		RouterId:                      e.RouterId,
+		RejectDefaultRoute:            util.YesNo(e.RejectDefaultRoute),
+		AllowRedistributeDefaultRoute: util.YesNo(e.AllowRedistributeDefaultRoute),
+		Rfc1583:                       util.YesNo(e.Rfc1583),
+	}
+
+	if e.BfdProfile != "" {
+		ans.Bfd = &bfd{
+			Profile: e.BfdProfile,
+		}
+	}
+
+	if e.SpfCalculationDelay != 0 || e.LsaInterval != 0 {
+		ans.Timers = &timers{
+			SpfCalculationDelay: e.SpfCalculationDelay,
+			LsaInterval:         e.LsaInterval,
+		}
+	}
+
+	if e.EnableGracefulRestart || e.GracePeriod != 0 || e.HelperEnable || e.StrictLsaChecking || e.MaxNeighborRestartTime != 0 {
+		ans.GracefulRestart = &gracefulRestart{
+			Enable:                 util.YesNo(e.EnableGracefulRestart),
+			GracePeriod:            e.GracePeriod,
+			HelperEnable:           util.YesNo(e.HelperEnable),
+			StrictLsaChecking:      util.YesNo(e.StrictLsaChecking),
+			MaxNeighborRestartTime: e.MaxNeighborRestartTime,
+		}
+	}
+
+	if text, present := e.raw["area"]; present {
+		ans.Area = &util.RawXml{text}
+	}
+	if text, present := e.raw["ap"]; present {
+		ans.AuthProfile = &util.RawXml{text}
+	}
+	if text, present := e.raw["exp"]; present {
+		ans.ExportRules = &util.RawXml{text}
+	}
+
+	return ans
+}
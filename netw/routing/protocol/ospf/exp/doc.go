@@ -0,0 +1,6 @@
+/*
+Package exp is the client.Network.OspfExport namespace.
+
+Normalized object:  Entry
+*/
+package exp
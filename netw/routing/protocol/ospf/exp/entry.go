@@ -0,0 +1,64 @@
+package exp
+
+import (
+	"encoding/xml"
+)
+
+// Entry is a normalized, version independent representation of an
+// OSPF export rule.
+//
+// The Name field is the prefix or redistribution profile to export.
+type Entry struct {
+	Name     string
+	PathType string // XML: new-path-type
+	Tag      string // XML: new-tag
+	Metric   int
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.PathType = s.PathType
+	o.Tag = s.Tag
+	o.Metric = s.Metric
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:     o.Answer.Name,
+		PathType: o.Answer.PathType,
+		Tag:      o.Answer.Tag,
+		Metric:   o.Answer.Metric,
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName  xml.Name `xml:"entry"`
+	Name     string   `xml:"name,attr"`
+	PathType string   `xml:"new-path-type,omitempty"`
+	Tag      string   `xml:"new-tag,omitempty"`
+	Metric   int      `xml:"metric,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:     e.Name,
+		PathType: e.PathType,
+		Tag:      e.Tag,
+		Metric:   e.Metric,
+	}
+
+	return ans
+}
@@ -0,0 +1,20 @@
+package exp
+
+func getTests() []testCase {
+	return []testCase{
+		{"prefix only", Entry{
+			Name: "10.0.0.0/8",
+		}},
+		{"with path type tag and metric", Entry{
+			Name:     "redist-profile",
+			PathType: PathTypeExt1,
+			Tag:      "10.1.1.1",
+			Metric:   100,
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
@@ -0,0 +1,6 @@
+package auth
+
+const (
+	singular = "ospf auth profile"
+	plural   = "ospf auth profiles"
+)
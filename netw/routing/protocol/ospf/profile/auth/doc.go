@@ -0,0 +1,6 @@
+/*
+Package auth is the client.Network.OspfAuthProfile namespace.
+
+Normalized object:  Entry
+*/
+package auth
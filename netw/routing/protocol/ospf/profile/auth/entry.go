@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an
+// OSPF auth profile.
+//
+// Only one of Password or Md5Keys should be specified.
+type Entry struct {
+	Name     string
+	Password string
+	Md5Keys  []Md5Key
+}
+
+// Md5Key is an MD5 key in an OSPF auth profile.
+type Md5Key struct {
+	KeyId     int
+	Key       string
+	Preferred bool
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Password = s.Password
+	if s.Md5Keys == nil {
+		o.Md5Keys = nil
+	} else {
+		o.Md5Keys = make([]Md5Key, len(s.Md5Keys))
+		copy(o.Md5Keys, s.Md5Keys)
+	}
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:     o.Answer.Name,
+		Password: o.Answer.Password,
+	}
+
+	if o.Answer.Md5 != nil {
+		ans.Md5Keys = make([]Md5Key, 0, len(o.Answer.Md5.Entries))
+		for _, v := range o.Answer.Md5.Entries {
+			ans.Md5Keys = append(ans.Md5Keys, Md5Key{
+				KeyId:     v.KeyId,
+				Key:       v.Key,
+				Preferred: util.AsBool(v.Preferred),
+			})
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName  xml.Name `xml:"entry"`
+	Name     string   `xml:"name,attr"`
+	Password string   `xml:"password,omitempty"`
+	Md5      *md5     `xml:"md5"`
+}
+
+type md5 struct {
+	Entries []md5Entry `xml:"entry"`
+}
+
+type md5Entry struct {
+	KeyId     int    `xml:"name,attr"`
+	Key       string `xml:"key"`
+	Preferred string `xml:"preferred"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:     e.Name,
+		Password: e.Password,
+	}
+
+	if len(e.Md5Keys) > 0 {
+		list := make([]md5Entry, 0, len(e.Md5Keys))
+		for _, v := range e.Md5Keys {
+			list = append(list, md5Entry{
+				KeyId:     v.KeyId,
+				Key:       v.Key,
+				Preferred: util.YesNo(v.Preferred),
+			})
+		}
+		ans.Md5 = &md5{Entries: list}
+	}
+
+	return ans
+}
@@ -0,0 +1,22 @@
+package auth
+
+func getTests() []testCase {
+	return []testCase{
+		{"password", Entry{
+			Name:     "one",
+			Password: "secret",
+		}},
+		{"md5 keys", Entry{
+			Name: "two",
+			Md5Keys: []Md5Key{
+				{KeyId: 1, Key: "first", Preferred: true},
+				{KeyId: 2, Key: "second"},
+			},
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
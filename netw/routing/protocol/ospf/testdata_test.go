@@ -0,0 +1,39 @@
+package ospf
+
+func getTests() []testCase {
+	return []testCase{
+		{"basic config", Config{
+			Enable:             true,
+			RouterId:           "10.1.1.1",
+			RejectDefaultRoute: true,
+			Rfc1583:            true,
+		}},
+		{"with timers and graceful restart", Config{
+			Enable:                        true,
+			RouterId:                      "10.1.1.2",
+			AllowRedistributeDefaultRoute: true,
+			BfdProfile:                    "default",
+			SpfCalculationDelay:           5,
+			LsaInterval:                   2.5,
+			EnableGracefulRestart:         true,
+			GracePeriod:                   120,
+			HelperEnable:                  true,
+			StrictLsaChecking:             true,
+			MaxNeighborRestartTime:        140,
+		}},
+		{"with raw", Config{
+			Enable:   true,
+			RouterId: "10.1.1.3",
+			raw: map[string]string{
+				"area": "<entry name=\"0.0.0.0\"><type><normal/></type></entry>",
+				"ap":   "<entry name=\"ap\"><password>secret</password></entry>",
+				"exp":  "<entry name=\"10.0.0.0/8\"><new-path-type>ext-2</new-path-type></entry>",
+			},
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Config
+}
@@ -0,0 +1,6 @@
+/*
+Package area is the client.Network.Ospfv3Area namespace.
+
+Normalized object:  Entry
+*/
+package area
@@ -0,0 +1,6 @@
+/*
+Package iface is the client.Network.Ospfv3AreaInterface namespace.
+
+Normalized object:  Entry
+*/
+package iface
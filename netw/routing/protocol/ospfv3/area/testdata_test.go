@@ -0,0 +1,44 @@
+package area
+
+func getTests() []testCase {
+	return []testCase{
+		{"normal area", Entry{
+			Name: "0.0.0.0",
+			Type: TypeNormal,
+		}},
+		{"stub area no default route", Entry{
+			Name:          "0.0.0.1",
+			Type:          TypeStub,
+			AcceptSummary: true,
+		}},
+		{"stub area with default route", Entry{
+			Name:                  "0.0.0.2",
+			Type:                  TypeStub,
+			DefaultRouteAdvertise: true,
+			DefaultRouteMetric:    10,
+		}},
+		{"nssa area with default route", Entry{
+			Name:                  "0.0.0.3",
+			Type:                  TypeNssa,
+			AcceptSummary:         true,
+			DefaultRouteAdvertise: true,
+			DefaultRouteMetric:    20,
+			DefaultRouteType:      DefaultRouteTypeExt1,
+		}},
+		{"with raw", Entry{
+			Name: "0.0.0.4",
+			Type: TypeNssa,
+			raw: map[string]string{
+				"ext":   "<entry name=\"10.1.0.0/16\"><advertise/></entry>",
+				"range": "<entry name=\"10.2.0.0/16\"><advertise/></entry>",
+				"iface": "<entry name=\"ethernet1/1\"><enable>yes</enable></entry>",
+				"vlink": "<entry name=\"vl\"><enable>yes</enable></entry>",
+			},
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
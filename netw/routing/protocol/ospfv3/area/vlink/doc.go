@@ -0,0 +1,6 @@
+/*
+Package vlink is the client.Network.Ospfv3AreaVirtualLink namespace.
+
+Normalized object:  Entry
+*/
+package vlink
@@ -0,0 +1,111 @@
+package vlink
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an
+// OSPFv3 virtual link.
+type Entry struct {
+	Name               string
+	Enable             bool
+	InstanceId         int
+	NeighborId         string
+	TransitAreaId      string
+	HelloInterval      int
+	DeadCounts         int
+	RetransmitInterval int
+	TransitDelay       int
+	AuthProfile        string // XML: authentication
+	BfdProfile         string // XML: bfd/profile
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Enable = s.Enable
+	o.InstanceId = s.InstanceId
+	o.NeighborId = s.NeighborId
+	o.TransitAreaId = s.TransitAreaId
+	o.HelloInterval = s.HelloInterval
+	o.DeadCounts = s.DeadCounts
+	o.RetransmitInterval = s.RetransmitInterval
+	o.TransitDelay = s.TransitDelay
+	o.AuthProfile = s.AuthProfile
+	o.BfdProfile = s.BfdProfile
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:               o.Answer.Name,
+		Enable:             util.AsBool(o.Answer.Enable),
+		InstanceId:         o.Answer.InstanceId,
+		NeighborId:         o.Answer.NeighborId,
+		TransitAreaId:      o.Answer.TransitAreaId,
+		HelloInterval:      o.Answer.HelloInterval,
+		DeadCounts:         o.Answer.DeadCounts,
+		RetransmitInterval: o.Answer.RetransmitInterval,
+		TransitDelay:       o.Answer.TransitDelay,
+		AuthProfile:        o.Answer.AuthProfile,
+	}
+
+	if o.Answer.Bfd != nil {
+		ans.BfdProfile = o.Answer.Bfd.Profile
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName            xml.Name `xml:"entry"`
+	Name               string   `xml:"name,attr"`
+	Enable             string   `xml:"enable"`
+	InstanceId         int      `xml:"instance-id,omitempty"`
+	NeighborId         string   `xml:"neighbor-id"`
+	TransitAreaId      string   `xml:"transit-area-id"`
+	HelloInterval      int      `xml:"hello-interval,omitempty"`
+	DeadCounts         int      `xml:"dead-counts,omitempty"`
+	RetransmitInterval int      `xml:"retransmit-interval,omitempty"`
+	TransitDelay       int      `xml:"transit-delay,omitempty"`
+	AuthProfile        string   `xml:"authentication,omitempty"`
+	Bfd                *bfd     `xml:"bfd"`
+}
+
+type bfd struct {
+	Profile string `xml:"profile"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:               e.Name,
+		Enable:             util.YesNo(e.Enable),
+		InstanceId:         e.InstanceId,
+		NeighborId:         e.NeighborId,
+		TransitAreaId:      e.TransitAreaId,
+		HelloInterval:      e.HelloInterval,
+		DeadCounts:         e.DeadCounts,
+		RetransmitInterval: e.RetransmitInterval,
+		TransitDelay:       e.TransitDelay,
+		AuthProfile:        e.AuthProfile,
+	}
+
+	if e.BfdProfile != "" {
+		ans.Bfd = &bfd{
+			Profile: e.BfdProfile,
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,35 @@
+package vlink
+
+func getTests() []testCase {
+	return []testCase{
+		{"basic virtual link", Entry{
+			Name:          "vl1",
+			Enable:        true,
+			NeighborId:    "10.1.1.1",
+			TransitAreaId: "0.0.0.1",
+		}},
+		{"with instance id and timers", Entry{
+			Name:               "vl2",
+			InstanceId:         2,
+			NeighborId:         "10.1.1.2",
+			TransitAreaId:      "0.0.0.2",
+			HelloInterval:      10,
+			DeadCounts:         4,
+			RetransmitInterval: 5,
+			TransitDelay:       1,
+			AuthProfile:        "auth",
+		}},
+		{"with bfd", Entry{
+			Name:          "vl3",
+			Enable:        true,
+			NeighborId:    "10.1.1.3",
+			TransitAreaId: "0.0.0.3",
+			BfdProfile:    BfdProfileInherit,
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
@@ -0,0 +1,6 @@
+/*
+Package ospfv3 is the client.Network.Ospfv3Config namespace.
+
+Normalized object:  Config
+*/
+package ospfv3
@@ -0,0 +1,12 @@
+package exp
+
+// Valid values for PathType.
+const (
+	PathTypeExt1 = "ext-1"
+	PathTypeExt2 = "ext-2"
+)
+
+const (
+	singular = "ospfv3 export rule"
+	plural   = "ospfv3 export rules"
+)
@@ -0,0 +1,6 @@
+/*
+Package exp is the client.Network.Ospfv3Export namespace.
+
+Normalized object:  Entry
+*/
+package exp
@@ -0,0 +1,108 @@
+package ospfv3
+
+import (
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwOspfv3 is the client.Network.Ospfv3Config namespace.
+type FwOspfv3 struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *FwOspfv3) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Get performs GET to retrieve the OSPFv3 config.
+func (c *FwOspfv3) Get(vr string) (Config, error) {
+	c.con.LogQuery("(get) ospfv3 config for %q", vr)
+	return c.details(c.con.Get, vr)
+}
+
+// Show performs SHOW to retrieve the OSPFv3 config.
+func (c *FwOspfv3) Show(vr string) (Config, error) {
+	c.con.LogQuery("(show) ospfv3 config for %q", vr)
+	return c.details(c.con.Show, vr)
+}
+
+// Set performs SET to create / update the OSPFv3 config.
+func (c *FwOspfv3) Set(vr string, e Config) error {
+	var err error
+
+	if vr == "" {
+		return fmt.Errorf("vr must be specified")
+	}
+
+	_, fn := c.versioning()
+	c.con.LogAction("(set) ospfv3 config for %q", vr)
+	path := c.xpath(vr)
+	path = path[:len(path)-1]
+
+	_, err = c.con.Set(path, fn(e), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to create / update the OSPFv3 config.
+func (c *FwOspfv3) Edit(vr string, e Config) error {
+	var err error
+
+	if vr == "" {
+		return fmt.Errorf("vr must be specified")
+	}
+
+	_, fn := c.versioning()
+	c.con.LogAction("(edit) ospfv3 config for %q", vr)
+	path := c.xpath(vr)
+
+	_, err = c.con.Edit(path, fn(e), nil, nil)
+	return err
+}
+
+// Delete removes the OSPFv3 config for the given virtual router.
+func (c *FwOspfv3) Delete(vr string) error {
+	var err error
+
+	if vr == "" {
+		return fmt.Errorf("vr must be specified")
+	}
+
+	c.con.LogAction("(delete) ospfv3 config for %q", vr)
+
+	// Remove the objects.
+	path := c.xpath(vr)
+	_, err = c.con.Delete(path, nil, nil)
+	return err
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *FwOspfv3) versioning() (normalizer, func(Config) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwOspfv3) details(fn util.Retriever, vr string) (Config, error) {
+	path := c.xpath(vr)
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Config{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *FwOspfv3) xpath(vr string) []string {
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"network",
+		"virtual-router",
+		util.AsEntryXpath([]string{vr}),
+		"protocol",
+		"ospfv3",
+	}
+}
@@ -0,0 +1,33 @@
+package auth
+
+// Valid values for Protocol.
+const (
+	ProtocolEsp = "esp"
+	ProtocolAh  = "ah"
+)
+
+// Valid values for AuthenticationAlgorithm.
+//
+// AuthenticationNone is only valid when Protocol is ESP.
+const (
+	AuthenticationMd5    = "md5"
+	AuthenticationSha1   = "sha1"
+	AuthenticationSha256 = "sha256"
+	AuthenticationSha384 = "sha384"
+	AuthenticationSha512 = "sha512"
+	AuthenticationNone   = "none"
+)
+
+// Valid values for EncryptionAlgorithm.
+const (
+	Encryption3des      = "3des"
+	EncryptionAes128Cbc = "aes-128-cbc"
+	EncryptionAes192Cbc = "aes-192-cbc"
+	EncryptionAes256Cbc = "aes-256-cbc"
+	EncryptionNull      = "null"
+)
+
+const (
+	singular = "ospfv3 auth profile"
+	plural   = "ospfv3 auth profiles"
+)
@@ -0,0 +1,6 @@
+/*
+Package auth is the client.Network.Ospfv3AuthProfile namespace.
+
+Normalized object:  Entry
+*/
+package auth
@@ -0,0 +1,161 @@
+package auth
+
+import (
+	"encoding/xml"
+)
+
+// Entry is a normalized, version independent representation of an
+// OSPFv3 auth profile.
+//
+// The EncryptionAlgorithm and EncryptionKey fields are only valid when
+// Protocol is ESP.
+type Entry struct {
+	Name                    string
+	Spi                     string
+	Protocol                string
+	AuthenticationAlgorithm string
+	AuthenticationKey       string
+	EncryptionAlgorithm     string
+	EncryptionKey           string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Spi = s.Spi
+	o.Protocol = s.Protocol
+	o.AuthenticationAlgorithm = s.AuthenticationAlgorithm
+	o.AuthenticationKey = s.AuthenticationKey
+	o.EncryptionAlgorithm = s.EncryptionAlgorithm
+	o.EncryptionKey = s.EncryptionKey
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name: o.Answer.Name,
+		Spi:  o.Answer.Spi,
+	}
+
+	switch {
+	case o.Answer.Esp != nil:
+		ans.Protocol = ProtocolEsp
+		if o.Answer.Esp.Authentication != nil {
+			ans.AuthenticationAlgorithm, ans.AuthenticationKey = o.Answer.Esp.Authentication.normalize()
+		}
+		if o.Answer.Esp.Encryption != nil {
+			ans.EncryptionAlgorithm = o.Answer.Esp.Encryption.Algorithm
+			ans.EncryptionKey = o.Answer.Esp.Encryption.Key
+		}
+	case o.Answer.Ah != nil:
+		ans.Protocol = ProtocolAh
+		ans.AuthenticationAlgorithm, ans.AuthenticationKey = o.Answer.Ah.normalize()
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName xml.Name `xml:"entry"`
+	Name    string   `xml:"name,attr"`
+	Spi     string   `xml:"spi,omitempty"`
+	Esp     *esp     `xml:"esp"`
+	Ah      *authAlg `xml:"ah"`
+}
+
+type esp struct {
+	Authentication *authAlg    `xml:"authentication"`
+	Encryption     *encryption `xml:"encryption"`
+}
+
+type authAlg struct {
+	Md5    *authKey `xml:"md5"`
+	Sha1   *authKey `xml:"sha1"`
+	Sha256 *authKey `xml:"sha256"`
+	Sha384 *authKey `xml:"sha384"`
+	Sha512 *authKey `xml:"sha512"`
+	None   *string  `xml:"none"`
+}
+
+func (o *authAlg) normalize() (string, string) {
+	switch {
+	case o.Md5 != nil:
+		return AuthenticationMd5, o.Md5.Key
+	case o.Sha1 != nil:
+		return AuthenticationSha1, o.Sha1.Key
+	case o.Sha256 != nil:
+		return AuthenticationSha256, o.Sha256.Key
+	case o.Sha384 != nil:
+		return AuthenticationSha384, o.Sha384.Key
+	case o.Sha512 != nil:
+		return AuthenticationSha512, o.Sha512.Key
+	case o.None != nil:
+		return AuthenticationNone, ""
+	}
+
+	return "", ""
+}
+
+type authKey struct {
+	Key string `xml:"key"`
+}
+
+type encryption struct {
+	Algorithm string `xml:"algorithm,omitempty"`
+	Key       string `xml:"key,omitempty"`
+}
+
+func specifyAuth(alg, key string) *authAlg {
+	k := &authKey{Key: key}
+
+	switch alg {
+	case AuthenticationMd5:
+		return &authAlg{Md5: k}
+	case AuthenticationSha1:
+		return &authAlg{Sha1: k}
+	case AuthenticationSha256:
+		return &authAlg{Sha256: k}
+	case AuthenticationSha384:
+		return &authAlg{Sha384: k}
+	case AuthenticationSha512:
+		return &authAlg{Sha512: k}
+	case AuthenticationNone:
+		s := ""
+		return &authAlg{None: &s}
+	}
+
+	return nil
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name: e.Name,
+		Spi:  e.Spi,
+	}
+
+	switch e.Protocol {
+	case ProtocolEsp:
+		ans.Esp = &esp{
+			Authentication: specifyAuth(e.AuthenticationAlgorithm, e.AuthenticationKey),
+		}
+		if e.EncryptionAlgorithm != "" || e.EncryptionKey != "" {
+			ans.Esp.Encryption = &encryption{
+				Algorithm: e.EncryptionAlgorithm,
+				Key:       e.EncryptionKey,
+			}
+		}
+	case ProtocolAh:
+		ans.Ah = specifyAuth(e.AuthenticationAlgorithm, e.AuthenticationKey)
+	}
+
+	return ans
+}
@@ -0,0 +1,34 @@
+package auth
+
+func getTests() []testCase {
+	return []testCase{
+		{"esp with encryption", Entry{
+			Name:                    "one",
+			Spi:                     "0000abcd",
+			Protocol:                ProtocolEsp,
+			AuthenticationAlgorithm: AuthenticationSha256,
+			AuthenticationKey:       "authkey",
+			EncryptionAlgorithm:     EncryptionAes128Cbc,
+			EncryptionKey:           "enckey",
+		}},
+		{"esp no auth", Entry{
+			Name:                    "two",
+			Spi:                     "0000abce",
+			Protocol:                ProtocolEsp,
+			AuthenticationAlgorithm: AuthenticationNone,
+			EncryptionAlgorithm:     EncryptionNull,
+		}},
+		{"ah", Entry{
+			Name:                    "three",
+			Spi:                     "0000abcf",
+			Protocol:                ProtocolAh,
+			AuthenticationAlgorithm: AuthenticationSha1,
+			AuthenticationKey:       "authkey",
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
@@ -0,0 +1,143 @@
+package rip
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Config is a normalized, version independent representation of a virtual
+// router's RIP configuration.
+//
+// Interfaces, auth profiles, and export rules are managed through their own
+// namespaces and are preserved as-is when the config is updated.
+type Config struct {
+	Enable                        bool
+	RejectDefaultRoute            bool
+	AllowRedistributeDefaultRoute bool
+	BfdProfile                    string // XML: global-bfd/profile
+	IntervalSeconds               int
+	UpdateIntervals               int
+	ExpireIntervals               int
+	DeleteIntervals               int
+
+	raw map[string]string
+}
+
+// Copy copies the information from source Config `s` to this object.
+func (o *Config) Copy(s Config) {
+	o.Enable = s.Enable
+	o.RejectDefaultRoute = s.RejectDefaultRoute
+	o.AllowRedistributeDefaultRoute = s.AllowRedistributeDefaultRoute
+	o.BfdProfile = s.BfdProfile
+	o.IntervalSeconds = s.IntervalSeconds
+	o.UpdateIntervals = s.UpdateIntervals
+	o.ExpireIntervals = s.ExpireIntervals
+	o.DeleteIntervals = s.DeleteIntervals
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Config
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>rip"`
+}
+
+func (o *container_v1) Normalize() Config {
+	ans := Config{
+		Enable:                        util.AsBool(o.Answer.Enable),
+		RejectDefaultRoute:            util.AsBool(o.Answer.RejectDefaultRoute),
+		AllowRedistributeDefaultRoute: util.AsBool(o.Answer.AllowRedistributeDefaultRoute),
+	}
+
+	if o.Answer.Bfd != nil {
+		ans.BfdProfile = o.Answer.Bfd.Profile
+	}
+
+	if o.Answer.Timers != nil {
+		ans.IntervalSeconds = o.Answer.Timers.IntervalSeconds
+		ans.UpdateIntervals = o.Answer.Timers.UpdateIntervals
+		ans.ExpireIntervals = o.Answer.Timers.ExpireIntervals
+		ans.DeleteIntervals = o.Answer.Timers.DeleteIntervals
+	}
+
+	raw := make(map[string]string)
+
+	if o.Answer.Interface != nil {
+		raw["iface"] = util.CleanRawXml(o.Answer.Interface.Text)
+	}
+	if o.Answer.AuthProfile != nil {
+		raw["ap"] = util.CleanRawXml(o.Answer.AuthProfile.Text)
+	}
+	if o.Answer.ExportRules != nil {
+		raw["exp"] = util.CleanRawXml(o.Answer.ExportRules.Text)
+	}
+
+	if len(raw) != 0 {
+		ans.raw = raw
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName                       xml.Name `xml:"rip"`
+	Enable                        string   `xml:"enable"`
+	RejectDefaultRoute            string   `xml:"reject-default-route"`
+	AllowRedistributeDefaultRoute string   `xml:"allow-redist-default-route"`
+	Bfd                           *bfd     `xml:"global-bfd"`
+	Timers                        *timers  `xml:"timers"`
+
+	Interface   *util.RawXml `xml:"interface"`
+	AuthProfile *util.RawXml `xml:"auth-profile"`
+	ExportRules *util.RawXml `xml:"export-rules"`
+}
+
+type bfd struct {
+	Profile string `xml:"profile"`
+}
+
+type timers struct {
+	IntervalSeconds int `xml:"interval-seconds,omitempty"`
+	UpdateIntervals int `xml:"update-intervals,omitempty"`
+	ExpireIntervals int `xml:"expire-intervals,omitempty"`
+	DeleteIntervals int `xml:"delete-intervals,omitempty"`
+}
+
+func specify_v1(e Config) interface{} {
+	ans := entry_v1{
+		Enable:                        util.YesNo(e.Enable),
+		RejectDefaultRoute:            util.YesNo(e.RejectDefaultRoute),
+		AllowRedistributeDefaultRoute: util.YesNo(e.AllowRedistributeDefaultRoute),
+	}
+
+	if e.BfdProfile != "" {
+		ans.Bfd = &bfd{
+			Profile: e.BfdProfile,
+		}
+	}
+
+	if e.IntervalSeconds != 0 || e.UpdateIntervals != 0 || e.ExpireIntervals != 0 || e.DeleteIntervals != 0 {
+		ans.Timers = &timers{
+			IntervalSeconds: e.IntervalSeconds,
+			UpdateIntervals: e.UpdateIntervals,
+			ExpireIntervals: e.ExpireIntervals,
+			DeleteIntervals: e.DeleteIntervals,
+		}
+	}
+
+	if text, present := e.raw["iface"]; present {
+		ans.Interface = &util.RawXml{text}
+	}
+	if text, present := e.raw["ap"]; present {
+		ans.AuthProfile = &util.RawXml{text}
+	}
+	if text, present := e.raw["exp"]; present {
+		ans.ExportRules = &util.RawXml{text}
+	}
+
+	return ans
+}
@@ -0,0 +1,6 @@
+/*
+Package rip is the client.Network.RipConfig namespace.
+
+Normalized object:  Config
+*/
+package rip
@@ -0,0 +1,6 @@
+package exp
+
+const (
+	singular = "rip export rule"
+	plural   = "rip export rules"
+)
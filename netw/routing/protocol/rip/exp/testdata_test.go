@@ -0,0 +1,18 @@
+package exp
+
+func getTests() []testCase {
+	return []testCase{
+		{"no metric", Entry{
+			Name: "redist1",
+		}},
+		{"with metric", Entry{
+			Name:   "redist2",
+			Metric: 5,
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
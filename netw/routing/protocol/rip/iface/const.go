@@ -0,0 +1,19 @@
+package iface
+
+// Valid values for Mode.
+const (
+	ModeNormal   = "normal"
+	ModePassive  = "passive"
+	ModeSendOnly = "send-only"
+)
+
+// Valid values for BfdProfile, besides an actual BFD profile's name.
+const (
+	BfdProfileInherit = "Inherit-vr-global-setting"
+	BfdProfileNone    = "None"
+)
+
+const (
+	singular = "rip interface"
+	plural   = "rip interfaces"
+)
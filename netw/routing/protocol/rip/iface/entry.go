@@ -0,0 +1,113 @@
+package iface
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an
+// interface participating in RIP.
+type Entry struct {
+	Name                  string
+	Enable                bool
+	Mode                  string
+	AuthProfile           string // XML: authentication
+	BfdProfile            string // XML: bfd/profile
+	DefaultRouteAdvertise bool
+	DefaultRouteMetric    int
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Enable = s.Enable
+	o.Mode = s.Mode
+	o.AuthProfile = s.AuthProfile
+	o.BfdProfile = s.BfdProfile
+	o.DefaultRouteAdvertise = s.DefaultRouteAdvertise
+	o.DefaultRouteMetric = s.DefaultRouteMetric
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:        o.Answer.Name,
+		Enable:      util.AsBool(o.Answer.Enable),
+		Mode:        o.Answer.Mode,
+		AuthProfile: o.Answer.AuthProfile,
+	}
+
+	if o.Answer.Bfd != nil {
+		ans.BfdProfile = o.Answer.Bfd.Profile
+	}
+
+	if o.Answer.DefaultRoute != nil && o.Answer.DefaultRoute.Advertise != nil {
+		ans.DefaultRouteAdvertise = true
+		ans.DefaultRouteMetric = o.Answer.DefaultRoute.Advertise.Metric
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName      xml.Name      `xml:"entry"`
+	Name         string        `xml:"name,attr"`
+	Enable       string        `xml:"enable"`
+	Mode         string        `xml:"mode,omitempty"`
+	AuthProfile  string        `xml:"authentication,omitempty"`
+	Bfd          *bfd          `xml:"bfd"`
+	DefaultRoute *defaultRoute `xml:"default-route"`
+}
+
+type bfd struct {
+	Profile string `xml:"profile"`
+}
+
+type defaultRoute struct {
+	Disable   *string    `xml:"disable"`
+	Advertise *advertise `xml:"advertise"`
+}
+
+type advertise struct {
+	Metric int `xml:"metric,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:        e.Name,
+		Enable:      util.YesNo(e.Enable),
+		Mode:        e.Mode,
+		AuthProfile: e.AuthProfile,
+	}
+
+	if e.BfdProfile != "" {
+		ans.Bfd = &bfd{
+			Profile: e.BfdProfile,
+		}
+	}
+
+	if e.DefaultRouteAdvertise {
+		ans.DefaultRoute = &defaultRoute{
+			Advertise: &advertise{
+				Metric: e.DefaultRouteMetric,
+			},
+		}
+	} else {
+		s := ""
+		ans.DefaultRoute = &defaultRoute{
+			Disable: &s,
+		}
+	}
+
+	return ans
+}
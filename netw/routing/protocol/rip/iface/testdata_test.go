@@ -0,0 +1,34 @@
+package iface
+
+func getTests() []testCase {
+	return []testCase{
+		{"normal interface", Entry{
+			Name:        "ethernet1/1",
+			Enable:      true,
+			Mode:        ModeNormal,
+			AuthProfile: "auth",
+			BfdProfile:  "default",
+		}},
+		{"passive interface", Entry{
+			Name: "ethernet1/2",
+			Mode: ModePassive,
+		}},
+		{"advertise default route", Entry{
+			Name:                  "ethernet1/3",
+			Enable:                true,
+			Mode:                  ModeSendOnly,
+			DefaultRouteAdvertise: true,
+			DefaultRouteMetric:    5,
+		}},
+		{"bfd disabled", Entry{
+			Name:       "ethernet1/4",
+			Enable:     true,
+			BfdProfile: BfdProfileNone,
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Entry
+}
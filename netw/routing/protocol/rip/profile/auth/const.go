@@ -0,0 +1,6 @@
+package auth
+
+const (
+	singular = "rip auth profile"
+	plural   = "rip auth profiles"
+)
@@ -0,0 +1,32 @@
+package rip
+
+func getTests() []testCase {
+	return []testCase{
+		{"basic config", Config{
+			Enable:             true,
+			RejectDefaultRoute: true,
+		}},
+		{"with timers", Config{
+			Enable:                        true,
+			AllowRedistributeDefaultRoute: true,
+			BfdProfile:                    "default",
+			IntervalSeconds:               1,
+			UpdateIntervals:               30,
+			ExpireIntervals:               180,
+			DeleteIntervals:               120,
+		}},
+		{"with raw", Config{
+			Enable: true,
+			raw: map[string]string{
+				"iface": "<entry name=\"ethernet1/1\"><enable>yes</enable></entry>",
+				"ap":    "<entry name=\"ap\"><password>secret</password></entry>",
+				"exp":   "<entry name=\"redist\"><metric>5</metric></entry>",
+			},
+		}},
+	}
+}
+
+type testCase struct {
+	desc string
+	conf Config
+}
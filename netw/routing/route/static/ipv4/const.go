@@ -5,6 +5,7 @@ const (
 	NextHopDiscard   = "discard"
 	NextHopIpAddress = "ip-address"
 	NextHopNextVr    = "next-vr"
+	NextHopFqdn      = "fqdn" // 9.0+
 )
 
 // Valid RouteTable values.
@@ -15,6 +16,17 @@ const (
 	RouteTableBoth      = "both"
 )
 
+// Valid FailureCondition values.
+const (
+	FailureConditionAny = "any"
+	FailureConditionAll = "all"
+)
+
+// Valid values for BfdProfile, besides an actual BFD profile's name.
+const (
+	BfdProfileNone = "None"
+)
+
 const (
 	singular = "ipv4 static route"
 	plural   = "ipv4 static routes"
@@ -124,5 +124,61 @@ func getTests() []testCase {
 			Metric:        111,
 			RouteTable:    RouteTableBoth,
 		}},
+		{"v3 route with path monitoring", version.Number{8, 0, 0, ""}, "v1", Entry{
+			Name:              "five",
+			Destination:       "0.0.0.0/0",
+			Interface:         "ethernet1/1",
+			Type:              NextHopIpAddress,
+			NextHop:           "10.2.3.5",
+			RouteTable:        RouteTableUnicast,
+			EnablePathMonitor: true,
+			FailureCondition:  FailureConditionAll,
+			HoldTime:          5,
+			MonitorDestinations: []MonitorDestination{
+				{
+					Name:        "dest1",
+					Enable:      true,
+					Source:      "10.2.3.1/24",
+					Destination: "8.8.8.8",
+					Interval:    3,
+					Count:       5,
+				},
+				{
+					Name:        "dest2",
+					Source:      "DHCP",
+					Destination: "8.8.4.4",
+				},
+			},
+		}},
+		{"v3 route with bfd disabled", version.Number{8, 0, 0, ""}, "v1", Entry{
+			Name:        "six",
+			Destination: "10.5.0.0/16",
+			Interface:   "ethernet1/1",
+			Type:        NextHopIpAddress,
+			NextHop:     "10.2.3.6",
+			BfdProfile:  BfdProfileNone,
+		}},
+		{"v4 route fqdn nexthop", version.Number{9, 0, 0, ""}, "v1", Entry{
+			Name:          "seven",
+			Destination:   "10.6.0.0/16",
+			Interface:     "ethernet1/1",
+			Type:          NextHopFqdn,
+			NextHop:       "gw.example.com",
+			AdminDistance: 10,
+			RouteTable:    RouteTableUnicast,
+		}},
+		{"v4 route next vr nexthop", version.Number{9, 0, 0, ""}, "v1", Entry{
+			Name:        "eight",
+			Destination: "10.7.0.0/16",
+			Type:        NextHopNextVr,
+			NextHop:     "vr2",
+			RouteTable:  RouteTableBoth,
+			BfdProfile:  BfdProfileNone,
+		}},
+		{"v4 route discard", version.Number{9, 0, 0, ""}, "v1", Entry{
+			Name:        "nine",
+			Destination: "10.8.0.0/16",
+			Type:        NextHopDiscard,
+		}},
 	}
 }
@@ -0,0 +1,31 @@
+package ipv6
+
+// Valid NextHop values.
+const (
+	NextHopDiscard     = "discard"
+	NextHopIpv6Address = "ipv6-address"
+	NextHopNextVr      = "next-vr"
+	NextHopFqdn        = "fqdn" // 9.0+
+)
+
+// Valid RouteTable values.
+const (
+	RouteTableNoInstall = "no install"
+	RouteTableUnicast   = "unicast"
+)
+
+// Valid FailureCondition values.
+const (
+	FailureConditionAny = "any"
+	FailureConditionAll = "all"
+)
+
+// Valid values for BfdProfile, besides an actual BFD profile's name.
+const (
+	BfdProfileNone = "None"
+)
+
+const (
+	singular = "ipv6 static route"
+	plural   = "ipv6 static routes"
+)
@@ -0,0 +1,6 @@
+/*
+Package ipv4 is the client.Network.Ipv6StaticRoute namespace.
+
+Normalized object:  Entry
+*/
+package ipv6
@@ -0,0 +1,606 @@
+package ipv6
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an IPv6
+// static route.
+type Entry struct {
+	Name          string
+	Destination   string
+	Interface     string
+	Type          string
+	NextHop       string
+	AdminDistance int
+	Metric        int
+	RouteTable    string
+	BfdProfile    string
+
+	// Path monitoring, 8.0+.
+	EnablePathMonitor   bool
+	FailureCondition    string
+	HoldTime            int
+	MonitorDestinations []MonitorDestination
+}
+
+// MonitorDestination is a path monitor destination for a static route.
+type MonitorDestination struct {
+	Name        string
+	Enable      bool
+	Source      string
+	Destination string
+	Interval    int
+	Count       int
+}
+
+func (o *Entry) Copy(s Entry) {
+	o.Destination = s.Destination
+	o.Interface = s.Interface
+	o.Type = s.Type
+	o.NextHop = s.NextHop
+	o.AdminDistance = s.AdminDistance
+	o.Metric = s.Metric
+	o.RouteTable = s.RouteTable
+	o.BfdProfile = s.BfdProfile
+	o.EnablePathMonitor = s.EnablePathMonitor
+	o.FailureCondition = s.FailureCondition
+	o.HoldTime = s.HoldTime
+	if s.MonitorDestinations == nil {
+		o.MonitorDestinations = nil
+	} else {
+		o.MonitorDestinations = make([]MonitorDestination, len(s.MonitorDestinations))
+		copy(o.MonitorDestinations, s.MonitorDestinations)
+	}
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() []Entry
+	Names() []string
+}
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:          o.Name,
+		Destination:   o.Destination,
+		Interface:     o.Interface,
+		AdminDistance: o.AdminDistance,
+		Metric:        o.Metric,
+	}
+
+	if o.NextHop == nil {
+		ans.Type = ""
+	} else if o.NextHop.Discard != nil {
+		ans.Type = NextHopDiscard
+	} else if o.NextHop.Ipv6Address != nil {
+		ans.Type = NextHopIpv6Address
+		ans.NextHop = *o.NextHop.Ipv6Address
+	} else if o.NextHop.NextVr != nil {
+		ans.Type = NextHopNextVr
+		ans.NextHop = *o.NextHop.NextVr
+	}
+
+	if o.Option != nil && o.Option.NoInstall != nil {
+		ans.RouteTable = RouteTableNoInstall
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName       xml.Name     `xml:"entry"`
+	Name          string       `xml:"name,attr"`
+	Destination   string       `xml:"destination"`
+	Interface     string       `xml:"interface,omitempty"`
+	NextHop       *nextHop     `xml:"nexthop"`
+	AdminDistance int          `xml:"admin-dist,omitempty"`
+	Metric        int          `xml:"metric,omitempty"`
+	Option        *rtOption_v1 `xml:"option"`
+}
+
+type nextHop struct {
+	Discard     *string `xml:"discard"`
+	Ipv6Address *string `xml:"ipv6-address"`
+	NextVr      *string `xml:"next-vr"`
+}
+
+type rtOption_v1 struct {
+	NoInstall *string `xml:"no-install"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:          e.Name,
+		Destination:   e.Destination,
+		Interface:     e.Interface,
+		AdminDistance: e.AdminDistance,
+		Metric:        e.Metric,
+	}
+
+	switch e.Type {
+	case NextHopDiscard:
+		var sp string
+		ans.NextHop = &nextHop{Discard: &sp}
+	case NextHopIpv6Address:
+		sp := e.NextHop
+		ans.NextHop = &nextHop{Ipv6Address: &sp}
+	case NextHopNextVr:
+		sp := e.NextHop
+		ans.NextHop = &nextHop{NextVr: &sp}
+	}
+
+	if e.RouteTable == RouteTableNoInstall {
+		sp := ""
+		ans.Option = &rtOption_v1{NoInstall: &sp}
+	}
+
+	return ans
+}
+
+// PAN-OS 7.1, adds BfdProfile
+type container_v2 struct {
+	Answer []entry_v2 `xml:"entry"`
+}
+
+func (o *container_v2) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v2) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v2) normalize() Entry {
+	ans := Entry{
+		Name:          o.Name,
+		Destination:   o.Destination,
+		Interface:     o.Interface,
+		AdminDistance: o.AdminDistance,
+		Metric:        o.Metric,
+	}
+
+	if o.NextHop == nil {
+		ans.Type = ""
+	} else if o.NextHop.Discard != nil {
+		ans.Type = NextHopDiscard
+	} else if o.NextHop.Ipv6Address != nil {
+		ans.Type = NextHopIpv6Address
+		ans.NextHop = *o.NextHop.Ipv6Address
+	} else if o.NextHop.NextVr != nil {
+		ans.Type = NextHopNextVr
+		ans.NextHop = *o.NextHop.NextVr
+	}
+
+	if o.Option != nil && o.Option.NoInstall != nil {
+		ans.RouteTable = RouteTableNoInstall
+	}
+
+	if o.Bfd != nil {
+		ans.BfdProfile = o.Bfd.Profile
+	}
+
+	return ans
+}
+
+type entry_v2 struct {
+	XMLName       xml.Name     `xml:"entry"`
+	Name          string       `xml:"name,attr"`
+	Destination   string       `xml:"destination"`
+	Interface     string       `xml:"interface,omitempty"`
+	NextHop       *nextHop     `xml:"nexthop"`
+	AdminDistance int          `xml:"admin-dist,omitempty"`
+	Metric        int          `xml:"metric,omitempty"`
+	Option        *rtOption_v1 `xml:"option"`
+	Bfd           *bfd         `xml:"bfd"`
+}
+
+type bfd struct {
+	Profile string `xml:"profile"`
+}
+
+func specify_v2(e Entry) interface{} {
+	ans := entry_v2{
+		Name:          e.Name,
+		Destination:   e.Destination,
+		Interface:     e.Interface,
+		AdminDistance: e.AdminDistance,
+		Metric:        e.Metric,
+	}
+
+	switch e.Type {
+	case NextHopDiscard:
+		var sp string
+		ans.NextHop = &nextHop{Discard: &sp}
+	case NextHopIpv6Address:
+		sp := e.NextHop
+		ans.NextHop = &nextHop{Ipv6Address: &sp}
+	case NextHopNextVr:
+		sp := e.NextHop
+		ans.NextHop = &nextHop{NextVr: &sp}
+	}
+
+	if e.RouteTable == RouteTableNoInstall {
+		sp := ""
+		ans.Option = &rtOption_v1{NoInstall: &sp}
+	}
+
+	if e.BfdProfile != "" {
+		ans.Bfd = &bfd{Profile: e.BfdProfile}
+	}
+
+	return ans
+}
+
+// PAN-OS 8.0, new routing table options
+type container_v3 struct {
+	Answer []entry_v3 `xml:"entry"`
+}
+
+func (o *container_v3) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v3) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v3) normalize() Entry {
+	ans := Entry{
+		Name:          o.Name,
+		Destination:   o.Destination,
+		Interface:     o.Interface,
+		AdminDistance: o.AdminDistance,
+		Metric:        o.Metric,
+	}
+
+	if o.NextHop == nil {
+		ans.Type = ""
+	} else if o.NextHop.Discard != nil {
+		ans.Type = NextHopDiscard
+	} else if o.NextHop.Ipv6Address != nil {
+		ans.Type = NextHopIpv6Address
+		ans.NextHop = *o.NextHop.Ipv6Address
+	} else if o.NextHop.NextVr != nil {
+		ans.Type = NextHopNextVr
+		ans.NextHop = *o.NextHop.NextVr
+	}
+
+	if o.Option != nil {
+		if o.Option.Unicast != nil {
+			ans.RouteTable = RouteTableUnicast
+		} else if o.Option.NoInstall != nil {
+			ans.RouteTable = RouteTableNoInstall
+		}
+	}
+
+	if o.Bfd != nil {
+		ans.BfdProfile = o.Bfd.Profile
+	}
+
+	if o.PathMonitor != nil {
+		ans.EnablePathMonitor = util.AsBool(o.PathMonitor.Enable)
+		ans.FailureCondition = o.PathMonitor.FailureCondition
+		ans.HoldTime = o.PathMonitor.HoldTime
+		if o.PathMonitor.Destinations != nil {
+			ans.MonitorDestinations = make([]MonitorDestination, 0, len(o.PathMonitor.Destinations.Entries))
+			for _, v := range o.PathMonitor.Destinations.Entries {
+				ans.MonitorDestinations = append(ans.MonitorDestinations, MonitorDestination{
+					Name:        v.Name,
+					Enable:      util.AsBool(v.Enable),
+					Source:      v.Source,
+					Destination: v.Destination,
+					Interval:    v.Interval,
+					Count:       v.Count,
+				})
+			}
+		}
+	}
+
+	return ans
+}
+
+type entry_v3 struct {
+	XMLName       xml.Name     `xml:"entry"`
+	Name          string       `xml:"name,attr"`
+	Destination   string       `xml:"destination"`
+	Interface     string       `xml:"interface,omitempty"`
+	NextHop       *nextHop     `xml:"nexthop"`
+	AdminDistance int          `xml:"admin-dist,omitempty"`
+	Metric        int          `xml:"metric,omitempty"`
+	Option        *rtOption_v2 `xml:"route-table"`
+	Bfd           *bfd         `xml:"bfd"`
+	PathMonitor   *pathMonitor `xml:"path-monitor"`
+}
+
+type pathMonitor struct {
+	Enable           string        `xml:"enable"`
+	FailureCondition string        `xml:"failure-condition,omitempty"`
+	HoldTime         int           `xml:"hold-time,omitempty"`
+	Destinations     *destinations `xml:"monitor-destinations"`
+}
+
+type destinations struct {
+	Entries []destination `xml:"entry"`
+}
+
+type destination struct {
+	Name        string `xml:"name,attr"`
+	Enable      string `xml:"enable"`
+	Source      string `xml:"source,omitempty"`
+	Destination string `xml:"destination,omitempty"`
+	Interval    int    `xml:"interval,omitempty"`
+	Count       int    `xml:"count,omitempty"`
+}
+
+type rtOption_v2 struct {
+	Unicast   *string `xml:"unicast"`
+	NoInstall *string `xml:"no-install"`
+}
+
+func specify_v3(e Entry) interface{} {
+	ans := entry_v3{
+		Name:          e.Name,
+		Destination:   e.Destination,
+		Interface:     e.Interface,
+		AdminDistance: e.AdminDistance,
+		Metric:        e.Metric,
+	}
+
+	switch e.Type {
+	case NextHopDiscard:
+		var sp string
+		ans.NextHop = &nextHop{Discard: &sp}
+	case NextHopIpv6Address:
+		sp := e.NextHop
+		ans.NextHop = &nextHop{Ipv6Address: &sp}
+	case NextHopNextVr:
+		sp := e.NextHop
+		ans.NextHop = &nextHop{NextVr: &sp}
+	}
+
+	switch e.RouteTable {
+	case RouteTableUnicast:
+		sp := ""
+		ans.Option = &rtOption_v2{Unicast: &sp}
+	case RouteTableNoInstall:
+		sp := ""
+		ans.Option = &rtOption_v2{NoInstall: &sp}
+	}
+
+	if e.BfdProfile != "" {
+		ans.Bfd = &bfd{Profile: e.BfdProfile}
+	}
+
+	if e.EnablePathMonitor || e.FailureCondition != "" || e.HoldTime != 0 || len(e.MonitorDestinations) > 0 {
+		ans.PathMonitor = &pathMonitor{
+			Enable:           util.YesNo(e.EnablePathMonitor),
+			FailureCondition: e.FailureCondition,
+			HoldTime:         e.HoldTime,
+		}
+		if len(e.MonitorDestinations) > 0 {
+			list := make([]destination, 0, len(e.MonitorDestinations))
+			for _, v := range e.MonitorDestinations {
+				list = append(list, destination{
+					Name:        v.Name,
+					Enable:      util.YesNo(v.Enable),
+					Source:      v.Source,
+					Destination: v.Destination,
+					Interval:    v.Interval,
+					Count:       v.Count,
+				})
+			}
+			ans.PathMonitor.Destinations = &destinations{Entries: list}
+		}
+	}
+
+	return ans
+}
+
+// PAN-OS 9.0, adds the fqdn next hop type
+type container_v4 struct {
+	Answer []entry_v4 `xml:"entry"`
+}
+
+func (o *container_v4) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v4) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v4) normalize() Entry {
+	ans := Entry{
+		Name:          o.Name,
+		Destination:   o.Destination,
+		Interface:     o.Interface,
+		AdminDistance: o.AdminDistance,
+		Metric:        o.Metric,
+	}
+
+	if o.NextHop == nil {
+		ans.Type = ""
+	} else if o.NextHop.Discard != nil {
+		ans.Type = NextHopDiscard
+	} else if o.NextHop.Ipv6Address != nil {
+		ans.Type = NextHopIpv6Address
+		ans.NextHop = *o.NextHop.Ipv6Address
+	} else if o.NextHop.NextVr != nil {
+		ans.Type = NextHopNextVr
+		ans.NextHop = *o.NextHop.NextVr
+	} else if o.NextHop.Fqdn != nil {
+		ans.Type = NextHopFqdn
+		ans.NextHop = *o.NextHop.Fqdn
+	}
+
+	if o.Option != nil {
+		if o.Option.Unicast != nil {
+			ans.RouteTable = RouteTableUnicast
+		} else if o.Option.NoInstall != nil {
+			ans.RouteTable = RouteTableNoInstall
+		}
+	}
+
+	if o.Bfd != nil {
+		ans.BfdProfile = o.Bfd.Profile
+	}
+
+	if o.PathMonitor != nil {
+		ans.EnablePathMonitor = util.AsBool(o.PathMonitor.Enable)
+		ans.FailureCondition = o.PathMonitor.FailureCondition
+		ans.HoldTime = o.PathMonitor.HoldTime
+		if o.PathMonitor.Destinations != nil {
+			ans.MonitorDestinations = make([]MonitorDestination, 0, len(o.PathMonitor.Destinations.Entries))
+			for _, v := range o.PathMonitor.Destinations.Entries {
+				ans.MonitorDestinations = append(ans.MonitorDestinations, MonitorDestination{
+					Name:        v.Name,
+					Enable:      util.AsBool(v.Enable),
+					Source:      v.Source,
+					Destination: v.Destination,
+					Interval:    v.Interval,
+					Count:       v.Count,
+				})
+			}
+		}
+	}
+
+	return ans
+}
+
+type entry_v4 struct {
+	XMLName       xml.Name     `xml:"entry"`
+	Name          string       `xml:"name,attr"`
+	Destination   string       `xml:"destination"`
+	Interface     string       `xml:"interface,omitempty"`
+	NextHop       *nextHop_v2  `xml:"nexthop"`
+	AdminDistance int          `xml:"admin-dist,omitempty"`
+	Metric        int          `xml:"metric,omitempty"`
+	Option        *rtOption_v2 `xml:"route-table"`
+	Bfd           *bfd         `xml:"bfd"`
+	PathMonitor   *pathMonitor `xml:"path-monitor"`
+}
+
+type nextHop_v2 struct {
+	Discard     *string `xml:"discard"`
+	Ipv6Address *string `xml:"ipv6-address"`
+	NextVr      *string `xml:"next-vr"`
+	Fqdn        *string `xml:"fqdn"`
+}
+
+func specify_v4(e Entry) interface{} {
+	ans := entry_v4{
+		Name:          e.Name,
+		Destination:   e.Destination,
+		Interface:     e.Interface,
+		AdminDistance: e.AdminDistance,
+		Metric:        e.Metric,
+	}
+
+	switch e.Type {
+	case NextHopDiscard:
+		var sp string
+		ans.NextHop = &nextHop_v2{Discard: &sp}
+	case NextHopIpv6Address:
+		sp := e.NextHop
+		ans.NextHop = &nextHop_v2{Ipv6Address: &sp}
+	case NextHopNextVr:
+		sp := e.NextHop
+		ans.NextHop = &nextHop_v2{NextVr: &sp}
+	case NextHopFqdn:
+		sp := e.NextHop
+		ans.NextHop = &nextHop_v2{Fqdn: &sp}
+	}
+
+	switch e.RouteTable {
+	case RouteTableUnicast:
+		sp := ""
+		ans.Option = &rtOption_v2{Unicast: &sp}
+	case RouteTableNoInstall:
+		sp := ""
+		ans.Option = &rtOption_v2{NoInstall: &sp}
+	}
+
+	if e.BfdProfile != "" {
+		ans.Bfd = &bfd{Profile: e.BfdProfile}
+	}
+
+	if e.EnablePathMonitor || e.FailureCondition != "" || e.HoldTime != 0 || len(e.MonitorDestinations) > 0 {
+		ans.PathMonitor = &pathMonitor{
+			Enable:           util.YesNo(e.EnablePathMonitor),
+			FailureCondition: e.FailureCondition,
+			HoldTime:         e.HoldTime,
+		}
+		if len(e.MonitorDestinations) > 0 {
+			list := make([]destination, 0, len(e.MonitorDestinations))
+			for _, v := range e.MonitorDestinations {
+				list = append(list, destination{
+					Name:        v.Name,
+					Enable:      util.YesNo(v.Enable),
+					Source:      v.Source,
+					Destination: v.Destination,
+					Interval:    v.Interval,
+					Count:       v.Count,
+				})
+			}
+			ans.PathMonitor.Destinations = &destinations{Entries: list}
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,81 @@
+package ipv6
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type testCase struct {
+	desc    string
+	version version.Number
+	vr      string
+	conf    Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"v1 route no nexthop", version.Number{5, 0, 0, ""}, "v1", Entry{
+			Name:          "one",
+			Destination:   "::/0",
+			Interface:     "ethernet1/1",
+			AdminDistance: 101,
+			Metric:        111,
+		}},
+		{"v1 route ipv6 address nexthop", version.Number{5, 0, 0, ""}, "v1", Entry{
+			Name:          "two",
+			Destination:   "2001:db8::/32",
+			Interface:     "ethernet1/1",
+			Type:          NextHopIpv6Address,
+			NextHop:       "2001:db8::1",
+			AdminDistance: 101,
+			Metric:        111,
+			RouteTable:    RouteTableNoInstall,
+		}},
+		{"v2 route discard nexthop", version.Number{7, 1, 0, ""}, "v1", Entry{
+			Name:        "three",
+			Destination: "2001:db8:1::/48",
+			Type:        NextHopDiscard,
+			BfdProfile:  "bfd one",
+		}},
+		{"v3 route next vr nexthop", version.Number{8, 0, 0, ""}, "v1", Entry{
+			Name:        "four",
+			Destination: "::/0",
+			Type:        NextHopNextVr,
+			NextHop:     "default",
+			RouteTable:  RouteTableUnicast,
+		}},
+		{"v3 route with path monitoring", version.Number{8, 0, 0, ""}, "v1", Entry{
+			Name:              "five",
+			Destination:       "::/0",
+			Interface:         "ethernet1/1",
+			Type:              NextHopIpv6Address,
+			NextHop:           "2001:db8::1",
+			EnablePathMonitor: true,
+			FailureCondition:  FailureConditionAny,
+			MonitorDestinations: []MonitorDestination{
+				{
+					Name:        "dest1",
+					Enable:      true,
+					Source:      "2001:db8::2/64",
+					Destination: "2001:4860:4860::8888",
+					Interval:    3,
+					Count:       5,
+				},
+			},
+		}},
+		{"v4 route fqdn nexthop", version.Number{9, 0, 0, ""}, "v1", Entry{
+			Name:        "six",
+			Destination: "2001:db8:2::/48",
+			Interface:   "ethernet1/1",
+			Type:        NextHopFqdn,
+			NextHop:     "gw.example.com",
+			RouteTable:  RouteTableNoInstall,
+		}},
+		{"v4 route next vr nexthop", version.Number{9, 0, 0, ""}, "v1", Entry{
+			Name:        "seven",
+			Destination: "2001:db8:3::/48",
+			Type:        NextHopNextVr,
+			NextHop:     "vr2",
+			BfdProfile:  BfdProfileNone,
+		}},
+	}
+}
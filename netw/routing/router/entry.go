@@ -2,12 +2,17 @@ package router
 
 import (
 	"encoding/xml"
+	"sort"
 
 	"github.com/PaloAltoNetworks/pango/util"
 )
 
 // Entry is a normalized, version independent representation of a virtual
 // router.
+//
+// The *Dist fields are the administrative distances used to select between
+// routes to the same destination learned from different protocols.  Use
+// Defaults() to populate any unset distances with the PAN-OS defaults.
 type Entry struct {
 	Name                             string
 	Interfaces                       []string
@@ -104,7 +109,14 @@ func (o *Entry) Copy(s Entry) {
 	o.EcmpHashSourceOnly = s.EcmpHashSourceOnly
 	o.EcmpHashUsePort = s.EcmpHashUsePort
 	o.EcmpHashSeed = s.EcmpHashSeed
-	o.EcmpWeightedRoundRobinInterfaces = s.EcmpWeightedRoundRobinInterfaces
+	if s.EcmpWeightedRoundRobinInterfaces == nil {
+		o.EcmpWeightedRoundRobinInterfaces = nil
+	} else {
+		o.EcmpWeightedRoundRobinInterfaces = make(map[string]int, len(s.EcmpWeightedRoundRobinInterfaces))
+		for k, v := range s.EcmpWeightedRoundRobinInterfaces {
+			o.EcmpWeightedRoundRobinInterfaces[k] = v
+		}
+	}
 }
 
 /** Structs / functions for this namespace. **/
@@ -301,19 +313,23 @@ func specify_v1(e Entry) interface{} {
 				},
 			}
 		case EcmpLoadBalanceMethodWeightedRoundRobin:
-			var wrrValue *wrr
+			wrrValue := &wrr{}
 			if len(e.EcmpWeightedRoundRobinInterfaces) > 0 {
-				listing := make([]wrrInterface, 0, len(e.EcmpWeightedRoundRobinInterfaces))
-				for name, weight := range e.EcmpWeightedRoundRobinInterfaces {
+				names := make([]string, 0, len(e.EcmpWeightedRoundRobinInterfaces))
+				for name := range e.EcmpWeightedRoundRobinInterfaces {
+					names = append(names, name)
+				}
+				sort.Strings(names)
+
+				listing := make([]wrrInterface, 0, len(names))
+				for _, name := range names {
 					listing = append(listing, wrrInterface{
 						Interface: name,
-						Weight:    weight,
+						Weight:    e.EcmpWeightedRoundRobinInterfaces[name],
 					})
 				}
-				wrrValue = &wrr{
-					Interfaces: &wrrInterfaces{
-						Entries: listing,
-					},
+				wrrValue.Interfaces = &wrrInterfaces{
+					Entries: listing,
 				}
 			}
 			ans.Ecmp.Algorithm = &algorithm{
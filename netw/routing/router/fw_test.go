@@ -43,3 +43,44 @@ func TestFwNormalization(t *testing.T) {
 		})
 	}
 }
+
+func TestWeightedRoundRobinOrder(t *testing.T) {
+	e := Entry{
+		Name:                  "vr",
+		EcmpLoadBalanceMethod: EcmpLoadBalanceMethodWeightedRoundRobin,
+		EcmpWeightedRoundRobinInterfaces: map[string]int{
+			"ethernet1/3": 3,
+			"ethernet1/1": 1,
+			"ethernet1/2": 2,
+		},
+	}
+
+	for i := 0; i < 5; i++ {
+		ans := specify_v1(e).(entry_v1)
+		list := ans.Ecmp.Algorithm.Wrr.Interfaces.Entries
+		if len(list) != 3 {
+			t.Fatalf("Expected 3 interfaces, got %d", len(list))
+		}
+		for j, name := range []string{"ethernet1/1", "ethernet1/2", "ethernet1/3"} {
+			if list[j].Interface != name || list[j].Weight != j+1 {
+				t.Errorf("Interface %d is %#v, expected %q", j, list[j], name)
+			}
+		}
+	}
+}
+
+func TestCopy(t *testing.T) {
+	src := Entry{
+		EcmpWeightedRoundRobinInterfaces: map[string]int{
+			"ethernet1/1": 1,
+		},
+	}
+
+	var dst Entry
+	dst.Copy(src)
+	dst.EcmpWeightedRoundRobinInterfaces["ethernet1/1"] = 5
+
+	if src.EcmpWeightedRoundRobinInterfaces["ethernet1/1"] != 1 {
+		t.Errorf("Copy did not copy the weighted round robin interfaces")
+	}
+}
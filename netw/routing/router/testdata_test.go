@@ -27,6 +27,19 @@ func getTests() []testCase {
 				"routing":   "<routing-table><route1>something</route1><route2>b</route2></routing-table>",
 			},
 		}},
+		{"custom admin distances", "x", "vsys1", false, []string{"dist"}, Entry{
+			Name:           "dist",
+			Interfaces:     []string{"ethernet1/5"},
+			StaticDist:     15,
+			StaticIpv6Dist: 16,
+			OspfIntDist:    31,
+			OspfExtDist:    111,
+			Ospfv3IntDist:  32,
+			Ospfv3ExtDist:  112,
+			IbgpDist:       201,
+			EbgpDist:       21,
+			RipDist:        121,
+		}},
 		{"ecmp ip modulo", "x", "vsys1", false, []string{"ecmp1"}, Entry{
 			Name:                  "ecmp1",
 			EnableEcmp:            true,
@@ -62,6 +75,11 @@ func getTests() []testCase {
 				"routing": "<routing-table><route1>something</route1><route2>b</route2></routing-table>",
 			},
 		}},
+		{"ecmp wrr no weights", "x", "vsys1", false, []string{"ecmp5"}, Entry{
+			Name:                  "ecmp5",
+			EnableEcmp:            true,
+			EcmpLoadBalanceMethod: EcmpLoadBalanceMethodWeightedRoundRobin,
+		}},
 		{"ecmp brr", "x", "vsys1", false, []string{"ecmp4"}, Entry{
 			Name:                  "ecmp4",
 			EnableEcmp:            true,
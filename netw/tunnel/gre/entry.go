@@ -6,7 +6,11 @@ import (
 	"github.com/PaloAltoNetworks/pango/util"
 )
 
-// Entry is a normalized, version independent representation of a peer.
+// Entry is a normalized, version independent representation of a GRE
+// tunnel.
+//
+// The TunnelInterface field binds the tunnel to a tunnel interface, which
+// is configured separately via client.Network.TunnelInterface.
 type Entry struct {
 	Name               string
 	Interface          string
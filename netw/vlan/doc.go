@@ -1,4 +1,12 @@
 // Package vlan is the client.Network.Vlan namespace.
 //
+// A VLAN groups layer2 interfaces and subinterfaces into a single broadcast
+// domain.  The member interfaces are ethernet or aggregate ethernet
+// interfaces with a Mode of "layer2" (client.Network.EthernetInterface and
+// client.Network.AggregateInterface) or layer2 subinterfaces
+// (client.Network.Layer2Subinterface).  Routing for the VLAN is done by
+// setting VlanInterface to a "vlan.N" interface from the
+// client.Network.VlanInterface namespace.
+//
 // Normalized object:  Entry
 package vlan
@@ -0,0 +1,6 @@
+package vwire
+
+const (
+	singular = "virtual wire"
+	plural   = "virtual wires"
+)
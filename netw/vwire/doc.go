@@ -0,0 +1,8 @@
+// Package vwire is the client.Network.VirtualWire namespace.
+//
+// The subinterfaces of a virtual wire are managed with the
+// client.Network.Layer2Subinterface namespace, using an mType of
+// layer2.VirtualWire.
+//
+// Normalized object:  Entry
+package vwire
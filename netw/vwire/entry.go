@@ -0,0 +1,116 @@
+package vwire
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a virtual
+// wire.
+//
+// TagAllowed is a comma separated list of VLAN tags and tag ranges, such as
+// "0,100-200", allowed through the virtual wire.
+type Entry struct {
+	Name                 string
+	Interface1           string
+	Interface2           string
+	TagAllowed           string
+	MulticastFirewalling bool
+	LinkStatePassThrough bool
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Interface1 = s.Interface1
+	o.Interface2 = s.Interface2
+	o.TagAllowed = s.TagAllowed
+	o.MulticastFirewalling = s.MulticastFirewalling
+	o.LinkStatePassThrough = s.LinkStatePassThrough
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() []Entry
+	Names() []string
+}
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *container_v1) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:       o.Name,
+		Interface1: o.Interface1,
+		Interface2: o.Interface2,
+		TagAllowed: o.TagAllowed,
+	}
+
+	if o.Multicast != nil {
+		ans.MulticastFirewalling = util.AsBool(o.Multicast.Enable)
+	}
+
+	if o.LinkState != nil {
+		ans.LinkStatePassThrough = util.AsBool(o.LinkState.Enable)
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName    xml.Name `xml:"entry"`
+	Name       string   `xml:"name,attr"`
+	Interface1 string   `xml:"interface1,omitempty"`
+	Interface2 string   `xml:"interface2,omitempty"`
+	TagAllowed string   `xml:"tag-allowed,omitempty"`
+	Multicast  *enable  `xml:"multicast-firewalling"`
+	LinkState  *enable  `xml:"link-state-pass-through"`
+}
+
+type enable struct {
+	Enable string `xml:"enable"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:       e.Name,
+		Interface1: e.Interface1,
+		Interface2: e.Interface2,
+		TagAllowed: e.TagAllowed,
+	}
+
+	if e.MulticastFirewalling {
+		ans.Multicast = &enable{
+			Enable: util.YesNo(e.MulticastFirewalling),
+		}
+	}
+
+	if e.LinkStatePassThrough {
+		ans.LinkState = &enable{
+			Enable: util.YesNo(e.LinkStatePassThrough),
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,172 @@
+package vwire
+
+import (
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// FwVwire is the client.Network.VirtualWire namespace.
+type FwVwire struct {
+	con util.XapiClient
+	ns  *namespace.Namespace
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *FwVwire) Initialize(con util.XapiClient) {
+	c.con = con
+	c.ns = namespace.New(singular, plural, con)
+}
+
+// ShowList performs SHOW to retrieve a list of virtual wires.
+func (c *FwVwire) ShowList() ([]string, error) {
+	result, _ := c.versioning()
+	return c.ns.Listing(util.Show, c.xpath(nil), result)
+}
+
+// GetList performs GET to retrieve a list of virtual wires.
+func (c *FwVwire) GetList() ([]string, error) {
+	result, _ := c.versioning()
+	return c.ns.Listing(util.Get, c.xpath(nil), result)
+}
+
+// Get performs GET to retrieve information for the given virtual wire.
+func (c *FwVwire) Get(name string) (Entry, error) {
+	result, _ := c.versioning()
+	if err := c.ns.Object(util.Get, c.xpath([]string{name}), name, result); err != nil {
+		return Entry{}, err
+	}
+
+	return result.Normalize()[0], nil
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *FwVwire) GetAll() ([]Entry, error) {
+	result, _ := c.versioning()
+	if err := c.ns.Objects(util.Get, c.xpath(nil), result); err != nil {
+		return nil, err
+	}
+
+	return result.Normalize(), nil
+}
+
+// Show performs SHOW to retrieve information for the given virtual wire.
+func (c *FwVwire) Show(name string) (Entry, error) {
+	result, _ := c.versioning()
+	if err := c.ns.Object(util.Show, c.xpath([]string{name}), name, result); err != nil {
+		return Entry{}, err
+	}
+
+	return result.Normalize()[0], nil
+}
+
+// ShowAll performs GET to retrieve information for all objects.
+func (c *FwVwire) ShowAll() ([]Entry, error) {
+	result, _ := c.versioning()
+	if err := c.ns.Objects(util.Show, c.xpath(nil), result); err != nil {
+		return nil, err
+	}
+
+	return result.Normalize(), nil
+}
+
+// Set performs SET to create / update one or more virtual wires.
+//
+// Specify a non-empty vsys to import the virtual wire(s) into the given vsys
+// after creating, allowing the vsys to use them.
+func (c *FwVwire) Set(vsys string, e ...Entry) error {
+	var err error
+
+	if len(e) == 0 {
+		return nil
+	}
+
+	_, fn := c.versioning()
+	data := make([]interface{}, 0, len(e))
+	names := make([]string, 0, len(e))
+
+	for i := range e {
+		data = append(data, fn(e[i]))
+		names = append(names, e[i].Name)
+	}
+	path := c.xpath(names)
+
+	if err = c.ns.Set(names, path, data); err != nil {
+		return err
+	}
+
+	// Remove the virtual wires from any vsys they're currently in.
+	if err = c.con.VsysUnimport(util.VirtualWireImport, "", "", names); err != nil {
+		return err
+	}
+
+	// Perform vsys import next.
+	return c.con.VsysImport(util.VirtualWireImport, "", "", vsys, names)
+}
+
+// Edit performs EDIT to create / update a virtual wire.
+//
+// Specify a non-empty vsys to import the virtual wire into the given vsys
+// after creating, allowing the vsys to use it.
+func (c *FwVwire) Edit(vsys string, e Entry) error {
+	var err error
+
+	_, fn := c.versioning()
+	path := c.xpath([]string{e.Name})
+	data := fn(e)
+
+	if err = c.ns.Edit(e.Name, path, data); err != nil {
+		return err
+	}
+
+	// Remove the virtual wires from any vsys they're currently in.
+	if err = c.con.VsysUnimport(util.VirtualWireImport, "", "", []string{e.Name}); err != nil {
+		return err
+	}
+
+	// Perform vsys import next.
+	return c.con.VsysImport(util.VirtualWireImport, "", "", vsys, []string{e.Name})
+}
+
+// Delete removes the given virtual wire(s) from the firewall.
+//
+// virtual wires can be a string or an Entry object.
+func (c *FwVwire) Delete(e ...interface{}) error {
+	names := make([]string, 0, len(e))
+	for i := range e {
+		switch v := e[i].(type) {
+		case string:
+			names = append(names, v)
+		case Entry:
+			names = append(names, v.Name)
+		default:
+			return fmt.Errorf("Unknown type sent to delete: %s", v)
+		}
+	}
+	path := c.xpath(names)
+
+	// Unimport virtual wires.
+	if err := c.con.VsysUnimport(util.VirtualWireImport, "", "", names); err != nil {
+		return err
+	}
+
+	return c.ns.Delete(names, path)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *FwVwire) versioning() (normalizer, func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *FwVwire) xpath(vals []string) []string {
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"network",
+		"virtual-wire",
+		util.AsEntryXpath(vals),
+	}
+}
@@ -0,0 +1,188 @@
+package vwire
+
+import (
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/namespace"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PanoVwire is the client.Network.VirtualWire namespace.
+type PanoVwire struct {
+	con util.XapiClient
+	ns  *namespace.Namespace
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *PanoVwire) Initialize(con util.XapiClient) {
+	c.con = con
+	c.ns = namespace.New(singular, plural, con)
+}
+
+// ShowList performs SHOW to retrieve a list of virtual wires.
+func (c *PanoVwire) ShowList(tmpl, ts string) ([]string, error) {
+	result, _ := c.versioning()
+	return c.ns.Listing(util.Show, c.xpath(tmpl, ts, nil), result)
+}
+
+// GetList performs GET to retrieve a list of virtual wires.
+func (c *PanoVwire) GetList(tmpl, ts string) ([]string, error) {
+	result, _ := c.versioning()
+	return c.ns.Listing(util.Get, c.xpath(tmpl, ts, nil), result)
+}
+
+// Get performs GET to retrieve information for the given virtual wire.
+func (c *PanoVwire) Get(tmpl, ts, name string) (Entry, error) {
+	result, _ := c.versioning()
+	if err := c.ns.Object(util.Get, c.xpath(tmpl, ts, []string{name}), name, result); err != nil {
+		return Entry{}, err
+	}
+
+	return result.Normalize()[0], nil
+}
+
+// GetAll performs GET to retrieve information for all objects.
+func (c *PanoVwire) GetAll(tmpl, ts string) ([]Entry, error) {
+	result, _ := c.versioning()
+	if err := c.ns.Objects(util.Get, c.xpath(tmpl, ts, nil), result); err != nil {
+		return nil, err
+	}
+
+	return result.Normalize(), nil
+}
+
+// Show performs SHOW to retrieve information for the given virtual wire.
+func (c *PanoVwire) Show(tmpl, ts, name string) (Entry, error) {
+	result, _ := c.versioning()
+	if err := c.ns.Object(util.Show, c.xpath(tmpl, ts, []string{name}), name, result); err != nil {
+		return Entry{}, err
+	}
+
+	return result.Normalize()[0], nil
+}
+
+// ShowAll performs GET to retrieve information for all objects.
+func (c *PanoVwire) ShowAll(tmpl, ts string) ([]Entry, error) {
+	result, _ := c.versioning()
+	if err := c.ns.Objects(util.Show, c.xpath(tmpl, ts, nil), result); err != nil {
+		return nil, err
+	}
+
+	return result.Normalize(), nil
+}
+
+// Set performs SET to create / update one or more virtual wires.
+//
+// Specify a non-empty vsys to import the virtual wire(s) into the given vsys
+// after creating, allowing the vsys to use them.
+func (c *PanoVwire) Set(tmpl, ts, vsys string, e ...Entry) error {
+	var err error
+
+	if len(e) == 0 {
+		return nil
+	} else if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	}
+
+	_, fn := c.versioning()
+	data := make([]interface{}, 0, len(e))
+	names := make([]string, 0, len(e))
+
+	for i := range e {
+		data = append(data, fn(e[i]))
+		names = append(names, e[i].Name)
+	}
+	path := c.xpath(tmpl, ts, names)
+
+	if err = c.ns.Set(names, path, data); err != nil {
+		return err
+	}
+
+	// Remove the virtual wires from any vsys they're currently in.
+	if err = c.con.VsysUnimport(util.VirtualWireImport, tmpl, ts, names); err != nil {
+		return err
+	}
+
+	// Perform vsys import next.
+	return c.con.VsysImport(util.VirtualWireImport, tmpl, ts, vsys, names)
+}
+
+// Edit performs EDIT to create / update a virtual wire.
+//
+// Specify a non-empty vsys to import the virtual wire into the given vsys
+// after creating, allowing the vsys to use it.
+func (c *PanoVwire) Edit(tmpl, ts, vsys string, e Entry) error {
+	var err error
+
+	if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	}
+
+	_, fn := c.versioning()
+	path := c.xpath(tmpl, ts, []string{e.Name})
+	data := fn(e)
+
+	if err = c.ns.Edit(e.Name, path, data); err != nil {
+		return err
+	}
+
+	// Remove the virtual wires from any vsys they're currently in.
+	if err = c.con.VsysUnimport(util.VirtualWireImport, tmpl, ts, []string{e.Name}); err != nil {
+		return err
+	}
+
+	// Perform vsys import next.
+	return c.con.VsysImport(util.VirtualWireImport, tmpl, ts, vsys, []string{e.Name})
+}
+
+// Delete removes the given virtual wire(s) from the firewall.
+//
+// virtual wires can be a string or an Entry object.
+func (c *PanoVwire) Delete(tmpl, ts string, e ...interface{}) error {
+	if len(e) == 0 {
+		return nil
+	} else if tmpl == "" && ts == "" {
+		return fmt.Errorf("tmpl or ts must be specified")
+	}
+
+	names := make([]string, 0, len(e))
+	for i := range e {
+		switch v := e[i].(type) {
+		case string:
+			names = append(names, v)
+		case Entry:
+			names = append(names, v.Name)
+		default:
+			return fmt.Errorf("Unknown type sent to delete: %s", v)
+		}
+	}
+	path := c.xpath(tmpl, ts, names)
+
+	// Unimport virtual wires.
+	if err := c.con.VsysUnimport(util.VirtualWireImport, tmpl, ts, names); err != nil {
+		return err
+	}
+
+	return c.ns.Delete(names, path)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *PanoVwire) versioning() (normalizer, func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *PanoVwire) xpath(tmpl, ts string, vals []string) []string {
+	ans := make([]string, 0, 11)
+	ans = append(ans, util.TemplateXpathPrefix(tmpl, ts)...)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"network",
+		"virtual-wire",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
@@ -0,0 +1,30 @@
+package vwire
+
+type testCase struct {
+	desc    string
+	tmpl    string
+	vsys    string
+	imports []string
+	conf    Entry
+}
+
+func getTests() []testCase {
+	return []testCase{
+		{"empty vwire", "", "", []string{"one"}, Entry{
+			Name: "one",
+		}},
+		{"vwire with interfaces", "x", "vsys2", []string{"two"}, Entry{
+			Name:       "two",
+			Interface1: "ethernet1/1",
+			Interface2: "ethernet1/2",
+			TagAllowed: "0,100-200",
+		}},
+		{"vwire with all options", "y", "vsys3", []string{"three"}, Entry{
+			Name:                 "three",
+			Interface1:           "ethernet1/3",
+			Interface2:           "ethernet1/4",
+			MulticastFirewalling: true,
+			LinkStatePassThrough: true,
+		}},
+	}
+}
@@ -1,4 +1,9 @@
 // Package zone is the client.Network.Zone namespace.
 //
+// Interfaces and virtual routers are imported into the given vsys when they
+// are created, but zones are not importable.  On multi-vsys firewalls, use
+// SetWithImports and DeleteWithUnimports to also manage the vsys import of
+// the zone's interfaces.
+//
 // Normalized object:  Entry
 package zone
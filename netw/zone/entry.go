@@ -7,15 +7,19 @@ import (
 )
 
 // Entry is a normalized, version independent representation of a zone.
+//
+// IncludeAcls and ExcludeAcls are the User-ID include / exclude lists, and are
+// only used when EnableUserId is true.
 type Entry struct {
-	Name         string
-	Mode         string
-	Interfaces   []string // unordered
-	ZoneProfile  string
-	LogSetting   string
-	EnableUserId bool
-	IncludeAcls  []string // unordered
-	ExcludeAcls  []string // unordered
+	Name                         string
+	Mode                         string
+	Interfaces                   []string // unordered
+	ZoneProfile                  string
+	LogSetting                   string
+	EnableUserId                 bool
+	IncludeAcls                  []string // unordered
+	ExcludeAcls                  []string // unordered
+	EnablePacketBufferProtection bool     // 8.0+
 }
 
 // Copy copies the information from source Entry `s` to this object.  As the
@@ -28,6 +32,7 @@ func (o *Entry) Copy(s Entry) {
 	o.EnableUserId = s.EnableUserId
 	o.IncludeAcls = s.IncludeAcls
 	o.ExcludeAcls = s.ExcludeAcls
+	o.EnablePacketBufferProtection = s.EnablePacketBufferProtection
 }
 
 /** Structs / functions for this namespace. **/
@@ -146,3 +151,121 @@ func specify_v1(e Entry) interface{} {
 
 	return ans
 }
+
+// PAN-OS 8.0+
+type container_v2 struct {
+	Answer []entry_v2 `xml:"entry"`
+}
+
+func (o *container_v2) Normalize() []Entry {
+	ans := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].normalize())
+	}
+
+	return ans
+}
+
+func (o *container_v2) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *entry_v2) normalize() Entry {
+	ans := Entry{
+		Name:                         o.Name,
+		ZoneProfile:                  o.Profile,
+		LogSetting:                   o.LogSetting,
+		EnablePacketBufferProtection: util.AsBool(o.PacketBufferProtection),
+		EnableUserId:                 util.AsBool(o.EnableUserId),
+	}
+	if o.L3 != nil {
+		ans.Mode = ModeL3
+		ans.Interfaces = o.L3.Interfaces
+	} else if o.L2 != nil {
+		ans.Mode = ModeL2
+		ans.Interfaces = o.L2.Interfaces
+	} else if o.VWire != nil {
+		ans.Mode = ModeVirtualWire
+		ans.Interfaces = o.VWire.Interfaces
+	} else if o.Tap != nil {
+		ans.Mode = ModeTap
+		ans.Interfaces = o.Tap.Interfaces
+	} else if o.External != nil {
+		ans.Mode = ModeExternal
+		ans.Interfaces = o.External.Interfaces
+	}
+	if o.IncludeAcls != nil {
+		ans.IncludeAcls = o.IncludeAcls.Acls
+	}
+	if o.ExcludeAcls != nil {
+		ans.ExcludeAcls = o.ExcludeAcls.Acls
+	}
+
+	return ans
+}
+
+type entry_v2 struct {
+	XMLName                xml.Name           `xml:"entry"`
+	Name                   string             `xml:"name,attr"`
+	L3                     *zoneInterfaceList `xml:"network>layer3"`
+	L2                     *zoneInterfaceList `xml:"network>layer2"`
+	VWire                  *zoneInterfaceList `xml:"network>virtual-wire"`
+	Tap                    *zoneInterfaceList `xml:"network>tap"`
+	External               *zoneInterfaceList `xml:"network>external"`
+	Profile                string             `xml:"network>zone-protection-profile,omitempty"`
+	LogSetting             string             `xml:"network>log-setting,omitempty"`
+	PacketBufferProtection string             `xml:"network>enable-packet-buffer-protection"`
+	EnableUserId           string             `xml:"enable-user-identification"`
+	IncludeAcls            *aclList           `xml:"user-acl>include-list"`
+	ExcludeAcls            *aclList           `xml:"user-acl>exclude-list"`
+}
+
+func specify_v2(e Entry) interface{} {
+	ans := entry_v2{
+		Name:                   e.Name,
+		Profile:                e.ZoneProfile,
+		LogSetting:             e.LogSetting,
+		PacketBufferProtection: util.YesNo(e.EnablePacketBufferProtection),
+		EnableUserId:           util.YesNo(e.EnableUserId),
+	}
+	il := &zoneInterfaceList{e.Interfaces}
+	switch e.Mode {
+	case ModeL2:
+		ans.L2 = il
+	case ModeL3:
+		ans.L3 = il
+	case ModeVirtualWire:
+		ans.VWire = il
+	case ModeTap:
+		ans.Tap = il
+	case ModeExternal:
+		ans.External = il
+	}
+	if len(e.IncludeAcls) > 0 {
+		ans.IncludeAcls = &aclList{e.IncludeAcls}
+	}
+	if len(e.ExcludeAcls) > 0 {
+		ans.ExcludeAcls = &aclList{e.ExcludeAcls}
+	}
+
+	return ans
+}
+
+// interfaces returns the interfaces of the given zones, skipping external
+// zones, as their "interfaces" are vsys names.
+func interfaces(e []Entry) []string {
+	var ans []string
+
+	for _, o := range e {
+		if o.Mode != ModeExternal {
+			ans = append(ans, o.Interfaces...)
+		}
+	}
+
+	return ans
+}
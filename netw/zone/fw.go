@@ -5,6 +5,7 @@ import (
 
 	"github.com/PaloAltoNetworks/pango/namespace"
 	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
 )
 
 // FwZone is a namespace struct, included as part of pango.Client.
@@ -138,6 +139,28 @@ func (c *FwZone) Set(vsys string, e ...Entry) error {
 	return c.ns.Set(names, path, data)
 }
 
+// SetWithImports performs SET to create / update one or more zones, then
+// imports the zones' interfaces into the vsys.
+//
+// On multi-vsys firewalls an interface must be imported into the vsys of
+// its zone, so this saves a separate VsysImport.  Interfaces imported into
+// another vsys are moved into this one.
+func (c *FwZone) SetWithImports(vsys string, e ...Entry) error {
+	if err := c.Set(vsys, e...); err != nil {
+		return err
+	}
+
+	if vsys == "" {
+		vsys = "vsys1"
+	}
+	ifaces := interfaces(e)
+	if err := c.con.VsysUnimport(util.InterfaceImport, "", "", ifaces); err != nil {
+		return err
+	}
+
+	return c.con.VsysImport(util.InterfaceImport, "", "", vsys, ifaces)
+}
+
 // Edit performs EDIT to create / update one object.
 func (c *FwZone) Edit(vsys string, e Entry) error {
 	_, fn := c.versioning()
@@ -167,10 +190,45 @@ func (c *FwZone) Delete(vsys string, e ...interface{}) error {
 	return c.ns.Delete(names, path)
 }
 
+// DeleteWithUnimports removes the given zones, then removes the vsys import
+// of the zones' interfaces.
+//
+// Objects can be either a string or an Entry object.  Zones given as a string
+// are retrieved first to find their interfaces.
+func (c *FwZone) DeleteWithUnimports(vsys string, e ...interface{}) error {
+	list := make([]Entry, 0, len(e))
+	for i := range e {
+		switch v := e[i].(type) {
+		case string:
+			o, err := c.Get(vsys, v)
+			if err != nil {
+				return err
+			}
+			list = append(list, o)
+		case Entry:
+			list = append(list, v)
+		default:
+			return fmt.Errorf("Unsupported type to delete: %s", v)
+		}
+	}
+
+	if err := c.Delete(vsys, e...); err != nil {
+		return err
+	}
+
+	return c.con.VsysUnimport(util.InterfaceImport, "", "", interfaces(list))
+}
+
 /** Internal functions for this namespace struct **/
 
 func (c *FwZone) versioning() (normalizer, func(Entry) interface{}) {
-	return &container_v1{}, specify_v1
+	v := c.con.Versioning()
+
+	if v.Gte(version.Number{8, 0, 0, ""}) {
+		return &container_v2{}, specify_v2
+	} else {
+		return &container_v1{}, specify_v1
+	}
 }
 
 func (c *FwZone) xpath(vsys string, vals []string) []string {
@@ -17,6 +17,7 @@ func TestFwNormalization(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.desc, func(t *testing.T) {
 			mc.Reset()
+			mc.Version = tc.version
 			mc.AddResp("")
 			err := ns.Set(tc.vsys, tc.conf)
 			if err != nil {
@@ -33,3 +34,36 @@ func TestFwNormalization(t *testing.T) {
 		})
 	}
 }
+
+func TestFwSetWithImports(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &FwZone{}
+	ns.Initialize(mc)
+
+	e := Entry{
+		Name:       "trust",
+		Mode:       ModeL3,
+		Interfaces: []string{"ethernet1/1", "ethernet1/2"},
+	}
+
+	mc.AddResp("")
+	if err := ns.SetWithImports("vsys2", e); err != nil {
+		t.Fatalf("Error in set: %s", err)
+	}
+	if mc.Vsys != "vsys2" {
+		t.Errorf("Imported into %q, not vsys2", mc.Vsys)
+	}
+	if !reflect.DeepEqual(mc.Imports, e.Interfaces) || !reflect.DeepEqual(mc.Unimports, e.Interfaces) {
+		t.Errorf("Bad imports %v / unimports %v", mc.Imports, mc.Unimports)
+	}
+
+	mc.Reset()
+	mc.AddResp("")
+	ext := Entry{Name: "ext", Mode: ModeExternal, Interfaces: []string{"vsys1"}}
+	if err := ns.DeleteWithUnimports("vsys2", e, ext); err != nil {
+		t.Fatalf("Error in delete: %s", err)
+	}
+	if !reflect.DeepEqual(mc.Unimports, e.Interfaces) {
+		t.Errorf("Bad unimports: %v", mc.Unimports)
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/PaloAltoNetworks/pango/namespace"
 	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
 )
 
 // PanoZone is a namespace struct, included as part of pango.Client.
@@ -146,6 +147,28 @@ func (c *PanoZone) Set(tmpl, ts, vsys string, e ...Entry) error {
 	return c.ns.Set(names, path, data)
 }
 
+// SetWithImports performs SET to create / update one or more zones, then
+// imports the zones' interfaces into the vsys.
+//
+// On multi-vsys firewalls an interface must be imported into the vsys of
+// its zone, so this saves a separate VsysImport.  Interfaces imported into
+// another vsys are moved into this one.
+func (c *PanoZone) SetWithImports(tmpl, ts, vsys string, e ...Entry) error {
+	if err := c.Set(tmpl, ts, vsys, e...); err != nil {
+		return err
+	}
+
+	if vsys == "" {
+		vsys = "vsys1"
+	}
+	ifaces := interfaces(e)
+	if err := c.con.VsysUnimport(util.InterfaceImport, tmpl, ts, ifaces); err != nil {
+		return err
+	}
+
+	return c.con.VsysImport(util.InterfaceImport, tmpl, ts, vsys, ifaces)
+}
+
 // Edit performs EDIT to create / update one object.
 func (c *PanoZone) Edit(tmpl, ts, vsys string, e Entry) error {
 	if tmpl == "" && ts == "" {
@@ -185,10 +208,45 @@ func (c *PanoZone) Delete(tmpl, ts, vsys string, e ...interface{}) error {
 	return c.ns.Delete(names, path)
 }
 
+// DeleteWithUnimports removes the given zones, then removes the vsys import
+// of the zones' interfaces.
+//
+// Objects can be either a string or an Entry object.  Zones given as a string
+// are retrieved first to find their interfaces.
+func (c *PanoZone) DeleteWithUnimports(tmpl, ts, vsys string, e ...interface{}) error {
+	list := make([]Entry, 0, len(e))
+	for i := range e {
+		switch v := e[i].(type) {
+		case string:
+			o, err := c.Get(tmpl, ts, vsys, v)
+			if err != nil {
+				return err
+			}
+			list = append(list, o)
+		case Entry:
+			list = append(list, v)
+		default:
+			return fmt.Errorf("Unsupported type to delete: %s", v)
+		}
+	}
+
+	if err := c.Delete(tmpl, ts, vsys, e...); err != nil {
+		return err
+	}
+
+	return c.con.VsysUnimport(util.InterfaceImport, tmpl, ts, interfaces(list))
+}
+
 /** Internal functions for this namespace struct **/
 
 func (c *PanoZone) versioning() (normalizer, func(Entry) interface{}) {
-	return &container_v1{}, specify_v1
+	v := c.con.Versioning()
+
+	if v.Gte(version.Number{8, 0, 0, ""}) {
+		return &container_v2{}, specify_v2
+	} else {
+		return &container_v1{}, specify_v1
+	}
 }
 
 func (c *PanoZone) xpath(tmpl, ts, vsys string, vals []string) []string {
@@ -1,18 +1,23 @@
 package zone
 
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
 type testCase struct {
-	desc string
-	vsys string
-	conf Entry
+	desc    string
+	version version.Number
+	vsys    string
+	conf    Entry
 }
 
 func getTests() []testCase {
 	return []testCase{
-		{"empty zone", "", Entry{
+		{"v1 empty zone", version.Number{7, 1, 0, ""}, "", Entry{
 			Name: "one",
 			Mode: "layer3",
 		}},
-		{"layer3 zone", "vsys1", Entry{
+		{"v1 layer3 zone", version.Number{7, 1, 0, ""}, "vsys1", Entry{
 			Name:        "two",
 			Mode:        "layer3",
 			Interfaces:  []string{"ethernet1/1", "ethernet1/2"},
@@ -20,23 +25,39 @@ func getTests() []testCase {
 			LogSetting:  "setting1",
 			IncludeAcls: []string{"10.1.2.0/24"},
 		}},
-		{"layer2 zone", "vsys2", Entry{
+		{"v1 layer2 zone", version.Number{7, 1, 0, ""}, "vsys2", Entry{
 			Name:        "three",
 			Mode:        "layer2",
 			Interfaces:  []string{"ethernet1/3", "ethernet1/4"},
 			ExcludeAcls: []string{"10.100.1.0/24"},
 		}},
-		{"vwire zone", "vsys3", Entry{
+		{"v1 vwire zone", version.Number{7, 1, 0, ""}, "vsys3", Entry{
 			Name:        "four",
 			Mode:        "virtual-wire",
 			Interfaces:  []string{"ethernet1/5", "ethernet1/6"},
 			IncludeAcls: []string{"10.1.3.0/24"},
 		}},
-		{"tap zone", "vsys4", Entry{
+		{"v1 tap zone", version.Number{7, 1, 0, ""}, "vsys4", Entry{
 			Name:        "five",
 			Mode:        "external",
 			Interfaces:  []string{"ethernet1/7", "ethernet1/8"},
 			ExcludeAcls: []string{"10.100.2.0/24"},
 		}},
+		{"v2 layer3 zone with pbp", version.Number{8, 0, 0, ""}, "vsys1", Entry{
+			Name:                         "six",
+			Mode:                         "layer3",
+			Interfaces:                   []string{"ethernet1/1", "ethernet1/2"},
+			ZoneProfile:                  "profile1",
+			LogSetting:                   "setting1",
+			EnablePacketBufferProtection: true,
+		}},
+		{"v2 layer2 zone with user id acls", version.Number{8, 1, 0, ""}, "vsys2", Entry{
+			Name:         "seven",
+			Mode:         "layer2",
+			Interfaces:   []string{"ethernet1/3"},
+			EnableUserId: true,
+			IncludeAcls:  []string{"10.1.2.0/24", "10.1.3.0/24"},
+			ExcludeAcls:  []string{"10.1.2.5"},
+		}},
 	}
 }
@@ -0,0 +1,176 @@
+package pango
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PaloAltoNetworks/pango/cfgtree"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Object kinds, which are the location of the object relative to Shared or
+// a device group.
+const (
+	ObjectAddress              = "address"
+	ObjectAddressGroup         = "address-group"
+	ObjectService              = "service"
+	ObjectServiceGroup         = "service-group"
+	ObjectTag                  = "tag"
+	ObjectApplicationGroup     = "application-group"
+	ObjectAntivirusProfile     = "profiles/virus"
+	ObjectAntiSpywareProfile   = "profiles/spyware"
+	ObjectVulnerabilityProfile = "profiles/vulnerability"
+	ObjectUrlFilteringProfile  = "profiles/url-filtering"
+	ObjectProfileGroup         = "profile-group"
+)
+
+// ObjectReferenceError is returned when an object can't be changed because
+// of the references to it.
+type ObjectReferenceError struct {
+	Kind       string
+	Name       string
+	References []ObjectReference
+}
+
+// Error returns the error message.
+func (e ObjectReferenceError) Error() string {
+	scopes := make([]string, 0, len(e.References))
+	seen := make(map[string]bool)
+	for _, ref := range e.References {
+		if !seen[ref.Scope] {
+			seen[ref.Scope] = true
+			scopes = append(scopes, ref.Scope)
+		}
+	}
+
+	return fmt.Sprintf("%s %q has %d references that would break, in: %s", e.Kind, e.Name, len(e.References), strings.Join(scopes, ", "))
+}
+
+// MoveObject moves an object between Shared and a device group, or between
+// two device groups.
+//
+// The kind param is one of the Object* constants.  For src and dst, an empty
+// string or "shared" means Shared, and anything else is a device group.
+//
+// Before anything is changed, the candidate config is checked for references
+// to the object that would no longer resolve once it is moved, such as
+// references from a sibling device group when moving an object out of Shared.
+// If there are any, an ObjectReferenceError is returned and nothing is moved.
+// References are matched by name, and a reference from a device group that
+// has its own object of the same name is not considered broken.
+func (c *Panorama) MoveObject(kind, name, src, dst string) error {
+	src, dst = objectScope(src), objectScope(dst)
+	if src == dst {
+		return fmt.Errorf("Can't move %s %q onto itself", kind, name)
+	}
+
+	cfg, err := c.CandidateConfig()
+	if err != nil {
+		return err
+	}
+
+	srcNode := scopeNode(cfg, src)
+	if srcNode == nil {
+		return fmt.Errorf("Device group %q does not exist", src)
+	}
+	obj := srcNode.Find(objectXpath(kind, name))
+	if obj == nil {
+		return fmt.Errorf("%s %q does not exist in %s", kind, name, src)
+	}
+
+	dstNode := scopeNode(cfg, dst)
+	if dstNode == nil {
+		return fmt.Errorf("Device group %q does not exist", dst)
+	} else if dstNode.Find(objectXpath(kind, name)) != nil {
+		return fmt.Errorf("%s %q already exists in %s", kind, name, dst)
+	}
+
+	hier, err := c.DeviceGroupHierarchy()
+	if err != nil {
+		return err
+	}
+
+	var broken []ObjectReference
+	for _, ref := range FindReferences(cfg, kind, name) {
+		if resolvesTo(cfg, hier, kind, name, src, ref) && !visibleFrom(hier, dst, ref.Scope) {
+			broken = append(broken, ref)
+		}
+	}
+	if len(broken) > 0 {
+		return ObjectReferenceError{Kind: kind, Name: name, References: broken}
+	}
+
+	c.LogAction("(move) %s %q from %s to %s", kind, name, src, dst)
+
+	if err = c.SetXpath(scopeXpath(dst)+"/"+kind, obj); err != nil {
+		return err
+	}
+
+	if err = c.DeleteXpath(scopeXpath(src) + "/" + objectXpath(kind, name)); err != nil {
+		return fmt.Errorf("%s %q copied to %s, but not removed from %s: %s", kind, name, dst, src, err)
+	}
+
+	return nil
+}
+
+/** Internal functions **/
+
+func objectScope(scope string) string {
+	if scope == "" {
+		return "shared"
+	}
+
+	return scope
+}
+
+func objectXpath(kind, name string) string {
+	return kind + "/" + util.AsEntryXpath([]string{name})
+}
+
+func scopeXpath(scope string) string {
+	if scope == "shared" {
+		return "/config/shared"
+	}
+
+	node := "device-group"
+	if i := strings.Index(scope, ":"); i != -1 {
+		node, scope = scope[:i], scope[i+1:]
+	}
+
+	return "/config/devices/" + util.AsEntryXpath([]string{"localhost.localdomain"}) + "/" + node + "/" + util.AsEntryXpath([]string{scope})
+}
+
+func scopeNode(cfg *cfgtree.Node, scope string) *cfgtree.Node {
+	return cfg.Find(scopeXpath(scope))
+}
+
+// objectScopes returns "shared" followed by all device groups in the config.
+func objectScopes(cfg *cfgtree.Node) []string {
+	ans := []string{"shared"}
+
+	for _, n := range cfg.FindAll("/config/devices/entry[@name='localhost.localdomain']/device-group/entry") {
+		ans = append(ans, n.Attr("name"))
+	}
+
+	return ans
+}
+
+// visibleFrom returns if an object in scope obj is usable from scope ref.
+//
+// Templates and template stacks only have access to Shared.
+func visibleFrom(hier map[string]string, obj, ref string) bool {
+	if obj == "shared" {
+		return true
+	}
+
+	seen := make(map[string]bool)
+	for ref != "" && ref != "shared" && !seen[ref] {
+		if ref == obj {
+			return true
+		}
+		seen[ref] = true
+		ref = hier[ref]
+	}
+
+	return false
+}
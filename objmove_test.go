@@ -0,0 +1,79 @@
+package pango
+
+import (
+	"strings"
+	"testing"
+)
+
+const moveConfig = `<response status="success"><result><config>
+<shared><address><entry name="web"><ip-netmask>10.1.1.1</ip-netmask></entry></address></shared>
+<devices><entry name="localhost.localdomain"><device-group>
+<entry name="parent"><address-group><entry name="grp"><static><member>web</member></static></entry></address-group></entry>
+<entry name="child"><pre-rulebase><security><rules><entry name="r1"><destination><member>web</member></destination></entry></rules></security></pre-rulebase></entry>
+<entry name="other"><address><entry name="web"><fqdn>example.com</fqdn></entry></address><post-rulebase><security><rules><entry name="r2"><source><member>web</member></source></entry></rules></security></post-rulebase></entry>
+</device-group></entry></devices>
+</config></result></response>`
+
+const moveHierarchy = `<response status="success"><result><dg-hierarchy>
+<dg name="parent"><dg name="child"/></dg><dg name="other"/>
+</dg-hierarchy></result></response>`
+
+func TestMoveObject(t *testing.T) {
+	pano := &Panorama{Client: Client{
+		rb: [][]byte{
+			[]byte(moveConfig),
+			[]byte(moveHierarchy),
+			[]byte(`<response status="success" code="20"><msg>command succeeded</msg></response>`),
+			[]byte(`<response status="success" code="20"><msg>command succeeded</msg></response>`),
+		},
+	}}
+	if err := pano.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	if err := pano.MoveObject(ObjectAddress, "web", "", "parent"); err != nil {
+		t.Fatalf("MoveObject failed: %s", err)
+	}
+
+	if len(pano.rp) != 4 {
+		t.Fatalf("Expected 4 requests, got %d", len(pano.rp))
+	}
+	set := pano.rp[2]
+	if set.Get("action") != "set" || set.Get("xpath") != "/config/devices/entry[@name='localhost.localdomain']/device-group/entry[@name='parent']/address" {
+		t.Errorf("Bad set: %v", set)
+	}
+	if !strings.Contains(set.Get("element"), "<ip-netmask>10.1.1.1</ip-netmask>") {
+		t.Errorf("Bad element: %s", set.Get("element"))
+	}
+	del := pano.rp[3]
+	if del.Get("action") != "delete" || del.Get("xpath") != "/config/shared/address/entry[@name='web']" {
+		t.Errorf("Bad delete: %v", del)
+	}
+}
+
+func TestMoveObjectBreaksReferences(t *testing.T) {
+	pano := &Panorama{Client: Client{
+		rb: [][]byte{
+			[]byte(moveConfig),
+			[]byte(moveHierarchy),
+		},
+	}}
+	if err := pano.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	err := pano.MoveObject(ObjectAddress, "web", "shared", "child")
+	e2, ok := err.(ObjectReferenceError)
+	if !ok {
+		t.Fatalf("Expected ObjectReferenceError, got %#v", err)
+	}
+	if len(e2.References) != 1 || e2.References[0].Scope != "parent" {
+		t.Errorf("Bad references: %#v", e2.References)
+	}
+	if !strings.HasSuffix(e2.References[0].Path, "/address-group/entry[@name='grp']/static/member[text()='web']") {
+		t.Errorf("Bad reference path: %s", e2.References[0].Path)
+	}
+	if len(pano.rp) != 2 {
+		t.Errorf("Expected no changes, but %d requests were sent", len(pano.rp))
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/xml"
 
 	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
 )
 
 // Entry is a normalized, version independent representation of an address
@@ -25,6 +26,15 @@ func (o *Entry) Copy(s Entry) {
 	o.Tags = s.Tags
 }
 
+// Audit returns an error if this object uses fields that the given PAN-OS
+// version does not support.
+func (o *Entry) Audit(v version.Number) error {
+	a := util.NewAuditor(o.Name, v)
+	a.Since("Type="+IpWildcard, o.Type == IpWildcard, version.Number{9, 0, 0, ""})
+
+	return a.Err()
+}
+
 /** Structs / functions for normalization. **/
 
 type normalizer interface {
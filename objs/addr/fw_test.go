@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/PaloAltoNetworks/pango/testdata"
+	"github.com/PaloAltoNetworks/pango/util"
 	"github.com/PaloAltoNetworks/pango/version"
 )
 
@@ -87,3 +88,24 @@ func TestFwNormalization(t *testing.T) {
 		})
 	}
 }
+
+func TestFwAuditUnsupportedType(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.Version = version.Number{8, 1, 0, ""}
+
+	ns := &FwAddr{}
+	ns.Initialize(mc)
+
+	err := ns.Set("", Entry{
+		Name:  "wild",
+		Value: "10.20.1.0/0.0.248.255",
+		Type:  IpWildcard,
+	})
+	if err == nil {
+		t.Fatalf("Expected an audit error")
+	} else if _, ok := err.(util.AuditError); !ok {
+		t.Errorf("Expected util.AuditError, got %T: %s", err, err)
+	} else if mc.Function != "" {
+		t.Errorf("API call made despite failed audit: %s", mc.Function)
+	}
+}
@@ -138,26 +138,12 @@ func (c *FwAddrGrp) details(fn util.Retriever, vsys, name string) (Entry, error)
 }
 
 func (c *FwAddrGrp) xpath(vsys string, vals []string) []string {
-	if vsys == "" {
-		vsys = "vsys1"
-	}
-
-	if vsys == "shared" {
-		return []string{
-			"config",
-			"shared",
-			"address-group",
-			util.AsEntryXpath(vals),
-		}
-	}
-
-	return []string{
-		"config",
-		"devices",
-		util.AsEntryXpath([]string{"localhost.localdomain"}),
-		"vsys",
-		util.AsEntryXpath([]string{vsys}),
+	ans := make([]string, 0, 7)
+	ans = append(ans, util.VsysXpathPrefix(vsys)...)
+	ans = append(ans,
 		"address-group",
 		util.AsEntryXpath(vals),
-	}
+	)
+
+	return ans
 }
@@ -0,0 +1,6 @@
+package override
+
+const (
+	singular = "application override"
+	plural   = "application overrides"
+)
@@ -0,0 +1,11 @@
+/*
+Package override is the client.Objects.ApplicationOverride namespace.
+
+This namespace manages overrides of the content-based characteristics of
+predefined applications, such as the risk and the session timeouts.  On
+Panorama, overrides can be defined per device group, and the effective
+override for a device group follows the device group hierarchy.
+
+Normalized object:  Entry
+*/
+package override
@@ -0,0 +1,80 @@
+package override
+
+import (
+	"encoding/xml"
+)
+
+// Entry is a normalized, version independent representation of an override
+// of a predefined application's characteristics.
+//
+// The Name is the name of the predefined application being overridden.  Zero
+// valued fields are not overridden.
+type Entry struct {
+	Name                 string
+	Risk                 int
+	Timeout              int
+	TcpTimeout           int
+	UdpTimeout           int
+	TcpHalfClosedTimeout int
+	TcpTimeWaitTimeout   int
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Risk = s.Risk
+	o.Timeout = s.Timeout
+	o.TcpTimeout = s.TcpTimeout
+	o.UdpTimeout = s.UdpTimeout
+	o.TcpHalfClosedTimeout = s.TcpHalfClosedTimeout
+	o.TcpTimeWaitTimeout = s.TcpTimeWaitTimeout
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:                 o.Answer.Name,
+		Risk:                 o.Answer.Risk,
+		Timeout:              o.Answer.Timeout,
+		TcpTimeout:           o.Answer.TcpTimeout,
+		UdpTimeout:           o.Answer.UdpTimeout,
+		TcpHalfClosedTimeout: o.Answer.TcpHalfClosedTimeout,
+		TcpTimeWaitTimeout:   o.Answer.TcpTimeWaitTimeout,
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName              xml.Name `xml:"entry"`
+	Name                 string   `xml:"name,attr"`
+	Risk                 int      `xml:"risk,omitempty"`
+	Timeout              int      `xml:"timeout,omitempty"`
+	TcpTimeout           int      `xml:"tcp-timeout,omitempty"`
+	UdpTimeout           int      `xml:"udp-timeout,omitempty"`
+	TcpHalfClosedTimeout int      `xml:"tcp-half-closed-timeout,omitempty"`
+	TcpTimeWaitTimeout   int      `xml:"tcp-time-wait-timeout,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:                 e.Name,
+		Risk:                 e.Risk,
+		Timeout:              e.Timeout,
+		TcpTimeout:           e.TcpTimeout,
+		UdpTimeout:           e.UdpTimeout,
+		TcpHalfClosedTimeout: e.TcpHalfClosedTimeout,
+		TcpTimeWaitTimeout:   e.TcpTimeWaitTimeout,
+	}
+
+	return ans
+}
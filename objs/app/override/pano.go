@@ -0,0 +1,188 @@
+package override
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PanoOverride is the client.Objects.ApplicationOverride namespace.
+type PanoOverride struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *PanoOverride) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *PanoOverride) ShowList(dg string) ([]string, error) {
+	c.con.LogQuery("(show) list of %s", plural)
+	path := c.xpath(dg, nil)
+	return c.con.EntryListUsing(c.con.Show, path[:len(path)-1])
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *PanoOverride) GetList(dg string) ([]string, error) {
+	c.con.LogQuery("(get) list of %s", plural)
+	path := c.xpath(dg, nil)
+	return c.con.EntryListUsing(c.con.Get, path[:len(path)-1])
+}
+
+// Get performs GET to retrieve information for the given uid.
+func (c *PanoOverride) Get(dg, name string) (Entry, error) {
+	c.con.LogQuery("(get) %s %q", singular, name)
+	return c.details(c.con.Get, dg, name)
+}
+
+// Show performs SHOW to retrieve information for the given uid.
+func (c *PanoOverride) Show(dg, name string) (Entry, error) {
+	c.con.LogQuery("(show) %s %q", singular, name)
+	return c.details(c.con.Show, dg, name)
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *PanoOverride) Set(dg string, e ...Entry) error {
+	var err error
+
+	if len(e) == 0 {
+		return nil
+	}
+
+	_, fn := c.versioning()
+	names := make([]string, len(e))
+
+	// Build up the struct.
+	d := util.BulkElement{XMLName: xml.Name{Local: "temp"}}
+	for i := range e {
+		d.Data = append(d.Data, fn(e[i]))
+		names[i] = e[i].Name
+	}
+	c.con.LogAction("(set) %s: %v", plural, names)
+
+	// Set xpath.
+	path := c.xpath(dg, names)
+	d.XMLName = xml.Name{Local: path[len(path)-2]}
+	if len(e) == 1 {
+		path = path[:len(path)-1]
+	} else {
+		path = path[:len(path)-2]
+	}
+
+	// Create the objects.
+	_, err = c.con.Set(path, d.Config(), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *PanoOverride) Edit(dg string, e Entry) error {
+	var err error
+
+	_, fn := c.versioning()
+
+	c.con.LogAction("(edit) %s %q", singular, e.Name)
+
+	// Set xpath.
+	path := c.xpath(dg, []string{e.Name})
+
+	// Edit the object.
+	_, err = c.con.Edit(path, fn(e), nil, nil)
+	return err
+}
+
+// Delete removes the given objects.
+//
+// Objects can be a string or an Entry object.
+func (c *PanoOverride) Delete(dg string, e ...interface{}) error {
+	var err error
+
+	if len(e) == 0 {
+		return nil
+	}
+
+	names := make([]string, len(e))
+	for i := range e {
+		switch v := e[i].(type) {
+		case string:
+			names[i] = v
+		case Entry:
+			names[i] = v.Name
+		default:
+			return fmt.Errorf("Unknown type sent to delete: %s", v)
+		}
+	}
+	c.con.LogAction("(delete) %s: %v", plural, names)
+
+	// Remove the objects.
+	path := c.xpath(dg, names)
+	_, err = c.con.Delete(path, nil, nil)
+	return err
+}
+
+// Effective returns the override for the given application that is in effect
+// for device group dg, along with the device group it was defined in.
+//
+// The hierarchy param is a map of device group to parent device group, as
+// returned by the Panorama client's DeviceGroupHierarchy().  The device group
+// dg is checked first, then each of its ancestors, and finally shared.  If no
+// override is found, then the returned location is an empty string.
+func (c *PanoOverride) Effective(hierarchy map[string]string, dg, name string) (Entry, string, error) {
+	seen := make(map[string]bool)
+
+	for dg != "" {
+		if seen[dg] {
+			return Entry{}, "", fmt.Errorf("Loop in device group hierarchy at %q", dg)
+		}
+		seen[dg] = true
+
+		e, err := c.Get(dg, name)
+		if err != nil {
+			return Entry{}, "", err
+		} else if e.Name != "" {
+			return e, dg, nil
+		}
+		dg = hierarchy[dg]
+	}
+
+	e, err := c.Get("shared", name)
+	if err != nil || e.Name == "" {
+		return Entry{}, "", err
+	}
+
+	return e, "shared", nil
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *PanoOverride) versioning() (normalizer, func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *PanoOverride) details(fn util.Retriever, dg, name string) (Entry, error) {
+	path := c.xpath(dg, []string{name})
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Entry{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *PanoOverride) xpath(dg string, vals []string) []string {
+	if dg == "" {
+		dg = "shared"
+	}
+
+	ans := make([]string, 0, 8)
+	ans = append(ans, util.DeviceGroupXpathPrefix(dg)...)
+	ans = append(ans,
+		"override",
+		"application",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
@@ -0,0 +1,71 @@
+package override
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestPanoNormalization(t *testing.T) {
+	testCases := getTests()
+
+	mc := &testdata.MockClient{}
+	ns := &PanoOverride{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("")
+			err := ns.Set("my device group", tc.conf)
+			if err != nil {
+				t.Errorf("Error in set: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get("my device group", tc.conf.Name)
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				}
+				if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
+
+func TestPanoEffective(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &PanoOverride{}
+	ns.Initialize(mc)
+
+	hier := map[string]string{"child": "parent", "parent": ""}
+
+	mc.AddResp("")
+	mc.AddResp(`<entry name="ssl"><risk>5</risk></entry>`)
+	e, loc, err := ns.Effective(hier, "child", "ssl")
+	if err != nil {
+		t.Fatalf("Error in effective: %s", err)
+	}
+	if loc != "parent" || e.Risk != 5 {
+		t.Errorf("Bad effective override from %q: %#v", loc, e)
+	}
+	if mc.Path != "/config/devices/entry[@name='localhost.localdomain']/device-group/entry[@name='parent']/override/application/entry[@name='ssl']" {
+		t.Errorf("Bad path: %s", mc.Path)
+	}
+
+	mc = &testdata.MockClient{}
+	ns.Initialize(mc)
+	mc.AddResp("")
+	e, loc, err = ns.Effective(hier, "child", "ssl")
+	if err != nil {
+		t.Fatalf("Error in effective: %s", err)
+	}
+	if loc != "" || e.Name != "" {
+		t.Errorf("Expected no override, got %q: %#v", loc, e)
+	}
+	if mc.Path != "/config/shared/override/application/entry[@name='ssl']" {
+		t.Errorf("Bad shared path: %s", mc.Path)
+	}
+}
@@ -0,0 +1,23 @@
+package override
+
+type tc struct {
+	desc string
+	conf Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"risk only", Entry{
+			Name: "web-browsing",
+			Risk: 4,
+		}},
+		{"timeouts", Entry{
+			Name:                 "ssl",
+			Timeout:              3600,
+			TcpTimeout:           3601,
+			UdpTimeout:           30,
+			TcpHalfClosedTimeout: 120,
+			TcpTimeWaitTimeout:   15,
+		}},
+	}
+}
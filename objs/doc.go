@@ -1,4 +1,8 @@
 /*
 Package objs is the client.Objects namespace.
+
+Firewall namespaces take the vsys as their first param.  An empty vsys is
+usually vsys1, while "shared" is the shared location, which is where objects
+visible to every vsys of a multi-vsys firewall live.
 */
 package objs
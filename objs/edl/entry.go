@@ -4,15 +4,18 @@ import (
 	"encoding/xml"
 
 	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
 )
 
 // Constants for Entry.Type field.  Only TypeIp is valid for PAN-OS 7.0 and
-// earlier.  TypePredefined is valid for PAN-OS 8.0 and later.
+// earlier.  TypePredefined (a predefined IP list) is valid for PAN-OS 8.0 and
+// later, and TypePredefinedUrl is valid for PAN-OS 9.0 and later.
 const (
-	TypeIp         string = "ip"
-	TypeDomain     string = "domain"
-	TypeUrl        string = "url"
-	TypePredefined string = "predefined"
+	TypeIp            string = "ip"
+	TypeDomain        string = "domain"
+	TypeUrl           string = "url"
+	TypePredefined    string = "predefined"
+	TypePredefinedUrl string = "predefined-url"
 )
 
 // Constants for the Repeat field.  Option "RepeatEveryFiveMinutes" is valid
@@ -27,6 +30,8 @@ const (
 
 // Entry is a normalized, version independent representation of an
 // external dynamic list.
+//
+// The ExpandDomain field is only used when Type is TypeDomain.
 type Entry struct {
 	Name               string
 	Type               string
@@ -40,6 +45,7 @@ type Entry struct {
 	RepeatDayOfWeek    string
 	RepeatDayOfMonth   int
 	Exceptions         []string // ordered
+	ExpandDomain       bool     // 9.0+
 }
 
 // Copy copies the information from source Entry `s` to this object.  As the
@@ -56,6 +62,25 @@ func (o *Entry) Copy(s Entry) {
 	o.RepeatDayOfWeek = s.RepeatDayOfWeek
 	o.RepeatDayOfMonth = s.RepeatDayOfMonth
 	o.Exceptions = s.Exceptions
+	o.ExpandDomain = s.ExpandDomain
+}
+
+// Audit returns an error if this object uses fields that the given PAN-OS
+// version does not support.
+func (o *Entry) Audit(v version.Number) error {
+	v80 := version.Number{8, 0, 0, ""}
+	v90 := version.Number{9, 0, 0, ""}
+
+	a := util.NewAuditor(o.Name, v)
+	a.Since("Type="+TypePredefined, o.Type == TypePredefined, v80)
+	a.Since("CertificateProfile", o.CertificateProfile != "", v80)
+	a.Since("Username", o.Username != "", v80)
+	a.Since("Password", o.Password != "", v80)
+	a.Since("Exceptions", len(o.Exceptions) != 0, v80)
+	a.Since("Type="+TypePredefinedUrl, o.Type == TypePredefinedUrl, v90)
+	a.Since("ExpandDomain", o.ExpandDomain, v90)
+
+	return a.Err()
 }
 
 /** Structs / functions for normalization. **/
@@ -113,6 +138,11 @@ func (o *container_v2) Normalize() Entry {
 		ans.Description = o.Answer.PredefinedIp.Description
 		ans.Source = o.Answer.PredefinedIp.Source
 		ans.Exceptions = util.MemToStr(o.Answer.PredefinedIp.Exceptions)
+	} else if o.Answer.PredefinedUrl != nil {
+		ans.Type = TypePredefinedUrl
+		ans.Description = o.Answer.PredefinedUrl.Description
+		ans.Source = o.Answer.PredefinedUrl.Source
+		ans.Exceptions = util.MemToStr(o.Answer.PredefinedUrl.Exceptions)
 	} else if o.Answer.Ip != nil {
 		ans.Type = TypeIp
 		sp = o.Answer.Ip
@@ -129,6 +159,7 @@ func (o *container_v2) Normalize() Entry {
 		ans.Source = sp.Source
 		ans.CertificateProfile = sp.CertificateProfile
 		ans.Exceptions = util.MemToStr(sp.Exceptions)
+		ans.ExpandDomain = util.AsBool(sp.ExpandDomain)
 		if sp.Auth != nil {
 			ans.Username = sp.Auth.Username
 			ans.Password = sp.Auth.Password
@@ -190,12 +221,13 @@ type timeMonth struct {
 }
 
 type entry_v2 struct {
-	XMLName      xml.Name        `xml:"entry"`
-	Name         string          `xml:"name,attr"`
-	PredefinedIp *typePredefined `xml:"type>predefined-ip"`
-	Ip           *typeSpec       `xml:"type>ip"`
-	Domain       *typeSpec       `xml:"type>domain"`
-	Url          *typeSpec       `xml:"type>url"`
+	XMLName       xml.Name        `xml:"entry"`
+	Name          string          `xml:"name,attr"`
+	PredefinedIp  *typePredefined `xml:"type>predefined-ip"`
+	PredefinedUrl *typePredefined `xml:"type>predefined-url"`
+	Ip            *typeSpec       `xml:"type>ip"`
+	Domain        *typeSpec       `xml:"type>domain"`
+	Url           *typeSpec       `xml:"type>url"`
 }
 
 type typePredefined struct {
@@ -211,6 +243,7 @@ type typeSpec struct {
 	Auth               *authType        `xml:"auth"`
 	Repeat             rep_v2           `xml:"recurring"`
 	Exceptions         *util.MemberType `xml:"exception-list"`
+	ExpandDomain       string           `xml:"expand-domain,omitempty"`
 }
 
 type authType struct {
@@ -263,6 +296,12 @@ func specify_v2(e Entry) interface{} {
 			Source:      e.Source,
 			Exceptions:  util.StrToMem(e.Exceptions),
 		}
+	case TypePredefinedUrl:
+		ans.PredefinedUrl = &typePredefined{
+			Description: e.Description,
+			Source:      e.Source,
+			Exceptions:  util.StrToMem(e.Exceptions),
+		}
 	default:
 		spec := &typeSpec{
 			Description:        e.Description,
@@ -293,6 +332,9 @@ func specify_v2(e Entry) interface{} {
 		case TypeIp:
 			ans.Ip = spec
 		case TypeDomain:
+			if e.ExpandDomain {
+				spec.ExpandDomain = util.YesNo(e.ExpandDomain)
+			}
 			ans.Domain = spec
 		case TypeUrl:
 			ans.Url = spec
@@ -52,6 +52,12 @@ func (c *FwEdl) Set(vsys string, e ...Entry) error {
 		return nil
 	}
 
+	for i := range e {
+		if err = e[i].Audit(c.con.Versioning()); err != nil {
+			return err
+		}
+	}
+
 	_, fn := c.versioning()
 	names := make([]string, len(e))
 
@@ -80,6 +86,10 @@ func (c *FwEdl) Set(vsys string, e ...Entry) error {
 func (c *FwEdl) Edit(vsys string, e Entry) error {
 	var err error
 
+	if err = e.Audit(c.con.Versioning()); err != nil {
+		return err
+	}
+
 	_, fn := c.versioning()
 
 	c.con.LogAction("(edit) EDL %q", e.Name)
@@ -145,26 +155,12 @@ func (c *FwEdl) details(fn util.Retriever, vsys, name string) (Entry, error) {
 }
 
 func (c *FwEdl) xpath(vsys string, vals []string) []string {
-	if vsys == "" {
-		vsys = "vsys1"
-	}
-
-	if vsys == "shared" {
-		return []string{
-			"config",
-			"shared",
-			"external-list",
-			util.AsEntryXpath(vals),
-		}
-	}
-
-	return []string{
-		"config",
-		"devices",
-		util.AsEntryXpath([]string{"localhost.localdomain"}),
-		"vsys",
-		util.AsEntryXpath([]string{vsys}),
+	ans := make([]string, 0, 7)
+	ans = append(ans, util.VsysXpathPrefix(vsys)...)
+	ans = append(ans,
 		"external-list",
 		util.AsEntryXpath(vals),
-	}
+	)
+
+	return ans
 }
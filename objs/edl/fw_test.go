@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/PaloAltoNetworks/pango/testdata"
+	"github.com/PaloAltoNetworks/pango/util"
 	"github.com/PaloAltoNetworks/pango/version"
 )
 
@@ -104,6 +105,28 @@ func TestFwNormalization(t *testing.T) {
 			RepeatAt:         "also invalid",
 			RepeatDayOfMonth: 7,
 		}},
+		{"v2 predefined ip", version.Number{8, 0, 0, ""}, "", Entry{
+			Name:        "six",
+			Type:        TypePredefined,
+			Description: "sixth",
+			Source:      "panw-bulletproof-ip-list",
+			Exceptions:  []string{"10.6.6.6"},
+		}},
+		{"v2 predefined url", version.Number{9, 0, 0, ""}, "", Entry{
+			Name:        "seven",
+			Type:        TypePredefinedUrl,
+			Description: "seventh",
+			Source:      "panw-auth-portal-exclude-list",
+			Exceptions:  []string{"login.example.com"},
+		}},
+		{"v2 domain expand", version.Number{9, 0, 0, ""}, "", Entry{
+			Name:         "eight",
+			Type:         TypeDomain,
+			Description:  "eighth",
+			Source:       "http://8.8.8.8",
+			Repeat:       RepeatHourly,
+			ExpandDomain: true,
+		}},
 	}
 
 	mc := &testdata.MockClient{}
@@ -130,3 +153,46 @@ func TestFwNormalization(t *testing.T) {
 		})
 	}
 }
+
+func TestFwAuditPredefinedUrl(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.Version = version.Number{8, 1, 0, ""}
+
+	ns := &FwEdl{}
+	ns.Initialize(mc)
+
+	err := ns.Set("", Entry{
+		Name:   "seven",
+		Type:   TypePredefinedUrl,
+		Source: "panw-auth-portal-exclude-list",
+	})
+	if err == nil {
+		t.Fatalf("Expected an audit error")
+	} else if _, ok := err.(util.AuditError); !ok {
+		t.Errorf("Expected util.AuditError, got %T: %s", err, err)
+	} else if mc.Function != "" {
+		t.Errorf("API call made despite failed audit: %s", mc.Function)
+	}
+}
+
+func TestFwAuditExpandDomain(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.Version = version.Number{8, 1, 0, ""}
+
+	ns := &FwEdl{}
+	ns.Initialize(mc)
+
+	err := ns.Set("", Entry{
+		Name:         "eight",
+		Type:         TypeDomain,
+		Source:       "http://8.8.8.8",
+		ExpandDomain: true,
+	})
+	if err == nil {
+		t.Fatalf("Expected an audit error")
+	} else if _, ok := err.(util.AuditError); !ok {
+		t.Errorf("Expected util.AuditError, got %T: %s", err, err)
+	} else if mc.Function != "" {
+		t.Errorf("API call made despite failed audit: %s", mc.Function)
+	}
+}
@@ -52,6 +52,12 @@ func (c *PanoEdl) Set(dg string, e ...Entry) error {
 		return nil
 	}
 
+	for i := range e {
+		if err = e[i].Audit(c.con.Versioning()); err != nil {
+			return err
+		}
+	}
+
 	_, fn := c.versioning()
 	names := make([]string, len(e))
 
@@ -80,6 +86,10 @@ func (c *PanoEdl) Set(dg string, e ...Entry) error {
 func (c *PanoEdl) Edit(dg string, e Entry) error {
 	var err error
 
+	if err = e.Audit(c.con.Versioning()); err != nil {
+		return err
+	}
+
 	_, fn := c.versioning()
 
 	c.con.LogAction("(edit) EDL %q", e.Name)
@@ -7,13 +7,19 @@ import (
 	"github.com/PaloAltoNetworks/pango/objs/addrgrp"
 	"github.com/PaloAltoNetworks/pango/objs/app"
 	appgrp "github.com/PaloAltoNetworks/pango/objs/app/group"
+	appover "github.com/PaloAltoNetworks/pango/objs/app/override"
 	"github.com/PaloAltoNetworks/pango/objs/app/signature"
 	"github.com/PaloAltoNetworks/pango/objs/app/signature/andcond"
 	"github.com/PaloAltoNetworks/pango/objs/app/signature/orcond"
 	"github.com/PaloAltoNetworks/pango/objs/edl"
+	"github.com/PaloAltoNetworks/pango/objs/profile/decryptfwd"
 	"github.com/PaloAltoNetworks/pango/objs/profile/logfwd"
 	"github.com/PaloAltoNetworks/pango/objs/profile/logfwd/matchlist"
 	"github.com/PaloAltoNetworks/pango/objs/profile/logfwd/matchlist/action"
+	"github.com/PaloAltoNetworks/pango/objs/profile/security/spyware"
+	"github.com/PaloAltoNetworks/pango/objs/profile/security/urlfilter"
+	"github.com/PaloAltoNetworks/pango/objs/profile/security/virus"
+	"github.com/PaloAltoNetworks/pango/objs/profile/security/vulnerability"
 	"github.com/PaloAltoNetworks/pango/objs/srvc"
 	"github.com/PaloAltoNetworks/pango/objs/srvcgrp"
 	"github.com/PaloAltoNetworks/pango/objs/tags"
@@ -23,11 +29,15 @@ import (
 type FwObjs struct {
 	Address                             *addr.FwAddr
 	AddressGroup                        *addrgrp.FwAddrGrp
+	AntiSpywareProfile                  *spyware.FwSpyware
+	AntivirusProfile                    *virus.FwVirus
 	Application                         *app.FwApp
+	ApplicationOverride                 *appover.FwOverride
 	AppGroup                            *appgrp.FwGroup
 	AppSignature                        *signature.FwSignature
 	AppSigAndCond                       *andcond.FwAndCond
 	AppSigOrCond                        *orcond.FwOrCond
+	DecryptionForwardingProfile         *decryptfwd.FwDecryptFwd
 	Edl                                 *edl.FwEdl
 	LogForwardingProfile                *logfwd.FwLogFwd
 	LogForwardingProfileMatchList       *matchlist.FwMatchList
@@ -35,6 +45,8 @@ type FwObjs struct {
 	Services                            *srvc.FwSrvc
 	ServiceGroup                        *srvcgrp.FwSrvcGrp
 	Tags                                *tags.FwTags
+	UrlFilteringProfile                 *urlfilter.FwUrlFilter
+	VulnerabilityProfile                *vulnerability.FwVulnerability
 }
 
 // Initialize is invoked on client.Initialize().
@@ -45,9 +57,18 @@ func (c *FwObjs) Initialize(i util.XapiClient) {
 	c.AddressGroup = &addrgrp.FwAddrGrp{}
 	c.AddressGroup.Initialize(i)
 
+	c.AntiSpywareProfile = &spyware.FwSpyware{}
+	c.AntiSpywareProfile.Initialize(i)
+
+	c.AntivirusProfile = &virus.FwVirus{}
+	c.AntivirusProfile.Initialize(i)
+
 	c.Application = &app.FwApp{}
 	c.Application.Initialize(i)
 
+	c.ApplicationOverride = &appover.FwOverride{}
+	c.ApplicationOverride.Initialize(i)
+
 	c.AppGroup = &appgrp.FwGroup{}
 	c.AppGroup.Initialize(i)
 
@@ -60,6 +81,9 @@ func (c *FwObjs) Initialize(i util.XapiClient) {
 	c.AppSigOrCond = &orcond.FwOrCond{}
 	c.AppSigOrCond.Initialize(i)
 
+	c.DecryptionForwardingProfile = &decryptfwd.FwDecryptFwd{}
+	c.DecryptionForwardingProfile.Initialize(i)
+
 	c.Edl = &edl.FwEdl{}
 	c.Edl.Initialize(i)
 
@@ -80,4 +104,10 @@ func (c *FwObjs) Initialize(i util.XapiClient) {
 
 	c.Tags = &tags.FwTags{}
 	c.Tags.Initialize(i)
+
+	c.UrlFilteringProfile = &urlfilter.FwUrlFilter{}
+	c.UrlFilteringProfile.Initialize(i)
+
+	c.VulnerabilityProfile = &vulnerability.FwVulnerability{}
+	c.VulnerabilityProfile.Initialize(i)
 }
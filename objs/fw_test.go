@@ -0,0 +1,62 @@
+package objs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestFwSharedXpath(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &FwObjs{}
+	ns.Initialize(mc)
+
+	testCases := []struct {
+		desc string
+		fn   func(string) ([]string, error)
+		path string
+	}{
+		{"address", ns.Address.GetList, "/config/shared/address"},
+		{"address group", ns.AddressGroup.GetList, "/config/shared/address-group"},
+		{"anti-spyware profile", ns.AntiSpywareProfile.GetList, "/config/shared/profiles/spyware"},
+		{"antivirus profile", ns.AntivirusProfile.GetList, "/config/shared/profiles/virus"},
+		{"application", ns.Application.GetList, "/config/shared/application"},
+		{"application group", ns.AppGroup.GetList, "/config/shared/application-group"},
+		{"application signature", func(v string) ([]string, error) {
+			return ns.AppSignature.GetList(v, "app")
+		}, "/config/shared/application/entry[@name='app']/signature"},
+		{"application signature and condition", func(v string) ([]string, error) {
+			return ns.AppSigAndCond.GetList(v, "app", "sig")
+		}, "/config/shared/application/entry[@name='app']/signature/entry[@name='sig']/and-condition"},
+		{"application signature or condition", func(v string) ([]string, error) {
+			return ns.AppSigOrCond.GetList(v, "app", "sig", "and")
+		}, "/config/shared/application/entry[@name='app']/signature/entry[@name='sig']/and-condition/entry[@name='and']/or-condition"},
+		{"edl", ns.Edl.GetList, "/config/shared/external-list"},
+		{"log forwarding profile", ns.LogForwardingProfile.GetList, "/config/shared/log-settings/profiles"},
+		{"log forwarding profile match list", func(v string) ([]string, error) {
+			return ns.LogForwardingProfileMatchList.GetList(v, "lfp")
+		}, "/config/shared/log-settings/profiles/entry[@name='lfp']/match-list"},
+		{"log forwarding profile match list action", func(v string) ([]string, error) {
+			return ns.LogForwardingProfileMatchListAction.GetList(v, "lfp", "ml")
+		}, "/config/shared/log-settings/profiles/entry[@name='lfp']/match-list/entry[@name='ml']/actions"},
+		{"service", ns.Services.GetList, "/config/shared/service"},
+		{"service group", ns.ServiceGroup.GetList, "/config/shared/service-group"},
+		{"tag", ns.Tags.GetList, "/config/shared/tag"},
+		{"url filtering profile", ns.UrlFilteringProfile.GetList, "/config/shared/profiles/url-filtering"},
+		{"vulnerability profile", ns.VulnerabilityProfile.GetList, "/config/shared/profiles/vulnerability"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("")
+			if _, err := tc.fn("shared"); err != nil {
+				t.Fatalf("GetList failed: %s", err)
+			}
+			if !strings.HasPrefix(mc.Path, tc.path) {
+				t.Errorf("Expected path under %q, got %q", tc.path, mc.Path)
+			}
+		})
+	}
+}
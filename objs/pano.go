@@ -7,13 +7,21 @@ import (
 	"github.com/PaloAltoNetworks/pango/objs/addrgrp"
 	"github.com/PaloAltoNetworks/pango/objs/app"
 	appgrp "github.com/PaloAltoNetworks/pango/objs/app/group"
+	appover "github.com/PaloAltoNetworks/pango/objs/app/override"
 	"github.com/PaloAltoNetworks/pango/objs/app/signature"
 	"github.com/PaloAltoNetworks/pango/objs/app/signature/andcond"
 	"github.com/PaloAltoNetworks/pango/objs/app/signature/orcond"
 	"github.com/PaloAltoNetworks/pango/objs/edl"
+	"github.com/PaloAltoNetworks/pango/objs/profile/decryptfwd"
 	"github.com/PaloAltoNetworks/pango/objs/profile/logfwd"
 	"github.com/PaloAltoNetworks/pango/objs/profile/logfwd/matchlist"
 	"github.com/PaloAltoNetworks/pango/objs/profile/logfwd/matchlist/action"
+	"github.com/PaloAltoNetworks/pango/objs/profile/sdwan/pathquality"
+	"github.com/PaloAltoNetworks/pango/objs/profile/sdwan/trafficdist"
+	"github.com/PaloAltoNetworks/pango/objs/profile/security/spyware"
+	"github.com/PaloAltoNetworks/pango/objs/profile/security/urlfilter"
+	"github.com/PaloAltoNetworks/pango/objs/profile/security/virus"
+	"github.com/PaloAltoNetworks/pango/objs/profile/security/vulnerability"
 	"github.com/PaloAltoNetworks/pango/objs/srvc"
 	"github.com/PaloAltoNetworks/pango/objs/srvcgrp"
 	"github.com/PaloAltoNetworks/pango/objs/tags"
@@ -23,18 +31,26 @@ import (
 type PanoObjs struct {
 	Address                             *addr.PanoAddr
 	AddressGroup                        *addrgrp.PanoAddrGrp
+	AntiSpywareProfile                  *spyware.PanoSpyware
+	AntivirusProfile                    *virus.PanoVirus
 	Application                         *app.PanoApp
+	ApplicationOverride                 *appover.PanoOverride
 	AppGroup                            *appgrp.PanoGroup
 	AppSignature                        *signature.PanoSignature
 	AppSigAndCond                       *andcond.PanoAndCond
 	AppSigOrCond                        *orcond.PanoOrCond
+	DecryptionForwardingProfile         *decryptfwd.PanoDecryptFwd
 	Edl                                 *edl.PanoEdl
 	LogForwardingProfile                *logfwd.PanoLogFwd
 	LogForwardingProfileMatchList       *matchlist.PanoMatchList
 	LogForwardingProfileMatchListAction *action.PanoAction
+	SdwanPathQualityProfile             *pathquality.PanoPathQuality
+	SdwanTrafficDistributionProfile     *trafficdist.PanoTrafficDist
 	Services                            *srvc.PanoSrvc
 	ServiceGroup                        *srvcgrp.PanoSrvcGrp
 	Tags                                *tags.PanoTags
+	UrlFilteringProfile                 *urlfilter.PanoUrlFilter
+	VulnerabilityProfile                *vulnerability.PanoVulnerability
 }
 
 // Initialize is invoked on client.Initialize().
@@ -45,9 +61,18 @@ func (c *PanoObjs) Initialize(i util.XapiClient) {
 	c.AddressGroup = &addrgrp.PanoAddrGrp{}
 	c.AddressGroup.Initialize(i)
 
+	c.AntiSpywareProfile = &spyware.PanoSpyware{}
+	c.AntiSpywareProfile.Initialize(i)
+
+	c.AntivirusProfile = &virus.PanoVirus{}
+	c.AntivirusProfile.Initialize(i)
+
 	c.Application = &app.PanoApp{}
 	c.Application.Initialize(i)
 
+	c.ApplicationOverride = &appover.PanoOverride{}
+	c.ApplicationOverride.Initialize(i)
+
 	c.AppGroup = &appgrp.PanoGroup{}
 	c.AppGroup.Initialize(i)
 
@@ -60,6 +85,9 @@ func (c *PanoObjs) Initialize(i util.XapiClient) {
 	c.AppSigOrCond = &orcond.PanoOrCond{}
 	c.AppSigOrCond.Initialize(i)
 
+	c.DecryptionForwardingProfile = &decryptfwd.PanoDecryptFwd{}
+	c.DecryptionForwardingProfile.Initialize(i)
+
 	c.Edl = &edl.PanoEdl{}
 	c.Edl.Initialize(i)
 
@@ -72,6 +100,12 @@ func (c *PanoObjs) Initialize(i util.XapiClient) {
 	c.LogForwardingProfileMatchListAction = &action.PanoAction{}
 	c.LogForwardingProfileMatchListAction.Initialize(i)
 
+	c.SdwanPathQualityProfile = &pathquality.PanoPathQuality{}
+	c.SdwanPathQualityProfile.Initialize(i)
+
+	c.SdwanTrafficDistributionProfile = &trafficdist.PanoTrafficDist{}
+	c.SdwanTrafficDistributionProfile.Initialize(i)
+
 	c.Services = &srvc.PanoSrvc{}
 	c.Services.Initialize(i)
 
@@ -80,4 +114,10 @@ func (c *PanoObjs) Initialize(i util.XapiClient) {
 
 	c.Tags = &tags.PanoTags{}
 	c.Tags.Initialize(i)
+
+	c.UrlFilteringProfile = &urlfilter.PanoUrlFilter{}
+	c.UrlFilteringProfile.Initialize(i)
+
+	c.VulnerabilityProfile = &vulnerability.PanoVulnerability{}
+	c.VulnerabilityProfile.Initialize(i)
 }
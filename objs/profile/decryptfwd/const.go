@@ -0,0 +1,18 @@
+package decryptfwd
+
+// Valid values for SecurityChainType.
+const (
+	SecurityChainTypeRouted            = "routed"
+	SecurityChainTypeTransparentBridge = "transparent-bridge"
+)
+
+// Valid values for Flow.
+const (
+	FlowUnidirectional = "unidirectional"
+	FlowBidirectional  = "bidirectional"
+)
+
+const (
+	singular = "decryption forwarding profile"
+	plural   = "decryption forwarding profiles"
+)
@@ -0,0 +1,13 @@
+/*
+Package decryptfwd is the client.Objects.DecryptionForwardingProfile namespace.
+
+Decryption forwarding profiles configure decryption broker, where the firewall
+forwards decrypted traffic through a chain of inline security devices.  The
+primary and secondary interfaces are a dedicated pair of layer3 ethernet
+interfaces that have eth.Entry DecryptForward enabled.
+
+PAN-OS 8.1+.
+
+Normalized object:  Entry
+*/
+package decryptfwd
@@ -0,0 +1,193 @@
+package decryptfwd
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a decryption
+// forwarding profile.
+//
+// SecurityChains are only used when SecurityChainType is
+// SecurityChainTypeRouted.  Health check settings are preserved as-is when the
+// profile is updated.
+type Entry struct {
+	Name               string
+	Description        string
+	InterfacePrimary   string
+	InterfaceSecondary string
+	SecurityChainType  string
+	Flow               string
+	SecurityChains     []SecurityChain
+
+	raw map[string]string
+}
+
+// SecurityChain is a routed chain of security devices, identified by the IP
+// addresses of the first and last device in the chain.
+type SecurityChain struct {
+	Name        string
+	Enable      bool
+	FirstDevice string
+	LastDevice  string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Description = s.Description
+	o.InterfacePrimary = s.InterfacePrimary
+	o.InterfaceSecondary = s.InterfaceSecondary
+	o.SecurityChainType = s.SecurityChainType
+	o.Flow = s.Flow
+	if s.SecurityChains == nil {
+		o.SecurityChains = nil
+	} else {
+		o.SecurityChains = make([]SecurityChain, len(s.SecurityChains))
+		copy(o.SecurityChains, s.SecurityChains)
+	}
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() []Entry
+	Names() []string
+}
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *container_v1) Normalize() []Entry {
+	arr := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		arr = append(arr, o.Answer[i].normalize())
+	}
+	return arr
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:               o.Name,
+		Description:        o.Description,
+		InterfacePrimary:   o.InterfacePrimary,
+		InterfaceSecondary: o.InterfaceSecondary,
+	}
+
+	if o.ChainType != nil {
+		switch {
+		case o.ChainType.Routed != nil:
+			ans.SecurityChainType = SecurityChainTypeRouted
+			ans.Flow = o.ChainType.Routed.Flow
+			if o.ChainType.Routed.Chains != nil {
+				ans.SecurityChains = make([]SecurityChain, 0, len(o.ChainType.Routed.Chains.Entries))
+				for _, x := range o.ChainType.Routed.Chains.Entries {
+					ans.SecurityChains = append(ans.SecurityChains, SecurityChain{
+						Name:        x.Name,
+						Enable:      util.AsBool(x.Enable),
+						FirstDevice: x.FirstDevice,
+						LastDevice:  x.LastDevice,
+					})
+				}
+			}
+		case o.ChainType.TransparentBridge != nil:
+			ans.SecurityChainType = SecurityChainTypeTransparentBridge
+			ans.Flow = o.ChainType.TransparentBridge.Flow
+		}
+	}
+
+	if o.HealthCheck != nil {
+		ans.raw = map[string]string{
+			"hc": util.CleanRawXml(o.HealthCheck.Text),
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName            xml.Name     `xml:"entry"`
+	Name               string       `xml:"name,attr"`
+	Description        string       `xml:"description,omitempty"`
+	InterfacePrimary   string       `xml:"interface-primary,omitempty"`
+	InterfaceSecondary string       `xml:"interface-secondary,omitempty"`
+	ChainType          *chainType   `xml:"security-chain-type"`
+	HealthCheck        *util.RawXml `xml:"health-check"`
+}
+
+type chainType struct {
+	Routed            *routed `xml:"routed"`
+	TransparentBridge *bridge `xml:"transparent-bridge"`
+}
+
+type routed struct {
+	Flow   string  `xml:"flow,omitempty"`
+	Chains *chains `xml:"chain"`
+}
+
+type chains struct {
+	Entries []chainEntry `xml:"entry"`
+}
+
+type chainEntry struct {
+	Name        string `xml:"name,attr"`
+	Enable      string `xml:"enable"`
+	FirstDevice string `xml:"first-device,omitempty"`
+	LastDevice  string `xml:"last-device,omitempty"`
+}
+
+type bridge struct {
+	Flow string `xml:"flow,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:               e.Name,
+		Description:        e.Description,
+		InterfacePrimary:   e.InterfacePrimary,
+		InterfaceSecondary: e.InterfaceSecondary,
+	}
+
+	switch e.SecurityChainType {
+	case SecurityChainTypeRouted:
+		r := &routed{
+			Flow: e.Flow,
+		}
+		if len(e.SecurityChains) > 0 {
+			list := make([]chainEntry, 0, len(e.SecurityChains))
+			for _, x := range e.SecurityChains {
+				list = append(list, chainEntry{
+					Name:        x.Name,
+					Enable:      util.YesNo(x.Enable),
+					FirstDevice: x.FirstDevice,
+					LastDevice:  x.LastDevice,
+				})
+			}
+			r.Chains = &chains{Entries: list}
+		}
+		ans.ChainType = &chainType{Routed: r}
+	case SecurityChainTypeTransparentBridge:
+		ans.ChainType = &chainType{
+			TransparentBridge: &bridge{
+				Flow: e.Flow,
+			},
+		}
+	}
+
+	if text, present := e.raw["hc"]; present {
+		ans.HealthCheck = &util.RawXml{text}
+	}
+
+	return ans
+}
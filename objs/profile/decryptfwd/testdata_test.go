@@ -0,0 +1,47 @@
+package decryptfwd
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type tc struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"basic", version.Number{8, 1, 0, ""}, Entry{
+			Name:               "t1",
+			Description:        "foobar",
+			InterfacePrimary:   "ethernet1/5",
+			InterfaceSecondary: "ethernet1/6",
+		}},
+		{"transparent bridge", version.Number{8, 1, 0, ""}, Entry{
+			Name:               "t2",
+			InterfacePrimary:   "ethernet1/5",
+			InterfaceSecondary: "ethernet1/6",
+			SecurityChainType:  SecurityChainTypeTransparentBridge,
+			Flow:               FlowBidirectional,
+		}},
+		{"routed chains", version.Number{8, 1, 0, ""}, Entry{
+			Name:               "t3",
+			InterfacePrimary:   "ethernet1/5",
+			InterfaceSecondary: "ethernet1/6",
+			SecurityChainType:  SecurityChainTypeRouted,
+			Flow:               FlowUnidirectional,
+			SecurityChains: []SecurityChain{
+				{Name: "chain1", Enable: true, FirstDevice: "10.1.1.1", LastDevice: "10.1.1.2"},
+				{Name: "chain2", FirstDevice: "10.2.1.1", LastDevice: "10.2.1.2"},
+			},
+		}},
+		{"with raw health check", version.Number{8, 1, 0, ""}, Entry{
+			Name:              "t4",
+			SecurityChainType: SecurityChainTypeRouted,
+			raw: map[string]string{
+				"hc": "<path-health-check>raw</path-health-check>",
+			},
+		}},
+	}
+}
@@ -0,0 +1,11 @@
+/*
+Package pathquality is the client.Objects.SdwanPathQualityProfile namespace.
+
+SD-WAN path quality profiles set the latency, jitter, and packet loss
+thresholds that a path must stay within before SD-WAN moves sessions to a
+different path.  SD-WAN is managed through the Panorama SD-WAN plugin, so
+this namespace is only available on Panorama.
+
+Normalized object:  Entry
+*/
+package pathquality
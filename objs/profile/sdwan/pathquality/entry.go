@@ -0,0 +1,129 @@
+package pathquality
+
+import (
+	"encoding/xml"
+)
+
+// Entry is a normalized, version independent representation of an SD-WAN
+// path quality profile.
+//
+// Latency and jitter thresholds are in milliseconds, while the packet loss
+// threshold is a percentage.
+//
+// PAN-OS 9.1+.
+type Entry struct {
+	Name                  string
+	LatencyThreshold      int
+	LatencySensitivity    string
+	JitterThreshold       int
+	JitterSensitivity     string
+	PacketLossThreshold   int
+	PacketLossSensitivity string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.LatencyThreshold = s.LatencyThreshold
+	o.LatencySensitivity = s.LatencySensitivity
+	o.JitterThreshold = s.JitterThreshold
+	o.JitterSensitivity = s.JitterSensitivity
+	o.PacketLossThreshold = s.PacketLossThreshold
+	o.PacketLossSensitivity = s.PacketLossSensitivity
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() []Entry
+	Names() []string
+}
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *container_v1) Normalize() []Entry {
+	arr := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		arr = append(arr, o.Answer[i].normalize())
+	}
+	return arr
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name: o.Name,
+	}
+
+	if o.Metric != nil {
+		if o.Metric.Latency != nil {
+			ans.LatencyThreshold = o.Metric.Latency.Threshold
+			ans.LatencySensitivity = o.Metric.Latency.Sensitivity
+		}
+		if o.Metric.Jitter != nil {
+			ans.JitterThreshold = o.Metric.Jitter.Threshold
+			ans.JitterSensitivity = o.Metric.Jitter.Sensitivity
+		}
+		if o.Metric.PacketLoss != nil {
+			ans.PacketLossThreshold = o.Metric.PacketLoss.Threshold
+			ans.PacketLossSensitivity = o.Metric.PacketLoss.Sensitivity
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName xml.Name `xml:"entry"`
+	Name    string   `xml:"name,attr"`
+	Metric  *metric  `xml:"metric"`
+}
+
+type metric struct {
+	Latency    *threshold `xml:"latency"`
+	Jitter     *threshold `xml:"jitter"`
+	PacketLoss *threshold `xml:"pkt-loss"`
+}
+
+type threshold struct {
+	Threshold   int    `xml:"threshold,omitempty"`
+	Sensitivity string `xml:"sensitivity,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name: e.Name,
+	}
+
+	m := metric{
+		Latency:    specifyThreshold(e.LatencyThreshold, e.LatencySensitivity),
+		Jitter:     specifyThreshold(e.JitterThreshold, e.JitterSensitivity),
+		PacketLoss: specifyThreshold(e.PacketLossThreshold, e.PacketLossSensitivity),
+	}
+	if m.Latency != nil || m.Jitter != nil || m.PacketLoss != nil {
+		ans.Metric = &m
+	}
+
+	return ans
+}
+
+func specifyThreshold(value int, sensitivity string) *threshold {
+	if value == 0 && sensitivity == "" {
+		return nil
+	}
+
+	return &threshold{
+		Threshold:   value,
+		Sensitivity: sensitivity,
+	}
+}
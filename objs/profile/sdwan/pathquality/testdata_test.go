@@ -0,0 +1,33 @@
+package pathquality
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type tc struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"v1 empty", version.Number{9, 1, 0, ""}, Entry{
+			Name: "t1",
+		}},
+		{"v1 latency only", version.Number{9, 1, 0, ""}, Entry{
+			Name:               "t2",
+			LatencyThreshold:   100,
+			LatencySensitivity: SensitivityHigh,
+		}},
+		{"v1 all metrics", version.Number{9, 1, 0, ""}, Entry{
+			Name:                  "t3",
+			LatencyThreshold:      150,
+			LatencySensitivity:    SensitivityMedium,
+			JitterThreshold:       30,
+			JitterSensitivity:     SensitivityLow,
+			PacketLossThreshold:   5,
+			PacketLossSensitivity: SensitivityHigh,
+		}},
+	}
+}
@@ -0,0 +1,13 @@
+package trafficdist
+
+// Valid values for Distribution.
+const (
+	DistributionBestAvailablePath           = "Best Available Path"
+	DistributionTopDownPriority             = "Top Down Priority"
+	DistributionWeightedSessionDistribution = "Weighted Session Distribution"
+)
+
+const (
+	singular = "sdwan traffic distribution profile"
+	plural   = "sdwan traffic distribution profiles"
+)
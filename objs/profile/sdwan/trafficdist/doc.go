@@ -0,0 +1,12 @@
+/*
+Package trafficdist is the client.Objects.SdwanTrafficDistributionProfile
+namespace.
+
+SD-WAN traffic distribution profiles control how sessions are distributed
+across the links identified by one or more link tags.  SD-WAN is managed
+through the Panorama SD-WAN plugin, so this namespace is only available on
+Panorama.
+
+Normalized object:  Entry
+*/
+package trafficdist
@@ -0,0 +1,118 @@
+package trafficdist
+
+import (
+	"encoding/xml"
+)
+
+// Entry is a normalized, version independent representation of an SD-WAN
+// traffic distribution profile.
+//
+// PAN-OS 9.1+.
+type Entry struct {
+	Name         string
+	Distribution string
+	LinkTags     []LinkTag
+}
+
+// LinkTag is a link tag used by a traffic distribution profile.  Link tags
+// are listed in priority order, and Weight is only used with weighted session
+// distribution.
+type LinkTag struct {
+	Name   string
+	Weight int
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Distribution = s.Distribution
+	if s.LinkTags == nil {
+		o.LinkTags = nil
+	} else {
+		o.LinkTags = make([]LinkTag, len(s.LinkTags))
+		copy(o.LinkTags, s.LinkTags)
+	}
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() []Entry
+	Names() []string
+}
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *container_v1) Normalize() []Entry {
+	arr := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		arr = append(arr, o.Answer[i].normalize())
+	}
+	return arr
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:         o.Name,
+		Distribution: o.Distribution,
+	}
+
+	if o.LinkTags != nil {
+		ans.LinkTags = make([]LinkTag, 0, len(o.LinkTags.Entries))
+		for _, v := range o.LinkTags.Entries {
+			ans.LinkTags = append(ans.LinkTags, LinkTag{
+				Name:   v.Name,
+				Weight: v.Weight,
+			})
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName      xml.Name  `xml:"entry"`
+	Name         string    `xml:"name,attr"`
+	Distribution string    `xml:"traffic-distribution,omitempty"`
+	LinkTags     *linkTags `xml:"link-tags"`
+}
+
+type linkTags struct {
+	Entries []linkTag `xml:"entry"`
+}
+
+type linkTag struct {
+	Name   string `xml:"name,attr"`
+	Weight int    `xml:"weight,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:         e.Name,
+		Distribution: e.Distribution,
+	}
+
+	if len(e.LinkTags) > 0 {
+		list := make([]linkTag, 0, len(e.LinkTags))
+		for _, v := range e.LinkTags {
+			list = append(list, linkTag{
+				Name:   v.Name,
+				Weight: v.Weight,
+			})
+		}
+		ans.LinkTags = &linkTags{Entries: list}
+	}
+
+	return ans
+}
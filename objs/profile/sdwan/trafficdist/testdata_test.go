@@ -0,0 +1,35 @@
+package trafficdist
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type tc struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"v1 best available path", version.Number{9, 1, 0, ""}, Entry{
+			Name:         "t1",
+			Distribution: DistributionBestAvailablePath,
+			LinkTags: []LinkTag{
+				{Name: "broadband"},
+			},
+		}},
+		{"v1 weighted", version.Number{9, 1, 0, ""}, Entry{
+			Name:         "t2",
+			Distribution: DistributionWeightedSessionDistribution,
+			LinkTags: []LinkTag{
+				{Name: "mpls", Weight: 70},
+				{Name: "broadband", Weight: 30},
+			},
+		}},
+		{"v1 no link tags", version.Number{9, 1, 0, ""}, Entry{
+			Name:         "t3",
+			Distribution: DistributionTopDownPriority,
+		}},
+	}
+}
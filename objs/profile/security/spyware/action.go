@@ -0,0 +1,74 @@
+package spyware
+
+// actionType is the XML representation of a rule action, where the action
+// is the tag name of the single child element.
+type actionType struct {
+	Default     *string `xml:"default"`
+	Allow       *string `xml:"allow"`
+	Alert       *string `xml:"alert"`
+	Drop        *string `xml:"drop"`
+	ResetClient *string `xml:"reset-client"`
+	ResetServer *string `xml:"reset-server"`
+	ResetBoth   *string `xml:"reset-both"`
+	Block       *string `xml:"block"`
+	Sinkhole    *string `xml:"sinkhole"`
+}
+
+func (o *actionType) String() string {
+	if o == nil {
+		return ""
+	}
+
+	switch {
+	case o.Default != nil:
+		return ActionDefault
+	case o.Allow != nil:
+		return ActionAllow
+	case o.Alert != nil:
+		return ActionAlert
+	case o.Drop != nil:
+		return ActionDrop
+	case o.ResetClient != nil:
+		return ActionResetClient
+	case o.ResetServer != nil:
+		return ActionResetServer
+	case o.ResetBoth != nil:
+		return ActionResetBoth
+	case o.Block != nil:
+		return DnsActionBlock
+	case o.Sinkhole != nil:
+		return DnsActionSinkhole
+	}
+
+	return ""
+}
+
+func asActionType(v string) *actionType {
+	s := ""
+	ans := &actionType{}
+
+	switch v {
+	case ActionDefault:
+		ans.Default = &s
+	case ActionAllow:
+		ans.Allow = &s
+	case ActionAlert:
+		ans.Alert = &s
+	case ActionDrop:
+		ans.Drop = &s
+	case ActionResetClient:
+		ans.ResetClient = &s
+	case ActionResetServer:
+		ans.ResetServer = &s
+	case ActionResetBoth:
+		ans.ResetBoth = &s
+	case DnsActionBlock:
+		ans.Block = &s
+	case DnsActionSinkhole:
+		ans.Sinkhole = &s
+	default:
+		return nil
+	}
+
+	return ans
+}
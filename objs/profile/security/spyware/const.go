@@ -0,0 +1,48 @@
+package spyware
+
+const (
+	singular = "anti-spyware profile"
+	plural   = "anti-spyware profiles"
+)
+
+// Valid values for Rule.Action.
+const (
+	ActionDefault     = "default"
+	ActionAllow       = "allow"
+	ActionAlert       = "alert"
+	ActionDrop        = "drop"
+	ActionResetClient = "reset-client"
+	ActionResetServer = "reset-server"
+	ActionResetBoth   = "reset-both"
+)
+
+// Valid values for DnsList.Action.
+const (
+	DnsActionAlert    = "alert"
+	DnsActionAllow    = "allow"
+	DnsActionBlock    = "block"
+	DnsActionSinkhole = "sinkhole"
+)
+
+// Valid values for Rule.PacketCapture and DnsList.PacketCapture.
+const (
+	PacketCaptureDisable         = "disable"
+	PacketCaptureSinglePacket    = "single-packet"
+	PacketCaptureExtendedCapture = "extended-capture"
+)
+
+// Valid values for Rule.Severities.
+const (
+	SeverityAny           = "any"
+	SeverityCritical      = "critical"
+	SeverityHigh          = "high"
+	SeverityMedium        = "medium"
+	SeverityLow           = "low"
+	SeverityInformational = "informational"
+)
+
+// Default sinkhole addresses.
+const (
+	SinkholeDefaultIpv4 = "pan-sinkhole-default-ip"
+	SinkholeDefaultIpv6 = "::1"
+)
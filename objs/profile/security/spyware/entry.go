@@ -0,0 +1,249 @@
+package spyware
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an
+// anti-spyware security profile.
+type Entry struct {
+	Name                string
+	Description         string
+	SinkholeIpv4Address string
+	SinkholeIpv6Address string
+	DnsLists            []DnsList
+	Rules               []Rule // ordered
+	ThreatExceptions    []string
+
+	raw map[string]string
+}
+
+// DnsList is the DNS signature policy for a given list of domains.
+type DnsList struct {
+	Name          string
+	Action        string
+	PacketCapture string
+}
+
+// Rule is a single anti-spyware rule.
+type Rule struct {
+	Name          string
+	ThreatName    string
+	Category      string
+	Severities    []string
+	PacketCapture string
+	Action        string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Description = s.Description
+	o.SinkholeIpv4Address = s.SinkholeIpv4Address
+	o.SinkholeIpv6Address = s.SinkholeIpv6Address
+	if s.DnsLists == nil {
+		o.DnsLists = nil
+	} else {
+		o.DnsLists = make([]DnsList, len(s.DnsLists))
+		copy(o.DnsLists, s.DnsLists)
+	}
+	if s.Rules == nil {
+		o.Rules = nil
+	} else {
+		o.Rules = make([]Rule, 0, len(s.Rules))
+		for _, r := range s.Rules {
+			r.Severities = append([]string(nil), r.Severities...)
+			o.Rules = append(o.Rules, r)
+		}
+	}
+	o.ThreatExceptions = s.ThreatExceptions
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() []Entry
+	Names() []string
+}
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *container_v1) Normalize() []Entry {
+	arr := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		arr = append(arr, o.Answer[i].normalize())
+	}
+	return arr
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:             o.Name,
+		Description:      o.Description,
+		ThreatExceptions: util.EntToStr(o.ThreatExceptions),
+	}
+
+	raw := make(map[string]string)
+
+	if o.Botnet != nil {
+		if o.Botnet.Sinkhole != nil {
+			ans.SinkholeIpv4Address = o.Botnet.Sinkhole.Ipv4Address
+			ans.SinkholeIpv6Address = o.Botnet.Sinkhole.Ipv6Address
+		}
+		if o.Botnet.Lists != nil {
+			ans.DnsLists = make([]DnsList, 0, len(o.Botnet.Lists.Entries))
+			for _, v := range o.Botnet.Lists.Entries {
+				ans.DnsLists = append(ans.DnsLists, DnsList{
+					Name:          v.Name,
+					Action:        v.Action.String(),
+					PacketCapture: v.PacketCapture,
+				})
+			}
+		}
+		if o.Botnet.Categories != nil {
+			raw["dsc"] = util.CleanRawXml(o.Botnet.Categories.Text)
+		}
+		if o.Botnet.Whitelist != nil {
+			raw["wl"] = util.CleanRawXml(o.Botnet.Whitelist.Text)
+		}
+	}
+
+	if o.Rules != nil {
+		ans.Rules = make([]Rule, 0, len(o.Rules.Entries))
+		for _, v := range o.Rules.Entries {
+			ans.Rules = append(ans.Rules, Rule{
+				Name:          v.Name,
+				ThreatName:    v.ThreatName,
+				Category:      v.Category,
+				Severities:    util.MemToStr(v.Severities),
+				PacketCapture: v.PacketCapture,
+				Action:        v.Action.String(),
+			})
+		}
+	}
+
+	if len(raw) > 0 {
+		ans.raw = raw
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName          xml.Name        `xml:"entry"`
+	Name             string          `xml:"name,attr"`
+	Description      string          `xml:"description,omitempty"`
+	Botnet           *botnet         `xml:"botnet-domains"`
+	Rules            *rules          `xml:"rules"`
+	ThreatExceptions *util.EntryType `xml:"threat-exception"`
+}
+
+type botnet struct {
+	Lists      *dnsLists    `xml:"lists"`
+	Categories *util.RawXml `xml:"dns-security-categories"`
+	Whitelist  *util.RawXml `xml:"whitelist"`
+	Sinkhole   *sinkhole    `xml:"sinkhole"`
+}
+
+type dnsLists struct {
+	Entries []dnsList `xml:"entry"`
+}
+
+type dnsList struct {
+	Name          string      `xml:"name,attr"`
+	Action        *actionType `xml:"action"`
+	PacketCapture string      `xml:"packet-capture,omitempty"`
+}
+
+type sinkhole struct {
+	Ipv4Address string `xml:"ipv4-address,omitempty"`
+	Ipv6Address string `xml:"ipv6-address,omitempty"`
+}
+
+type rules struct {
+	Entries []rule `xml:"entry"`
+}
+
+type rule struct {
+	Name          string           `xml:"name,attr"`
+	ThreatName    string           `xml:"threat-name,omitempty"`
+	Category      string           `xml:"category,omitempty"`
+	Severities    *util.MemberType `xml:"severity"`
+	PacketCapture string           `xml:"packet-capture,omitempty"`
+	Action        *actionType      `xml:"action"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:             e.Name,
+		Description:      e.Description,
+		ThreatExceptions: util.StrToEnt(e.ThreatExceptions),
+	}
+
+	b := &botnet{}
+	hasBotnet := false
+
+	if e.SinkholeIpv4Address != "" || e.SinkholeIpv6Address != "" {
+		b.Sinkhole = &sinkhole{
+			Ipv4Address: e.SinkholeIpv4Address,
+			Ipv6Address: e.SinkholeIpv6Address,
+		}
+		hasBotnet = true
+	}
+
+	if len(e.DnsLists) > 0 {
+		list := make([]dnsList, 0, len(e.DnsLists))
+		for _, v := range e.DnsLists {
+			list = append(list, dnsList{
+				Name:          v.Name,
+				Action:        asActionType(v.Action),
+				PacketCapture: v.PacketCapture,
+			})
+		}
+		b.Lists = &dnsLists{Entries: list}
+		hasBotnet = true
+	}
+
+	if text, present := e.raw["dsc"]; present {
+		b.Categories = &util.RawXml{text}
+		hasBotnet = true
+	}
+	if text, present := e.raw["wl"]; present {
+		b.Whitelist = &util.RawXml{text}
+		hasBotnet = true
+	}
+
+	if hasBotnet {
+		ans.Botnet = b
+	}
+
+	if len(e.Rules) > 0 {
+		list := make([]rule, 0, len(e.Rules))
+		for _, v := range e.Rules {
+			list = append(list, rule{
+				Name:          v.Name,
+				ThreatName:    v.ThreatName,
+				Category:      v.Category,
+				Severities:    util.StrToMem(v.Severities),
+				PacketCapture: v.PacketCapture,
+				Action:        asActionType(v.Action),
+			})
+		}
+		ans.Rules = &rules{Entries: list}
+	}
+
+	return ans
+}
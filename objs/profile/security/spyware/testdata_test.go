@@ -0,0 +1,55 @@
+package spyware
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type tc struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"basic", version.Number{8, 1, 0, ""}, Entry{
+			Name:        "t1",
+			Description: "foobar",
+		}},
+		{"sinkhole and dns lists", version.Number{8, 1, 0, ""}, Entry{
+			Name:                "t2",
+			SinkholeIpv4Address: SinkholeDefaultIpv4,
+			SinkholeIpv6Address: SinkholeDefaultIpv6,
+			DnsLists: []DnsList{
+				{Name: "default-paloalto-dns", Action: DnsActionSinkhole, PacketCapture: PacketCaptureSinglePacket},
+			},
+		}},
+		{"rules", version.Number{8, 1, 0, ""}, Entry{
+			Name: "t3",
+			Rules: []Rule{
+				{
+					Name:          "critical",
+					ThreatName:    "any",
+					Category:      "any",
+					Severities:    []string{SeverityCritical, SeverityHigh},
+					PacketCapture: PacketCaptureSinglePacket,
+					Action:        ActionResetBoth,
+				},
+				{
+					Name:       "low",
+					ThreatName: "any",
+					Category:   "any",
+					Severities: []string{SeverityLow},
+					Action:     ActionDefault,
+				},
+			},
+			ThreatExceptions: []string{"10001"},
+		}},
+		{"raw", version.Number{10, 0, 0, ""}, Entry{
+			Name: "t4",
+			raw: map[string]string{
+				"dsc": "<entry name=\"pan-dns-sec-malware\"><action>sinkhole</action></entry>",
+			},
+		}},
+	}
+}
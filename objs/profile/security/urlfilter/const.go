@@ -0,0 +1,6 @@
+package urlfilter
+
+const (
+	singular = "URL filtering profile"
+	plural   = "URL filtering profiles"
+)
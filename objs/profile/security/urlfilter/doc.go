@@ -0,0 +1,6 @@
+/*
+Package urlfilter is the client.Objects.UrlFilteringProfile namespace.
+
+Normalized object:  Entry
+*/
+package urlfilter
@@ -0,0 +1,152 @@
+package urlfilter
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a URL
+// filtering security profile.
+//
+// Each URL category should be present in at most one of the category lists.
+// Categories not present in any list are allowed without logging.
+type Entry struct {
+	Name                   string
+	Description            string
+	AllowCategories        []string
+	AlertCategories        []string
+	BlockCategories        []string
+	ContinueCategories     []string
+	OverrideCategories     []string
+	TrackContainerPage     bool
+	LogContainerPageOnly   bool
+	SafeSearchEnforcement  bool
+	LogHttpHeaderXff       bool
+	LogHttpHeaderUserAgent bool
+	LogHttpHeaderReferer   bool
+
+	raw map[string]string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Description = s.Description
+	o.AllowCategories = s.AllowCategories
+	o.AlertCategories = s.AlertCategories
+	o.BlockCategories = s.BlockCategories
+	o.ContinueCategories = s.ContinueCategories
+	o.OverrideCategories = s.OverrideCategories
+	o.TrackContainerPage = s.TrackContainerPage
+	o.LogContainerPageOnly = s.LogContainerPageOnly
+	o.SafeSearchEnforcement = s.SafeSearchEnforcement
+	o.LogHttpHeaderXff = s.LogHttpHeaderXff
+	o.LogHttpHeaderUserAgent = s.LogHttpHeaderUserAgent
+	o.LogHttpHeaderReferer = s.LogHttpHeaderReferer
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() []Entry
+	Names() []string
+}
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *container_v1) Normalize() []Entry {
+	arr := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		arr = append(arr, o.Answer[i].normalize())
+	}
+	return arr
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:                   o.Name,
+		Description:            o.Description,
+		AllowCategories:        util.MemToStr(o.AllowCategories),
+		AlertCategories:        util.MemToStr(o.AlertCategories),
+		BlockCategories:        util.MemToStr(o.BlockCategories),
+		ContinueCategories:     util.MemToStr(o.ContinueCategories),
+		OverrideCategories:     util.MemToStr(o.OverrideCategories),
+		TrackContainerPage:     util.AsBool(o.TrackContainerPage),
+		LogContainerPageOnly:   util.AsBool(o.LogContainerPageOnly),
+		SafeSearchEnforcement:  util.AsBool(o.SafeSearchEnforcement),
+		LogHttpHeaderXff:       util.AsBool(o.LogHttpHeaderXff),
+		LogHttpHeaderUserAgent: util.AsBool(o.LogHttpHeaderUserAgent),
+		LogHttpHeaderReferer:   util.AsBool(o.LogHttpHeaderReferer),
+	}
+
+	raw := make(map[string]string)
+	if o.CredentialEnforcement != nil {
+		raw["ce"] = util.CleanRawXml(o.CredentialEnforcement.Text)
+	}
+	if o.HttpHeaderInsertion != nil {
+		raw["hhi"] = util.CleanRawXml(o.HttpHeaderInsertion.Text)
+	}
+	if len(raw) > 0 {
+		ans.raw = raw
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName                xml.Name         `xml:"entry"`
+	Name                   string           `xml:"name,attr"`
+	Description            string           `xml:"description,omitempty"`
+	AllowCategories        *util.MemberType `xml:"allow"`
+	AlertCategories        *util.MemberType `xml:"alert"`
+	BlockCategories        *util.MemberType `xml:"block"`
+	ContinueCategories     *util.MemberType `xml:"continue"`
+	OverrideCategories     *util.MemberType `xml:"override"`
+	TrackContainerPage     string           `xml:"enable-container-page"`
+	LogContainerPageOnly   string           `xml:"log-container-page-only"`
+	SafeSearchEnforcement  string           `xml:"safe-search-enforcement"`
+	LogHttpHeaderXff       string           `xml:"log-http-hdr-xff"`
+	LogHttpHeaderUserAgent string           `xml:"log-http-hdr-user-agent"`
+	LogHttpHeaderReferer   string           `xml:"log-http-hdr-referer"`
+	CredentialEnforcement  *util.RawXml     `xml:"credential-enforcement"`
+	HttpHeaderInsertion    *util.RawXml     `xml:"http-header-insertion"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:                   e.Name,
+		Description:            e.Description,
+		AllowCategories:        util.StrToMem(e.AllowCategories),
+		AlertCategories:        util.StrToMem(e.AlertCategories),
+		BlockCategories:        util.StrToMem(e.BlockCategories),
+		ContinueCategories:     util.StrToMem(e.ContinueCategories),
+		OverrideCategories:     util.StrToMem(e.OverrideCategories),
+		TrackContainerPage:     util.YesNo(e.TrackContainerPage),
+		LogContainerPageOnly:   util.YesNo(e.LogContainerPageOnly),
+		SafeSearchEnforcement:  util.YesNo(e.SafeSearchEnforcement),
+		LogHttpHeaderXff:       util.YesNo(e.LogHttpHeaderXff),
+		LogHttpHeaderUserAgent: util.YesNo(e.LogHttpHeaderUserAgent),
+		LogHttpHeaderReferer:   util.YesNo(e.LogHttpHeaderReferer),
+	}
+
+	if text, present := e.raw["ce"]; present {
+		ans.CredentialEnforcement = &util.RawXml{text}
+	}
+	if text, present := e.raw["hhi"]; present {
+		ans.HttpHeaderInsertion = &util.RawXml{text}
+	}
+
+	return ans
+}
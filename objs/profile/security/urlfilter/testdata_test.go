@@ -0,0 +1,43 @@
+package urlfilter
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type tc struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"basic", version.Number{8, 1, 0, ""}, Entry{
+			Name:        "t1",
+			Description: "foobar",
+		}},
+		{"categories", version.Number{8, 1, 0, ""}, Entry{
+			Name:               "t2",
+			AlertCategories:    []string{"news", "shopping"},
+			BlockCategories:    []string{"malware", "phishing"},
+			ContinueCategories: []string{"gambling"},
+			OverrideCategories: []string{"games"},
+			AllowCategories:    []string{"business-and-economy"},
+		}},
+		{"flags", version.Number{8, 1, 0, ""}, Entry{
+			Name:                   "t3",
+			TrackContainerPage:     true,
+			LogContainerPageOnly:   true,
+			SafeSearchEnforcement:  true,
+			LogHttpHeaderXff:       true,
+			LogHttpHeaderUserAgent: true,
+			LogHttpHeaderReferer:   true,
+		}},
+		{"raw", version.Number{8, 1, 0, ""}, Entry{
+			Name: "t4",
+			raw: map[string]string{
+				"ce": "<mode><disabled/></mode>",
+			},
+		}},
+	}
+}
@@ -0,0 +1,6 @@
+/*
+Package virus is the client.Objects.AntivirusProfile namespace.
+
+Normalized object:  Entry
+*/
+package virus
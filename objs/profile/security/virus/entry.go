@@ -0,0 +1,197 @@
+package virus
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an
+// antivirus security profile.
+type Entry struct {
+	Name                  string
+	Description           string
+	PacketCapture         bool
+	Decoders              []Decoder
+	ApplicationExceptions []ApplicationException
+	ThreatExceptions      []string
+
+	raw map[string]string
+}
+
+// Decoder is the action to take for a given protocol decoder.
+type Decoder struct {
+	Name           string
+	Action         string
+	WildfireAction string
+}
+
+// ApplicationException overrides the decoder action for a specific
+// application.
+type ApplicationException struct {
+	Application string
+	Action      string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Description = s.Description
+	o.PacketCapture = s.PacketCapture
+	if s.Decoders == nil {
+		o.Decoders = nil
+	} else {
+		o.Decoders = make([]Decoder, len(s.Decoders))
+		copy(o.Decoders, s.Decoders)
+	}
+	if s.ApplicationExceptions == nil {
+		o.ApplicationExceptions = nil
+	} else {
+		o.ApplicationExceptions = make([]ApplicationException, len(s.ApplicationExceptions))
+		copy(o.ApplicationExceptions, s.ApplicationExceptions)
+	}
+	o.ThreatExceptions = s.ThreatExceptions
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() []Entry
+	Names() []string
+}
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *container_v1) Normalize() []Entry {
+	arr := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		arr = append(arr, o.Answer[i].normalize())
+	}
+	return arr
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:             o.Name,
+		Description:      o.Description,
+		PacketCapture:    util.AsBool(o.PacketCapture),
+		ThreatExceptions: util.EntToStr(o.ThreatExceptions),
+	}
+
+	if o.Decoders != nil {
+		ans.Decoders = make([]Decoder, 0, len(o.Decoders.Entries))
+		for _, v := range o.Decoders.Entries {
+			ans.Decoders = append(ans.Decoders, Decoder{
+				Name:           v.Name,
+				Action:         v.Action,
+				WildfireAction: v.WildfireAction,
+			})
+		}
+	}
+
+	if o.Applications != nil {
+		ans.ApplicationExceptions = make([]ApplicationException, 0, len(o.Applications.Entries))
+		for _, v := range o.Applications.Entries {
+			ans.ApplicationExceptions = append(ans.ApplicationExceptions, ApplicationException{
+				Application: v.Name,
+				Action:      v.Action,
+			})
+		}
+	}
+
+	raw := make(map[string]string)
+	if o.MlavEngine != nil {
+		raw["mlav"] = util.CleanRawXml(o.MlavEngine.Text)
+	}
+	if o.MlavException != nil {
+		raw["mlavex"] = util.CleanRawXml(o.MlavException.Text)
+	}
+	if len(raw) > 0 {
+		ans.raw = raw
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName          xml.Name        `xml:"entry"`
+	Name             string          `xml:"name,attr"`
+	Description      string          `xml:"description,omitempty"`
+	PacketCapture    string          `xml:"packet-capture"`
+	Decoders         *decoders       `xml:"decoder"`
+	Applications     *applications   `xml:"application"`
+	ThreatExceptions *util.EntryType `xml:"threat-exception"`
+	MlavEngine       *util.RawXml    `xml:"mlav-engine-filebased-enabled"`
+	MlavException    *util.RawXml    `xml:"mlav-exception"`
+}
+
+type decoders struct {
+	Entries []decoder `xml:"entry"`
+}
+
+type decoder struct {
+	Name           string `xml:"name,attr"`
+	Action         string `xml:"action,omitempty"`
+	WildfireAction string `xml:"wildfire-action,omitempty"`
+}
+
+type applications struct {
+	Entries []application `xml:"entry"`
+}
+
+type application struct {
+	Name   string `xml:"name,attr"`
+	Action string `xml:"action,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:             e.Name,
+		Description:      e.Description,
+		PacketCapture:    util.YesNo(e.PacketCapture),
+		ThreatExceptions: util.StrToEnt(e.ThreatExceptions),
+	}
+
+	if len(e.Decoders) > 0 {
+		list := make([]decoder, 0, len(e.Decoders))
+		for _, v := range e.Decoders {
+			list = append(list, decoder{
+				Name:           v.Name,
+				Action:         v.Action,
+				WildfireAction: v.WildfireAction,
+			})
+		}
+		ans.Decoders = &decoders{Entries: list}
+	}
+
+	if len(e.ApplicationExceptions) > 0 {
+		list := make([]application, 0, len(e.ApplicationExceptions))
+		for _, v := range e.ApplicationExceptions {
+			list = append(list, application{
+				Name:   v.Application,
+				Action: v.Action,
+			})
+		}
+		ans.Applications = &applications{Entries: list}
+	}
+
+	if text, present := e.raw["mlav"]; present {
+		ans.MlavEngine = &util.RawXml{text}
+	}
+	if text, present := e.raw["mlavex"]; present {
+		ans.MlavException = &util.RawXml{text}
+	}
+
+	return ans
+}
@@ -0,0 +1,38 @@
+package virus
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type tc struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"basic", version.Number{8, 1, 0, ""}, Entry{
+			Name:          "t1",
+			Description:   "foobar",
+			PacketCapture: true,
+		}},
+		{"decoders and exceptions", version.Number{8, 1, 0, ""}, Entry{
+			Name: "t2",
+			Decoders: []Decoder{
+				{Name: "http", Action: ActionResetBoth, WildfireAction: ActionResetBoth},
+				{Name: "smtp", Action: ActionAlert, WildfireAction: ActionAlert},
+			},
+			ApplicationExceptions: []ApplicationException{
+				{Application: "web-browsing", Action: ActionAlert},
+			},
+			ThreatExceptions: []string{"100000", "100001"},
+		}},
+		{"with raw", version.Number{10, 0, 0, ""}, Entry{
+			Name: "t3",
+			raw: map[string]string{
+				"mlav": "<entry name=\"Windows Executables\"><mlav-policy-action>enable</mlav-policy-action></entry>",
+			},
+		}},
+	}
+}
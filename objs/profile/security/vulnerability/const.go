@@ -0,0 +1,41 @@
+package vulnerability
+
+const (
+	singular = "vulnerability protection profile"
+	plural   = "vulnerability protection profiles"
+)
+
+// Valid values for Rule.Action.
+const (
+	ActionDefault     = "default"
+	ActionAllow       = "allow"
+	ActionAlert       = "alert"
+	ActionDrop        = "drop"
+	ActionResetClient = "reset-client"
+	ActionResetServer = "reset-server"
+	ActionResetBoth   = "reset-both"
+)
+
+// Valid values for Rule.Host.
+const (
+	HostAny    = "any"
+	HostClient = "client"
+	HostServer = "server"
+)
+
+// Valid values for Rule.PacketCapture.
+const (
+	PacketCaptureDisable         = "disable"
+	PacketCaptureSinglePacket    = "single-packet"
+	PacketCaptureExtendedCapture = "extended-capture"
+)
+
+// Valid values for Rule.Severities.
+const (
+	SeverityAny           = "any"
+	SeverityCritical      = "critical"
+	SeverityHigh          = "high"
+	SeverityMedium        = "medium"
+	SeverityLow           = "low"
+	SeverityInformational = "informational"
+)
@@ -0,0 +1,6 @@
+/*
+Package vulnerability is the client.Objects.VulnerabilityProfile namespace.
+
+Normalized object:  Entry
+*/
+package vulnerability
@@ -0,0 +1,154 @@
+package vulnerability
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a
+// vulnerability protection security profile.
+type Entry struct {
+	Name             string
+	Description      string
+	Rules            []Rule // ordered
+	ThreatExceptions []string
+}
+
+// Rule is a single vulnerability protection rule.
+type Rule struct {
+	Name          string
+	ThreatName    string
+	Cves          []string
+	Host          string
+	VendorIds     []string
+	Severities    []string
+	Category      string
+	PacketCapture string
+	Action        string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Description = s.Description
+	if s.Rules == nil {
+		o.Rules = nil
+	} else {
+		o.Rules = make([]Rule, 0, len(s.Rules))
+		for _, r := range s.Rules {
+			r.Cves = append([]string(nil), r.Cves...)
+			r.VendorIds = append([]string(nil), r.VendorIds...)
+			r.Severities = append([]string(nil), r.Severities...)
+			o.Rules = append(o.Rules, r)
+		}
+	}
+	o.ThreatExceptions = s.ThreatExceptions
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() []Entry
+	Names() []string
+}
+
+type container_v1 struct {
+	Answer []entry_v1 `xml:"entry"`
+}
+
+func (o *container_v1) Names() []string {
+	ans := make([]string, 0, len(o.Answer))
+	for i := range o.Answer {
+		ans = append(ans, o.Answer[i].Name)
+	}
+
+	return ans
+}
+
+func (o *container_v1) Normalize() []Entry {
+	arr := make([]Entry, 0, len(o.Answer))
+	for i := range o.Answer {
+		arr = append(arr, o.Answer[i].normalize())
+	}
+	return arr
+}
+
+func (o *entry_v1) normalize() Entry {
+	ans := Entry{
+		Name:             o.Name,
+		Description:      o.Description,
+		ThreatExceptions: util.EntToStr(o.ThreatExceptions),
+	}
+
+	if o.Rules != nil {
+		ans.Rules = make([]Rule, 0, len(o.Rules.Entries))
+		for _, v := range o.Rules.Entries {
+			ans.Rules = append(ans.Rules, Rule{
+				Name:          v.Name,
+				ThreatName:    v.ThreatName,
+				Cves:          util.MemToStr(v.Cves),
+				Host:          v.Host,
+				VendorIds:     util.MemToStr(v.VendorIds),
+				Severities:    util.MemToStr(v.Severities),
+				Category:      v.Category,
+				PacketCapture: v.PacketCapture,
+				Action:        v.Action.String(),
+			})
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName          xml.Name        `xml:"entry"`
+	Name             string          `xml:"name,attr"`
+	Description      string          `xml:"description,omitempty"`
+	Rules            *rules          `xml:"rules"`
+	ThreatExceptions *util.EntryType `xml:"threat-exception"`
+}
+
+type rules struct {
+	Entries []rule `xml:"entry"`
+}
+
+type rule struct {
+	Name          string           `xml:"name,attr"`
+	ThreatName    string           `xml:"threat-name,omitempty"`
+	Cves          *util.MemberType `xml:"cve"`
+	Host          string           `xml:"host,omitempty"`
+	VendorIds     *util.MemberType `xml:"vendor-id"`
+	Severities    *util.MemberType `xml:"severity"`
+	Category      string           `xml:"category,omitempty"`
+	PacketCapture string           `xml:"packet-capture,omitempty"`
+	Action        *actionType      `xml:"action"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:             e.Name,
+		Description:      e.Description,
+		ThreatExceptions: util.StrToEnt(e.ThreatExceptions),
+	}
+
+	if len(e.Rules) > 0 {
+		list := make([]rule, 0, len(e.Rules))
+		for _, v := range e.Rules {
+			list = append(list, rule{
+				Name:          v.Name,
+				ThreatName:    v.ThreatName,
+				Cves:          util.StrToMem(v.Cves),
+				Host:          v.Host,
+				VendorIds:     util.StrToMem(v.VendorIds),
+				Severities:    util.StrToMem(v.Severities),
+				Category:      v.Category,
+				PacketCapture: v.PacketCapture,
+				Action:        asActionType(v.Action),
+			})
+		}
+		ans.Rules = &rules{Entries: list}
+	}
+
+	return ans
+}
@@ -0,0 +1,44 @@
+package vulnerability
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type tc struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"basic", version.Number{8, 1, 0, ""}, Entry{
+			Name:        "t1",
+			Description: "foobar",
+		}},
+		{"rules", version.Number{8, 1, 0, ""}, Entry{
+			Name: "t2",
+			Rules: []Rule{
+				{
+					Name:          "critical",
+					ThreatName:    "any",
+					Cves:          []string{"any"},
+					Host:          HostAny,
+					VendorIds:     []string{"any"},
+					Severities:    []string{SeverityCritical, SeverityHigh},
+					Category:      "any",
+					PacketCapture: PacketCaptureSinglePacket,
+					Action:        ActionResetBoth,
+				},
+				{
+					Name:       "client",
+					ThreatName: "any",
+					Host:       HostClient,
+					Severities: []string{SeverityLow},
+					Action:     ActionDefault,
+				},
+			},
+			ThreatExceptions: []string{"30001"},
+		}},
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/xml"
 
 	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
 )
 
 // Entry is a normalized, version independent representation of a service
@@ -37,6 +38,21 @@ func (o *Entry) Copy(s Entry) {
 	o.OverrideTimeWaitTimeout = s.OverrideTimeWaitTimeout
 }
 
+// Audit returns an error if this object uses fields that the given PAN-OS
+// version does not support.
+func (o *Entry) Audit(v version.Number) error {
+	v81 := version.Number{8, 1, 0, ""}
+
+	a := util.NewAuditor(o.Name, v)
+	a.Since("Protocol="+ProtocolSctp, o.Protocol == ProtocolSctp, v81)
+	a.Since("OverrideSessionTimeout", o.OverrideSessionTimeout, v81)
+	a.Since("OverrideTimeout", o.OverrideTimeout != 0, v81)
+	a.Since("OverrideHalfClosedTimeout", o.OverrideHalfClosedTimeout != 0, v81)
+	a.Since("OverrideTimeWaitTimeout", o.OverrideTimeWaitTimeout != 0, v81)
+
+	return a.Err()
+}
+
 /** Structs / functions for normalization. **/
 
 type normalizer interface {
@@ -72,6 +72,12 @@ func (c *FwSrvc) Set(vsys string, e ...Entry) error {
 		return nil
 	}
 
+	for i := range e {
+		if err = e[i].Audit(c.con.Versioning()); err != nil {
+			return err
+		}
+	}
+
 	_, fn := c.versioning()
 	names := make([]string, len(e))
 
@@ -100,6 +106,10 @@ func (c *FwSrvc) Set(vsys string, e ...Entry) error {
 func (c *FwSrvc) Edit(vsys string, e Entry) error {
 	var err error
 
+	if err = e.Audit(c.con.Versioning()); err != nil {
+		return err
+	}
+
 	_, fn := c.versioning()
 
 	c.con.LogAction("(edit) service object %q", e.Name)
@@ -165,26 +175,12 @@ func (c *FwSrvc) details(fn util.Retriever, vsys, name string) ([]Entry, error)
 }
 
 func (c *FwSrvc) xpath(vsys string, vals []string) []string {
-	if vsys == "" {
-		vsys = "vsys1"
-	}
-
-	if vsys == "shared" {
-		return []string{
-			"config",
-			"shared",
-			"service",
-			util.AsEntryXpath(vals),
-		}
-	}
-
-	return []string{
-		"config",
-		"devices",
-		util.AsEntryXpath([]string{"localhost.localdomain"}),
-		"vsys",
-		util.AsEntryXpath([]string{vsys}),
+	ans := make([]string, 0, 7)
+	ans = append(ans, util.VsysXpathPrefix(vsys)...)
+	ans = append(ans,
 		"service",
 		util.AsEntryXpath(vals),
-	}
+	)
+
+	return ans
 }
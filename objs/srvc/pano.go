@@ -72,6 +72,12 @@ func (c *PanoSrvc) Set(dg string, e ...Entry) error {
 		return nil
 	}
 
+	for i := range e {
+		if err = e[i].Audit(c.con.Versioning()); err != nil {
+			return err
+		}
+	}
+
 	_, fn := c.versioning()
 	names := make([]string, len(e))
 
@@ -100,6 +106,10 @@ func (c *PanoSrvc) Set(dg string, e ...Entry) error {
 func (c *PanoSrvc) Edit(dg string, e Entry) error {
 	var err error
 
+	if err = e.Audit(c.con.Versioning()); err != nil {
+		return err
+	}
+
 	_, fn := c.versioning()
 
 	c.con.LogAction("(edit) service object %q", e.Name)
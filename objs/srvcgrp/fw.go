@@ -138,26 +138,12 @@ func (c *FwSrvcGrp) details(fn util.Retriever, vsys, name string) (Entry, error)
 }
 
 func (c *FwSrvcGrp) xpath(vsys string, vals []string) []string {
-	if vsys == "" {
-		vsys = "vsys1"
-	}
-
-	if vsys == "shared" {
-		return []string{
-			"config",
-			"shared",
-			"service-group",
-			util.AsEntryXpath(vals),
-		}
-	}
-
-	return []string{
-		"config",
-		"devices",
-		util.AsEntryXpath([]string{"localhost.localdomain"}),
-		"vsys",
-		util.AsEntryXpath([]string{vsys}),
+	ans := make([]string, 0, 7)
+	ans = append(ans, util.VsysXpathPrefix(vsys)...)
+	ans = append(ans,
 		"service-group",
 		util.AsEntryXpath(vals),
-	}
+	)
+
+	return ans
 }
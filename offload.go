@@ -0,0 +1,199 @@
+package pango
+
+import (
+	"encoding/xml"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// SessionInfo is the session table summary of the firewall, including if
+// sessions are being offloaded to hardware.
+type SessionInfo struct {
+	MaxSessions        int
+	ActiveSessions     int
+	ActiveTcp          int
+	ActiveUdp          int
+	ActiveIcmp         int
+	Cps                int
+	Pps                int
+	Kbps               int
+	HardwareOffload    bool
+	HardwareUdpOffload bool
+}
+
+// ResourceUtilization is the most recent utilization percentages of a single
+// dataplane processor.
+//
+// A packet descriptor utilization that stays high while overall throughput is
+// low is a common symptom of an elephant flow pinning a single core.
+type ResourceUtilization struct {
+	DataProcessor          string
+	Session                int
+	PacketBuffer           int
+	PacketDescriptor       int
+	PacketDescriptorOnChip int
+}
+
+// Counter is a single global counter.
+type Counter struct {
+	Id          int
+	Name        string
+	Category    string
+	Aspect      string
+	Severity    string
+	Description string
+	Value       int64
+	Rate        int64
+}
+
+// SessionInfo returns the session table summary.
+func (c *Firewall) SessionInfo() (SessionInfo, error) {
+	type req struct {
+		XMLName xml.Name `xml:"show"`
+		Cmd     string   `xml:"session>info"`
+	}
+
+	ans := sessionInfoResp{}
+
+	c.LogOp("(op) show session info")
+	if _, err := c.Op(req{}, "", nil, &ans); err != nil {
+		return SessionInfo{}, err
+	}
+
+	return ans.normalize(), nil
+}
+
+// DataplaneResources returns the most recent resource utilization of each
+// dataplane processor, sorted by processor name.
+func (c *Firewall) DataplaneResources() ([]ResourceUtilization, error) {
+	cmd := "<show><running><resource-monitor><second><last>1</last></second></resource-monitor></running></show>"
+	ans := resourceMonitorResp{}
+
+	c.LogOp("(op) show running resource-monitor second last 1")
+	if _, err := c.Op(cmd, "", nil, &ans); err != nil {
+		return nil, err
+	}
+
+	return ans.normalize(), nil
+}
+
+// OffloadCounters returns the global counters for the offload aspect, which
+// cover sessions and packets handled by offload hardware.
+func (c *Firewall) OffloadCounters() ([]Counter, error) {
+	cmd := "<show><counter><global><filter><aspect>offload</aspect></filter></global></counter></show>"
+	ans := countersResp{}
+
+	c.LogOp("(op) show counter global filter aspect offload")
+	if _, err := c.Op(cmd, "", nil, &ans); err != nil {
+		return nil, err
+	}
+
+	list := make([]Counter, 0, len(ans.Entries))
+	for _, e := range ans.Entries {
+		list = append(list, Counter{
+			Id:          e.Id,
+			Name:        e.Name,
+			Category:    e.Category,
+			Aspect:      e.Aspect,
+			Severity:    e.Severity,
+			Description: e.Description,
+			Value:       e.Value,
+			Rate:        e.Rate,
+		})
+	}
+
+	return list, nil
+}
+
+/** Internal structs **/
+
+type sessionInfoResp struct {
+	MaxSessions        int    `xml:"result>num-max"`
+	ActiveSessions     int    `xml:"result>num-active"`
+	ActiveTcp          int    `xml:"result>num-tcp"`
+	ActiveUdp          int    `xml:"result>num-udp"`
+	ActiveIcmp         int    `xml:"result>num-icmp"`
+	Cps                int    `xml:"result>cps"`
+	Pps                int    `xml:"result>pps"`
+	Kbps               int    `xml:"result>kbps"`
+	HardwareOffload    string `xml:"result>hw-offload"`
+	HardwareUdpOffload string `xml:"result>hw-udp-offload"`
+}
+
+func (o *sessionInfoResp) normalize() SessionInfo {
+	return SessionInfo{
+		MaxSessions:        o.MaxSessions,
+		ActiveSessions:     o.ActiveSessions,
+		ActiveTcp:          o.ActiveTcp,
+		ActiveUdp:          o.ActiveUdp,
+		ActiveIcmp:         o.ActiveIcmp,
+		Cps:                o.Cps,
+		Pps:                o.Pps,
+		Kbps:               o.Kbps,
+		HardwareOffload:    strings.EqualFold(o.HardwareOffload, "true"),
+		HardwareUdpOffload: strings.EqualFold(o.HardwareUdpOffload, "true"),
+	}
+}
+
+type resourceMonitorResp struct {
+	Processors dataProcessors `xml:"result>resource-monitor>data-processors"`
+}
+
+type dataProcessors struct {
+	List []dataProcessor `xml:",any"`
+}
+
+type dataProcessor struct {
+	XMLName xml.Name
+	Entries []resourceEntry `xml:"second>resource-utilization>entry"`
+}
+
+type resourceEntry struct {
+	Name  string `xml:"name"`
+	Value string `xml:"value"`
+}
+
+func (o *resourceMonitorResp) normalize() []ResourceUtilization {
+	ans := make([]ResourceUtilization, 0, len(o.Processors.List))
+
+	for _, dp := range o.Processors.List {
+		ru := ResourceUtilization{DataProcessor: dp.XMLName.Local}
+		for _, e := range dp.Entries {
+			// Values are a comma separated list, most recent first.
+			val, _ := strconv.Atoi(strings.TrimSpace(strings.Split(e.Value, ",")[0]))
+			switch e.Name {
+			case "session":
+				ru.Session = val
+			case "packet buffer":
+				ru.PacketBuffer = val
+			case "packet descriptor":
+				ru.PacketDescriptor = val
+			case "packet descriptor (on-chip)":
+				ru.PacketDescriptorOnChip = val
+			}
+		}
+		ans = append(ans, ru)
+	}
+
+	sort.Slice(ans, func(i, j int) bool {
+		return ans[i].DataProcessor < ans[j].DataProcessor
+	})
+
+	return ans
+}
+
+type countersResp struct {
+	Entries []counterEntry `xml:"result>global>counters>entry"`
+}
+
+type counterEntry struct {
+	Id          int    `xml:"id"`
+	Name        string `xml:"name"`
+	Category    string `xml:"category"`
+	Aspect      string `xml:"aspect"`
+	Severity    string `xml:"severity"`
+	Description string `xml:"desc"`
+	Value       int64  `xml:"value"`
+	Rate        int64  `xml:"rate"`
+}
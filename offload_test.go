@@ -0,0 +1,77 @@
+package pango
+
+import (
+	"testing"
+)
+
+func TestSessionInfo(t *testing.T) {
+	fw := &Firewall{Client: Client{
+		rb: [][]byte{
+			[]byte(`<response status="success"><result><num-max>262142</num-max><num-active>1200</num-active><num-tcp>1000</num-tcp><num-udp>190</num-udp><num-icmp>10</num-icmp><cps>35</cps><pps>4200</pps><kbps>51234</kbps><hw-offload>True</hw-offload><hw-udp-offload>False</hw-udp-offload></result></response>`),
+		},
+	}}
+	if err := fw.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	ans, err := fw.SessionInfo()
+	if err != nil {
+		t.Fatalf("SessionInfo failed: %s", err)
+	}
+	if ans.MaxSessions != 262142 || ans.ActiveSessions != 1200 || ans.Kbps != 51234 {
+		t.Errorf("Bad counts: %#v", ans)
+	}
+	if !ans.HardwareOffload || ans.HardwareUdpOffload {
+		t.Errorf("Bad offload flags: %#v", ans)
+	}
+}
+
+func TestDataplaneResources(t *testing.T) {
+	fw := &Firewall{Client: Client{
+		rb: [][]byte{
+			[]byte(`<response status="success"><result><resource-monitor><data-processors>
+<dp1><second><resource-utilization><entry><name>session</name><value>3</value></entry><entry><name>packet descriptor</name><value>95</value></entry></resource-utilization></second></dp1>
+<dp0><second><resource-utilization><entry><name>session</name><value>2</value></entry><entry><name>packet buffer</name><value>1</value></entry><entry><name>packet descriptor</name><value>4</value></entry><entry><name>packet descriptor (on-chip)</name><value>7,8</value></entry></resource-utilization></second></dp0>
+</data-processors></resource-monitor></result></response>`),
+		},
+	}}
+	if err := fw.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	ans, err := fw.DataplaneResources()
+	if err != nil {
+		t.Fatalf("DataplaneResources failed: %s", err)
+	}
+	if len(ans) != 2 {
+		t.Fatalf("Expected 2 processors, got %#v", ans)
+	}
+	if ans[0].DataProcessor != "dp0" || ans[0].PacketDescriptor != 4 || ans[0].PacketDescriptorOnChip != 7 {
+		t.Errorf("Bad dp0: %#v", ans[0])
+	}
+	if ans[1].DataProcessor != "dp1" || ans[1].PacketDescriptor != 95 {
+		t.Errorf("Bad dp1: %#v", ans[1])
+	}
+}
+
+func TestOffloadCounters(t *testing.T) {
+	fw := &Firewall{Client: Client{
+		rb: [][]byte{
+			[]byte(`<response status="success"><result><global><counters><entry><category>flow</category><severity>info</severity><value>1234</value><rate>12</rate><aspect>offload</aspect><desc>Sessions offloaded</desc><id>2001</id><name>flow_offload_session</name></entry></counters></global></result></response>`),
+		},
+	}}
+	if err := fw.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	ans, err := fw.OffloadCounters()
+	if err != nil {
+		t.Fatalf("OffloadCounters failed: %s", err)
+	}
+	if len(ans) != 1 || ans[0].Name != "flow_offload_session" || ans[0].Value != 1234 || ans[0].Rate != 12 {
+		t.Errorf("Bad counters: %#v", ans)
+	}
+	if len(fw.rp) != 1 || fw.rp[0].Get("cmd") != "<show><counter><global><filter><aspect>offload</aspect></filter></global></counter></show>" {
+		t.Errorf("Bad request: %v", fw.rp)
+	}
+}
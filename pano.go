@@ -10,11 +10,14 @@ import (
 
 	// Various namespace imports.
 	"github.com/PaloAltoNetworks/pango/dev"
+	"github.com/PaloAltoNetworks/pango/jobs"
 	"github.com/PaloAltoNetworks/pango/licen"
 	"github.com/PaloAltoNetworks/pango/netw"
 	"github.com/PaloAltoNetworks/pango/objs"
 	"github.com/PaloAltoNetworks/pango/pnrm"
+	"github.com/PaloAltoNetworks/pango/pnrm/accessdomain"
 	"github.com/PaloAltoNetworks/pango/poli"
+	"github.com/PaloAltoNetworks/pango/predefined"
 	"github.com/PaloAltoNetworks/pango/userid"
 )
 
@@ -25,23 +28,29 @@ import (
 // It has the following namespaces:
 //      * Licensing
 //      * UserId
+//      * Predefined
+//      * Jobs
 type Panorama struct {
 	Client
 
 	// Namespaces
-	Device    *dev.PanoDev
-	Licensing *licen.Licen
-	UserId    *userid.UserId
-	Panorama  *pnrm.Pnrm
-	Objects   *objs.PanoObjs
-	Policies  *poli.PanoPoli
-	Network   *netw.PanoNetw
+	Device     *dev.PanoDev
+	Licensing  *licen.Licen
+	UserId     *userid.UserId
+	Panorama   *pnrm.Pnrm
+	Objects    *objs.PanoObjs
+	Policies   *poli.PanoPoli
+	Network    *netw.PanoNetw
+	Predefined *predefined.Predefined
+	Jobs       *jobs.Jobs
+
+	accessDomain *accessdomain.Entry
 }
 
 // Initialize does some initial setup of the Panorama connection, retrieves
 // the API key if it was not already present, then performs "show system
 // info" to get the PAN-OS version.  The full results are saved into the
-// client's SystemInfo map.
+// client's SystemInfo map, and the typed form into the client's Info.
 //
 // If not specified, the following is assumed:
 //  * Protocol: https
@@ -123,7 +132,9 @@ func (c *Panorama) CreateVmAuthKey(hours int) (VmAuthKey, error) {
 		AuthKey: tokens[3],
 		Expiry:  strings.Join(tokens[7:], " "),
 	}
-	key.ParseExpires(clock)
+	if err = key.ParseExpires(clock); err != nil {
+		c.LogOp("(op) Failed to parse vm auth key expiry: %s", err)
+	}
 
 	return key, nil
 }
@@ -153,12 +164,49 @@ func (c *Panorama) GetVmAuthKeys() ([]VmAuthKey, error) {
 	}
 
 	for i := range ans.List {
-		ans.List[i].ParseExpires(clock)
+		if err = ans.List[i].ParseExpires(clock); err != nil {
+			c.LogOp("(op) Failed to parse vm auth key expiry: %s", err)
+		}
 	}
 
 	return ans.List, nil
 }
 
+// RevokeVmAuthKey revokes the given VM auth key, so it can no longer be used
+// to bootstrap a VM-Series firewall.
+func (c *Panorama) RevokeVmAuthKey(key string) error {
+	type rv_req struct {
+		XMLName xml.Name `xml:"request"`
+		Key     string   `xml:"bootstrap>vm-auth-key>revoke>vm-auth-key"`
+	}
+
+	c.LogOp("(op) revoking vm auth code: ********")
+	_, err := c.Op(rv_req{Key: key}, "", nil, nil)
+	return err
+}
+
+// GetVmAuthKeysExpiringBefore gets the list of VM auth keys that expire
+// before the given time, including keys that have already expired.
+//
+// PAN-OS does not support filtering VM auth keys itself, so all keys are
+// retrieved and then filtered.  Keys whose expiration could not be parsed are
+// not included.
+func (c *Panorama) GetVmAuthKeysExpiringBefore(t time.Time) ([]VmAuthKey, error) {
+	list, err := c.GetVmAuthKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	var ans []VmAuthKey
+	for _, key := range list {
+		if !key.Expires.IsZero() && key.Expires.Before(t) {
+			ans = append(ans, key)
+		}
+	}
+
+	return ans, nil
+}
+
 /** Public structs **/
 
 // VmAuthKey is a VM auth key paired with when it expires.
@@ -177,15 +225,24 @@ type VmAuthKey struct {
 // the current PAN-OS time is retrieved, which does contain timezone
 // information.  Then in the string parsing for Expires, the location
 // information of the system clock is applied.
-func (o *VmAuthKey) ParseExpires(clock time.Time) {
-	format := "2006/01/02 15:04:05"
+//
+// Expires is left unchanged if Expiry can't be parsed.
+func (o *VmAuthKey) ParseExpires(clock time.Time) error {
+	t, err := util.ParseTime(util.TimeFormat, o.Expiry, clock.Location())
+	if err != nil {
+		return err
+	}
 
-	if t, err := time.ParseInLocation(format, o.Expiry, clock.Location()); err == nil {
+	if !t.IsZero() {
 		o.Expires = t
 	}
+	return nil
 }
 
-// DeviceGroupHeirarchy returns a map where the
+// DeviceGroupHierarchy returns a map where the key is a device group and the
+// value is its parent, or an empty string for top level device groups.
+//
+// Use DeviceGroupTree to get the hierarchy as a tree instead.
 func (c *Panorama) DeviceGroupHierarchy() (map[string]string, error) {
 	type dghReq struct {
 		XMLName xml.Name `xml:"show"`
@@ -237,6 +294,58 @@ func (c *Panorama) AssignDeviceGroupParent(child, parent string) error {
 	return c.WaitForJob(ans.Id, 0, nil)
 }
 
+// CloneDeviceGroup creates device group dst as a copy of device group src.
+//
+// All objects, profiles, rules, and other settings of the source device group
+// are copied, but the devices are not, as a device can only belong to a
+// single device group.  The copied rules are given new UUIDs by PAN-OS.
+//
+// If parent is an empty string, then the new device group is placed under
+// the same parent as src.  To place the new device group at the top level,
+// specify a parent of "shared".
+func (c *Panorama) CloneDeviceGroup(src, dst, parent string) error {
+	if src == dst {
+		return fmt.Errorf("Can't clone device group %q onto itself", src)
+	}
+
+	list, err := c.Panorama.DeviceGroup.GetList()
+	if err != nil {
+		return err
+	}
+	for _, name := range list {
+		if name == dst {
+			return fmt.Errorf("Device group %q already exists", dst)
+		}
+	}
+
+	if parent == "" {
+		hier, err := c.DeviceGroupHierarchy()
+		if err != nil {
+			return err
+		}
+		parent = hier[src]
+	} else if parent == "shared" {
+		parent = ""
+	}
+
+	o, err := c.Panorama.DeviceGroup.Get(src)
+	if err != nil {
+		return err
+	}
+	o = o.Clone(dst)
+
+	c.LogAction("(clone) device group %q to %q", src, dst)
+	if err = c.Panorama.DeviceGroup.Set(o); err != nil {
+		return err
+	}
+
+	if parent == "" {
+		return nil
+	}
+
+	return c.AssignDeviceGroupParent(dst, parent)
+}
+
 /** Private functions **/
 
 func (c *Panorama) initNamespaces() {
@@ -260,6 +369,12 @@ func (c *Panorama) initNamespaces() {
 
 	c.Network = &netw.PanoNetw{}
 	c.Network.Initialize(c)
+
+	c.Predefined = &predefined.Predefined{}
+	c.Predefined.Initialize(c)
+
+	c.Jobs = &jobs.Jobs{}
+	c.Jobs.Initialize(c)
 }
 
 type dghResp struct {
@@ -0,0 +1,99 @@
+package pango
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCloneDeviceGroup(t *testing.T) {
+	pano := &Panorama{Client: Client{
+		rb: [][]byte{
+			[]byte(`<response status="success"><result><entry name="golden" /><entry name="other" /></result></response>`),
+			[]byte(`<response status="success"><result><dg-hierarchy><dg name="root"><dg name="golden" /></dg></dg-hierarchy></result></response>`),
+			[]byte(`<response status="success"><result><entry name="golden"><description>gold</description><devices><entry name="0123" /></devices><address><entry name="a1"><ip-netmask>10.1.1.1</ip-netmask></entry></address><pre-rulebase><security><rules><entry name="r1" uuid="11111111-2222-3333-4444-555555555555"><action>allow</action></entry></rules></security></pre-rulebase></entry></result></response>`),
+			[]byte(`<response status="success" code="20"><msg>command succeeded</msg></response>`),
+			[]byte(`<response status="success"><result><job>7</job></result></response>`),
+			[]byte(`<response status="success"><result><job><id>7</id><result>OK</result><progress>100</progress></job></result></response>`),
+		},
+	}}
+	if err := pano.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	if err := pano.CloneDeviceGroup("golden", "copy", ""); err != nil {
+		t.Fatalf("Clone failed: %s", err)
+	}
+
+	if len(pano.rp) != 6 {
+		t.Fatalf("Expected 6 requests, got %d", len(pano.rp))
+	}
+
+	set := pano.rp[3]
+	if set.Get("action") != "set" {
+		t.Errorf("Expected set, got %q", set.Get("action"))
+	}
+	elm := set.Get("element")
+	if !strings.Contains(elm, `name="copy"`) {
+		t.Errorf("Clone name not in element: %s", elm)
+	} else if strings.Contains(elm, "0123") {
+		t.Errorf("Devices were copied: %s", elm)
+	} else if !strings.Contains(elm, "10.1.1.1") {
+		t.Errorf("Objects were not copied: %s", elm)
+	} else if !strings.Contains(elm, `<entry name="r1">`) {
+		t.Errorf("Rules were not copied without their uuid: %s", elm)
+	}
+
+	if cmd := pano.rp[4].Get("cmd"); !strings.Contains(cmd, "<new-parent-dg>root</new-parent-dg>") {
+		t.Errorf("Clone not moved under the source's parent: %s", cmd)
+	}
+}
+
+func TestCloneDeviceGroupExists(t *testing.T) {
+	pano := &Panorama{Client: Client{
+		rb: [][]byte{
+			[]byte(`<response status="success"><result><entry name="golden" /><entry name="copy" /></result></response>`),
+		},
+	}}
+	if err := pano.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	if err := pano.CloneDeviceGroup("golden", "copy", ""); err == nil {
+		t.Errorf("Clone onto existing device group did not error")
+	}
+}
+
+func TestVmAuthKeyHygiene(t *testing.T) {
+	pano := &Panorama{Client: Client{
+		rb: [][]byte{
+			[]byte(`<response status="success"><result>Wed Jan  1 12:00:00 UTC 2020
+</result></response>`),
+			[]byte(`<response status="success"><result><bootstrap-vm-auth-keys>
+<entry><vm-auth-key>111</vm-auth-key><expiry-time>2019/12/31 12:00:00</expiry-time></entry>
+<entry><vm-auth-key>222</vm-auth-key><expiry-time>2020/01/01 18:00:00</expiry-time></entry>
+<entry><vm-auth-key>333</vm-auth-key><expiry-time>2020/02/01 12:00:00</expiry-time></entry>
+</bootstrap-vm-auth-keys></result></response>`),
+			[]byte(`<response status="success"><result>VM auth key 111 revoked</result></response>`),
+		},
+	}}
+	if err := pano.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	cutoff := time.Date(2020, time.January, 2, 0, 0, 0, 0, time.UTC)
+	list, err := pano.GetVmAuthKeysExpiringBefore(cutoff)
+	if err != nil {
+		t.Fatalf("GetVmAuthKeysExpiringBefore failed: %s", err)
+	}
+	if len(list) != 2 || list[0].AuthKey != "111" || list[1].AuthKey != "222" {
+		t.Fatalf("Bad expiring keys: %#v", list)
+	}
+
+	if err = pano.RevokeVmAuthKey(list[0].AuthKey); err != nil {
+		t.Fatalf("RevokeVmAuthKey failed: %s", err)
+	}
+	if cmd := pano.rp[2].Get("cmd"); cmd != "<request><bootstrap><vm-auth-key><revoke><vm-auth-key>111</vm-auth-key></revoke></vm-auth-key></bootstrap></request>" {
+		t.Errorf("Bad revoke cmd: %s", cmd)
+	}
+}
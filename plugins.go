@@ -0,0 +1,186 @@
+package pango
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PluginReleaseDateFormat is the time format of plugin release dates.
+const PluginReleaseDateFormat = "2006-01-02"
+
+// PluginPackage is a plugin package known to PAN-OS, whether or not it has
+// been downloaded or installed.
+//
+// ReleasedAt is the parsed form of ReleaseDate.
+//
+// The Installed and Downloaded fields are as returned by PAN-OS, which is
+// usually "yes" or "no".
+type PluginPackage struct {
+	Name           string
+	Version        string
+	ReleaseDate    string
+	ReleasedAt     time.Time
+	ReleaseNoteUrl string
+	PackageFile    string
+	Size           string
+	Platform       string
+	Installed      string
+	Downloaded     string
+}
+
+// PluginPackages returns the plugin packages PAN-OS knows about.
+//
+// The list of available packages is only refreshed by CheckPlugins.
+func (c *Client) PluginPackages() ([]PluginPackage, error) {
+	c.LogOp("(op) show plugins packages")
+	return c.pluginPackages()
+}
+
+// CheckPlugins has PAN-OS check for available plugin packages, then returns
+// all known plugin packages.
+func (c *Client) CheckPlugins() ([]PluginPackage, error) {
+	type req struct {
+		XMLName xml.Name `xml:"request"`
+		Cmd     string   `xml:"plugins>check"`
+	}
+
+	c.LogOp("(op) request plugins check")
+	if _, err := c.Op(req{}, "", nil, nil); err != nil {
+		return nil, err
+	}
+
+	return c.PluginPackages()
+}
+
+// DownloadPlugin downloads the given plugin package file, blocking until the
+// download has completed.
+func (c *Client) DownloadPlugin(file string) error {
+	type req struct {
+		XMLName xml.Name `xml:"request"`
+		File    string   `xml:"plugins>download>file"`
+	}
+
+	c.LogOp("(op) request plugins download file %s", file)
+	return c.pluginJob(req{File: file})
+}
+
+// InstallPlugin installs the given plugin package file, which must already
+// be downloaded, blocking until the install has completed.
+//
+// The client's Plugin info is refreshed afterwards.
+func (c *Client) InstallPlugin(file string) error {
+	type req struct {
+		XMLName xml.Name `xml:"request"`
+		File    string   `xml:"plugins>install"`
+	}
+
+	c.LogOp("(op) request plugins install %s", file)
+	if err := c.pluginJob(req{File: file}); err != nil {
+		return err
+	}
+
+	c.initPlugins()
+	return nil
+}
+
+// RemovePlugin uninstalls the given plugin, blocking until the uninstall has
+// completed.
+//
+// The client's Plugin info is refreshed afterwards.
+func (c *Client) RemovePlugin(name string) error {
+	type req struct {
+		XMLName xml.Name `xml:"request"`
+		Name    string   `xml:"plugins>uninstall"`
+	}
+
+	c.LogOp("(op) request plugins uninstall %s", name)
+	if err := c.pluginJob(req{Name: name}); err != nil {
+		return err
+	}
+
+	c.initPlugins()
+	return nil
+}
+
+/** Private functions **/
+
+func (c *Client) pluginPackages() ([]PluginPackage, error) {
+	type req struct {
+		XMLName xml.Name `xml:"show"`
+		Cmd     string   `xml:"plugins>packages"`
+	}
+
+	type relNote struct {
+		ReleaseNoteUrl string `xml:",cdata"`
+	}
+
+	type pkgInfo struct {
+		Name        string  `xml:"name"`
+		Version     string  `xml:"version"`
+		ReleaseDate string  `xml:"release-date"`
+		RelNote     relNote `xml:"release-note-url"`
+		PackageFile string  `xml:"pkg-file"`
+		Size        string  `xml:"size"`
+		Platform    string  `xml:"platform"`
+		Installed   string  `xml:"installed"`
+		Downloaded  string  `xml:"downloaded"`
+	}
+
+	type resp struct {
+		Answer []pkgInfo `xml:"result>plugins>entry"`
+	}
+
+	ans := resp{}
+	if _, err := c.Op(req{}, "", nil, &ans); err != nil {
+		return nil, err
+	}
+
+	list := make([]PluginPackage, 0, len(ans.Answer))
+	for _, data := range ans.Answer {
+		released, err := parseReleaseDate(data.ReleaseDate)
+		if err != nil {
+			return nil, fmt.Errorf("Bad release date for %s-%s: %s", data.Name, data.Version, err)
+		}
+		list = append(list, PluginPackage{
+			Name:           data.Name,
+			Version:        data.Version,
+			ReleaseDate:    data.ReleaseDate,
+			ReleasedAt:     released,
+			ReleaseNoteUrl: data.RelNote.ReleaseNoteUrl,
+			PackageFile:    data.PackageFile,
+			Size:           data.Size,
+			Platform:       data.Platform,
+			Installed:      data.Installed,
+			Downloaded:     data.Downloaded,
+		})
+	}
+
+	return list, nil
+}
+
+func (c *Client) pluginJob(req interface{}) error {
+	ans := util.JobResponse{}
+
+	if _, err := c.Op(req, "", nil, &ans); err != nil {
+		return err
+	} else if ans.Id == 0 {
+		return nil
+	}
+
+	return c.WaitForJob(ans.Id, 0, nil)
+}
+
+// parseReleaseDate parses a plugin release date, which some PAN-OS versions
+// follow with the time of day.
+func parseReleaseDate(v string) (time.Time, error) {
+	v = strings.TrimSpace(v)
+	if len(v) > len(PluginReleaseDateFormat) {
+		v = v[:len(PluginReleaseDateFormat)]
+	}
+
+	return util.ParseTime(PluginReleaseDateFormat, v, nil)
+}
@@ -0,0 +1,55 @@
+package pango
+
+import (
+	"testing"
+	"time"
+)
+
+const pluginsXml = `<response status="success"><result><plugins>
+<entry><name>aws</name><version>2.0.1</version><release-date>2021-01-01</release-date><release-note-url><![CDATA[https://example.com/notes]]></release-note-url><pkg-file>aws-2.0.1</pkg-file><size>10M</size><platform>any</platform><installed>yes</installed><downloaded>yes</downloaded></entry>
+<entry><name>aws</name><version>2.0.2</version><pkg-file>aws-2.0.2</pkg-file><installed>no</installed><downloaded>no</downloaded></entry>
+</plugins></result></response>`
+
+func TestInstallPlugin(t *testing.T) {
+	pano := &Panorama{Client: Client{
+		rb: [][]byte{
+			[]byte(`<response status="success"><result><job>4</job></result></response>`),
+			[]byte(`<response status="success"><result><job><id>4</id><type>PluginInstall</type><status>FIN</status><result>OK</result><progress>100</progress></job></result></response>`),
+			[]byte(pluginsXml),
+			[]byte(pluginsXml),
+		},
+	}}
+	if err := pano.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	if err := pano.InstallPlugin("aws-2.0.1"); err != nil {
+		t.Fatalf("InstallPlugin failed: %s", err)
+	}
+
+	if len(pano.rp) != 3 {
+		t.Fatalf("Expected 3 requests, got %d", len(pano.rp))
+	}
+	expected := "<request><plugins><install>aws-2.0.1</install></plugins></request>"
+	if cmd := pano.rp[0].Get("cmd"); cmd != expected {
+		t.Errorf("Expected cmd %q, got %q", expected, cmd)
+	}
+
+	if len(pano.Plugin) != 2 {
+		t.Fatalf("Plugin info not refreshed: %#v", pano.Plugin)
+	}
+	if pano.Plugin[0]["installed"] != "yes" || pano.Plugin[0]["release-note-url"] != "https://example.com/notes" {
+		t.Errorf("Bad plugin info: %#v", pano.Plugin[0])
+	}
+
+	list, err := pano.PluginPackages()
+	if err != nil {
+		t.Fatalf("PluginPackages failed: %s", err)
+	}
+	if len(list) != 2 || list[1].Version != "2.0.2" || list[1].PackageFile != "aws-2.0.2" {
+		t.Errorf("Bad plugin packages: %#v", list)
+	}
+	if len(list) == 2 && (!list[0].ReleasedAt.Equal(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)) || !list[1].ReleasedAt.IsZero()) {
+		t.Errorf("Bad release dates: %s and %s", list[0].ReleasedAt, list[1].ReleasedAt)
+	}
+}
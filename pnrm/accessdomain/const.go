@@ -0,0 +1,8 @@
+package accessdomain
+
+// Valid values for SharedAccess.
+const (
+	SharedAccessNone  = "none"
+	SharedAccessRead  = "read"
+	SharedAccessWrite = "write"
+)
@@ -0,0 +1,7 @@
+// Package accessdomain is the client.Panorama.AccessDomain namespace.
+//
+// Access domains limit the device groups, templates, devices, and shared
+// config that delegated administrators have access to.
+//
+// Normalized object:  Entry
+package accessdomain
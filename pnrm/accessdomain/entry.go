@@ -0,0 +1,73 @@
+package accessdomain
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an access
+// domain.
+//
+// Templates includes both templates and template stacks, while Devices is a
+// list of the serial numbers of the devices whose device context can be
+// accessed.
+type Entry struct {
+	Name         string
+	SharedAccess string
+	DeviceGroups []string
+	Templates    []string
+	Devices      []string
+}
+
+// Copy copies the information from source's Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.SharedAccess = s.SharedAccess
+	o.DeviceGroups = s.DeviceGroups
+	o.Templates = s.Templates
+	o.Devices = s.Devices
+}
+
+/** Structs / functions for normalization. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:         o.Answer.Name,
+		SharedAccess: o.Answer.SharedAccess,
+		DeviceGroups: util.MemToStr(o.Answer.DeviceGroups),
+		Templates:    util.MemToStr(o.Answer.Templates),
+		Devices:      util.MemToStr(o.Answer.Devices),
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName      xml.Name         `xml:"entry"`
+	Name         string           `xml:"name,attr"`
+	SharedAccess string           `xml:"shared-access,omitempty"`
+	DeviceGroups *util.MemberType `xml:"device-groups"`
+	Templates    *util.MemberType `xml:"templates"`
+	Devices      *util.MemberType `xml:"devices"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:         e.Name,
+		SharedAccess: e.SharedAccess,
+		DeviceGroups: util.StrToMem(e.DeviceGroups),
+		Templates:    util.StrToMem(e.Templates),
+		Devices:      util.StrToMem(e.Devices),
+	}
+
+	return ans
+}
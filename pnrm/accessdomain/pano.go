@@ -0,0 +1,147 @@
+package accessdomain
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// AccessDomain is the client.Panorama.AccessDomain namespace.
+type AccessDomain struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *AccessDomain) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// ShowList performs SHOW to retrieve a list of access domains.
+func (c *AccessDomain) ShowList() ([]string, error) {
+	c.con.LogQuery("(show) list of access domains")
+	path := c.xpath(nil)
+	return c.con.EntryListUsing(c.con.Show, path[:len(path)-1])
+}
+
+// GetList performs GET to retrieve a list of access domains.
+func (c *AccessDomain) GetList() ([]string, error) {
+	c.con.LogQuery("(get) list of access domains")
+	path := c.xpath(nil)
+	return c.con.EntryListUsing(c.con.Get, path[:len(path)-1])
+}
+
+// Get performs GET to retrieve information for the given access domain.
+func (c *AccessDomain) Get(name string) (Entry, error) {
+	c.con.LogQuery("(get) access domain %q", name)
+	return c.details(c.con.Get, name)
+}
+
+// Show performs SHOW to retrieve information for the given access domain.
+func (c *AccessDomain) Show(name string) (Entry, error) {
+	c.con.LogQuery("(show) access domain %q", name)
+	return c.details(c.con.Show, name)
+}
+
+// Set performs SET to create / update one or more access domains.
+func (c *AccessDomain) Set(e ...Entry) error {
+	var err error
+
+	if len(e) == 0 {
+		return nil
+	}
+
+	_, fn := c.versioning()
+	names := make([]string, len(e))
+
+	// Build up the struct with the given configs.
+	d := util.BulkElement{XMLName: xml.Name{Local: "access-domain"}}
+	for i := range e {
+		d.Data = append(d.Data, fn(e[i]))
+		names[i] = e[i].Name
+	}
+	c.con.LogAction("(set) access domains: %v", names)
+
+	// Set xpath.
+	path := c.xpath(names)
+	if len(e) == 1 {
+		path = path[:len(path)-1]
+	} else {
+		path = path[:len(path)-2]
+	}
+
+	// Create the access domains.
+	_, err = c.con.Set(path, d.Config(), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to create / update a access domain.
+func (c *AccessDomain) Edit(e Entry) error {
+	var err error
+
+	_, fn := c.versioning()
+
+	c.con.LogAction("(edit) access domain %q", e.Name)
+
+	// Set xpath.
+	path := c.xpath([]string{e.Name})
+
+	// Edit the access domain.
+	_, err = c.con.Edit(path, fn(e), nil, nil)
+	return err
+}
+
+// Delete removes the given access domains from Panorama.
+//
+// Objects can be a string or an Entry object.
+func (c *AccessDomain) Delete(e ...interface{}) error {
+	var err error
+
+	if len(e) == 0 {
+		return nil
+	}
+
+	names := make([]string, len(e))
+	for i := range e {
+		switch v := e[i].(type) {
+		case string:
+			names[i] = v
+		case Entry:
+			names[i] = v.Name
+		default:
+			return fmt.Errorf("Unknown type sent to delete: %s", v)
+		}
+	}
+	c.con.LogAction("(delete) access domains: %v", names)
+
+	// Remove the access domains.
+	path := c.xpath(names)
+	_, err = c.con.Delete(path, nil, nil)
+	return err
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *AccessDomain) versioning() (normalizer, func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *AccessDomain) details(fn util.Retriever, name string) (Entry, error) {
+	path := c.xpath([]string{name})
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Entry{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *AccessDomain) xpath(vals []string) []string {
+	return []string{
+		"config",
+		"mgt-config",
+		"access-domain",
+		util.AsEntryXpath(vals),
+	}
+}
@@ -0,0 +1,53 @@
+package accessdomain
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestNormalization(t *testing.T) {
+	testCases := []struct {
+		desc string
+		conf Entry
+	}{
+		{"read only shared", Entry{
+			Name:         "one",
+			SharedAccess: SharedAccessRead,
+			DeviceGroups: []string{"dg1", "dg2"},
+		}},
+		{"templates and devices", Entry{
+			Name:         "two",
+			SharedAccess: SharedAccessNone,
+			Templates:    []string{"t1", "ts1"},
+			Devices:      []string{"001234"},
+		}},
+	}
+
+	mc := &testdata.MockClient{}
+	ns := &AccessDomain{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("")
+			err := ns.Set(tc.conf)
+			if err != nil {
+				t.Errorf("Error in set: %s", err)
+			} else {
+				if mc.Path != "/config/mgt-config/access-domain" {
+					t.Errorf("Bad path: %s", mc.Path)
+				}
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get(tc.conf.Name)
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				} else if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
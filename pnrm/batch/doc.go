@@ -0,0 +1,23 @@
+/*
+Package batch is the client.Panorama.Batch namespace.
+
+This namespace wraps the Panorama "request batch" operations, which deploy
+content, software, and licenses to a list of managed devices as a single
+Panorama job.  Each operation returns the job ID, and Wait then collects the
+result of each device in the job:
+
+	id, err := pano.Panorama.Batch.InstallSoftware("PanOS_vm-9.1.3", "0001", "0002")
+	if err != nil {
+	    return err
+	}
+	res, err := pano.Panorama.Batch.Wait(id, 5*time.Second, time.Hour)
+	if err != nil {
+	    return err
+	}
+	for _, d := range res.Failed() {
+	    log.Printf("%s failed: %v", d.Serial, d.Errors)
+	}
+
+Normalized object: Result
+*/
+package batch
@@ -0,0 +1,51 @@
+package batch
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PaloAltoNetworks/pango/jobs"
+)
+
+// Result is the outcome of a batch job, along with the result of each
+// device in the job.
+type Result struct {
+	Job     jobs.Job
+	Devices []jobs.Device
+}
+
+// Failed returns the devices whose part of the batch job did not succeed.
+func (o Result) Failed() []jobs.Device {
+	var ans []jobs.Device
+
+	for _, d := range o.Devices {
+		if d.Result != "OK" {
+			ans = append(ans, d)
+		}
+	}
+
+	return ans
+}
+
+// Err returns an error summarizing the failed devices, or nil if the job and
+// all devices succeeded.
+func (o Result) Err() error {
+	failed := o.Failed()
+	if len(failed) == 0 {
+		if o.Job.Result != "" && o.Job.Result != "OK" {
+			return fmt.Errorf("Job %d failed: %s", o.Job.Id, strings.Join(o.Job.Details, " | "))
+		}
+		return nil
+	}
+
+	msgs := make([]string, 0, len(failed))
+	for _, d := range failed {
+		msg := d.Result
+		if len(d.Errors) > 0 {
+			msg = strings.Join(d.Errors, " | ")
+		}
+		msgs = append(msgs, fmt.Sprintf("%s: %s", d.Serial, msg))
+	}
+
+	return fmt.Errorf("Job %d failed on %d device(s): %s", o.Job.Id, len(failed), strings.Join(msgs, "; "))
+}
@@ -0,0 +1,128 @@
+package batch
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/jobs"
+	"github.com/PaloAltoNetworks/pango/licen"
+	"github.com/PaloAltoNetworks/pango/pnrm/content"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Batch is the client.Panorama.Batch namespace.
+type Batch struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *Batch) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// InstallContent starts installing the given content update file, which
+// must already be downloaded to Panorama, on the given managed devices.
+//
+// The kind param is one of the content.Kind* constants.  This is the same
+// as client.Panorama.Content.Push.
+func (c *Batch) InstallContent(kind, filename string, serials ...string) (uint, error) {
+	ns := &content.Content{}
+	ns.Initialize(c.con)
+
+	return ns.Push(kind, filename, serials...)
+}
+
+// UploadSoftware starts copying the given software image, which must
+// already be downloaded to Panorama, to the given managed devices without
+// installing it.
+func (c *Batch) UploadSoftware(filename string, serials ...string) (uint, error) {
+	type req struct {
+		XMLName  xml.Name         `xml:"request"`
+		Filename string           `xml:"batch>software>upload>file"`
+		Devices  *util.MemberType `xml:"batch>software>upload>devices"`
+	}
+
+	c.con.LogOp("(op) request batch software upload file %s devices %v", filename, serials)
+	return c.job(serials, req{Filename: filename, Devices: util.StrToMem(serials)})
+}
+
+// InstallSoftware starts installing the given software image, which must
+// already be downloaded to Panorama, on the given managed devices.
+//
+// The devices are not rebooted.
+func (c *Batch) InstallSoftware(filename string, serials ...string) (uint, error) {
+	type req struct {
+		XMLName  xml.Name         `xml:"request"`
+		Filename string           `xml:"batch>software>install>file"`
+		Devices  *util.MemberType `xml:"batch>software>install>devices"`
+	}
+
+	c.con.LogOp("(op) request batch software install file %s devices %v", filename, serials)
+	return c.job(serials, req{Filename: filename, Devices: util.StrToMem(serials)})
+}
+
+// ActivateLicense starts activating the given auth code on the given managed
+// devices.  This is the same as client.Licensing.PushAuthCodeJob.
+func (c *Batch) ActivateLicense(authCode string, serials ...string) (uint, error) {
+	ns := &licen.Licen{}
+	ns.Initialize(c.con)
+
+	return ns.PushAuthCodeJob(authCode, serials...)
+}
+
+// RefreshLicenses starts having the given managed devices retrieve their
+// licenses from the license server.  This is the same as
+// client.Licensing.RefreshManagedDevicesJob.
+func (c *Batch) RefreshLicenses(serials ...string) (uint, error) {
+	ns := &licen.Licen{}
+	ns.Initialize(c.con)
+
+	return ns.RefreshManagedDevicesJob(serials...)
+}
+
+// Wait waits for the given batch job to finish, returning the job and the
+// result of each device in it.
+//
+// The sleep param is the length of time to wait between checks, and is at
+// least util.MinPollSleep, while the timeout param is the total length of time
+// to wait.  A timeout of zero waits forever.
+//
+// An error is only returned if the job could not be retrieved or it did not
+// finish in time; use Result.Err() to check if the job succeeded.
+func (c *Batch) Wait(id uint, sleep, timeout time.Duration) (Result, error) {
+	ns := &jobs.Jobs{}
+	ns.Initialize(c.con)
+
+	var j jobs.Job
+
+	err := util.Poll(sleep, timeout, func() (bool, error) {
+		var err error
+		j, err = ns.Get(id)
+		return err == nil && j.Status == jobs.StatusFinished, err
+	})
+	switch err {
+	case nil:
+		return Result{Job: j, Devices: j.Devices}, nil
+	case util.ErrTimeout:
+		return Result{Job: j, Devices: j.Devices}, fmt.Errorf("Timed out waiting for job %d", id)
+	}
+
+	return Result{}, err
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *Batch) job(serials []string, req interface{}) (uint, error) {
+	if len(serials) == 0 {
+		return 0, fmt.Errorf("No devices specified")
+	}
+
+	ans := util.JobResponse{}
+
+	if _, err := c.con.Op(req, "", nil, &ans); err != nil {
+		return 0, err
+	}
+
+	return ans.Id, nil
+}
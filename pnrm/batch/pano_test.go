@@ -0,0 +1,83 @@
+package batch
+
+import (
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/pnrm/content"
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestOps(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &Batch{}
+	ns.Initialize(mc)
+
+	testCases := []struct {
+		desc     string
+		fn       func() (uint, error)
+		expected string
+	}{
+		{"content", func() (uint, error) { return ns.InstallContent(content.KindAntivirus, "panup-av", "0001", "0002") },
+			"<request><batch><anti-virus><upgrade><install><file>panup-av</file><devices><member>0001</member><member>0002</member></devices></install></upgrade></anti-virus></batch></request>"},
+		{"software upload", func() (uint, error) { return ns.UploadSoftware("PanOS_vm-9.1.3", "0001") },
+			"<request><batch><software><upload><file>PanOS_vm-9.1.3</file><devices><member>0001</member></devices></upload></software></batch></request>"},
+		{"software install", func() (uint, error) { return ns.InstallSoftware("PanOS_vm-9.1.3", "0001") },
+			"<request><batch><software><install><file>PanOS_vm-9.1.3</file><devices><member>0001</member></devices></install></software></batch></request>"},
+		{"license activate", func() (uint, error) { return ns.ActivateLicense("I1234", "0001") },
+			"<request><batch><license><activate><auth-code>I1234</auth-code><devices><member>0001</member></devices></activate></license></batch></request>"},
+		{"license refresh", func() (uint, error) { return ns.RefreshLicenses("0001") },
+			"<request><batch><license><refresh><devices><member>0001</member></devices></refresh></license></batch></request>"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("<job>7</job>")
+			id, err := tc.fn()
+			if err != nil {
+				t.Fatalf("Error: %s", err)
+			}
+			if id != 7 {
+				t.Errorf("Job id is %d, not 7", id)
+			}
+			if mc.Elm != tc.expected {
+				t.Errorf("Expected:\n%s\nGot:\n%s", tc.expected, mc.Elm)
+			}
+		})
+	}
+
+	if _, err := ns.InstallSoftware("PanOS_vm-9.1.3"); err == nil {
+		t.Errorf("No error without devices")
+	}
+	if _, err := ns.InstallContent("bogus", "f", "0001"); err == nil {
+		t.Errorf("No error for invalid kind")
+	}
+}
+
+func TestWait(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(`<job><id>7</id><type>BatchSoftwareInstall</type><status>FIN</status><result>FAIL</result><devices>
+<entry><serial-no>0001</serial-no><devicename>fw1</devicename><status>installed</status><result>OK</result></entry>
+<entry><serial-no>0002</serial-no><devicename>fw2</devicename><status>failed</status><result>FAIL</result><details><msg><errors><line>not enough disk space</line></errors></msg></details></entry>
+</devices></job>`)
+
+	ns := &Batch{}
+	ns.Initialize(mc)
+
+	r, err := ns.Wait(7, 0, 0)
+	if err != nil {
+		t.Fatalf("Wait failed: %s", err)
+	}
+	if len(r.Devices) != 2 {
+		t.Fatalf("Expected 2 devices, got %d", len(r.Devices))
+	}
+	failed := r.Failed()
+	if len(failed) != 1 || failed[0].Serial != "0002" {
+		t.Fatalf("Bad failed devices: %#v", failed)
+	}
+	if err = r.Err(); err == nil {
+		t.Errorf("No error for failed device")
+	} else if err.Error() != "Job 7 failed on 1 device(s): 0002: not enough disk space" {
+		t.Errorf("Bad error: %s", err)
+	}
+}
@@ -0,0 +1,11 @@
+package content
+
+// Valid values for the kind of dynamic update.
+const (
+	KindContent   = "content"
+	KindAntivirus = "anti-virus"
+	KindWildfire  = "wildfire"
+)
+
+// Latest can be given as the version to install the latest update.
+const Latest = "latest"
@@ -0,0 +1,11 @@
+/*
+Package content is the client.Panorama.Content namespace.
+
+This namespace manages the dynamic updates (applications and threats,
+antivirus, and WildFire) on Panorama, and pushes downloaded updates out to
+the managed firewalls.  The content versions currently installed on each
+managed firewall are reported by Panorama.ManagedDevice.All().
+
+Normalized object: Update
+*/
+package content
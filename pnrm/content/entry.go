@@ -0,0 +1,70 @@
+package content
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Update is a single dynamic update version.
+//
+// ReleasedOn is the release date as returned by PAN-OS, while ReleasedAt is
+// the parsed form of it.  PAN-OS includes the timezone abbreviation in the
+// release date, which is only understood if it is UTC, GMT, or the one used
+// by the Content namespace's Location.
+type Update struct {
+	Version    string
+	Filename   string
+	Size       string
+	ReleasedOn string
+	ReleasedAt time.Time
+	Features   string
+	UpdateType string
+	Downloaded bool
+	Current    bool
+	Previous   bool
+}
+
+/** Structs / functions for normalization. **/
+
+type updatesResp struct {
+	Entries []updateEntry `xml:"result>content-updates>entry"`
+}
+
+func (o *updatesResp) Normalize(loc *time.Location) ([]Update, error) {
+	ans := make([]Update, 0, len(o.Entries))
+
+	for _, e := range o.Entries {
+		released, err := util.ParseTime(util.ZonedTimeFormat, e.ReleasedOn, loc)
+		if err != nil {
+			return nil, fmt.Errorf("Bad release date for %s: %s", e.Version, err)
+		}
+		ans = append(ans, Update{
+			Version:    e.Version,
+			Filename:   e.Filename,
+			Size:       e.Size,
+			ReleasedOn: e.ReleasedOn,
+			ReleasedAt: released,
+			Features:   e.Features,
+			UpdateType: e.UpdateType,
+			Downloaded: util.AsBool(e.Downloaded),
+			Current:    util.AsBool(e.Current),
+			Previous:   util.AsBool(e.Previous),
+		})
+	}
+
+	return ans, nil
+}
+
+type updateEntry struct {
+	Version    string `xml:"version"`
+	Filename   string `xml:"filename"`
+	Size       string `xml:"size"`
+	ReleasedOn string `xml:"released-on"`
+	Features   string `xml:"features"`
+	UpdateType string `xml:"update-type"`
+	Downloaded string `xml:"downloaded"`
+	Current    string `xml:"current"`
+	Previous   string `xml:"previous"`
+}
@@ -0,0 +1,150 @@
+package content
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Content is the client.Panorama.Content namespace.
+//
+// Location is the timezone of Panorama, used to interpret update release
+// dates, defaulting to UTC if unspecified.
+type Content struct {
+	Location *time.Location
+
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *Content) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Check checks for and returns the available updates of the given kind.
+func (c *Content) Check(kind string) ([]Update, error) {
+	type check struct {
+		XMLName xml.Name
+		Cmd     string `xml:"upgrade>check"`
+	}
+
+	type req struct {
+		XMLName xml.Name `xml:"request"`
+		Kind    check
+	}
+
+	if err := validKind(kind); err != nil {
+		return nil, err
+	}
+
+	ans := updatesResp{}
+
+	c.con.LogOp("(op) request %s upgrade check", kind)
+	if _, err := c.con.Op(req{Kind: check{XMLName: xml.Name{Local: kind}}}, "", nil, &ans); err != nil {
+		return nil, err
+	}
+
+	return ans.Normalize(c.Location)
+}
+
+// Download starts downloading the latest update of the given kind to
+// Panorama, returning the job ID.
+func (c *Content) Download(kind string) (uint, error) {
+	type download struct {
+		XMLName xml.Name
+		Cmd     string `xml:"upgrade>download>latest"`
+	}
+
+	type req struct {
+		XMLName xml.Name `xml:"request"`
+		Kind    download
+	}
+
+	if err := validKind(kind); err != nil {
+		return 0, err
+	}
+
+	c.con.LogOp("(op) request %s upgrade download latest", kind)
+	return c.job(req{Kind: download{XMLName: xml.Name{Local: kind}}})
+}
+
+// Install starts installing the given version of the given kind of update
+// on Panorama itself, returning the job ID.  If version is empty, then the
+// latest version is installed.
+func (c *Content) Install(kind, version string) (uint, error) {
+	type install struct {
+		XMLName xml.Name
+		Version string `xml:"upgrade>install>version"`
+	}
+
+	type req struct {
+		XMLName xml.Name `xml:"request"`
+		Kind    install
+	}
+
+	if err := validKind(kind); err != nil {
+		return 0, err
+	}
+	if version == "" {
+		version = Latest
+	}
+
+	c.con.LogOp("(op) request %s upgrade install version %s", kind, version)
+	return c.job(req{Kind: install{XMLName: xml.Name{Local: kind}, Version: version}})
+}
+
+// Push starts installing the given update file, which must already be
+// downloaded to Panorama, on the given managed devices.  The job ID on
+// Panorama is returned.
+func (c *Content) Push(kind, filename string, serials ...string) (uint, error) {
+	type push struct {
+		XMLName  xml.Name
+		Filename string           `xml:"upgrade>install>file"`
+		Devices  *util.MemberType `xml:"upgrade>install>devices"`
+	}
+
+	type batch struct {
+		Kind push
+	}
+
+	type req struct {
+		XMLName xml.Name `xml:"request"`
+		Batch   batch    `xml:"batch"`
+	}
+
+	if err := validKind(kind); err != nil {
+		return 0, err
+	} else if len(serials) == 0 {
+		return 0, fmt.Errorf("No devices specified")
+	}
+
+	c.con.LogOp("(op) request batch %s upgrade install file %s devices %v", kind, filename, serials)
+	return c.job(req{Batch: batch{Kind: push{
+		XMLName:  xml.Name{Local: kind},
+		Filename: filename,
+		Devices:  util.StrToMem(serials),
+	}}})
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *Content) job(req interface{}) (uint, error) {
+	ans := util.JobResponse{}
+
+	if _, err := c.con.Op(req, "", nil, &ans); err != nil {
+		return 0, err
+	}
+
+	return ans.Id, nil
+}
+
+func validKind(kind string) error {
+	switch kind {
+	case KindContent, KindAntivirus, KindWildfire:
+		return nil
+	}
+
+	return fmt.Errorf("Invalid update kind: %q", kind)
+}
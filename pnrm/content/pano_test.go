@@ -0,0 +1,84 @@
+package content
+
+import (
+	"testing"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestCheck(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(`<content-updates last-updated-at="2021/01/01 10:00:00 PST">
+<entry><version>8500-7000</version><app-version>8500-7000</app-version><filename>panupv2-all-contents-8500-7000</filename><size>58</size><released-on>2021/12/01 10:00:00 PST</released-on><downloaded>yes</downloaded><current>yes</current><previous>no</previous><features>contents</features><update-type>Full</update-type></entry>
+<entry><version>8501-7001</version><filename>panupv2-all-contents-8501-7001</filename><downloaded>no</downloaded><current>no</current><previous>no</previous></entry>
+</content-updates>`)
+
+	ns := &Content{Location: time.FixedZone("PST", -8*60*60)}
+	ns.Initialize(mc)
+
+	list, err := ns.Check(KindContent)
+	if err != nil {
+		t.Fatalf("Check failed: %s", err)
+	}
+
+	expected := "<request><content><upgrade><check></check></upgrade></content></request>"
+	if mc.Elm != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, mc.Elm)
+	}
+	if len(list) != 2 {
+		t.Fatalf("Expected 2 updates, got %d", len(list))
+	}
+	if list[0].Version != "8500-7000" || !list[0].Current || !list[0].Downloaded || list[0].UpdateType != "Full" {
+		t.Errorf("Bad first update: %#v", list[0])
+	}
+	if !list[0].ReleasedAt.Equal(time.Date(2021, 12, 1, 18, 0, 0, 0, time.UTC)) {
+		t.Errorf("Bad first update release date: %s", list[0].ReleasedAt)
+	}
+	if list[1].Downloaded || list[1].Filename != "panupv2-all-contents-8501-7001" {
+		t.Errorf("Bad second update: %#v", list[1])
+	}
+
+	if _, err = ns.Check("bogus"); err == nil {
+		t.Errorf("No error for invalid kind")
+	}
+}
+
+func TestJobs(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		fn       func(*Content) (uint, error)
+		expected string
+	}{
+		{"download", func(c *Content) (uint, error) {
+			return c.Download(KindAntivirus)
+		}, "<request><anti-virus><upgrade><download><latest></latest></download></upgrade></anti-virus></request>"},
+		{"install latest", func(c *Content) (uint, error) {
+			return c.Install(KindWildfire, "")
+		}, "<request><wildfire><upgrade><install><version>latest</version></install></upgrade></wildfire></request>"},
+		{"push", func(c *Content) (uint, error) {
+			return c.Push(KindContent, "panupv2-all-contents-8501-7001", "0011", "0012")
+		}, "<request><batch><content><upgrade><install><file>panupv2-all-contents-8501-7001</file><devices><member>0011</member><member>0012</member></devices></install></upgrade></content></batch></request>"},
+	}
+
+	mc := &testdata.MockClient{}
+	ns := &Content{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("<job>12</job>")
+			id, err := tc.fn(ns)
+			if err != nil {
+				t.Fatalf("Failed: %s", err)
+			}
+			if id != 12 {
+				t.Errorf("Expected job 12, got %d", id)
+			}
+			if mc.Elm != tc.expected {
+				t.Errorf("Expected:\n%s\nGot:\n%s", tc.expected, mc.Elm)
+			}
+		})
+	}
+}
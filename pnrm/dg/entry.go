@@ -2,6 +2,7 @@ package dg
 
 import (
 	"encoding/xml"
+	"regexp"
 
 	"github.com/PaloAltoNetworks/pango/util"
 )
@@ -27,6 +28,33 @@ func (o *Entry) Copy(s Entry) {
 	o.Devices = s.Devices
 }
 
+// Clone returns a copy of this device group named name, for creating a new
+// device group from this one.
+//
+// Devices are not copied, as a device can only belong to one device group.
+// The UUIDs of the copied rules are removed so that PAN-OS assigns new ones,
+// as rule UUIDs must be unique across device groups.
+func (o Entry) Clone(name string) Entry {
+	ans := Entry{
+		Name:        name,
+		Description: o.Description,
+	}
+
+	if o.raw != nil {
+		ans.raw = make(map[string]string, len(o.raw))
+		for key, value := range o.raw {
+			if key == "prerb" || key == "postrb" {
+				value = uuidAttr.ReplaceAllString(value, "")
+			}
+			ans.raw[key] = value
+		}
+	}
+
+	return ans
+}
+
+var uuidAttr = regexp.MustCompile(`\s+uuid="[^"]*"`)
+
 /** Structs / functions for normalization. **/
 
 type normalizer interface {
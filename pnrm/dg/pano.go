@@ -3,6 +3,7 @@ package dg
 import (
 	"encoding/xml"
 	"fmt"
+	"sort"
 
 	"github.com/PaloAltoNetworks/pango/util"
 )
@@ -113,6 +114,73 @@ func (c *Dg) DeleteDeviceVsys(g interface{}, d string, vsys []string) error {
 	return err
 }
 
+// AddDevices performs a SET to add the given devices to device group g.
+//
+// The devices param is a map where the key is the serial number of the
+// device and the value is the list of vsys to include.  If you want all vsys
+// to be included, or the device is a virtual firewall, then leave the vsys
+// list empty.
+//
+// An error is returned if the device group does not exist.
+func (c *Dg) AddDevices(g string, devices map[string][]string) error {
+	if len(devices) == 0 {
+		return nil
+	}
+
+	if err := c.exists(g); err != nil {
+		return err
+	}
+
+	serials := make([]string, 0, len(devices))
+	for key := range devices {
+		serials = append(serials, key)
+	}
+	sort.Strings(serials)
+
+	type devs struct {
+		XMLName xml.Name `xml:"devices"`
+		util.VsysEntryType
+	}
+
+	d := devs{}
+	for _, serial := range serials {
+		d.Entries = append(d.Entries, util.VsysEntry{
+			Serial: serial,
+			Vsys:   util.StrToEnt(devices[serial]),
+		})
+	}
+
+	c.con.LogAction("(set) devices in device group %s: %v", g, serials)
+
+	path := c.xpath([]string{g})
+
+	_, err := c.con.Set(path, d, nil, nil)
+	return err
+}
+
+// RemoveDevices performs a DELETE to remove the given devices from device
+// group g.
+//
+// An error is returned if the device group does not exist.
+func (c *Dg) RemoveDevices(g string, serials ...string) error {
+	if len(serials) == 0 {
+		return nil
+	}
+
+	if err := c.exists(g); err != nil {
+		return err
+	}
+
+	c.con.LogAction("(delete) devices from device group %s: %v", g, serials)
+
+	path := make([]string, 0, 7)
+	path = append(path, c.xpath([]string{g})...)
+	path = append(path, "devices", util.AsEntryXpath(serials))
+
+	_, err := c.con.Delete(path, nil, nil)
+	return err
+}
+
 // ShowList performs SHOW to retrieve a list of device groups.
 func (c *Dg) ShowList() ([]string, error) {
 	c.con.LogQuery("(show) list of device groups")
@@ -233,6 +301,24 @@ func (c *Dg) details(fn util.Retriever, name string) (Entry, error) {
 	return ans, nil
 }
 
+func (c *Dg) exists(name string) error {
+	type resp struct {
+		Entries []util.Entry `xml:"result>entry"`
+	}
+
+	path := c.xpath([]string{name})
+	path = append(path, "@name")
+
+	ans := resp{}
+	if _, err := c.con.Get(path, nil, &ans); err != nil {
+		return err
+	} else if len(ans.Entries) == 0 {
+		return fmt.Errorf("Device group %q does not exist", name)
+	}
+
+	return nil
+}
+
 func (c *Dg) xpath(vals []string) []string {
 	return []string{
 		"config",
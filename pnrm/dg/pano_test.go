@@ -88,3 +88,39 @@ func TestNormalization(t *testing.T) {
 		})
 	}
 }
+
+func TestDevices(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &Dg{}
+	ns.Initialize(mc)
+
+	prefix := "/config/devices/entry[@name='localhost.localdomain']/device-group/entry[@name='dg1']"
+
+	mc.AddResp(`<entry name="dg1"/>`)
+	if err := ns.AddDevices("dg1", map[string][]string{"b": nil, "a": {"vsys2"}}); err != nil {
+		t.Fatalf("Error in add devices: %s", err)
+	}
+	elm := `<devices><entry name="a"><vsys><entry name="vsys2"></entry></vsys></entry><entry name="b"></entry></devices>`
+	if mc.Function != "set" || mc.Path != prefix || mc.Elm != elm {
+		t.Errorf("Bad add devices: %s %s %s", mc.Function, mc.Path, mc.Elm)
+	}
+
+	mc.Reset()
+	mc.AddResp(`<entry name="dg1"/>`)
+	if err := ns.RemoveDevices("dg1", "a", "b"); err != nil {
+		t.Fatalf("Error in remove devices: %s", err)
+	}
+	if mc.Function != "delete" || mc.Path != prefix+"/devices/entry[@name='a' or @name='b']" {
+		t.Errorf("Bad remove devices: %s %s", mc.Function, mc.Path)
+	}
+
+	mc = &testdata.MockClient{}
+	ns.Initialize(mc)
+	mc.AddResp("")
+	if err := ns.AddDevices("dg2", map[string][]string{"a": nil}); err == nil {
+		t.Errorf("No error for missing device group")
+	}
+	if mc.Called != 1 {
+		t.Errorf("Expected only the existence check, got %d calls", mc.Called)
+	}
+}
@@ -0,0 +1,7 @@
+package ha
+
+// Valid values for Config.Priority.
+const (
+	PriorityPrimary   = "primary"
+	PrioritySecondary = "secondary"
+)
@@ -0,0 +1,11 @@
+/*
+Package ha is the client.Panorama.Ha namespace.
+
+This namespace configures the high availability peer of Panorama itself
+(not of managed firewalls), and provides the operational commands needed to
+automate a Panorama failover: checking the HA state, suspending the local
+Panorama, returning it to functional, and making it the primary.
+
+Normalized object: Config
+*/
+package ha
@@ -0,0 +1,96 @@
+package ha
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Config is a normalized, version independent representation of the
+// Panorama HA configuration.
+//
+// Priority is either PriorityPrimary or PrioritySecondary.  Encryption
+// requires that the HA keys have been exchanged between the peers.
+type Config struct {
+	Enable     bool
+	PeerIp     string
+	Encryption bool
+	Priority   string
+	Preemptive bool
+}
+
+// Copy copies the information from source Config `s` to this object.
+func (o *Config) Copy(s Config) {
+	o.Enable = s.Enable
+	o.PeerIp = s.PeerIp
+	o.Encryption = s.Encryption
+	o.Priority = s.Priority
+	o.Preemptive = s.Preemptive
+}
+
+/** Structs / functions for normalization. **/
+
+type normalizer interface {
+	Normalize() Config
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>high-availability"`
+}
+
+func (o *container_v1) Normalize() Config {
+	ans := Config{
+		Enable: util.AsBool(o.Answer.Enable),
+	}
+
+	if o.Answer.Peer != nil {
+		ans.PeerIp = o.Answer.Peer.PeerIp
+		ans.Encryption = util.AsBool(o.Answer.Peer.Encryption)
+	}
+
+	if o.Answer.Election != nil {
+		ans.Priority = o.Answer.Election.Priority
+		ans.Preemptive = util.AsBool(o.Answer.Election.Preemptive)
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName  xml.Name  `xml:"high-availability"`
+	Enable   string    `xml:"enabled"`
+	Peer     *peer     `xml:"peer"`
+	Election *election `xml:"election-option"`
+}
+
+type peer struct {
+	PeerIp     string `xml:"ip-address,omitempty"`
+	Encryption string `xml:"encryption>enabled"`
+}
+
+type election struct {
+	Priority   string `xml:"priority,omitempty"`
+	Preemptive string `xml:"preemptive"`
+}
+
+func specify_v1(e Config) interface{} {
+	ans := entry_v1{
+		Enable: util.YesNo(e.Enable),
+	}
+
+	if e.PeerIp != "" || e.Encryption {
+		ans.Peer = &peer{
+			PeerIp:     e.PeerIp,
+			Encryption: util.YesNo(e.Encryption),
+		}
+	}
+
+	if e.Priority != "" || e.Preemptive {
+		ans.Election = &election{
+			Priority:   e.Priority,
+			Preemptive: util.YesNo(e.Preemptive),
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,178 @@
+package ha
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Ha is the client.Panorama.Ha namespace.
+type Ha struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *Ha) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Show performs SHOW to retrieve the Panorama HA config.
+func (c *Ha) Show() (Config, error) {
+	c.con.LogQuery("(show) panorama ha config")
+	return c.details(c.con.Show)
+}
+
+// Get performs GET to retrieve the Panorama HA config.
+func (c *Ha) Get() (Config, error) {
+	c.con.LogQuery("(get) panorama ha config")
+	return c.details(c.con.Get)
+}
+
+// Set performs SET to update the Panorama HA config.
+func (c *Ha) Set(e Config) error {
+	var err error
+	_, fn := c.versioning()
+	c.con.LogAction("(set) panorama ha config")
+
+	path := c.xpath()
+	path = path[:len(path)-1]
+
+	_, err = c.con.Set(path, fn(e), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to update the Panorama HA config.
+func (c *Ha) Edit(e Config) error {
+	var err error
+	_, fn := c.versioning()
+	c.con.LogAction("(edit) panorama ha config")
+
+	path := c.xpath()
+
+	_, err = c.con.Edit(path, fn(e), nil, nil)
+	return err
+}
+
+// Delete removes the Panorama HA config.
+func (c *Ha) Delete() error {
+	c.con.LogAction("(delete) panorama ha config")
+	path := c.xpath()
+
+	_, err := c.con.Delete(path, nil, nil)
+	return err
+}
+
+// State returns the current HA state of Panorama and its peer.
+func (c *Ha) State() (State, error) {
+	type req struct {
+		XMLName xml.Name `xml:"show"`
+		Cmd     string   `xml:"high-availability>state"`
+	}
+
+	ans := stateResp{}
+
+	c.con.LogOp("(op) show high-availability state")
+	if _, err := c.con.Op(req{}, "", nil, &ans); err != nil {
+		return State{}, err
+	}
+
+	return ans.Normalize(), nil
+}
+
+// Suspend suspends the local Panorama, causing the peer to become active.
+func (c *Ha) Suspend() error {
+	c.con.LogOp("(op) request high-availability state suspend")
+	return c.request("state", "suspend")
+}
+
+// Functional returns a suspended local Panorama to the functional state.
+func (c *Ha) Functional() error {
+	c.con.LogOp("(op) request high-availability state functional")
+	return c.request("state", "functional")
+}
+
+// MakePrimary makes the local Panorama the primary of the pair, which moves
+// the primary role (such as for NFS logging on legacy mode Panoramas) from
+// the peer.
+func (c *Ha) MakePrimary() error {
+	c.con.LogOp("(op) request high-availability make-primary")
+	return c.request("make-primary", "")
+}
+
+// WaitForState waits until the local Panorama's HA state satisfies the given
+// check, such as State.Active, returning the last state retrieved.
+//
+// The sleep param is the length of time to wait between checks, and is at
+// least util.MinPollSleep, while the timeout param is the total length of time
+// to wait.  A timeout of zero waits forever.
+func (c *Ha) WaitForState(check func(State) bool, sleep, timeout time.Duration) (State, error) {
+	var s State
+
+	err := util.Poll(sleep, timeout, func() (bool, error) {
+		var err error
+		s, err = c.State()
+		return err == nil && check(s), err
+	})
+	if err == util.ErrTimeout {
+		return s, fmt.Errorf("Timed out waiting for HA state, currently %q", s.LocalState)
+	}
+
+	return s, err
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *Ha) request(cmd, arg string) error {
+	type inner struct {
+		XMLName xml.Name
+	}
+
+	type outer struct {
+		XMLName xml.Name
+		Arg     *inner
+	}
+
+	type ha struct {
+		Cmd outer
+	}
+
+	type req struct {
+		XMLName xml.Name `xml:"request"`
+		Ha      ha       `xml:"high-availability"`
+	}
+
+	r := req{Ha: ha{Cmd: outer{XMLName: xml.Name{Local: cmd}}}}
+	if arg != "" {
+		r.Ha.Cmd.Arg = &inner{XMLName: xml.Name{Local: arg}}
+	}
+
+	_, err := c.con.Op(r, "", nil, nil)
+	return err
+}
+
+func (c *Ha) versioning() (normalizer, func(Config) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *Ha) details(fn util.Retriever) (Config, error) {
+	path := c.xpath()
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Config{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *Ha) xpath() []string {
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"deviceconfig",
+		"high-availability",
+	}
+}
@@ -0,0 +1,112 @@
+package ha
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestNormalization(t *testing.T) {
+	testCases := []struct {
+		desc string
+		conf Config
+	}{
+		{"disabled", Config{}},
+		{"primary", Config{
+			Enable:     true,
+			PeerIp:     "10.1.1.2",
+			Encryption: true,
+			Priority:   PriorityPrimary,
+			Preemptive: true,
+		}},
+		{"secondary", Config{
+			Enable:   true,
+			PeerIp:   "10.1.1.1",
+			Priority: PrioritySecondary,
+		}},
+	}
+
+	mc := &testdata.MockClient{}
+	ns := &Ha{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Reset()
+			mc.AddResp("")
+			err := ns.Set(tc.conf)
+			if err != nil {
+				t.Errorf("Error in set: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get()
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				} else if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
+
+func TestState(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(`<enabled>yes</enabled><group><mode>Active-Passive</mode><running-sync>synchronized</running-sync><local-info><state>primary-passive</state><priority>primary</priority></local-info><peer-info><state>secondary-active</state><priority>secondary</priority><mgmt-ip>10.1.1.2</mgmt-ip><conn-status>up</conn-status></peer-info></group>`)
+
+	ns := &Ha{}
+	ns.Initialize(mc)
+
+	s, err := ns.State()
+	if err != nil {
+		t.Fatalf("Error in state: %s", err)
+	}
+
+	expected := State{
+		Enabled:       true,
+		Mode:          "Active-Passive",
+		LocalState:    "primary-passive",
+		LocalPriority: PriorityPrimary,
+		PeerState:     "secondary-active",
+		PeerPriority:  PrioritySecondary,
+		PeerIp:        "10.1.1.2",
+		PeerConnected: true,
+		ConfigInSync:  true,
+	}
+	if !reflect.DeepEqual(s, expected) {
+		t.Errorf("%#v != %#v", s, expected)
+	}
+	if s.Active() || s.Suspended() {
+		t.Errorf("Passive peer reported as active or suspended")
+	}
+}
+
+func TestRequests(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp("")
+
+	ns := &Ha{}
+	ns.Initialize(mc)
+
+	testCases := []struct {
+		desc     string
+		fn       func() error
+		expected string
+	}{
+		{"suspend", ns.Suspend, "<request><high-availability><state><suspend></suspend></state></high-availability></request>"},
+		{"functional", ns.Functional, "<request><high-availability><state><functional></functional></state></high-availability></request>"},
+		{"make primary", ns.MakePrimary, "<request><high-availability><make-primary></make-primary></high-availability></request>"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			if err := tc.fn(); err != nil {
+				t.Fatalf("Error: %s", err)
+			}
+			if mc.Elm != tc.expected {
+				t.Errorf("Expected:\n%s\nGot:\n%s", tc.expected, mc.Elm)
+			}
+		})
+	}
+}
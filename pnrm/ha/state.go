@@ -0,0 +1,79 @@
+package ha
+
+import (
+	"strings"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// State is the current HA state of Panorama and its peer.
+//
+// Panorama states are prefixed with the priority, such as "primary-active"
+// or "secondary-passive".  If HA is not enabled, then only Enabled is
+// populated.
+type State struct {
+	Enabled       bool
+	Mode          string
+	LocalState    string
+	LocalPriority string
+	PeerState     string
+	PeerPriority  string
+	PeerIp        string
+	PeerConnected bool
+	ConfigInSync  bool
+}
+
+// Active returns true if the local Panorama is the active peer.
+func (o State) Active() bool {
+	return strings.HasSuffix(o.LocalState, "active")
+}
+
+// Suspended returns true if the local Panorama is suspended.
+func (o State) Suspended() bool {
+	return strings.HasSuffix(o.LocalState, "suspended")
+}
+
+/** Structs / functions for normalization. **/
+
+type stateResp struct {
+	Enabled string     `xml:"result>enabled"`
+	Group   *stateInfo `xml:"result>group"`
+}
+
+type stateInfo struct {
+	Mode  string    `xml:"mode"`
+	Local localInfo `xml:"local-info"`
+	Peer  peerInfo  `xml:"peer-info"`
+	Sync  string    `xml:"running-sync"`
+}
+
+type localInfo struct {
+	State    string `xml:"state"`
+	Priority string `xml:"priority"`
+}
+
+type peerInfo struct {
+	State      string `xml:"state"`
+	Priority   string `xml:"priority"`
+	PeerIp     string `xml:"mgmt-ip"`
+	ConnStatus string `xml:"conn-status"`
+}
+
+func (o *stateResp) Normalize() State {
+	ans := State{
+		Enabled: util.AsBool(o.Enabled),
+	}
+
+	if o.Group != nil {
+		ans.Mode = o.Group.Mode
+		ans.LocalState = o.Group.Local.State
+		ans.LocalPriority = o.Group.Local.Priority
+		ans.PeerState = o.Group.Peer.State
+		ans.PeerPriority = o.Group.Peer.Priority
+		ans.PeerIp = o.Group.Peer.PeerIp
+		ans.PeerConnected = o.Group.Peer.ConnStatus == "up"
+		ans.ConfigInSync = o.Group.Sync == "synchronized"
+	}
+
+	return ans
+}
@@ -0,0 +1,13 @@
+/*
+Package managed is the client.Panorama.ManagedDevice namespace.
+
+This namespace registers firewalls with Panorama by serial number and reports
+the inventory of the firewalls that Panorama manages.  Use a Filter to select
+managed devices by tag, model, software version, or HA state.
+
+The inventory, including device group and template membership, can be
+exported as CSV or JSON with Inventory.
+
+Normalized object: Device
+*/
+package managed
@@ -0,0 +1,132 @@
+package managed
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// CertificateExpiryFormat is the time format of device certificate
+// expiration dates.
+const CertificateExpiryFormat = util.ZonedTimeFormat
+
+// Device is a firewall managed by Panorama, as reported by Panorama.
+//
+// HaState and HaPeer are empty if the firewall is not part of an HA pair.
+// The content version fields are the dynamic updates currently installed on
+// the firewall.  CertificateStatus and CertificateExpiry describe the device
+// certificate, as reported by PAN-OS, with CertificateExpires being the
+// parsed expiry.  The expiry includes a timezone abbreviation, which is only
+// understood if it is UTC, GMT, or the one used by the Managed namespace's
+// Location.  Tags are the device tags assigned in Panorama, if reported
+// by the PAN-OS version in use.
+type Device struct {
+	Serial             string
+	Hostname           string
+	IpAddress          string
+	Model              string
+	SwVersion          string
+	AppVersion         string
+	ThreatVersion      string
+	AvVersion          string
+	WildfireVersion    string
+	CertificateStatus  string
+	CertificateExpiry  string
+	CertificateExpires time.Time
+	Connected          bool
+	HaState            string
+	HaPeer             string
+	MultiVsys          bool
+	Tags               []string
+	Vsys               []Vsys
+}
+
+// Vsys is a single vsys of a managed device.
+type Vsys struct {
+	Name        string
+	DisplayName string
+}
+
+/** Structs / functions for normalization. **/
+
+type devicesResp struct {
+	Entries []deviceEntry `xml:"result>devices>entry"`
+}
+
+func (o *devicesResp) Normalize(loc *time.Location) ([]Device, error) {
+	ans := make([]Device, 0, len(o.Entries))
+
+	for _, e := range o.Entries {
+		expires, err := util.ParseTime(CertificateExpiryFormat, e.CertificateExpiry, loc)
+		if err != nil {
+			return nil, fmt.Errorf("Bad certificate expiry for %s: %s", e.Name, err)
+		}
+		d := Device{
+			Serial:             e.Serial,
+			Hostname:           e.Hostname,
+			IpAddress:          e.IpAddress,
+			Model:              e.Model,
+			SwVersion:          e.SwVersion,
+			AppVersion:         e.AppVersion,
+			ThreatVersion:      e.ThreatVersion,
+			AvVersion:          e.AvVersion,
+			WildfireVersion:    e.WildfireVersion,
+			CertificateStatus:  e.CertificateStatus,
+			CertificateExpiry:  e.CertificateExpiry,
+			CertificateExpires: expires,
+			Connected:          util.AsBool(e.Connected),
+			MultiVsys:          util.AsBool(e.MultiVsys),
+			Tags:               util.MemToStr(e.Tags),
+		}
+		if d.Serial == "" {
+			d.Serial = e.Name
+		}
+		if e.Ha != nil {
+			d.HaState = e.Ha.State
+			d.HaPeer = e.Ha.Peer
+		}
+		if len(e.Vsys) > 0 {
+			d.Vsys = make([]Vsys, 0, len(e.Vsys))
+			for _, v := range e.Vsys {
+				d.Vsys = append(d.Vsys, Vsys{
+					Name:        v.Name,
+					DisplayName: v.DisplayName,
+				})
+			}
+		}
+		ans = append(ans, d)
+	}
+
+	return ans, nil
+}
+
+type deviceEntry struct {
+	Name              string           `xml:"name,attr"`
+	Serial            string           `xml:"serial"`
+	Hostname          string           `xml:"hostname"`
+	IpAddress         string           `xml:"ip-address"`
+	Model             string           `xml:"model"`
+	SwVersion         string           `xml:"sw-version"`
+	AppVersion        string           `xml:"app-version"`
+	ThreatVersion     string           `xml:"threat-version"`
+	AvVersion         string           `xml:"av-version"`
+	WildfireVersion   string           `xml:"wildfire-version"`
+	CertificateStatus string           `xml:"device-cert-present"`
+	CertificateExpiry string           `xml:"device-cert-expiry-date"`
+	Connected         string           `xml:"connected"`
+	MultiVsys         string           `xml:"multi-vsys"`
+	Tags              *util.MemberType `xml:"tags"`
+	Ha                *deviceHa        `xml:"ha"`
+	Vsys              []vsysEntry      `xml:"vsys>entry"`
+}
+
+type deviceHa struct {
+	State string `xml:"state"`
+	Peer  string `xml:"peer>serial"`
+}
+
+type vsysEntry struct {
+	Name        string `xml:"name,attr"`
+	DisplayName string `xml:"display-name"`
+}
@@ -0,0 +1,91 @@
+package managed
+
+import (
+	"strings"
+)
+
+// Filter selects managed devices by their properties.
+//
+// Each non-empty field is a list of acceptable values, and a device must
+// match at least one value of every non-empty field to be selected.  An
+// empty Filter matches every device.
+//
+// SwVersions match either exactly or as a version prefix, so "10.1" matches
+// both "10.1.0" and "10.1.3-h1".  HaStates are compared against the device's
+// HaState, so an empty string in HaStates matches standalone devices.
+type Filter struct {
+	Tags          []string
+	Models        []string
+	SwVersions    []string
+	HaStates      []string
+	ConnectedOnly bool
+}
+
+// Match returns true if the given device is selected by this filter.
+func (o Filter) Match(d Device) bool {
+	if o.ConnectedOnly && !d.Connected {
+		return false
+	}
+
+	if len(o.Tags) > 0 && !o.anyTag(d.Tags) {
+		return false
+	}
+
+	if len(o.Models) > 0 && !contains(o.Models, d.Model) {
+		return false
+	}
+
+	if len(o.SwVersions) > 0 && !o.version(d.SwVersion) {
+		return false
+	}
+
+	if len(o.HaStates) > 0 && !contains(o.HaStates, d.HaState) {
+		return false
+	}
+
+	return true
+}
+
+// Apply returns the devices of the given list that are selected by this
+// filter.
+func (o Filter) Apply(list []Device) []Device {
+	var ans []Device
+
+	for _, d := range list {
+		if o.Match(d) {
+			ans = append(ans, d)
+		}
+	}
+
+	return ans
+}
+
+func (o Filter) anyTag(tags []string) bool {
+	for _, tag := range tags {
+		if contains(o.Tags, tag) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (o Filter) version(v string) bool {
+	for _, want := range o.SwVersions {
+		if v == want || strings.HasPrefix(v, want+".") || strings.HasPrefix(v, want+"-") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func contains(list []string, v string) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,148 @@
+package managed
+
+import (
+	"encoding/xml"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// InventoryRecord is a single managed device as exported for inventory
+// synchronization.
+//
+// Templates contains both the templates and the template stacks that the
+// device is assigned to.
+type InventoryRecord struct {
+	Serial      string   `json:"serial"`
+	Hostname    string   `json:"hostname"`
+	IpAddress   string   `json:"ip_address"`
+	Model       string   `json:"model"`
+	SwVersion   string   `json:"sw_version"`
+	DeviceGroup string   `json:"device_group"`
+	Templates   []string `json:"templates"`
+	HaState     string   `json:"ha_state"`
+	HaPeer      string   `json:"ha_peer"`
+}
+
+// InventoryCsvHeader is the header row of CSV files written by
+// WriteInventoryCsv.
+var InventoryCsvHeader = []string{
+	"serial", "hostname", "ip_address", "model", "sw_version",
+	"device_group", "templates", "ha_state", "ha_peer",
+}
+
+// Inventory returns the inventory of all managed devices along with their
+// device group and template membership.
+func (c *Managed) Inventory() ([]InventoryRecord, error) {
+	list, err := c.All()
+	if err != nil {
+		return nil, err
+	}
+
+	type dgReq struct {
+		XMLName xml.Name `xml:"show"`
+		Cmd     string   `xml:"devicegroups"`
+	}
+
+	type tmplReq struct {
+		XMLName xml.Name `xml:"show"`
+		Cmd     string   `xml:"templates"`
+	}
+
+	dgs := membershipResp{}
+	c.con.LogOp("(op) show devicegroups")
+	if _, err = c.con.Op(dgReq{}, "", nil, &dgs); err != nil {
+		return nil, err
+	}
+
+	tmpls := membershipResp{}
+	c.con.LogOp("(op) show templates")
+	if _, err = c.con.Op(tmplReq{}, "", nil, &tmpls); err != nil {
+		return nil, err
+	}
+
+	dgMap := dgs.members()
+	tmplMap := tmpls.members()
+
+	ans := make([]InventoryRecord, 0, len(list))
+	for _, d := range list {
+		rec := InventoryRecord{
+			Serial:    d.Serial,
+			Hostname:  d.Hostname,
+			IpAddress: d.IpAddress,
+			Model:     d.Model,
+			SwVersion: d.SwVersion,
+			Templates: tmplMap[d.Serial],
+			HaState:   d.HaState,
+			HaPeer:    d.HaPeer,
+		}
+		if g := dgMap[d.Serial]; len(g) > 0 {
+			rec.DeviceGroup = g[0]
+		}
+		ans = append(ans, rec)
+	}
+
+	return ans, nil
+}
+
+// WriteInventoryCsv writes the given records as CSV, including a header row.
+//
+// Multiple templates are separated by a semicolon.
+func WriteInventoryCsv(w io.Writer, list []InventoryRecord) error {
+	rows := make([][]string, 0, len(list))
+	for _, v := range list {
+		rows = append(rows, []string{
+			v.Serial, v.Hostname, v.IpAddress, v.Model, v.SwVersion,
+			v.DeviceGroup, strings.Join(v.Templates, ";"), v.HaState, v.HaPeer,
+		})
+	}
+
+	return util.WriteCsv(w, InventoryCsvHeader, rows)
+}
+
+// WriteInventoryJson writes the given records as a JSON array.
+func WriteInventoryJson(w io.Writer, list []InventoryRecord) error {
+	if list == nil {
+		list = []InventoryRecord{}
+	}
+
+	return util.WriteJson(w, list)
+}
+
+/** Structs / functions for inventory parsing. **/
+
+type membershipResp struct {
+	DeviceGroups []membershipEntry `xml:"result>devicegroups>entry"`
+	Templates    []membershipEntry `xml:"result>templates>entry"`
+}
+
+// members returns a map of serial number to the sorted names of the device
+// groups or templates that the device belongs to.
+func (o *membershipResp) members() map[string][]string {
+	ans := make(map[string][]string)
+
+	for _, list := range [][]membershipEntry{o.DeviceGroups, o.Templates} {
+		for _, e := range list {
+			for _, d := range e.Devices {
+				ans[d.Name] = append(ans[d.Name], e.Name)
+			}
+		}
+	}
+
+	for key := range ans {
+		sort.Strings(ans[key])
+	}
+
+	return ans
+}
+
+type membershipEntry struct {
+	Name    string           `xml:"name,attr"`
+	Devices []membershipName `xml:"devices>entry"`
+}
+
+type membershipName struct {
+	Name string `xml:"name,attr"`
+}
@@ -0,0 +1,51 @@
+package managed
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestInventory(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(devicesXml)
+	mc.AddResp(`<devicegroups><entry name="branch"><devices><entry name="0001" /></devices></entry></devicegroups>`)
+	mc.AddResp(`<templates><entry name="t2"><devices><entry name="0001" /></devices></entry><entry name="t1"><devices><entry name="0001" /><entry name="0002" /></devices></entry></templates>`)
+
+	ns := &Managed{}
+	ns.Initialize(mc)
+
+	list, err := ns.Inventory()
+	if err != nil {
+		t.Fatalf("Error in inventory: %s", err)
+	}
+
+	expected := []InventoryRecord{
+		{"0001", "fw1", "10.1.1.1", "PA-VM", "9.1.0", "branch", []string{"t1", "t2"}, "active", "0003"},
+		{"0002", "", "", "PA-220", "", "", []string{"t1"}, "", ""},
+	}
+	if !reflect.DeepEqual(list, expected) {
+		t.Fatalf("Expected %#v, got %#v", expected, list)
+	}
+
+	var buf bytes.Buffer
+	if err = WriteInventoryCsv(&buf, list); err != nil {
+		t.Fatalf("WriteInventoryCsv failed: %s", err)
+	}
+	csv := "serial,hostname,ip_address,model,sw_version,device_group,templates,ha_state,ha_peer\n" +
+		"0001,fw1,10.1.1.1,PA-VM,9.1.0,branch,t1;t2,active,0003\n" +
+		"0002,,,PA-220,,,t1,,\n"
+	if buf.String() != csv {
+		t.Errorf("Bad CSV:\n%s", buf.String())
+	}
+
+	buf.Reset()
+	if err = WriteInventoryJson(&buf, nil); err != nil {
+		t.Fatalf("WriteInventoryJson failed: %s", err)
+	}
+	if buf.String() != "[]\n" {
+		t.Errorf("Bad empty JSON: %s", buf.String())
+	}
+}
@@ -0,0 +1,173 @@
+package managed
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Managed is the client.Panorama.ManagedDevice namespace.
+//
+// Location is the timezone of Panorama, used to interpret device certificate
+// expirations, defaulting to UTC if unspecified.
+type Managed struct {
+	Location *time.Location
+
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *Managed) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// ShowList performs SHOW to retrieve the serial numbers of the registered
+// devices.
+func (c *Managed) ShowList() ([]string, error) {
+	c.con.LogQuery("(show) list of managed devices")
+	path := c.xpath(nil)
+	return c.con.EntryListUsing(c.con.Show, path[:len(path)-1])
+}
+
+// GetList performs GET to retrieve the serial numbers of the registered
+// devices.
+func (c *Managed) GetList() ([]string, error) {
+	c.con.LogQuery("(get) list of managed devices")
+	path := c.xpath(nil)
+	return c.con.EntryListUsing(c.con.Get, path[:len(path)-1])
+}
+
+// Add performs SET to register the given serial numbers as managed devices.
+func (c *Managed) Add(serials ...string) error {
+	var err error
+
+	if len(serials) == 0 {
+		return nil
+	}
+
+	c.con.LogAction("(set) managed devices: %v", serials)
+
+	type devices struct {
+		XMLName xml.Name `xml:"devices"`
+		util.EntryType
+	}
+
+	// Set xpath.
+	path := c.xpath(nil)
+	path = path[:len(path)-1]
+
+	// Register the devices.
+	_, err = c.con.Set(path, devices{EntryType: *util.StrToEnt(serials)}, nil, nil)
+	return err
+}
+
+// Remove performs DELETE to unregister the given serial numbers.
+//
+// Devices must be removed from any device groups, templates, and template
+// stacks before they can be removed.
+func (c *Managed) Remove(serials ...string) error {
+	var err error
+
+	if len(serials) == 0 {
+		return nil
+	}
+
+	c.con.LogAction("(delete) managed devices: %v", serials)
+
+	// Remove the devices.
+	path := c.xpath(serials)
+	_, err = c.con.Delete(path, nil, nil)
+	return err
+}
+
+// All returns the inventory of all managed devices, whether or not they are
+// currently connected to Panorama.
+func (c *Managed) All() ([]Device, error) {
+	type req struct {
+		XMLName xml.Name `xml:"show"`
+		Cmd     string   `xml:"devices>all"`
+	}
+
+	c.con.LogOp("(op) show devices all")
+	return c.devices(req{})
+}
+
+// Connected returns the inventory of the managed devices that are currently
+// connected to Panorama.
+func (c *Managed) Connected() ([]Device, error) {
+	type req struct {
+		XMLName xml.Name `xml:"show"`
+		Cmd     string   `xml:"devices>connected"`
+	}
+
+	c.con.LogOp("(op) show devices connected")
+	return c.devices(req{})
+}
+
+// Select returns the serial numbers of all managed devices selected by the
+// given filter.
+func (c *Managed) Select(f Filter) ([]string, error) {
+	list, err := c.All()
+	if err != nil {
+		return nil, err
+	}
+
+	var ans []string
+	for _, d := range f.Apply(list) {
+		ans = append(ans, d.Serial)
+	}
+
+	return ans, nil
+}
+
+// WaitForConnection waits for the device with the given serial number to
+// connect to Panorama, such as after it has been bootstrapped.
+//
+// The sleep param is the length of time to wait between checks, and is at
+// least util.MinPollSleep, while the timeout param is the total length of
+// time to wait.  A timeout of zero waits forever.
+func (c *Managed) WaitForConnection(serial string, sleep, timeout time.Duration) (Device, error) {
+	var ans Device
+
+	err := util.Poll(sleep, timeout, func() (bool, error) {
+		list, err := c.Connected()
+		if err != nil {
+			return false, err
+		}
+		for _, d := range list {
+			if d.Serial == serial && d.Connected {
+				ans = d
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err == util.ErrTimeout {
+		return Device{}, fmt.Errorf("Timed out waiting for %q to connect", serial)
+	}
+
+	return ans, err
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *Managed) devices(req interface{}) ([]Device, error) {
+	ans := devicesResp{}
+
+	if _, err := c.con.Op(req, "", nil, &ans); err != nil {
+		return nil, err
+	}
+
+	return ans.Normalize(c.Location)
+}
+
+func (c *Managed) xpath(vals []string) []string {
+	return []string{
+		"config",
+		"mgt-config",
+		"devices",
+		util.AsEntryXpath(vals),
+	}
+}
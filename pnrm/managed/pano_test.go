@@ -0,0 +1,162 @@
+package managed
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+const devicesXml = `<devices>
+    <entry name="0001">
+        <serial>0001</serial>
+        <connected>yes</connected>
+        <hostname>fw1</hostname>
+        <ip-address>10.1.1.1</ip-address>
+        <model>PA-VM</model>
+        <sw-version>9.1.0</sw-version>
+        <app-version>8500-7000</app-version>
+        <threat-version>8500-7000</threat-version>
+        <av-version>4100-4600</av-version>
+        <wildfire-version>600000-603000</wildfire-version>
+        <device-cert-present>Valid</device-cert-present>
+        <device-cert-expiry-date>2030/01/01 00:00:00 UTC</device-cert-expiry-date>
+        <multi-vsys>yes</multi-vsys>
+        <tags><member>branch</member><member>east</member></tags>
+        <ha><state>active</state><peer><serial>0003</serial></peer></ha>
+        <vsys>
+            <entry name="vsys1"><display-name>Corp</display-name></entry>
+            <entry name="vsys2"><display-name>Guest</display-name></entry>
+        </vsys>
+    </entry>
+    <entry name="0002">
+        <serial>0002</serial>
+        <connected>no</connected>
+        <model>PA-220</model>
+    </entry>
+</devices>`
+
+func TestAll(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(devicesXml)
+
+	ns := &Managed{}
+	ns.Initialize(mc)
+
+	list, err := ns.All()
+	if err != nil {
+		t.Fatalf("Error in all: %s", err)
+	}
+	if len(list) != 2 {
+		t.Fatalf("Expected 2 devices, got %d", len(list))
+	}
+
+	d := list[0]
+	if d.Serial != "0001" || d.Hostname != "fw1" || d.Model != "PA-VM" || d.SwVersion != "9.1.0" {
+		t.Errorf("Bad device: %#v", d)
+	}
+	if !d.Connected || !d.MultiVsys || d.HaState != "active" {
+		t.Errorf("Bad device state: %#v", d)
+	}
+	if d.AppVersion != "8500-7000" || d.ThreatVersion != "8500-7000" || d.AvVersion != "4100-4600" || d.WildfireVersion != "600000-603000" {
+		t.Errorf("Bad content versions: %#v", d)
+	}
+	if d.CertificateStatus != "Valid" || d.CertificateExpiry != "2030/01/01 00:00:00 UTC" || d.CertificateExpires.Year() != 2030 {
+		t.Errorf("Bad certificate: %#v", d)
+	}
+	if len(d.Tags) != 2 || d.Tags[0] != "branch" || d.Tags[1] != "east" {
+		t.Errorf("Bad tags: %#v", d.Tags)
+	}
+	if len(d.Vsys) != 2 || d.Vsys[1].Name != "vsys2" || d.Vsys[1].DisplayName != "Guest" {
+		t.Errorf("Bad vsys: %#v", d.Vsys)
+	}
+	if list[1].Connected || list[1].HaState != "" || !list[1].CertificateExpires.IsZero() {
+		t.Errorf("Bad second device: %#v", list[1])
+	}
+}
+
+func TestSelect(t *testing.T) {
+	testCases := []struct {
+		desc string
+		f    Filter
+		want string
+	}{
+		{"everything", Filter{}, "0001,0002"},
+		{"empty lists", Filter{Tags: []string{}, Models: []string{}}, "0001,0002"},
+		{"connected", Filter{ConnectedOnly: true}, "0001"},
+		{"tag", Filter{Tags: []string{"west", "east"}}, "0001"},
+		{"model", Filter{Models: []string{"PA-220"}}, "0002"},
+		{"version prefix", Filter{SwVersions: []string{"9.1"}}, "0001"},
+		{"version mismatch", Filter{SwVersions: []string{"9.1.1"}}, ""},
+		{"standalone", Filter{HaStates: []string{""}}, "0002"},
+		{"all criteria", Filter{Tags: []string{"branch"}, Models: []string{"PA-VM"}, HaStates: []string{"passive"}}, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc := &testdata.MockClient{}
+			mc.AddResp(devicesXml)
+
+			ns := &Managed{}
+			ns.Initialize(mc)
+
+			list, err := ns.Select(tc.f)
+			if err != nil {
+				t.Fatalf("Error in select: %s", err)
+			}
+			if strings.Join(list, ",") != tc.want {
+				t.Errorf("Selected %v, not %s", list, tc.want)
+			}
+		})
+	}
+}
+
+func TestAddRemove(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp("")
+
+	ns := &Managed{}
+	ns.Initialize(mc)
+
+	if err := ns.Add("0001", "0002"); err != nil {
+		t.Fatalf("Error in add: %s", err)
+	}
+	if mc.Path != "/config/mgt-config/devices" {
+		t.Errorf("Bad add path: %s", mc.Path)
+	}
+	if mc.Elm != `<devices><entry name="0001"></entry><entry name="0002"></entry></devices>` {
+		t.Errorf("Bad add element: %s", mc.Elm)
+	}
+
+	if err := ns.Remove("0001"); err != nil {
+		t.Fatalf("Error in remove: %s", err)
+	}
+	if mc.Function != "delete" || mc.Path != "/config/mgt-config/devices/entry[@name='0001']" {
+		t.Errorf("Bad remove: %s %s", mc.Function, mc.Path)
+	}
+}
+
+func TestWaitForConnection(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp("<devices />")
+	mc.AddResp(devicesXml)
+
+	ns := &Managed{}
+	ns.Initialize(mc)
+
+	d, err := ns.WaitForConnection("0001", 0, 0)
+	if err != nil {
+		t.Fatalf("Error in wait: %s", err)
+	}
+	if d.Hostname != "fw1" || mc.Called != 2 {
+		t.Errorf("Bad wait: %#v after %d calls", d, mc.Called)
+	}
+
+	mc.Reset()
+	mc.Resp = nil
+	mc.AddResp("<devices />")
+	if _, err = ns.WaitForConnection("0001", time.Millisecond, time.Millisecond); err == nil {
+		t.Errorf("No timeout error")
+	}
+}
@@ -0,0 +1,6 @@
+package account
+
+const (
+	singular = "aws account"
+	plural   = "aws accounts"
+)
@@ -0,0 +1,9 @@
+/*
+Package account is the client.Panorama.AwsAccount namespace.
+
+AWS accounts are the IAM credentials that the Panorama AWS plugin uses to
+retrieve VM inventory for VM monitoring.
+
+Normalized object:  Entry
+*/
+package account
@@ -0,0 +1,69 @@
+package account
+
+import (
+	"encoding/xml"
+)
+
+// Entry is a normalized, version independent representation of AWS account
+// credentials.
+//
+// Either specify an access key, or a role to assume with RoleArn.
+type Entry struct {
+	Name            string
+	Description     string
+	AccessKeyId     string
+	SecretAccessKey string // encrypted
+	RoleArn         string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Description = s.Description
+	o.AccessKeyId = s.AccessKeyId
+	o.SecretAccessKey = s.SecretAccessKey
+	o.RoleArn = s.RoleArn
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:            o.Answer.Name,
+		Description:     o.Answer.Description,
+		AccessKeyId:     o.Answer.AccessKeyId,
+		SecretAccessKey: o.Answer.SecretAccessKey,
+		RoleArn:         o.Answer.RoleArn,
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName         xml.Name `xml:"entry"`
+	Name            string   `xml:"name,attr"`
+	Description     string   `xml:"description,omitempty"`
+	AccessKeyId     string   `xml:"access-key-id,omitempty"`
+	SecretAccessKey string   `xml:"secret-access-key,omitempty"`
+	RoleArn         string   `xml:"role-arn,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:            e.Name,
+		Description:     e.Description,
+		AccessKeyId:     e.AccessKeyId,
+		SecretAccessKey: e.SecretAccessKey,
+		RoleArn:         e.RoleArn,
+	}
+
+	return ans
+}
@@ -0,0 +1,26 @@
+package account
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type tc struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"access key", version.Number{9, 1, 0, ""}, Entry{
+			Name:            "prod",
+			Description:     "production account",
+			AccessKeyId:     "AKIAEXAMPLE",
+			SecretAccessKey: "secret",
+		}},
+		{"assume role", version.Number{9, 1, 0, ""}, Entry{
+			Name:    "dev",
+			RoleArn: "arn:aws:iam::123456789012:role/panorama",
+		}},
+	}
+}
@@ -0,0 +1,6 @@
+package account
+
+const (
+	singular = "azure account"
+	plural   = "azure accounts"
+)
@@ -0,0 +1,9 @@
+/*
+Package account is the client.Panorama.AzureAccount namespace.
+
+Azure accounts are the service principals that the Panorama Azure plugin uses
+to retrieve VM inventory for VM monitoring.
+
+Normalized object:  Entry
+*/
+package account
@@ -0,0 +1,72 @@
+package account
+
+import (
+	"encoding/xml"
+)
+
+// Entry is a normalized, version independent representation of an Azure
+// service principal.
+type Entry struct {
+	Name           string
+	Description    string
+	SubscriptionId string
+	TenantId       string
+	ClientId       string
+	ClientSecret   string // encrypted
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Description = s.Description
+	o.SubscriptionId = s.SubscriptionId
+	o.TenantId = s.TenantId
+	o.ClientId = s.ClientId
+	o.ClientSecret = s.ClientSecret
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:           o.Answer.Name,
+		Description:    o.Answer.Description,
+		SubscriptionId: o.Answer.SubscriptionId,
+		TenantId:       o.Answer.TenantId,
+		ClientId:       o.Answer.ClientId,
+		ClientSecret:   o.Answer.ClientSecret,
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName        xml.Name `xml:"entry"`
+	Name           string   `xml:"name,attr"`
+	Description    string   `xml:"description,omitempty"`
+	SubscriptionId string   `xml:"subscription-id,omitempty"`
+	TenantId       string   `xml:"tenant-id,omitempty"`
+	ClientId       string   `xml:"client-id,omitempty"`
+	ClientSecret   string   `xml:"client-secret,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:           e.Name,
+		Description:    e.Description,
+		SubscriptionId: e.SubscriptionId,
+		TenantId:       e.TenantId,
+		ClientId:       e.ClientId,
+		ClientSecret:   e.ClientSecret,
+	}
+
+	return ans
+}
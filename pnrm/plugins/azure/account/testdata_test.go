@@ -0,0 +1,24 @@
+package account
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type tc struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"service principal", version.Number{9, 1, 0, ""}, Entry{
+			Name:           "prod",
+			Description:    "production subscription",
+			SubscriptionId: "11111111-2222-3333-4444-555555555555",
+			TenantId:       "66666666-7777-8888-9999-000000000000",
+			ClientId:       "aaaaaaaa-bbbb-cccc-dddd-eeeeeeeeeeee",
+			ClientSecret:   "secret",
+		}},
+	}
+}
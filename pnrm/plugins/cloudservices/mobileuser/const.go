@@ -0,0 +1,9 @@
+package mobileuser
+
+// PoolWorldwide is the IpPools key for pools not tied to a single region.
+const PoolWorldwide = "worldwide"
+
+const (
+	singular = "cloud services mobile users onboarding"
+	plural   = "cloud services mobile users onboardings"
+)
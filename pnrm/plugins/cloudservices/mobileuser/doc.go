@@ -0,0 +1,12 @@
+/*
+Package mobileuser is the client.Panorama.CloudServicesMobileUser namespace.
+
+This namespace onboards GlobalProtect mobile users to Prisma Access: the
+portal hostname, the Prisma Access locations that users connect to, and the
+IP pools that users are assigned addresses from.  The onboarding is configured
+through the Panorama Cloud Services plugin, and takes effect once the plugin
+config is committed and pushed to Prisma Access.
+
+Normalized object:  Entry
+*/
+package mobileuser
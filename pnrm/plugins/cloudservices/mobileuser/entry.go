@@ -0,0 +1,119 @@
+package mobileuser
+
+import (
+	"encoding/xml"
+	"sort"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a Cloud
+// Services mobile users onboarding.
+//
+// PortalHostname is the hostname portion of the GlobalProtect portal under
+// the default Prisma Access domain.  Locations are the Prisma Access
+// locations that mobile users can connect to.  IpPools maps a region, or
+// PoolWorldwide, to the IP pools that mobile users in that region are
+// assigned addresses from.
+type Entry struct {
+	Name           string
+	PortalHostname string
+	Locations      []string
+	IpPools        map[string][]string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.PortalHostname = s.PortalHostname
+	o.Locations = s.Locations
+	if s.IpPools == nil {
+		o.IpPools = nil
+	} else {
+		o.IpPools = make(map[string][]string, len(s.IpPools))
+		for key, value := range s.IpPools {
+			o.IpPools[key] = value
+		}
+	}
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:      o.Answer.Name,
+		Locations: util.EntToStr(o.Answer.Locations),
+	}
+
+	if o.Answer.Portal != nil {
+		ans.PortalHostname = o.Answer.Portal.Hostname
+	}
+
+	if o.Answer.IpPools != nil && len(o.Answer.IpPools.Entries) > 0 {
+		ans.IpPools = make(map[string][]string, len(o.Answer.IpPools.Entries))
+		for _, v := range o.Answer.IpPools.Entries {
+			ans.IpPools[v.Name] = util.MemToStr(v.Pools)
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName   xml.Name        `xml:"entry"`
+	Name      string          `xml:"name,attr"`
+	Portal    *portal         `xml:"portal-hostname"`
+	Locations *util.EntryType `xml:"region"`
+	IpPools   *ipPools        `xml:"ip-pools"`
+}
+
+type portal struct {
+	Hostname string `xml:"default-domain>hostname"`
+}
+
+type ipPools struct {
+	Entries []ipPool `xml:"entry"`
+}
+
+type ipPool struct {
+	Name  string           `xml:"name,attr"`
+	Pools *util.MemberType `xml:"ip-pool"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:      e.Name,
+		Locations: util.StrToEnt(e.Locations),
+	}
+
+	if e.PortalHostname != "" {
+		ans.Portal = &portal{Hostname: e.PortalHostname}
+	}
+
+	if len(e.IpPools) > 0 {
+		keys := make([]string, 0, len(e.IpPools))
+		for key := range e.IpPools {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		list := make([]ipPool, 0, len(keys))
+		for _, key := range keys {
+			list = append(list, ipPool{
+				Name:  key,
+				Pools: util.StrToMem(e.IpPools[key]),
+			})
+		}
+		ans.IpPools = &ipPools{Entries: list}
+	}
+
+	return ans
+}
@@ -0,0 +1,29 @@
+package mobileuser
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type tc struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"portal only", version.Number{9, 1, 0, ""}, Entry{
+			Name:           "GlobalProtect",
+			PortalHostname: "acme",
+		}},
+		{"locations and pools", version.Number{9, 1, 0, ""}, Entry{
+			Name:           "GlobalProtect",
+			PortalHostname: "acme",
+			Locations:      []string{"us-east", "europe-west"},
+			IpPools: map[string][]string{
+				PoolWorldwide: {"100.64.0.0/16"},
+				"americas":    {"100.65.0.0/16", "100.66.0.0/16"},
+			},
+		}},
+	}
+}
@@ -0,0 +1,6 @@
+package remotenet
+
+const (
+	singular = "cloud services remote network"
+	plural   = "cloud services remote networks"
+)
@@ -0,0 +1,10 @@
+/*
+Package remotenet is the client.Panorama.CloudServicesRemoteNetwork namespace.
+
+Remote networks connect branch offices to Prisma Access.  They are configured
+through the Panorama Cloud Services plugin, and take effect once the plugin
+config is committed and pushed to Prisma Access.
+
+Normalized object:  Entry
+*/
+package remotenet
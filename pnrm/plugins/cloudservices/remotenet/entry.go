@@ -0,0 +1,121 @@
+package remotenet
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a Cloud
+// Services remote network.
+//
+// SpnName is the security processing node that the remote network is
+// onboarded to.  IpsecTunnel and SecondaryIpsecTunnel are IPSec tunnels
+// configured in the Remote_Network_Template template.
+type Entry struct {
+	Name                 string
+	Region               string
+	SpnName              string
+	IpsecTunnel          string
+	SecondaryIpsecTunnel string
+	Subnets              []string
+	BgpEnable            bool
+	BgpPeerAs            string
+	BgpPeerIpAddress     string
+	BgpLocalIpAddress    string
+	BgpSecret            string // encrypted
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Region = s.Region
+	o.SpnName = s.SpnName
+	o.IpsecTunnel = s.IpsecTunnel
+	o.SecondaryIpsecTunnel = s.SecondaryIpsecTunnel
+	o.Subnets = s.Subnets
+	o.BgpEnable = s.BgpEnable
+	o.BgpPeerAs = s.BgpPeerAs
+	o.BgpPeerIpAddress = s.BgpPeerIpAddress
+	o.BgpLocalIpAddress = s.BgpLocalIpAddress
+	o.BgpSecret = s.BgpSecret
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:                 o.Answer.Name,
+		Region:               o.Answer.Region,
+		SpnName:              o.Answer.SpnName,
+		IpsecTunnel:          o.Answer.IpsecTunnel,
+		SecondaryIpsecTunnel: o.Answer.SecondaryIpsecTunnel,
+		Subnets:              util.MemToStr(o.Answer.Subnets),
+	}
+
+	if o.Answer.Protocol != nil && o.Answer.Protocol.Bgp != nil {
+		ans.BgpEnable = util.AsBool(o.Answer.Protocol.Bgp.Enable)
+		ans.BgpPeerAs = o.Answer.Protocol.Bgp.PeerAs
+		ans.BgpPeerIpAddress = o.Answer.Protocol.Bgp.PeerIpAddress
+		ans.BgpLocalIpAddress = o.Answer.Protocol.Bgp.LocalIpAddress
+		ans.BgpSecret = o.Answer.Protocol.Bgp.Secret
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName              xml.Name         `xml:"entry"`
+	Name                 string           `xml:"name,attr"`
+	Region               string           `xml:"region,omitempty"`
+	SpnName              string           `xml:"spn-name,omitempty"`
+	IpsecTunnel          string           `xml:"ipsec-tunnel,omitempty"`
+	SecondaryIpsecTunnel string           `xml:"secondary-ipsec-tunnel,omitempty"`
+	Subnets              *util.MemberType `xml:"subnets"`
+	Protocol             *protocol        `xml:"protocol"`
+}
+
+type protocol struct {
+	Bgp *bgp `xml:"bgp"`
+}
+
+type bgp struct {
+	Enable         string `xml:"enable"`
+	PeerAs         string `xml:"peer-as,omitempty"`
+	PeerIpAddress  string `xml:"peer-ip-address,omitempty"`
+	LocalIpAddress string `xml:"local-ip-address,omitempty"`
+	Secret         string `xml:"secret,omitempty"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:                 e.Name,
+		Region:               e.Region,
+		SpnName:              e.SpnName,
+		IpsecTunnel:          e.IpsecTunnel,
+		SecondaryIpsecTunnel: e.SecondaryIpsecTunnel,
+		Subnets:              util.StrToMem(e.Subnets),
+	}
+
+	if e.BgpEnable || e.BgpPeerAs != "" || e.BgpPeerIpAddress != "" || e.BgpLocalIpAddress != "" || e.BgpSecret != "" {
+		ans.Protocol = &protocol{
+			Bgp: &bgp{
+				Enable:         util.YesNo(e.BgpEnable),
+				PeerAs:         e.BgpPeerAs,
+				PeerIpAddress:  e.BgpPeerIpAddress,
+				LocalIpAddress: e.BgpLocalIpAddress,
+				Secret:         e.BgpSecret,
+			},
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,33 @@
+package remotenet
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type tc struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"static routing", version.Number{9, 1, 0, ""}, Entry{
+			Name:        "branch1",
+			Region:      "us-east-1",
+			SpnName:     "us-east-spn-1",
+			IpsecTunnel: "branch1-tunnel",
+			Subnets:     []string{"10.10.0.0/24"},
+		}},
+		{"bgp", version.Number{9, 1, 0, ""}, Entry{
+			Name:                 "branch2",
+			Region:               "eu-west-1",
+			SpnName:              "eu-west-spn-1",
+			IpsecTunnel:          "branch2-tunnel",
+			SecondaryIpsecTunnel: "branch2-tunnel-backup",
+			BgpEnable:            true,
+			BgpPeerAs:            "65010",
+			BgpPeerIpAddress:     "172.16.0.1",
+		}},
+	}
+}
@@ -0,0 +1,6 @@
+package svcconn
+
+const (
+	singular = "cloud services service connection"
+	plural   = "cloud services service connections"
+)
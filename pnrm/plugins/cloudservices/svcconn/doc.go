@@ -0,0 +1,12 @@
+/*
+Package svcconn is the client.Panorama.CloudServicesServiceConnection
+namespace.
+
+Service connections link Prisma Access to headquarters and data center
+networks.  They are configured through the Panorama Cloud Services plugin,
+and take effect once the plugin config is committed and pushed to Prisma
+Access.
+
+Normalized object:  Entry
+*/
+package svcconn
@@ -0,0 +1,33 @@
+package svcconn
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type tc struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"static routing", version.Number{9, 1, 0, ""}, Entry{
+			Name:        "hq",
+			Region:      "us-east-1",
+			IpsecTunnel: "hq-tunnel",
+			Subnets:     []string{"10.1.0.0/16", "10.2.0.0/16"},
+		}},
+		{"bgp", version.Number{9, 1, 0, ""}, Entry{
+			Name:                 "dc",
+			Region:               "us-west-2",
+			IpsecTunnel:          "dc-tunnel",
+			SecondaryIpsecTunnel: "dc-tunnel-backup",
+			BgpEnable:            true,
+			BgpPeerAs:            "65001",
+			BgpPeerIpAddress:     "192.168.1.1",
+			BgpLocalIpAddress:    "192.168.1.2",
+			BgpSecret:            "secret",
+		}},
+	}
+}
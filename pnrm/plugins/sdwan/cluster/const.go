@@ -0,0 +1,11 @@
+package cluster
+
+// Valid values for Type.
+const (
+	TypeHubSpoke = "hub-spoke"
+)
+
+const (
+	singular = "sdwan vpn cluster"
+	plural   = "sdwan vpn clusters"
+)
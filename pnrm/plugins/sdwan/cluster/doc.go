@@ -0,0 +1,10 @@
+/*
+Package cluster is the client.Panorama.SdwanVpnCluster namespace.
+
+VPN clusters group SD-WAN hubs and branches, identified by serial number, so
+that the Panorama SD-WAN plugin can build the VPN tunnels between them.  The
+health of the paths between cluster members can be checked with PathHealth.
+
+Normalized object:  Entry
+*/
+package cluster
@@ -0,0 +1,66 @@
+package cluster
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of an SD-WAN
+// VPN cluster.
+//
+// Branches and Hubs are the serial numbers of the member firewalls.
+type Entry struct {
+	Name     string
+	Type     string
+	Branches []string
+	Hubs     []string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Type = s.Type
+	o.Branches = s.Branches
+	o.Hubs = s.Hubs
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:     o.Answer.Name,
+		Type:     o.Answer.Type,
+		Branches: util.EntToStr(o.Answer.Branches),
+		Hubs:     util.EntToStr(o.Answer.Hubs),
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName  xml.Name        `xml:"entry"`
+	Name     string          `xml:"name,attr"`
+	Type     string          `xml:"type,omitempty"`
+	Branches *util.EntryType `xml:"branches"`
+	Hubs     *util.EntryType `xml:"hubs"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:     e.Name,
+		Type:     e.Type,
+		Branches: util.StrToEnt(e.Branches),
+		Hubs:     util.StrToEnt(e.Hubs),
+	}
+
+	return ans
+}
@@ -0,0 +1,29 @@
+package cluster
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// PathHealth returns the health of the paths of the virtual SD-WAN interface
+// vif, such as "sdwan.901", on the firewall with the given serial number.
+//
+// Panorama proxies this command to the firewall, so the firewall must be
+// connected to Panorama.  This is the same path monitoring output as the
+// firewall's SdwanPathHealth.
+func (c *Cluster) PathHealth(serial, vif string) ([]util.SdwanPath, error) {
+	type req struct {
+		XMLName xml.Name `xml:"show"`
+		Vif     string   `xml:"sdwan>path-monitor>stats>vif"`
+	}
+
+	ans := util.SdwanPathResponse{}
+
+	c.con.LogOp("(op) show sdwan path-monitor stats vif %s on %s", vif, serial)
+	if _, err := c.con.Op(req{Vif: vif}, "", util.TargetExtras(serial), &ans); err != nil {
+		return nil, err
+	}
+
+	return ans.Normalize(vif), nil
+}
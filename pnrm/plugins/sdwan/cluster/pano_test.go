@@ -0,0 +1,67 @@
+package cluster
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+func TestPanoNormalization(t *testing.T) {
+	testCases := getTests()
+
+	mc := &testdata.MockClient{}
+	ns := &Cluster{}
+	ns.Initialize(mc)
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc.Version = tc.version
+			mc.Reset()
+			mc.AddResp("")
+			err := ns.Set(tc.conf)
+			if err != nil {
+				t.Errorf("Error in set: %s", err)
+			} else {
+				mc.AddResp(mc.Elm)
+				r, err := ns.Get(tc.conf.Name)
+				if err != nil {
+					t.Errorf("Error in get: %s", err)
+				}
+				if !reflect.DeepEqual(tc.conf, r) {
+					t.Errorf("%#v != %#v", tc.conf, r)
+				}
+			}
+		})
+	}
+}
+
+func TestPathHealth(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &Cluster{}
+	ns.Initialize(mc)
+
+	mc.AddResp(`<entry><tunnel>tunnel.901</tunnel><interface>ethernet1/1</interface><status>up</status><latency>12.5</latency><jitter>1ms</jitter><pkt-loss>0.1%</pkt-loss></entry>`)
+	list, err := ns.PathHealth("0001", "sdwan.901")
+	if err != nil {
+		t.Fatalf("Error in path health: %s", err)
+	}
+
+	expected := []util.SdwanPath{{
+		Vif:        "sdwan.901",
+		Tunnel:     "tunnel.901",
+		Interface:  "ethernet1/1",
+		Status:     "up",
+		Latency:    12.5,
+		Jitter:     1,
+		PacketLoss: 0.1,
+	}}
+	if !reflect.DeepEqual(list, expected) {
+		t.Errorf("Expected %#v, got %#v", expected, list)
+	}
+	if v := mc.Extras.(url.Values).Get("target"); v != "0001" {
+		t.Errorf("Target is %q, not 0001", v)
+	}
+}
@@ -0,0 +1,26 @@
+package cluster
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type tc struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"hub and spoke", version.Number{9, 1, 0, ""}, Entry{
+			Name:     "c1",
+			Type:     TypeHubSpoke,
+			Branches: []string{"0001", "0002"},
+			Hubs:     []string{"0101"},
+		}},
+		{"no members", version.Number{9, 1, 0, ""}, Entry{
+			Name: "c2",
+			Type: TypeHubSpoke,
+		}},
+	}
+}
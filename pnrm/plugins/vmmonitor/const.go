@@ -0,0 +1,13 @@
+package vmmonitor
+
+// Valid values for the cloud param.
+const (
+	CloudAws   = "aws"
+	CloudAzure = "azure"
+	CloudGcp   = "gcp"
+)
+
+const (
+	singular = "vm monitoring definition"
+	plural   = "vm monitoring definitions"
+)
@@ -0,0 +1,12 @@
+/*
+Package vmmonitor is the client.Panorama.VmMonitoringDefinition namespace.
+
+Monitoring definitions configure the AWS, Azure, and GCP plugins to retrieve
+VM inventory from a cloud account and register the VMs' tags as IP tags on
+the firewalls of the given device groups, so that dynamic address groups can
+match on them.  The cloud account itself is configured in the AwsAccount,
+AzureAccount, or GcpAccount namespace.
+
+Normalized object:  Entry
+*/
+package vmmonitor
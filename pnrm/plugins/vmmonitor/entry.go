@@ -0,0 +1,124 @@
+package vmmonitor
+
+import (
+	"encoding/xml"
+	"sort"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Entry is a normalized, version independent representation of a VM
+// monitoring definition.
+//
+// Account is the name of the cloud account to monitor.  DeviceGroups are the
+// device groups whose firewalls are notified of IP tags.  TagMappings maps a
+// cloud tag key to the IP tag it is registered as, for use in dynamic address
+// group match criteria; cloud tags not in TagMappings are registered using
+// the plugin's default naming.
+type Entry struct {
+	Name         string
+	Description  string
+	Enable       bool
+	Account      string
+	Regions      []string
+	DeviceGroups []string
+	TagMappings  map[string]string
+}
+
+// Copy copies the information from source Entry `s` to this object.  As the
+// Name field relates to the XPATH of this object, this field is not copied.
+func (o *Entry) Copy(s Entry) {
+	o.Description = s.Description
+	o.Enable = s.Enable
+	o.Account = s.Account
+	o.Regions = s.Regions
+	o.DeviceGroups = s.DeviceGroups
+	if s.TagMappings == nil {
+		o.TagMappings = nil
+	} else {
+		o.TagMappings = make(map[string]string, len(s.TagMappings))
+		for key, value := range s.TagMappings {
+			o.TagMappings[key] = value
+		}
+	}
+}
+
+/** Structs / functions for this namespace. **/
+
+type normalizer interface {
+	Normalize() Entry
+}
+
+type container_v1 struct {
+	Answer entry_v1 `xml:"result>entry"`
+}
+
+func (o *container_v1) Normalize() Entry {
+	ans := Entry{
+		Name:         o.Answer.Name,
+		Description:  o.Answer.Description,
+		Enable:       util.AsBool(o.Answer.Enable),
+		Account:      o.Answer.Account,
+		Regions:      util.MemToStr(o.Answer.Regions),
+		DeviceGroups: util.MemToStr(o.Answer.DeviceGroups),
+	}
+
+	if o.Answer.TagMappings != nil && len(o.Answer.TagMappings.Entries) > 0 {
+		ans.TagMappings = make(map[string]string, len(o.Answer.TagMappings.Entries))
+		for _, v := range o.Answer.TagMappings.Entries {
+			ans.TagMappings[v.Name] = v.IpTag
+		}
+	}
+
+	return ans
+}
+
+type entry_v1 struct {
+	XMLName      xml.Name         `xml:"entry"`
+	Name         string           `xml:"name,attr"`
+	Description  string           `xml:"description,omitempty"`
+	Enable       string           `xml:"enable"`
+	Account      string           `xml:"account,omitempty"`
+	Regions      *util.MemberType `xml:"regions"`
+	DeviceGroups *util.MemberType `xml:"notify-group>device-group"`
+	TagMappings  *tagMappings     `xml:"tag-mapping"`
+}
+
+type tagMappings struct {
+	Entries []tagMapping `xml:"entry"`
+}
+
+type tagMapping struct {
+	Name  string `xml:"name,attr"`
+	IpTag string `xml:"ip-tag"`
+}
+
+func specify_v1(e Entry) interface{} {
+	ans := entry_v1{
+		Name:         e.Name,
+		Description:  e.Description,
+		Enable:       util.YesNo(e.Enable),
+		Account:      e.Account,
+		Regions:      util.StrToMem(e.Regions),
+		DeviceGroups: util.StrToMem(e.DeviceGroups),
+	}
+
+	if len(e.TagMappings) > 0 {
+		keys := make([]string, 0, len(e.TagMappings))
+		for key := range e.TagMappings {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		list := make([]tagMapping, 0, len(keys))
+		for _, key := range keys {
+			list = append(list, tagMapping{
+				Name:  key,
+				IpTag: e.TagMappings[key],
+			})
+		}
+		ans.TagMappings = &tagMappings{Entries: list}
+	}
+
+	return ans
+}
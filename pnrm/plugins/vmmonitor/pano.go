@@ -0,0 +1,153 @@
+package vmmonitor
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// VmMonitor is the client.Panorama.VmMonitoringDefinition namespace.
+//
+// The cloud param of each function is one of the Cloud* constants.
+type VmMonitor struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *VmMonitor) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// ShowList performs SHOW to retrieve a list of values.
+func (c *VmMonitor) ShowList(cloud string) ([]string, error) {
+	c.con.LogQuery("(show) list of %s %s", cloud, plural)
+	path := c.xpath(cloud, nil)
+	return c.con.EntryListUsing(c.con.Show, path[:len(path)-1])
+}
+
+// GetList performs GET to retrieve a list of values.
+func (c *VmMonitor) GetList(cloud string) ([]string, error) {
+	c.con.LogQuery("(get) list of %s %s", cloud, plural)
+	path := c.xpath(cloud, nil)
+	return c.con.EntryListUsing(c.con.Get, path[:len(path)-1])
+}
+
+// Get performs GET to retrieve information for the given monitoring definition.
+func (c *VmMonitor) Get(cloud, name string) (Entry, error) {
+	c.con.LogQuery("(get) %s %s %q", cloud, singular, name)
+	return c.details(c.con.Get, cloud, name)
+}
+
+// Show performs SHOW to retrieve information for the given monitoring definition.
+func (c *VmMonitor) Show(cloud, name string) (Entry, error) {
+	c.con.LogQuery("(show) %s %s %q", cloud, singular, name)
+	return c.details(c.con.Show, cloud, name)
+}
+
+// Set performs SET to create / update one or more objects.
+func (c *VmMonitor) Set(cloud string, e ...Entry) error {
+	var err error
+
+	if len(e) == 0 {
+		return nil
+	}
+
+	_, fn := c.versioning()
+	names := make([]string, len(e))
+
+	// Build up the struct.
+	d := util.BulkElement{XMLName: xml.Name{Local: "temp"}}
+	for i := range e {
+		d.Data = append(d.Data, fn(e[i]))
+		names[i] = e[i].Name
+	}
+	c.con.LogAction("(set) %s %s: %v", cloud, plural, names)
+
+	// Set xpath.
+	path := c.xpath(cloud, names)
+	d.XMLName = xml.Name{Local: path[len(path)-2]}
+	if len(e) == 1 {
+		path = path[:len(path)-1]
+	} else {
+		path = path[:len(path)-2]
+	}
+
+	// Create the objects.
+	_, err = c.con.Set(path, d.Config(), nil, nil)
+	return err
+}
+
+// Edit performs EDIT to create / update one object.
+func (c *VmMonitor) Edit(cloud string, e Entry) error {
+	var err error
+
+	_, fn := c.versioning()
+
+	c.con.LogAction("(edit) %s %s %q", cloud, singular, e.Name)
+
+	// Set xpath.
+	path := c.xpath(cloud, []string{e.Name})
+
+	// Edit the object.
+	_, err = c.con.Edit(path, fn(e), nil, nil)
+	return err
+}
+
+// Delete removes the given objects.
+//
+// Objects can be a string or an Entry object.
+func (c *VmMonitor) Delete(cloud string, e ...interface{}) error {
+	var err error
+
+	if len(e) == 0 {
+		return nil
+	}
+
+	names := make([]string, len(e))
+	for i := range e {
+		switch v := e[i].(type) {
+		case string:
+			names[i] = v
+		case Entry:
+			names[i] = v.Name
+		default:
+			return fmt.Errorf("Unknown type sent to delete: %s", v)
+		}
+	}
+	c.con.LogAction("(delete) %s %s: %v", cloud, plural, names)
+
+	// Remove the objects.
+	path := c.xpath(cloud, names)
+	_, err = c.con.Delete(path, nil, nil)
+	return err
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *VmMonitor) versioning() (normalizer, func(Entry) interface{}) {
+	return &container_v1{}, specify_v1
+}
+
+func (c *VmMonitor) details(fn util.Retriever, cloud, name string) (Entry, error) {
+	path := c.xpath(cloud, []string{name})
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Entry{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *VmMonitor) xpath(cloud string, vals []string) []string {
+	return []string{
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"plugins",
+		cloud,
+		"monitoring-definition",
+		util.AsEntryXpath(vals),
+	}
+}
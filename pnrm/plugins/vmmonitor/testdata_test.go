@@ -0,0 +1,32 @@
+package vmmonitor
+
+import (
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+type tc struct {
+	desc    string
+	version version.Number
+	conf    Entry
+}
+
+func getTests() []tc {
+	return []tc{
+		{"disabled", version.Number{9, 1, 0, ""}, Entry{
+			Name:    "m1",
+			Account: "prod",
+		}},
+		{"full", version.Number{9, 1, 0, ""}, Entry{
+			Name:         "m2",
+			Description:  "production vms",
+			Enable:       true,
+			Account:      "prod",
+			Regions:      []string{"us-east-1", "us-west-2"},
+			DeviceGroups: []string{"aws-fws"},
+			TagMappings: map[string]string{
+				"App":  "app",
+				"Tier": "tier",
+			},
+		}},
+	}
+}
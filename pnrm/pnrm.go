@@ -6,28 +6,78 @@ package pnrm
 import (
 	"github.com/PaloAltoNetworks/pango/util"
 
+	"github.com/PaloAltoNetworks/pango/pnrm/accessdomain"
+	"github.com/PaloAltoNetworks/pango/pnrm/batch"
+	"github.com/PaloAltoNetworks/pango/pnrm/content"
 	"github.com/PaloAltoNetworks/pango/pnrm/dg"
+	"github.com/PaloAltoNetworks/pango/pnrm/ha"
+	"github.com/PaloAltoNetworks/pango/pnrm/managed"
+	awsaccount "github.com/PaloAltoNetworks/pango/pnrm/plugins/aws/account"
+	azureaccount "github.com/PaloAltoNetworks/pango/pnrm/plugins/azure/account"
+	"github.com/PaloAltoNetworks/pango/pnrm/plugins/cloudservices/mobileuser"
+	"github.com/PaloAltoNetworks/pango/pnrm/plugins/cloudservices/remotenet"
+	"github.com/PaloAltoNetworks/pango/pnrm/plugins/cloudservices/svcconn"
 	"github.com/PaloAltoNetworks/pango/pnrm/plugins/gcp/account"
 	"github.com/PaloAltoNetworks/pango/pnrm/plugins/gcp/gke/cluster"
 	"github.com/PaloAltoNetworks/pango/pnrm/plugins/gcp/gke/cluster/group"
+	sdwancluster "github.com/PaloAltoNetworks/pango/pnrm/plugins/sdwan/cluster"
+	"github.com/PaloAltoNetworks/pango/pnrm/plugins/vmmonitor"
 	"github.com/PaloAltoNetworks/pango/pnrm/template"
 	"github.com/PaloAltoNetworks/pango/pnrm/template/stack"
 	"github.com/PaloAltoNetworks/pango/pnrm/template/variable"
+	"github.com/PaloAltoNetworks/pango/pnrm/upgrade"
 )
 
 // Pnrm is the panorama.DeviceGroup namespace.
 type Pnrm struct {
-	DeviceGroup      *dg.Dg
-	GcpAccount       *account.Account
-	GkeCluster       *cluster.Cluster
-	GkeClusterGroup  *group.Group
-	Template         *template.Template
-	TemplateStack    *stack.Stack
-	TemplateVariable *variable.Variable
+	AccessDomain                   *accessdomain.AccessDomain
+	AwsAccount                     *awsaccount.Account
+	AzureAccount                   *azureaccount.Account
+	Batch                          *batch.Batch
+	CloudServicesMobileUser        *mobileuser.MobileUser
+	CloudServicesRemoteNetwork     *remotenet.RemoteNet
+	CloudServicesServiceConnection *svcconn.SvcConn
+	Content                        *content.Content
+	DeviceGroup                    *dg.Dg
+	GcpAccount                     *account.Account
+	GkeCluster                     *cluster.Cluster
+	GkeClusterGroup                *group.Group
+	Ha                             *ha.Ha
+	ManagedDevice                  *managed.Managed
+	SdwanVpnCluster                *sdwancluster.Cluster
+	Template                       *template.Template
+	TemplateStack                  *stack.Stack
+	TemplateVariable               *variable.Variable
+	Upgrade                        *upgrade.Upgrade
+	VmMonitoringDefinition         *vmmonitor.VmMonitor
 }
 
 // Initialize is invoked on panorama.Initialize().
 func (c *Pnrm) Initialize(i util.XapiClient) {
+	c.AccessDomain = &accessdomain.AccessDomain{}
+	c.AccessDomain.Initialize(i)
+
+	c.AwsAccount = &awsaccount.Account{}
+	c.AwsAccount.Initialize(i)
+
+	c.AzureAccount = &azureaccount.Account{}
+	c.AzureAccount.Initialize(i)
+
+	c.Batch = &batch.Batch{}
+	c.Batch.Initialize(i)
+
+	c.CloudServicesMobileUser = &mobileuser.MobileUser{}
+	c.CloudServicesMobileUser.Initialize(i)
+
+	c.CloudServicesRemoteNetwork = &remotenet.RemoteNet{}
+	c.CloudServicesRemoteNetwork.Initialize(i)
+
+	c.CloudServicesServiceConnection = &svcconn.SvcConn{}
+	c.CloudServicesServiceConnection.Initialize(i)
+
+	c.Content = &content.Content{}
+	c.Content.Initialize(i)
+
 	c.DeviceGroup = &dg.Dg{}
 	c.DeviceGroup.Initialize(i)
 
@@ -40,6 +90,15 @@ func (c *Pnrm) Initialize(i util.XapiClient) {
 	c.GkeClusterGroup = &group.Group{}
 	c.GkeClusterGroup.Initialize(i)
 
+	c.Ha = &ha.Ha{}
+	c.Ha.Initialize(i)
+
+	c.ManagedDevice = &managed.Managed{}
+	c.ManagedDevice.Initialize(i)
+
+	c.SdwanVpnCluster = &sdwancluster.Cluster{}
+	c.SdwanVpnCluster.Initialize(i)
+
 	c.Template = &template.Template{}
 	c.Template.Initialize(i)
 
@@ -48,4 +107,10 @@ func (c *Pnrm) Initialize(i util.XapiClient) {
 
 	c.TemplateVariable = &variable.Variable{}
 	c.TemplateVariable.Initialize(i)
+
+	c.Upgrade = &upgrade.Upgrade{}
+	c.Upgrade.Initialize(i)
+
+	c.VmMonitoringDefinition = &vmmonitor.VmMonitor{}
+	c.VmMonitoringDefinition.Initialize(i)
 }
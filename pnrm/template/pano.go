@@ -3,7 +3,9 @@ package template
 import (
 	"encoding/xml"
 	"fmt"
+	"sort"
 
+	"github.com/PaloAltoNetworks/pango/cfgtree"
 	"github.com/PaloAltoNetworks/pango/util"
 	"github.com/PaloAltoNetworks/pango/version"
 )
@@ -219,6 +221,101 @@ func (c *Template) Delete(e ...interface{}) error {
 	return err
 }
 
+// Clone creates template dst as a copy of template src.
+//
+// The config and variables of the source template are copied, but the
+// devices are not.
+func (c *Template) Clone(src, dst string) error {
+	if src == dst {
+		return fmt.Errorf("Can't clone template %q onto itself", src)
+	}
+
+	list, err := c.GetList()
+	if err != nil {
+		return err
+	}
+	for _, name := range list {
+		if name == dst {
+			return fmt.Errorf("Template %q already exists", dst)
+		}
+	}
+
+	o, err := c.Get(src)
+	if err != nil {
+		return err
+	}
+	o.Name = dst
+	o.Devices = nil
+
+	c.con.LogAction("(clone) template %q to %q", src, dst)
+	return c.Set(o)
+}
+
+// Merge merges the config and variables of template src into template dst.
+//
+// Config that is only present in src is added to dst.  Values present in both
+// templates but that differ are returned as conflicts, sorted by xpath.  If overwrite is true,
+// then the value from src is used, otherwise the value in dst is kept.
+//
+// Template dst is only updated if the merge results in a change.
+func (c *Template) Merge(src, dst string, overwrite bool) ([]cfgtree.Conflict, error) {
+	so, err := c.Get(src)
+	if err != nil {
+		return nil, err
+	}
+
+	do, err := c.Get(dst)
+	if err != nil {
+		return nil, err
+	}
+
+	c.con.LogAction("(merge) template %q into %q", src, dst)
+	var conflicts []cfgtree.Conflict
+	changed := false
+	for key, tag := range map[string]string{"conf": "config", "vars": "variable"} {
+		st, present := so.raw[key]
+		if !present {
+			continue
+		}
+
+		dt, present := do.raw[key]
+		if !present {
+			if do.raw == nil {
+				do.raw = make(map[string]string)
+			}
+			do.raw[key] = st
+			changed = true
+			continue
+		}
+
+		sn, err := cfgtree.ParseInner(tag, []byte(st))
+		if err != nil {
+			return nil, err
+		}
+		dn, err := cfgtree.ParseInner(tag, []byte(dt))
+		if err != nil {
+			return nil, err
+		}
+
+		before := dn.String()
+		conflicts = append(conflicts, dn.Merge(sn, overwrite)...)
+		if dn.String() != before {
+			do.raw[key] = dn.InnerXml()
+			changed = true
+		}
+	}
+
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].Path < conflicts[j].Path
+	})
+
+	if !changed {
+		return conflicts, nil
+	}
+
+	return conflicts, c.Edit(do)
+}
+
 /** Internal functions for this namespace struct **/
 
 func (c *Template) versioning() (normalizer, func(Entry) interface{}) {
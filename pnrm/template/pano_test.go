@@ -2,6 +2,7 @@ package template
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/PaloAltoNetworks/pango/testdata"
@@ -92,3 +93,34 @@ func TestNormalization(t *testing.T) {
 		})
 	}
 }
+
+func TestMerge(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.Version = version.Number{8, 1, 0, ""}
+	mc.AddResp(`<entry name="src"><config><devices><entry name="localhost.localdomain"><deviceconfig><system><hostname>src</hostname><timezone>UTC</timezone></system></deviceconfig></entry></devices></config><variable><entry name="$gw"><type><ip-netmask>10.1.1.1</ip-netmask></type></entry></variable></entry>`)
+	mc.AddResp(`<entry name="dst"><config><devices><entry name="localhost.localdomain"><deviceconfig><system><hostname>dst</hostname></system></deviceconfig></entry></devices></config><variable><entry name="$gw"><type><ip-netmask>10.2.2.2</ip-netmask></type></entry></variable></entry>`)
+	mc.AddResp("")
+
+	ns := &Template{}
+	ns.Initialize(mc)
+
+	conflicts, err := ns.Merge("src", "dst", false)
+	if err != nil {
+		t.Fatalf("Error in merge: %s", err)
+	}
+
+	if len(conflicts) != 2 {
+		t.Fatalf("Expected 2 conflicts, got %#v", conflicts)
+	} else if conflicts[0].Existing != "dst" || conflicts[0].Incoming != "src" {
+		t.Errorf("Unexpected first conflict: %#v", conflicts[0])
+	} else if conflicts[1].Existing != "10.2.2.2" || conflicts[1].Incoming != "10.1.1.1" {
+		t.Errorf("Unexpected second conflict: %#v", conflicts[1])
+	}
+
+	if mc.Function != "edit" {
+		t.Fatalf("Expected edit, got %q", mc.Function)
+	}
+	if !strings.Contains(mc.Elm, "<hostname>dst</hostname><timezone>UTC</timezone>") {
+		t.Errorf("Merged config is wrong: %s", mc.Elm)
+	}
+}
@@ -3,6 +3,7 @@ package stack
 import (
 	"encoding/xml"
 	"fmt"
+	"sort"
 
 	"github.com/PaloAltoNetworks/pango/util"
 )
@@ -95,6 +96,182 @@ func (c *Stack) DeleteDevice(st interface{}, d string) error {
 	return err
 }
 
+/*
+SetTemplate performs a SET to add template tmpl to template stack st.
+
+Templates are added at the lowest priority.  To change the priority of the
+templates in the stack, use EditTemplates.
+
+The template stack can be either a string or an Entry object.
+*/
+func (c *Stack) SetTemplate(st interface{}, tmpl string) error {
+	name, err := c.stackName(st)
+	if err != nil {
+		return err
+	}
+
+	c.con.LogAction("(set) template %q in template stack: %s", tmpl, name)
+
+	path := c.xpath([]string{name})
+	path = append(path, "templates")
+
+	_, err = c.con.Set(path, util.Member{Value: tmpl}, nil, nil)
+	return err
+}
+
+/*
+EditTemplates performs an EDIT to replace the templates of template stack st.
+
+The templates given are in priority order, with the highest priority template
+first.
+
+The template stack can be either a string or an Entry object.
+*/
+func (c *Stack) EditTemplates(st interface{}, tmpls []string) error {
+	name, err := c.stackName(st)
+	if err != nil {
+		return err
+	}
+
+	c.con.LogAction("(edit) templates in template stack %s: %v", name, tmpls)
+
+	type templates struct {
+		XMLName xml.Name `xml:"templates"`
+		util.MemberType
+	}
+
+	d := templates{}
+	if len(tmpls) > 0 {
+		d.MemberType = *util.StrToMem(tmpls)
+	}
+
+	path := c.xpath([]string{name})
+	path = append(path, "templates")
+
+	_, err = c.con.Edit(path, d, nil, nil)
+	return err
+}
+
+/*
+MoveTemplate moves template tmpl within template stack st.
+
+Templates earlier in the stack have a higher priority, so moving a template
+changes which template's config takes precedence.
+
+Param mvt is a util.Move* constant, and rel is the template that mvt is in
+relation to.  Param rel is ignored for util.MoveTop and util.MoveBottom.
+
+The template stack can be either a string or an Entry object.
+*/
+func (c *Stack) MoveTemplate(st interface{}, tmpl string, mvt int, rel string) error {
+	name, err := c.stackName(st)
+	if err != nil {
+		return err
+	}
+
+	e, err := c.Get(name)
+	if err != nil {
+		return err
+	}
+
+	list, err := moveTemplate(e.Templates, tmpl, mvt, rel)
+	if err != nil || list == nil {
+		return err
+	}
+
+	return c.EditTemplates(name, list)
+}
+
+/*
+MoveTemplateUp moves template tmpl one position up in template stack st,
+giving it a higher priority.
+
+The template stack can be either a string or an Entry object.
+*/
+func (c *Stack) MoveTemplateUp(st interface{}, tmpl string) error {
+	return c.shiftTemplate(st, tmpl, -1)
+}
+
+/*
+MoveTemplateDown moves template tmpl one position down in template stack st,
+giving it a lower priority.
+
+The template stack can be either a string or an Entry object.
+*/
+func (c *Stack) MoveTemplateDown(st interface{}, tmpl string) error {
+	return c.shiftTemplate(st, tmpl, 1)
+}
+
+/*
+DeleteTemplate performs a DELETE to remove template tmpl from template stack st.
+
+The template stack can be either a string or an Entry object.
+*/
+func (c *Stack) DeleteTemplate(st interface{}, tmpl string) error {
+	name, err := c.stackName(st)
+	if err != nil {
+		return err
+	}
+
+	c.con.LogAction("(delete) template %q from template stack: %s", tmpl, name)
+
+	path := c.xpath([]string{name})
+	path = append(path, "templates", util.AsMemberXpath([]string{tmpl}))
+
+	_, err = c.con.Delete(path, nil, nil)
+	return err
+}
+
+// AddDevices performs a SET to add the given devices to template stack st.
+//
+// An error is returned if the template stack does not exist.
+func (c *Stack) AddDevices(st string, serials ...string) error {
+	if len(serials) == 0 {
+		return nil
+	}
+
+	if err := c.exists(st); err != nil {
+		return err
+	}
+
+	list := append([]string(nil), serials...)
+	sort.Strings(list)
+
+	type devs struct {
+		XMLName xml.Name `xml:"devices"`
+		util.EntryType
+	}
+
+	c.con.LogAction("(set) devices in template stack %s: %v", st, list)
+
+	path := c.xpath([]string{st})
+
+	_, err := c.con.Set(path, devs{EntryType: *util.StrToEnt(list)}, nil, nil)
+	return err
+}
+
+// RemoveDevices performs a DELETE to remove the given devices from template
+// stack st.
+//
+// An error is returned if the template stack does not exist.
+func (c *Stack) RemoveDevices(st string, serials ...string) error {
+	if len(serials) == 0 {
+		return nil
+	}
+
+	if err := c.exists(st); err != nil {
+		return err
+	}
+
+	c.con.LogAction("(delete) devices from template stack %s: %v", st, serials)
+
+	path := c.xpath([]string{st})
+	path = append(path, "devices", util.AsEntryXpath(serials))
+
+	_, err := c.con.Delete(path, nil, nil)
+	return err
+}
+
 // ShowList performs SHOW to retrieve a list of template stacks.
 func (c *Stack) ShowList() ([]string, error) {
 	c.con.LogQuery("(show) list of template stacks")
@@ -215,6 +392,62 @@ func (c *Stack) details(fn util.Retriever, name string) (Entry, error) {
 	return ans, nil
 }
 
+func (c *Stack) stackName(st interface{}) (string, error) {
+	switch v := st.(type) {
+	case string:
+		return v, nil
+	case Entry:
+		return v.Name, nil
+	}
+
+	return "", fmt.Errorf("Unknown type sent for template stack: %s", st)
+}
+
+func (c *Stack) shiftTemplate(st interface{}, tmpl string, offset int) error {
+	name, err := c.stackName(st)
+	if err != nil {
+		return err
+	}
+
+	e, err := c.Get(name)
+	if err != nil {
+		return err
+	}
+
+	idx := indexOf(e.Templates, tmpl)
+	if idx == -1 {
+		return fmt.Errorf("Template %q is not in template stack %q", tmpl, name)
+	}
+
+	dst := idx + offset
+	if dst < 0 || dst >= len(e.Templates) {
+		return nil
+	}
+
+	list := append([]string(nil), e.Templates...)
+	list[idx], list[dst] = list[dst], list[idx]
+
+	return c.EditTemplates(name, list)
+}
+
+func (c *Stack) exists(name string) error {
+	type resp struct {
+		Entries []util.Entry `xml:"result>entry"`
+	}
+
+	path := c.xpath([]string{name})
+	path = append(path, "@name")
+
+	ans := resp{}
+	if _, err := c.con.Get(path, nil, &ans); err != nil {
+		return err
+	} else if len(ans.Entries) == 0 {
+		return fmt.Errorf("Template stack %q does not exist", name)
+	}
+
+	return nil
+}
+
 func (c *Stack) xpath(vals []string) []string {
 	return []string{
 		"config",
@@ -224,3 +457,79 @@ func (c *Stack) xpath(vals []string) []string {
 		util.AsEntryXpath(vals),
 	}
 }
+
+// moveTemplate returns the reordered list of templates, or nil if the list
+// is already in the requested order.
+func moveTemplate(list []string, tmpl string, mvt int, rel string) ([]string, error) {
+	if !util.ValidMovement(mvt) {
+		return nil, fmt.Errorf("Invalid position int given: %d", mvt)
+	} else if mvt == util.MoveSkip {
+		return nil, nil
+	}
+
+	idx := indexOf(list, tmpl)
+	if idx == -1 {
+		return nil, fmt.Errorf("Template %q is not in the template stack", tmpl)
+	}
+
+	var ridx int
+	switch mvt {
+	case util.MoveTop:
+		if idx == 0 {
+			return nil, nil
+		}
+	case util.MoveBottom:
+		if idx == len(list)-1 {
+			return nil, nil
+		}
+	default:
+		if rel == tmpl {
+			return nil, fmt.Errorf("Can't position %q in relation to itself", rel)
+		}
+		ridx = indexOf(list, rel)
+		if ridx == -1 {
+			return nil, fmt.Errorf("Reference template %q is not in the template stack", rel)
+		}
+		switch {
+		case mvt == util.MoveBefore && idx < ridx:
+			return nil, nil
+		case mvt == util.MoveDirectlyBefore && idx+1 == ridx:
+			return nil, nil
+		case mvt == util.MoveAfter && idx > ridx:
+			return nil, nil
+		case mvt == util.MoveDirectlyAfter && idx == ridx+1:
+			return nil, nil
+		}
+	}
+
+	ans := make([]string, 0, len(list))
+	ans = append(ans, list[:idx]...)
+	ans = append(ans, list[idx+1:]...)
+
+	switch mvt {
+	case util.MoveTop:
+		ans = append([]string{tmpl}, ans...)
+	case util.MoveBottom:
+		ans = append(ans, tmpl)
+	default:
+		pos := indexOf(ans, rel)
+		if mvt == util.MoveAfter || mvt == util.MoveDirectlyAfter {
+			pos++
+		}
+		ans = append(ans, "")
+		copy(ans[pos+1:], ans[pos:])
+		ans[pos] = tmpl
+	}
+
+	return ans, nil
+}
+
+func indexOf(list []string, v string) int {
+	for i := range list {
+		if list[i] == v {
+			return i
+		}
+	}
+
+	return -1
+}
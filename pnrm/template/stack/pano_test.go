@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/PaloAltoNetworks/pango/testdata"
+	"github.com/PaloAltoNetworks/pango/util"
 	"github.com/PaloAltoNetworks/pango/version"
 )
 
@@ -61,3 +62,125 @@ func TestNormalization(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplates(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &Stack{}
+	ns.Initialize(mc)
+
+	prefix := "/config/devices/entry[@name='localhost.localdomain']/template-stack/entry[@name='st']/templates"
+
+	mc.AddResp("")
+	if err := ns.SetTemplate("st", "t3"); err != nil {
+		t.Fatalf("Error in set template: %s", err)
+	}
+	if mc.Function != "set" || mc.Path != prefix || mc.Elm != "<member>t3</member>" {
+		t.Errorf("Bad set template: %s %s %s", mc.Function, mc.Path, mc.Elm)
+	}
+
+	mc.Reset()
+	mc.AddResp("")
+	if err := ns.EditTemplates(Entry{Name: "st"}, []string{"t2", "t1"}); err != nil {
+		t.Fatalf("Error in edit templates: %s", err)
+	}
+	if mc.Function != "edit" || mc.Path != prefix || mc.Elm != "<templates><member>t2</member><member>t1</member></templates>" {
+		t.Errorf("Bad edit templates: %s %s %s", mc.Function, mc.Path, mc.Elm)
+	}
+
+	mc.Reset()
+	mc.AddResp("")
+	if err := ns.DeleteTemplate("st", "t1"); err != nil {
+		t.Fatalf("Error in delete template: %s", err)
+	}
+	if mc.Function != "delete" || mc.Path != prefix+"/member[text()='t1']" {
+		t.Errorf("Bad delete template: %s %s", mc.Function, mc.Path)
+	}
+
+	if err := ns.SetTemplate(1, "t1"); err == nil {
+		t.Errorf("No error for bad template stack type")
+	}
+}
+
+func TestDevices(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &Stack{}
+	ns.Initialize(mc)
+
+	prefix := "/config/devices/entry[@name='localhost.localdomain']/template-stack/entry[@name='st']"
+
+	mc.AddResp(`<entry name="st"/>`)
+	if err := ns.AddDevices("st", "b", "a"); err != nil {
+		t.Fatalf("Error in add devices: %s", err)
+	}
+	elm := `<devices><entry name="a"></entry><entry name="b"></entry></devices>`
+	if mc.Function != "set" || mc.Path != prefix || mc.Elm != elm {
+		t.Errorf("Bad add devices: %s %s %s", mc.Function, mc.Path, mc.Elm)
+	}
+
+	mc.Reset()
+	mc.AddResp(`<entry name="st"/>`)
+	if err := ns.RemoveDevices("st", "a"); err != nil {
+		t.Fatalf("Error in remove devices: %s", err)
+	}
+	if mc.Function != "delete" || mc.Path != prefix+"/devices/entry[@name='a']" {
+		t.Errorf("Bad remove devices: %s %s", mc.Function, mc.Path)
+	}
+
+	mc = &testdata.MockClient{}
+	ns.Initialize(mc)
+	mc.AddResp("")
+	if err := ns.RemoveDevices("st2", "a"); err == nil {
+		t.Errorf("No error for missing template stack")
+	}
+}
+
+func TestMoveTemplate(t *testing.T) {
+	list := []string{"a", "b", "c", "d"}
+
+	testCases := []struct {
+		desc string
+		tmpl string
+		mvt  int
+		rel  string
+		want []string
+		err  bool
+	}{
+		{"top", "c", util.MoveTop, "", []string{"c", "a", "b", "d"}, false},
+		{"already top", "a", util.MoveTop, "", nil, false},
+		{"bottom", "b", util.MoveBottom, "", []string{"a", "c", "d", "b"}, false},
+		{"before", "d", util.MoveBefore, "b", []string{"a", "d", "b", "c"}, false},
+		{"already before", "a", util.MoveBefore, "c", nil, false},
+		{"directly before", "a", util.MoveDirectlyBefore, "c", []string{"b", "a", "c", "d"}, false},
+		{"after", "a", util.MoveAfter, "c", []string{"b", "c", "a", "d"}, false},
+		{"directly after", "d", util.MoveDirectlyAfter, "a", []string{"a", "d", "b", "c"}, false},
+		{"missing template", "x", util.MoveTop, "", nil, true},
+		{"missing reference", "a", util.MoveAfter, "x", nil, true},
+		{"relative to itself", "a", util.MoveAfter, "a", nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			ans, err := moveTemplate(list, tc.tmpl, tc.mvt, tc.rel)
+			if tc.err != (err != nil) {
+				t.Fatalf("Unexpected error state: %v", err)
+			}
+			if !reflect.DeepEqual(ans, tc.want) {
+				t.Errorf("Got %v, not %v", ans, tc.want)
+			}
+		})
+	}
+}
+
+func TestMoveTemplateUp(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &Stack{}
+	ns.Initialize(mc)
+
+	mc.AddResp(`<entry name="st"><templates><member>t1</member><member>t2</member></templates></entry>`)
+	if err := ns.MoveTemplateUp("st", "t2"); err != nil {
+		t.Fatalf("Error in move template up: %s", err)
+	}
+	if mc.Function != "edit" || mc.Elm != "<templates><member>t2</member><member>t1</member></templates>" {
+		t.Errorf("Bad move template up: %s %s", mc.Function, mc.Elm)
+	}
+}
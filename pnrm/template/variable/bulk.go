@@ -0,0 +1,199 @@
+package variable
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// DeviceValue is a per-device override of a template stack variable, as used
+// for bulk export and import.
+type DeviceValue struct {
+	TemplateStack string `json:"template_stack"`
+	Serial        string `json:"serial"`
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	Value         string `json:"value"`
+}
+
+// CsvHeader is the header row of CSV files written by WriteCsv.
+var CsvHeader = []string{"template_stack", "serial", "name", "type", "value"}
+
+// Entry returns the variable portion of this value.
+func (o DeviceValue) Entry() Entry {
+	return Entry{
+		Name:  o.Name,
+		Type:  o.Type,
+		Value: o.Value,
+	}
+}
+
+// ExportDevices performs GET to retrieve all per-device variable overrides in
+// template stack ts.
+//
+// The devices of the template stack are retrieved in a single GET.  If no
+// serial numbers are given, then the overrides of every device in the
+// template stack are returned, otherwise only those of the given devices are.
+func (c *Variable) ExportDevices(ts string, serials ...string) ([]DeviceValue, error) {
+	if ts == "" {
+		return nil, fmt.Errorf("ts must be specified")
+	}
+
+	c.con.LogQuery("(get) template variable overrides of template stack %q", ts)
+	ans := devicesResp{}
+	if _, err := c.con.Get(c.devicesXpath(ts), nil, &ans); err != nil {
+		return nil, err
+	}
+
+	return ans.Normalize(ts, serials), nil
+}
+
+// ImportDevices performs SET to create / update the given per-device variable
+// overrides, with one SET per device.
+func (c *Variable) ImportDevices(list []DeviceValue) error {
+	type device struct {
+		ts     string
+		serial string
+	}
+
+	var order []device
+	groups := make(map[device][]Entry)
+	for _, v := range list {
+		if v.TemplateStack == "" || v.Serial == "" || v.Name == "" {
+			return fmt.Errorf("Template stack, serial, and name must be specified: %#v", v)
+		}
+		key := device{v.TemplateStack, v.Serial}
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], v.Entry())
+	}
+
+	for _, key := range order {
+		if err := c.SetDevice(key.ts, key.serial, groups[key]...); err != nil {
+			return fmt.Errorf("%s / %s: %s", key.ts, key.serial, err)
+		}
+	}
+
+	return nil
+}
+
+// WriteCsv writes the given values as CSV, including a header row.
+func WriteCsv(w io.Writer, list []DeviceValue) error {
+	rows := make([][]string, 0, len(list))
+	for _, v := range list {
+		rows = append(rows, []string{v.TemplateStack, v.Serial, v.Name, v.Type, v.Value})
+	}
+
+	return util.WriteCsv(w, CsvHeader, rows)
+}
+
+// ReadCsv reads values written by WriteCsv.  The header row is required, but
+// the columns may be in any order.
+func ReadCsv(r io.Reader) ([]DeviceValue, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = len(CsvHeader)
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	idx := make(map[string]int, len(header))
+	for i, col := range header {
+		idx[strings.TrimSpace(col)] = i
+	}
+	for _, col := range CsvHeader {
+		if _, ok := idx[col]; !ok {
+			return nil, fmt.Errorf("CSV header is missing column %q", col)
+		}
+	}
+
+	var ans []DeviceValue
+	for {
+		row, err := cr.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		ans = append(ans, DeviceValue{
+			TemplateStack: row[idx["template_stack"]],
+			Serial:        row[idx["serial"]],
+			Name:          row[idx["name"]],
+			Type:          row[idx["type"]],
+			Value:         row[idx["value"]],
+		})
+	}
+
+	return ans, nil
+}
+
+// WriteJson writes the given values as a JSON array.
+func WriteJson(w io.Writer, list []DeviceValue) error {
+	if list == nil {
+		list = []DeviceValue{}
+	}
+
+	return util.WriteJson(w, list)
+}
+
+// ReadJson reads values written by WriteJson.
+func ReadJson(r io.Reader) ([]DeviceValue, error) {
+	var ans []DeviceValue
+
+	if err := json.NewDecoder(r).Decode(&ans); err != nil {
+		return nil, err
+	}
+
+	return ans, nil
+}
+
+/** Structs / functions for bulk export. **/
+
+type devicesResp struct {
+	Devices []deviceEntry `xml:"result>devices>entry"`
+}
+
+type deviceEntry struct {
+	Serial    string     `xml:"name,attr"`
+	Variables []entry_v1 `xml:"variable>entry"`
+}
+
+func (o *devicesResp) Normalize(ts string, serials []string) []DeviceValue {
+	var ans []DeviceValue
+
+	list := o.Devices
+	if len(serials) > 0 {
+		idx := make(map[string]deviceEntry, len(o.Devices))
+		for _, d := range o.Devices {
+			idx[d.Serial] = d
+		}
+		list = make([]deviceEntry, 0, len(serials))
+		for _, serial := range serials {
+			if d, ok := idx[serial]; ok {
+				list = append(list, d)
+			}
+		}
+	}
+
+	for _, d := range list {
+		for _, v := range d.Variables {
+			c := container_v1{Answer: v}
+			e := c.Normalize()
+			ans = append(ans, DeviceValue{
+				TemplateStack: ts,
+				Serial:        d.Serial,
+				Name:          e.Name,
+				Type:          e.Type,
+				Value:         e.Value,
+			})
+		}
+	}
+
+	return ans
+}
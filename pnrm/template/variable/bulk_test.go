@@ -0,0 +1,119 @@
+package variable
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+var bulkValues = []DeviceValue{
+	{"stack1", "0001", "$mgmt", TypeIpNetmask, "10.1.1.5/24"},
+	{"stack1", "0002", "$mgmt", TypeIpNetmask, "10.1.2.5/24"},
+	{"stack1", "0001", "$peer", TypeFqdn, "peer, inc.example.com"},
+}
+
+func TestCsvRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+
+	if err := WriteCsv(&buf, bulkValues); err != nil {
+		t.Fatalf("WriteCsv failed: %s", err)
+	}
+	if !strings.HasPrefix(buf.String(), "template_stack,serial,name,type,value\n") {
+		t.Errorf("Bad header: %s", buf.String())
+	}
+
+	list, err := ReadCsv(&buf)
+	if err != nil {
+		t.Fatalf("ReadCsv failed: %s", err)
+	}
+	if !reflect.DeepEqual(list, bulkValues) {
+		t.Errorf("Expected %#v, got %#v", bulkValues, list)
+	}
+
+	if _, err = ReadCsv(strings.NewReader("serial,name,type,value,stack\n")); err == nil {
+		t.Errorf("No error for missing column")
+	}
+}
+
+func TestJsonRoundTrip(t *testing.T) {
+	var buf bytes.Buffer
+
+	if err := WriteJson(&buf, bulkValues); err != nil {
+		t.Fatalf("WriteJson failed: %s", err)
+	}
+
+	list, err := ReadJson(&buf)
+	if err != nil {
+		t.Fatalf("ReadJson failed: %s", err)
+	}
+	if !reflect.DeepEqual(list, bulkValues) {
+		t.Errorf("Expected %#v, got %#v", bulkValues, list)
+	}
+}
+
+func TestImportDevices(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp("")
+
+	ns := &Variable{}
+	ns.Initialize(mc)
+
+	if err := ns.ImportDevices(bulkValues); err != nil {
+		t.Fatalf("ImportDevices failed: %s", err)
+	}
+	if mc.Called != 2 {
+		t.Errorf("Expected one set per device, got %d", mc.Called)
+	}
+	path := "/config/devices/entry[@name='localhost.localdomain']/template-stack/entry[@name='stack1']/devices/entry[@name='0002']/variable"
+	if mc.Path != path {
+		t.Errorf("Bad path: %s", mc.Path)
+	}
+
+	if err := ns.ImportDevices([]DeviceValue{{Name: "$x"}}); err == nil {
+		t.Errorf("No error for missing serial")
+	}
+}
+
+func TestExportDevices(t *testing.T) {
+	resp := `<devices>
+<entry name="0001"><variable><entry name="$mgmt"><type><ip-netmask>10.1.1.5/24</ip-netmask></type></entry><entry name="$peer"><type><fqdn>peer, inc.example.com</fqdn></type></entry></variable></entry>
+<entry name="0002"><variable><entry name="$mgmt"><type><ip-netmask>10.1.2.5/24</ip-netmask></type></entry></variable></entry>
+</devices>`
+
+	testCases := []struct {
+		desc     string
+		serials  []string
+		expected []DeviceValue
+	}{
+		{"all devices", nil, []DeviceValue{bulkValues[0], bulkValues[2], bulkValues[1]}},
+		{"given devices", []string{"0002", "0003"}, []DeviceValue{bulkValues[1]}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			mc := &testdata.MockClient{}
+			mc.AddResp(resp)
+
+			ns := &Variable{}
+			ns.Initialize(mc)
+
+			list, err := ns.ExportDevices("stack1", tc.serials...)
+			if err != nil {
+				t.Fatalf("ExportDevices failed: %s", err)
+			}
+			if mc.Called != 1 {
+				t.Errorf("Expected a single get, got %d", mc.Called)
+			}
+			path := "/config/devices/entry[@name='localhost.localdomain']/template-stack/entry[@name='stack1']/devices"
+			if mc.Path != path {
+				t.Errorf("Bad path: %s", mc.Path)
+			}
+			if !reflect.DeepEqual(list, tc.expected) {
+				t.Errorf("Expected %#v, got %#v", tc.expected, list)
+			}
+		})
+	}
+}
@@ -1,4 +1,7 @@
 // Package variable is the client.Panorama.TemplateVariable namespace.
 //
+// Per-device overrides can be exported and imported in bulk as DeviceValue
+// lists, which can in turn be written to / read from CSV or JSON.
+//
 // Normalized object:  Entry
 package variable
@@ -17,6 +17,9 @@ const (
 // variable.
 //
 // Template variables are a new addition to PAN-OS 8.1.
+//
+// The same Entry is used both to define a variable in a template or template
+// stack, and to override the value of a variable for a single device.
 type Entry struct {
 	Name  string
 	Type  string
@@ -128,6 +128,127 @@ func (c *Variable) Delete(tmpl, ts string, e ...interface{}) error {
 	return err
 }
 
+// ShowDeviceList performs SHOW to retrieve the list of variables that are
+// overridden for device serial in template stack ts.
+func (c *Variable) ShowDeviceList(ts, serial string) ([]string, error) {
+	c.con.LogQuery("(show) list of template variable overrides for %s", serial)
+	path := c.deviceXpath(ts, serial, nil)
+	return c.con.EntryListUsing(c.con.Show, path[:len(path)-1])
+}
+
+// GetDeviceList performs GET to retrieve the list of variables that are
+// overridden for device serial in template stack ts.
+func (c *Variable) GetDeviceList(ts, serial string) ([]string, error) {
+	c.con.LogQuery("(get) list of template variable overrides for %s", serial)
+	path := c.deviceXpath(ts, serial, nil)
+	return c.con.EntryListUsing(c.con.Get, path[:len(path)-1])
+}
+
+// GetDevice performs GET to retrieve the override of the given variable for
+// device serial in template stack ts.
+func (c *Variable) GetDevice(ts, serial, name string) (Entry, error) {
+	c.con.LogQuery("(get) template variable %q override for %s", name, serial)
+	return c.deviceDetails(c.con.Get, ts, serial, name)
+}
+
+// ShowDevice performs SHOW to retrieve the override of the given variable for
+// device serial in template stack ts.
+func (c *Variable) ShowDevice(ts, serial, name string) (Entry, error) {
+	c.con.LogQuery("(show) template variable %q override for %s", name, serial)
+	return c.deviceDetails(c.con.Show, ts, serial, name)
+}
+
+// SetDevice performs SET to create / update per-device overrides of one or
+// more template variables.
+//
+// Overrides are specified for a device that is assigned to template stack ts,
+// where serial is the device's serial number.
+func (c *Variable) SetDevice(ts, serial string, e ...Entry) error {
+	var err error
+
+	if len(e) == 0 {
+		return nil
+	} else if ts == "" || serial == "" {
+		return fmt.Errorf("ts and serial must be specified")
+	}
+
+	_, fn := c.versioning()
+	names := make([]string, len(e))
+
+	// Build up the struct with the given configs.
+	d := util.BulkElement{XMLName: xml.Name{Local: "variable"}}
+	for i := range e {
+		d.Data = append(d.Data, fn(e[i]))
+		names[i] = e[i].Name
+	}
+	c.con.LogAction("(set) template variable overrides for %s: %v", serial, names)
+
+	// Set xpath.
+	path := c.deviceXpath(ts, serial, names)
+	if len(e) == 1 {
+		path = path[:len(path)-1]
+	} else {
+		path = path[:len(path)-2]
+	}
+
+	// Create the template variable overrides.
+	_, err = c.con.Set(path, d.Config(), nil, nil)
+	return err
+}
+
+// EditDevice performs EDIT to create / update a per-device override of a
+// template variable.
+func (c *Variable) EditDevice(ts, serial string, e Entry) error {
+	var err error
+
+	if ts == "" || serial == "" {
+		return fmt.Errorf("ts and serial must be specified")
+	}
+
+	_, fn := c.versioning()
+
+	c.con.LogAction("(edit) template variable %q override for %s", e.Name, serial)
+
+	// Set xpath.
+	path := c.deviceXpath(ts, serial, []string{e.Name})
+
+	// Edit the template variable override.
+	_, err = c.con.Edit(path, fn(e), nil, nil)
+	return err
+}
+
+// DeleteDevice removes the per-device overrides of the given template
+// variables, reverting the device to the template stack's values.
+//
+// Variables can be a string or an Entry object.
+func (c *Variable) DeleteDevice(ts, serial string, e ...interface{}) error {
+	var err error
+
+	if len(e) == 0 {
+		return nil
+	} else if ts == "" || serial == "" {
+		return fmt.Errorf("ts and serial must be specified")
+	}
+
+	names := make([]string, len(e))
+	for i := range e {
+		switch v := e[i].(type) {
+		case string:
+			names[i] = v
+		case Entry:
+			names[i] = v.Name
+		default:
+			return fmt.Errorf("Unknown type sent to delete: %s", v)
+		}
+	}
+	c.con.LogAction("(delete) template variable overrides for %s: %v", serial, names)
+
+	// Remove the template variable overrides.
+	path := c.deviceXpath(ts, serial, names)
+	_, err = c.con.Delete(path, nil, nil)
+	return err
+}
+
 /** Internal functions for this namespace struct **/
 
 func (c *Variable) versioning() (normalizer, func(Entry) interface{}) {
@@ -155,3 +276,41 @@ func (c *Variable) xpath(tmpl, ts string, vals []string) []string {
 
 	return ans
 }
+
+func (c *Variable) deviceDetails(fn util.Retriever, ts, serial, name string) (Entry, error) {
+	path := c.deviceXpath(ts, serial, []string{name})
+	obj, _ := c.versioning()
+	if _, err := fn(path, nil, obj); err != nil {
+		return Entry{}, err
+	}
+	ans := obj.Normalize()
+
+	return ans, nil
+}
+
+func (c *Variable) deviceXpath(ts, serial string, vals []string) []string {
+	ans := c.devicesXpath(ts)
+	ans = append(ans,
+		util.AsEntryXpath([]string{serial}),
+		"variable",
+		util.AsEntryXpath(vals),
+	)
+
+	return ans
+}
+
+// devicesXpath returns the xpath of the devices container of template stack
+// ts, which holds the per-device variable overrides.
+func (c *Variable) devicesXpath(ts string) []string {
+	ans := make([]string, 0, 9)
+	ans = append(ans,
+		"config",
+		"devices",
+		util.AsEntryXpath([]string{"localhost.localdomain"}),
+		"template-stack",
+		util.AsEntryXpath([]string{ts}),
+		"devices",
+	)
+
+	return ans
+}
@@ -62,3 +62,36 @@ func TestNormalization(t *testing.T) {
 		})
 	}
 }
+
+func TestDeviceOverride(t *testing.T) {
+	mc := &testdata.MockClient{}
+	ns := &Variable{}
+	ns.Initialize(mc)
+
+	conf := Entry{
+		Name:  "$mgmt",
+		Type:  TypeIpNetmask,
+		Value: "10.1.1.5/24",
+	}
+
+	mc.AddResp("")
+	if err := ns.SetDevice("stack1", "0123456789", conf); err != nil {
+		t.Fatalf("Error in set: %s", err)
+	}
+	path := "/config/devices/entry[@name='localhost.localdomain']/template-stack/entry[@name='stack1']/devices/entry[@name='0123456789']/variable"
+	if mc.Path != path {
+		t.Errorf("Bad path: %s", mc.Path)
+	}
+
+	mc.AddResp(mc.Elm)
+	r, err := ns.GetDevice("stack1", "0123456789", conf.Name)
+	if err != nil {
+		t.Fatalf("Error in get: %s", err)
+	} else if !reflect.DeepEqual(conf, r) {
+		t.Errorf("%#v != %#v", conf, r)
+	}
+
+	if err = ns.SetDevice("", "0123456789", conf); err == nil {
+		t.Errorf("No error for missing template stack")
+	}
+}
@@ -0,0 +1,17 @@
+/*
+Package upgrade is the client.Panorama.Upgrade namespace.
+
+This namespace upgrades the PAN-OS software of firewalls managed by Panorama.
+Commands are proxied through Panorama to each firewall, and each device is
+taken through the download, install, reboot, and reconnect steps in turn,
+with the job of every step tracked on the firewall itself.
+
+	steps, err := pano.Panorama.Upgrade.HaPair(upgrade.Options{
+	    Version: "10.1.6",
+	    Sleep:   10 * time.Second,
+	    Timeout: 30 * time.Minute,
+	}, "0011", "0012")
+
+Normalized objects: Version, Step
+*/
+package upgrade
@@ -0,0 +1,85 @@
+package upgrade
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Valid values for Step.Name.
+const (
+	StepDownload  = "download"
+	StepInstall   = "install"
+	StepReboot    = "reboot"
+	StepReconnect = "reconnect"
+)
+
+// Version is a PAN-OS software version available to a firewall.
+//
+// ReleasedOn is the release date as returned by PAN-OS, while ReleasedAt is
+// the parsed form of it, in UTC.
+type Version struct {
+	Version    string
+	Filename   string
+	Size       string
+	ReleasedOn string
+	ReleasedAt time.Time
+	Downloaded bool
+	Current    bool
+	Latest     bool
+}
+
+// Step is the result of a single upgrade step on a single device.
+//
+// JobId is the ID of the job on the firewall, and is zero for steps that
+// do not run as a job (reboot and reconnect).  A step that was not needed,
+// such as downloading a version that is already downloaded, is Skipped.
+type Step struct {
+	Serial  string
+	Name    string
+	JobId   uint
+	Skipped bool
+	Start   time.Time
+	End     time.Time
+	Err     error
+}
+
+/** Structs / functions for normalization. **/
+
+type versionsResp struct {
+	Entries []versionEntry `xml:"result>sw-updates>versions>entry"`
+}
+
+func (o *versionsResp) Normalize() ([]Version, error) {
+	ans := make([]Version, 0, len(o.Entries))
+
+	for _, e := range o.Entries {
+		released, err := util.ParseTime(util.TimeFormat, e.ReleasedOn, nil)
+		if err != nil {
+			return nil, fmt.Errorf("Bad release date for %s: %s", e.Version, err)
+		}
+		ans = append(ans, Version{
+			Version:    e.Version,
+			Filename:   e.Filename,
+			Size:       e.Size,
+			ReleasedOn: e.ReleasedOn,
+			ReleasedAt: released,
+			Downloaded: util.AsBool(e.Downloaded),
+			Current:    util.AsBool(e.Current),
+			Latest:     util.AsBool(e.Latest),
+		})
+	}
+
+	return ans, nil
+}
+
+type versionEntry struct {
+	Version    string `xml:"version"`
+	Filename   string `xml:"filename"`
+	Size       string `xml:"size"`
+	ReleasedOn string `xml:"released-on"`
+	Downloaded string `xml:"downloaded"`
+	Current    string `xml:"current"`
+	Latest     string `xml:"latest"`
+}
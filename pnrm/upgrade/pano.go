@@ -0,0 +1,316 @@
+package upgrade
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/jobs"
+	"github.com/PaloAltoNetworks/pango/pnrm/managed"
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Options control how devices are upgraded.
+//
+// Sleep is the length of time to wait between checks of jobs and device
+// connectivity, and is at least util.MinPollSleep, while Timeout is the total
+// length of time to wait for any single step to finish.  A Timeout of zero
+// waits forever.
+//
+// If Handler is specified, then it is invoked as each step finishes.
+type Options struct {
+	Version string
+	Sleep   time.Duration
+	Timeout time.Duration
+	Handler func(Step)
+}
+
+// Upgrade is the client.Panorama.Upgrade namespace.
+type Upgrade struct {
+	con     util.XapiClient
+	devices *managed.Managed
+}
+
+// Initialize is invoked by client.Initialize().
+func (c *Upgrade) Initialize(con util.XapiClient) {
+	c.con = con
+	c.devices = &managed.Managed{}
+	c.devices.Initialize(con)
+}
+
+// Versions checks for and returns the software versions available to the
+// given managed device.
+func (c *Upgrade) Versions(serial string) ([]Version, error) {
+	type req struct {
+		XMLName xml.Name `xml:"request"`
+		Cmd     string   `xml:"system>software>check"`
+	}
+
+	ans := versionsResp{}
+
+	c.con.LogOp("(op) request system software check on %s", serial)
+	if _, err := c.con.Op(req{}, "", util.TargetExtras(serial), &ans); err != nil {
+		return nil, err
+	}
+
+	return ans.Normalize()
+}
+
+// Download starts downloading the given software version to the given
+// managed device, returning the job ID on the device.
+func (c *Upgrade) Download(serial, version string) (uint, error) {
+	type req struct {
+		XMLName xml.Name `xml:"request"`
+		Version string   `xml:"system>software>download>version"`
+	}
+
+	c.con.LogOp("(op) request system software download version %s on %s", version, serial)
+	return c.job(serial, req{Version: version})
+}
+
+// Install starts installing the given software version on the given managed
+// device, returning the job ID on the device.
+func (c *Upgrade) Install(serial, version string) (uint, error) {
+	type req struct {
+		XMLName xml.Name `xml:"request"`
+		Version string   `xml:"system>software>install>version"`
+	}
+
+	c.con.LogOp("(op) request system software install version %s on %s", version, serial)
+	return c.job(serial, req{Version: version})
+}
+
+// Reboot restarts the given managed device.
+func (c *Upgrade) Reboot(serial string) error {
+	type req struct {
+		XMLName xml.Name `xml:"request"`
+		Cmd     string   `xml:"restart>system"`
+	}
+
+	c.con.LogOp("(op) request restart system on %s", serial)
+	_, err := c.con.Op(req{}, "", util.TargetExtras(serial), nil)
+	return err
+}
+
+// WaitForJob waits for the given job on the given managed device to finish,
+// returning an error if the job did not finish successfully.
+func (c *Upgrade) WaitForJob(serial string, id uint, sleep, timeout time.Duration) (jobs.Job, error) {
+	ns := &jobs.Jobs{Target: serial}
+	ns.Initialize(c.con)
+	var j jobs.Job
+
+	err := util.Poll(sleep, timeout, func() (bool, error) {
+		var err error
+		j, err = ns.Get(id)
+		return err == nil && j.Status == jobs.StatusFinished, err
+	})
+	if err == util.ErrTimeout {
+		return j, fmt.Errorf("Timed out waiting for job %d on %s", id, serial)
+	} else if err != nil {
+		return j, err
+	}
+
+	if j.Result != "OK" {
+		return j, fmt.Errorf("Job %d on %s failed: %s", id, serial, strings.Join(j.Details, " | "))
+	}
+
+	return j, nil
+}
+
+// Devices upgrades the given managed devices one at a time, in the order
+// given.  Devices already running the target version are skipped.
+//
+// The steps performed so far are returned, and the upgrade stops at the
+// first failure.
+func (c *Upgrade) Devices(o Options, serials ...string) ([]Step, error) {
+	if o.Version == "" {
+		return nil, fmt.Errorf("No version specified")
+	}
+
+	list, err := c.inventory(serials)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.upgrade(o, list)
+}
+
+// HaPair upgrades the two firewalls of an HA pair.
+//
+// The passive firewall (or active-secondary, for active/active pairs) is
+// upgraded first, and the active firewall is only upgraded after its peer
+// has been upgraded and has reconnected to Panorama.
+func (c *Upgrade) HaPair(o Options, a, b string) ([]Step, error) {
+	if o.Version == "" {
+		return nil, fmt.Errorf("No version specified")
+	}
+
+	list, err := c.inventory([]string{a, b})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, d := range list {
+		if d.HaState == "" {
+			return nil, fmt.Errorf("%s is not part of an HA pair", d.Serial)
+		}
+	}
+
+	if haActive(list[0]) && !haActive(list[1]) {
+		list[0], list[1] = list[1], list[0]
+	}
+
+	return c.upgrade(o, list)
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *Upgrade) upgrade(o Options, list []managed.Device) ([]Step, error) {
+	var steps []Step
+
+	for _, d := range list {
+		if d.SwVersion == o.Version {
+			continue
+		}
+
+		if err := c.device(o, d.Serial, &steps); err != nil {
+			return steps, err
+		}
+	}
+
+	return steps, nil
+}
+
+func (c *Upgrade) device(o Options, serial string, steps *[]Step) error {
+	versions, err := c.Versions(serial)
+	if err != nil {
+		return err
+	}
+
+	var ver *Version
+	for i := range versions {
+		if versions[i].Version == o.Version {
+			ver = &versions[i]
+			break
+		}
+	}
+	if ver == nil {
+		return fmt.Errorf("Version %s is not available to %s", o.Version, serial)
+	}
+
+	err = c.step(o, steps, serial, StepDownload, func(s *Step) error {
+		if ver.Downloaded {
+			s.Skipped = true
+			return nil
+		}
+		return c.jobStep(o, s, c.Download)
+	})
+	if err != nil {
+		return err
+	}
+
+	err = c.step(o, steps, serial, StepInstall, func(s *Step) error {
+		return c.jobStep(o, s, c.Install)
+	})
+	if err != nil {
+		return err
+	}
+
+	err = c.step(o, steps, serial, StepReboot, func(s *Step) error {
+		return c.Reboot(serial)
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.step(o, steps, serial, StepReconnect, func(s *Step) error {
+		return c.waitForVersion(serial, o.Version, o.Sleep, o.Timeout)
+	})
+}
+
+func (c *Upgrade) step(o Options, steps *[]Step, serial, name string, fn func(*Step) error) error {
+	s := Step{
+		Serial: serial,
+		Name:   name,
+		Start:  time.Now(),
+	}
+	s.Err = fn(&s)
+	s.End = time.Now()
+
+	*steps = append(*steps, s)
+	if o.Handler != nil {
+		o.Handler(s)
+	}
+
+	return s.Err
+}
+
+func (c *Upgrade) jobStep(o Options, s *Step, fn func(string, string) (uint, error)) error {
+	id, err := fn(s.Serial, o.Version)
+	if err != nil {
+		return err
+	}
+	s.JobId = id
+
+	_, err = c.WaitForJob(s.Serial, id, o.Sleep, o.Timeout)
+	return err
+}
+
+func (c *Upgrade) waitForVersion(serial, version string, sleep, timeout time.Duration) error {
+	err := util.Poll(sleep, timeout, func() (bool, error) {
+		list, err := c.devices.Connected()
+		if err != nil {
+			return false, err
+		}
+		for _, d := range list {
+			if d.Serial == serial && d.Connected && d.SwVersion == version {
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+	if err == util.ErrTimeout {
+		return fmt.Errorf("Timed out waiting for %s to reconnect running %s", serial, version)
+	}
+
+	return err
+}
+
+func (c *Upgrade) inventory(serials []string) ([]managed.Device, error) {
+	all, err := c.devices.All()
+	if err != nil {
+		return nil, err
+	}
+
+	ans := make([]managed.Device, 0, len(serials))
+	for _, serial := range serials {
+		var found bool
+		for _, d := range all {
+			if d.Serial == serial {
+				ans = append(ans, d)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("%s is not a managed device", serial)
+		}
+	}
+
+	return ans, nil
+}
+
+func (c *Upgrade) job(serial string, req interface{}) (uint, error) {
+	ans := util.JobResponse{}
+
+	if _, err := c.con.Op(req, "", util.TargetExtras(serial), &ans); err != nil {
+		return 0, err
+	}
+
+	return ans.Id, nil
+}
+
+func haActive(d managed.Device) bool {
+	return d.HaState == "active" || d.HaState == "active-primary"
+}
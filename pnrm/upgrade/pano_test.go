@@ -0,0 +1,141 @@
+package upgrade
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+const inventory = `<devices>
+<entry name="0011"><serial>0011</serial><sw-version>10.0.0</sw-version><connected>yes</connected><ha><state>active</state></ha></entry>
+<entry name="0012"><serial>0012</serial><sw-version>10.0.0</sw-version><connected>yes</connected><ha><state>passive</state></ha></entry>
+</devices>`
+
+func versions(downloaded string) string {
+	return `<sw-updates><versions>
+<entry><version>10.1.6</version><filename>PanOS_vm-10.1.6</filename><downloaded>` + downloaded + `</downloaded><current>no</current><latest>yes</latest></entry>
+<entry><version>10.0.0</version><filename>PanOS_vm-10.0.0</filename><downloaded>yes</downloaded><current>yes</current><latest>no</latest></entry>
+</versions></sw-updates>`
+}
+
+func job(id, result string) string {
+	return `<job><id>` + id + `</id><type>SWInstall</type><status>FIN</status><result>` + result + `</result><details><line>done</line></details></job>`
+}
+
+func TestVersions(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(versions("no"))
+
+	ns := &Upgrade{}
+	ns.Initialize(mc)
+
+	list, err := ns.Versions("0011")
+	if err != nil {
+		t.Fatalf("Versions failed: %s", err)
+	}
+	if len(list) != 2 {
+		t.Fatalf("Expected 2 versions, got %d", len(list))
+	}
+	if list[0].Version != "10.1.6" || list[0].Downloaded || !list[0].Latest {
+		t.Errorf("Bad first version: %#v", list[0])
+	}
+	if !list[1].Current || !list[1].Downloaded {
+		t.Errorf("Bad second version: %#v", list[1])
+	}
+	if !reflect.DeepEqual(mc.Extras, url.Values{"target": []string{"0011"}}) {
+		t.Errorf("Op not sent to the device: %#v", mc.Extras)
+	}
+}
+
+func TestHaPair(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(inventory)
+	// Passive peer.
+	mc.AddResp(versions("no"))
+	mc.AddResp("<job>3</job>")
+	mc.AddResp(job("3", "OK"))
+	mc.AddResp("<job>4</job>")
+	mc.AddResp(job("4", "OK"))
+	mc.AddResp("")
+	mc.AddResp(`<devices><entry name="0012"><serial>0012</serial><sw-version>10.1.6</sw-version><connected>yes</connected></entry></devices>`)
+	// Active peer.
+	mc.AddResp(versions("yes"))
+	mc.AddResp("<job>9</job>")
+	mc.AddResp(job("9", "OK"))
+	mc.AddResp("")
+	mc.AddResp(`<devices><entry name="0011"><serial>0011</serial><sw-version>10.1.6</sw-version><connected>yes</connected></entry></devices>`)
+
+	ns := &Upgrade{}
+	ns.Initialize(mc)
+
+	var handled int
+	steps, err := ns.HaPair(Options{
+		Version: "10.1.6",
+		Handler: func(s Step) { handled++ },
+	}, "0011", "0012")
+	if err != nil {
+		t.Fatalf("HaPair failed: %s", err)
+	}
+
+	expected := []struct {
+		serial  string
+		name    string
+		jobId   uint
+		skipped bool
+	}{
+		{"0012", StepDownload, 3, false},
+		{"0012", StepInstall, 4, false},
+		{"0012", StepReboot, 0, false},
+		{"0012", StepReconnect, 0, false},
+		{"0011", StepDownload, 0, true},
+		{"0011", StepInstall, 9, false},
+		{"0011", StepReboot, 0, false},
+		{"0011", StepReconnect, 0, false},
+	}
+
+	if len(steps) != len(expected) {
+		t.Fatalf("Expected %d steps, got %#v", len(expected), steps)
+	}
+	for i, e := range expected {
+		s := steps[i]
+		if s.Serial != e.serial || s.Name != e.name || s.JobId != e.jobId || s.Skipped != e.skipped || s.Err != nil {
+			t.Errorf("Step %d: expected %v, got %#v", i, e, s)
+		}
+	}
+	if handled != len(expected) {
+		t.Errorf("Handler invoked %d times", handled)
+	}
+}
+
+func TestDevicesJobFailure(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(inventory)
+	mc.AddResp(versions("no"))
+	mc.AddResp("<job>3</job>")
+	mc.AddResp(job("3", "FAIL"))
+
+	ns := &Upgrade{}
+	ns.Initialize(mc)
+
+	steps, err := ns.Devices(Options{Version: "10.1.6"}, "0011", "0012")
+	if err == nil {
+		t.Fatalf("No error returned")
+	}
+	if len(steps) != 1 || steps[0].Name != StepDownload || steps[0].Err == nil {
+		t.Errorf("Bad steps: %#v", steps)
+	}
+}
+
+func TestDevicesUnknownDevice(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(inventory)
+
+	ns := &Upgrade{}
+	ns.Initialize(mc)
+
+	if _, err := ns.Devices(Options{Version: "10.1.6"}, "0099"); err == nil {
+		t.Errorf("No error for unmanaged device")
+	}
+}
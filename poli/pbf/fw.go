@@ -136,6 +136,20 @@ func (c *FwPbf) Delete(vsys string, e ...interface{}) error {
 	return c.ns.Delete(names, path)
 }
 
+// DeleteAll removes all policy based forwarding rules from the specified vsys.
+func (c *FwPbf) DeleteAll(vsys string) error {
+	c.con.LogAction("(delete) all policy based forwarding rules")
+	list, err := c.GetList(vsys)
+	if err != nil || len(list) == 0 {
+		return err
+	}
+	li := make([]interface{}, len(list))
+	for i := range list {
+		li[i] = list[i]
+	}
+	return c.Delete(vsys, li...)
+}
+
 // MoveGroup moves a logical group of policy based forwarding rules somewhere
 // in relation to another rule.
 //
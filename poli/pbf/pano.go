@@ -140,6 +140,21 @@ func (c *PanoPbf) Delete(dg, base string, e ...interface{}) error {
 	return c.ns.Delete(names, path)
 }
 
+// DeleteAll removes all policy based forwarding rules from the specified
+// dg / rulebase.
+func (c *PanoPbf) DeleteAll(dg, base string) error {
+	c.con.LogAction("(delete) all policy based forwarding rules")
+	list, err := c.GetList(dg, base)
+	if err != nil || len(list) == 0 {
+		return err
+	}
+	li := make([]interface{}, len(list))
+	for i := range list {
+		li[i] = list[i]
+	}
+	return c.Delete(dg, base, li...)
+}
+
 // MoveGroup moves a logical group of policy based forwarding rules
 // somewhere in relation to another rule.
 //
@@ -0,0 +1,7 @@
+package predefined
+
+// Valid values for Threat.Type.
+const (
+	ThreatVulnerability = "vulnerability"
+	ThreatSpyware       = "phone-home"
+)
@@ -0,0 +1,10 @@
+/*
+Package predefined is the client.Predefined namespace.
+
+This namespace provides read-only access to the content-delivered objects that
+PAN-OS ships with, such as applications, threats, and URL categories.  What is
+present is determined by the content version installed on the device.
+
+Normalized objects:  Application, Threat, UrlCategory
+*/
+package predefined
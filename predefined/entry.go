@@ -0,0 +1,126 @@
+package predefined
+
+import (
+	"encoding/xml"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Application is a predefined application.
+type Application struct {
+	Name         string
+	Category     string
+	Subcategory  string
+	Technology   string
+	Risk         int
+	Description  string
+	DefaultPorts []string
+	ParentApp    string
+}
+
+// Threat is a predefined threat signature.
+type Threat struct {
+	Id       string
+	Name     string
+	Type     string
+	Category string
+	Severity string
+}
+
+// UrlCategory is a predefined URL category.
+type UrlCategory struct {
+	Name        string
+	Description string
+}
+
+/** Structs / functions for normalization. **/
+
+type appContainer struct {
+	Answer []appEntry `xml:"result>entry"`
+}
+
+func (o *appContainer) Normalize() []Application {
+	ans := make([]Application, 0, len(o.Answer))
+	for _, e := range o.Answer {
+		app := Application{
+			Name:        e.Name,
+			Category:    e.Category,
+			Subcategory: e.Subcategory,
+			Technology:  e.Technology,
+			Risk:        e.Risk,
+			Description: e.Description,
+			ParentApp:   e.ParentApp,
+		}
+		if e.Default != nil {
+			app.DefaultPorts = util.MemToStr(e.Default.Ports)
+		}
+		ans = append(ans, app)
+	}
+
+	return ans
+}
+
+type appEntry struct {
+	XMLName     xml.Name    `xml:"entry"`
+	Name        string      `xml:"name,attr"`
+	Category    string      `xml:"category"`
+	Subcategory string      `xml:"subcategory"`
+	Technology  string      `xml:"technology"`
+	Risk        int         `xml:"risk"`
+	Description string      `xml:"description"`
+	Default     *appDefault `xml:"default"`
+	ParentApp   string      `xml:"parent-app"`
+}
+
+type appDefault struct {
+	Ports *util.MemberType `xml:"port"`
+}
+
+type threatContainer struct {
+	Answer []threatEntry `xml:"result>entry"`
+}
+
+func (o *threatContainer) Normalize(tt string) []Threat {
+	ans := make([]Threat, 0, len(o.Answer))
+	for _, e := range o.Answer {
+		ans = append(ans, Threat{
+			Id:       e.Id,
+			Name:     e.Name,
+			Type:     tt,
+			Category: e.Category,
+			Severity: e.Severity,
+		})
+	}
+
+	return ans
+}
+
+type threatEntry struct {
+	XMLName  xml.Name `xml:"entry"`
+	Id       string   `xml:"name,attr"`
+	Name     string   `xml:"threatname"`
+	Category string   `xml:"category"`
+	Severity string   `xml:"severity"`
+}
+
+type urlContainer struct {
+	Answer []urlEntry `xml:"result>entry"`
+}
+
+func (o *urlContainer) Normalize() []UrlCategory {
+	ans := make([]UrlCategory, 0, len(o.Answer))
+	for _, e := range o.Answer {
+		ans = append(ans, UrlCategory{
+			Name:        e.Name,
+			Description: e.Description,
+		})
+	}
+
+	return ans
+}
+
+type urlEntry struct {
+	XMLName     xml.Name `xml:"entry"`
+	Name        string   `xml:"name,attr"`
+	Description string   `xml:"description"`
+}
@@ -0,0 +1,122 @@
+package predefined
+
+import (
+	"fmt"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// Predefined is the client.Predefined namespace.
+type Predefined struct {
+	con util.XapiClient
+}
+
+// Initialize is invoked on client.Initialize().
+func (c *Predefined) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// ApplicationList returns the names of all predefined applications.
+func (c *Predefined) ApplicationList() ([]string, error) {
+	c.con.LogQuery("(get) list of predefined applications")
+	return c.con.EntryListUsing(c.con.Get, c.xpath("application"))
+}
+
+// Application returns the given predefined application.
+func (c *Predefined) Application(name string) (Application, error) {
+	c.con.LogQuery("(get) predefined application %q", name)
+	list, err := c.applications(name)
+	if err != nil {
+		return Application{}, err
+	} else if len(list) == 0 {
+		return Application{}, fmt.Errorf("Predefined application %q not found", name)
+	}
+
+	return list[0], nil
+}
+
+// Applications returns all predefined applications.
+func (c *Predefined) Applications() ([]Application, error) {
+	c.con.LogQuery("(get) all predefined applications")
+	return c.applications("")
+}
+
+// Threats returns all predefined threats of the given type.
+//
+// The tt param should be one of the Threat constants.
+func (c *Predefined) Threats(tt string) ([]Threat, error) {
+	switch tt {
+	case ThreatVulnerability, ThreatSpyware:
+	default:
+		return nil, fmt.Errorf("Invalid threat type: %q", tt)
+	}
+
+	c.con.LogQuery("(get) predefined %s threats", tt)
+	path := append(c.xpath("threats"), tt, util.AsEntryXpath(nil))
+	ans := threatContainer{}
+	if _, err := c.con.Get(path, nil, &ans); err != nil {
+		return nil, err
+	}
+
+	return ans.Normalize(tt), nil
+}
+
+// UrlCategories returns all predefined URL categories.
+func (c *Predefined) UrlCategories() ([]UrlCategory, error) {
+	c.con.LogQuery("(get) predefined url categories")
+	path := append(c.xpath("pan-url-categories"), util.AsEntryXpath(nil))
+	ans := urlContainer{}
+	if _, err := c.con.Get(path, nil, &ans); err != nil {
+		return nil, err
+	}
+
+	return ans.Normalize(), nil
+}
+
+// MissingApplications returns the names from the given list that are not
+// predefined applications.
+//
+// This is useful for verifying that the applications referenced in rules
+// exist at the content version installed on the device.  Note that custom
+// applications and application groups are not predefined, so they will be
+// returned as missing.
+func (c *Predefined) MissingApplications(names []string) ([]string, error) {
+	list, err := c.ApplicationList()
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]bool, len(list))
+	for _, name := range list {
+		known[name] = true
+	}
+
+	var ans []string
+	for _, name := range names {
+		if name != "any" && !known[name] {
+			ans = append(ans, name)
+		}
+	}
+
+	return ans, nil
+}
+
+/** Internal functions for this namespace struct **/
+
+func (c *Predefined) applications(name string) ([]Application, error) {
+	path := append(c.xpath("application"), util.AsEntryXpath([]string{name}))
+	ans := appContainer{}
+	if _, err := c.con.Get(path, nil, &ans); err != nil {
+		return nil, err
+	}
+
+	return ans.Normalize(), nil
+}
+
+func (c *Predefined) xpath(loc string) []string {
+	return []string{
+		"config",
+		"predefined",
+		loc,
+	}
+}
@@ -0,0 +1,64 @@
+package predefined
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/testdata"
+)
+
+func TestApplication(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(`<entry name="web-browsing"><category>general-internet</category><subcategory>internet-utility</subcategory><technology>browser-based</technology><risk>4</risk><default><port><member>tcp/80</member></port></default></entry>`)
+
+	ns := &Predefined{}
+	ns.Initialize(mc)
+
+	app, err := ns.Application("web-browsing")
+	if err != nil {
+		t.Fatalf("Error in get: %s", err)
+	}
+
+	expected := Application{
+		Name:         "web-browsing",
+		Category:     "general-internet",
+		Subcategory:  "internet-utility",
+		Technology:   "browser-based",
+		Risk:         4,
+		DefaultPorts: []string{"tcp/80"},
+	}
+	if !reflect.DeepEqual(app, expected) {
+		t.Errorf("%#v != %#v", app, expected)
+	}
+	if mc.Path != "/config/predefined/application/entry[@name='web-browsing']" {
+		t.Errorf("Wrong path: %s", mc.Path)
+	}
+}
+
+func TestThreats(t *testing.T) {
+	mc := &testdata.MockClient{}
+	mc.AddResp(`<entry name="30003"><threatname>Bad Thing</threatname><category>code-execution</category><severity>critical</severity></entry>`)
+
+	ns := &Predefined{}
+	ns.Initialize(mc)
+
+	list, err := ns.Threats(ThreatVulnerability)
+	if err != nil {
+		t.Fatalf("Error in get: %s", err)
+	}
+
+	expected := []Threat{{
+		Id:       "30003",
+		Name:     "Bad Thing",
+		Type:     ThreatVulnerability,
+		Category: "code-execution",
+		Severity: "critical",
+	}}
+	if !reflect.DeepEqual(list, expected) {
+		t.Errorf("%#v != %#v", list, expected)
+	}
+
+	if _, err = ns.Threats("bogus"); err == nil {
+		t.Errorf("No error on invalid threat type")
+	}
+}
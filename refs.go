@@ -0,0 +1,183 @@
+package pango
+
+import (
+	"strings"
+
+	"github.com/PaloAltoNetworks/pango/cfgtree"
+)
+
+// ObjectReference is a reference to an object.
+//
+// Scope is where the reference was found: "shared", a device group, or a
+// template or template stack given as "template:name" or
+// "template-stack:name".  Path is the xpath of the reference itself, while
+// Owner is the xpath of the entry containing it, such as a rule, a group, or a
+// profile.
+type ObjectReference struct {
+	Scope string
+	Path  string
+	Owner string
+}
+
+// FindReferences returns all references to objects of the given kind and
+// name in the given Panorama config, across Shared, the device groups,
+// templates, and template stacks.
+//
+// References are leaves whose text is the name and whose element (or, for
+// members, whose parent element) is one that refers to objects of the given
+// kind, such as a rule's source or destination for an address.  Objects that
+// share a namespace, such as addresses and address groups, share references.
+// For kinds other than the Object* constants, any leaf whose text is the name
+// is considered a reference.
+//
+// References are matched by name, so a reference may be to any object of
+// that name.  Use WhereUsed to only return the references that resolve to the
+// object in a specific scope.
+func FindReferences(cfg *cfgtree.Node, kind, name string) []ObjectReference {
+	var ans []ObjectReference
+
+	scopes := objectScopes(cfg)
+	for _, node := range []string{"template", "template-stack"} {
+		for _, n := range cfg.FindAll("/config/devices/entry[@name='localhost.localdomain']/" + node + "/entry") {
+			scopes = append(scopes, node+":"+n.Attr("name"))
+		}
+	}
+
+	for _, scope := range scopes {
+		n := scopeNode(cfg, scope)
+		if n == nil {
+			continue
+		}
+
+		prefix := scopeXpath(scope)
+		own := prefix + "/" + objectXpath(kind, name)
+		base := prefix[:len(prefix)-len(n.Key())-1]
+
+		_ = n.Walk(func(path string, v *cfgtree.Node) error {
+			path = base + path
+			if v.IsLeaf() && v.Text == name && !strings.HasPrefix(path, own) && isReferenceTo(kind, path, v) {
+				ans = append(ans, ObjectReference{
+					Scope: scope,
+					Path:  path,
+					Owner: referenceOwner(prefix, path),
+				})
+			}
+			return nil
+		})
+	}
+
+	return ans
+}
+
+// WhereUsed retrieves the candidate config and returns every reference to the
+// given object, which is of the given kind (an Object* constant) and is in
+// the given scope.
+//
+// The scope is either "shared" or a device group.  Only references that
+// resolve to this object are returned, so references from device groups that
+// can't see the object, or that have their own object of the same name, are
+// excluded.  If scope is an empty string, then all references to any object
+// of the given name are returned.
+func (c *Panorama) WhereUsed(kind, name, scope string) ([]ObjectReference, error) {
+	cfg, err := c.CandidateConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	list := FindReferences(cfg, kind, name)
+	if scope == "" {
+		return list, nil
+	}
+
+	hier, err := c.DeviceGroupHierarchy()
+	if err != nil {
+		return nil, err
+	}
+
+	var ans []ObjectReference
+	for _, ref := range list {
+		if resolvesTo(cfg, hier, kind, name, scope, ref) {
+			ans = append(ans, ref)
+		}
+	}
+
+	return ans, nil
+}
+
+/** Internal functions **/
+
+// referenceElements are the names of the elements that refer to objects of
+// each kind, either as their text or as the text of their members.
+var referenceElements = map[string][]string{
+	ObjectAddress:              {"source", "destination", "static", "translated-address"},
+	ObjectAddressGroup:         {"source", "destination", "static", "translated-address"},
+	ObjectService:              {"service", "members"},
+	ObjectServiceGroup:         {"service", "members"},
+	ObjectTag:                  {"tag", "group-tag"},
+	ObjectApplicationGroup:     {"application", "members"},
+	ObjectAntivirusProfile:     {"virus"},
+	ObjectAntiSpywareProfile:   {"spyware"},
+	ObjectVulnerabilityProfile: {"vulnerability"},
+	ObjectUrlFilteringProfile:  {"url-filtering"},
+	ObjectProfileGroup:         {"group"},
+}
+
+// isReferenceTo returns if the leaf v at path refers to an object of the
+// given kind.
+func isReferenceTo(kind, path string, v *cfgtree.Node) bool {
+	list, ok := referenceElements[kind]
+	if !ok {
+		return true
+	}
+
+	elm := v.Name()
+	if elm == "member" {
+		parent := strings.TrimSuffix(path, "/"+v.Key())
+		elm = parent[strings.LastIndex(parent, "/")+1:]
+	}
+
+	for _, x := range list {
+		if x == elm {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resolvesTo returns if the given reference resolves to the object in scope.
+func resolvesTo(cfg *cfgtree.Node, hier map[string]string, kind, name, scope string, ref ObjectReference) bool {
+	if !visibleFrom(hier, scope, ref.Scope) {
+		return false
+	}
+
+	// Check for a closer object of the same name between the reference and
+	// the object.
+	for cur := ref.Scope; cur != "" && cur != scope && cur != "shared"; cur = hier[cur] {
+		if n := scopeNode(cfg, cur); n != nil && n.Find(objectXpath(kind, name)) != nil {
+			return false
+		}
+		if strings.Contains(cur, ":") {
+			break
+		}
+	}
+
+	return true
+}
+
+// referenceOwner returns the xpath of the innermost entry containing the
+// reference at path, or the scope's xpath if there is none.
+func referenceOwner(prefix, path string) string {
+	rest := path[len(prefix):]
+
+	i := strings.LastIndex(rest, "/entry[@name='")
+	if i == -1 {
+		return prefix
+	}
+	j := strings.Index(rest[i:], "']")
+	if j == -1 {
+		return prefix
+	}
+
+	return prefix + rest[:i+j+2]
+}
@@ -0,0 +1,120 @@
+package pango
+
+import (
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/cfgtree"
+)
+
+func TestWhereUsed(t *testing.T) {
+	pano := &Panorama{Client: Client{
+		rb: [][]byte{
+			[]byte(moveConfig),
+			[]byte(moveHierarchy),
+		},
+	}}
+	if err := pano.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	list, err := pano.WhereUsed(ObjectAddress, "web", "shared")
+	if err != nil {
+		t.Fatalf("WhereUsed failed: %s", err)
+	}
+
+	dg := "/config/devices/entry[@name='localhost.localdomain']/device-group"
+	want := []ObjectReference{
+		{"parent", dg + "/entry[@name='parent']/address-group/entry[@name='grp']/static/member[text()='web']", dg + "/entry[@name='parent']/address-group/entry[@name='grp']"},
+		{"child", dg + "/entry[@name='child']/pre-rulebase/security/rules/entry[@name='r1']/destination/member[text()='web']", dg + "/entry[@name='child']/pre-rulebase/security/rules/entry[@name='r1']"},
+	}
+	if len(list) != len(want) {
+		t.Fatalf("Expected %d references, got %d: %#v", len(want), len(list), list)
+	}
+	for i := range want {
+		if list[i] != want[i] {
+			t.Errorf("Reference %d is %#v, not %#v", i, list[i], want[i])
+		}
+	}
+}
+
+func TestWhereUsedAnyScope(t *testing.T) {
+	pano := &Panorama{Client: Client{
+		rb: [][]byte{
+			[]byte(`<response status="success"><result><config>
+<shared><address><entry name="web"><ip-netmask>10.1.1.1</ip-netmask></entry></address></shared>
+<devices><entry name="localhost.localdomain">
+<device-group><entry name="dg1"><post-rulebase><nat><rules><entry name="n1"><destination><member>web</member></destination></entry></rules></nat></post-rulebase></entry></device-group>
+<template><entry name="t1"><config><devices><entry name="localhost.localdomain"><vsys><entry name="vsys1"><zone><entry name="z"><network><layer3><member>web</member></layer3></network></entry></zone><address-group><entry name="g"><static><member>web</member></static></entry></address-group></entry></vsys></entry></devices></config></entry></template>
+</entry></devices>
+</config></result></response>`),
+		},
+	}}
+	if err := pano.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	list, err := pano.WhereUsed(ObjectAddress, "web", "")
+	if err != nil {
+		t.Fatalf("WhereUsed failed: %s", err)
+	}
+	if len(list) != 2 {
+		t.Fatalf("Expected 2 references, got %#v", list)
+	}
+	if list[0].Scope != "dg1" || list[1].Scope != "template:t1" {
+		t.Errorf("Bad scopes: %#v", list)
+	}
+	if list[1].Owner != "/config/devices/entry[@name='localhost.localdomain']/template/entry[@name='t1']/config/devices/entry[@name='localhost.localdomain']/vsys/entry[@name='vsys1']/address-group/entry[@name='g']" {
+		t.Errorf("Bad template owner: %s", list[1].Owner)
+	}
+	if len(pano.rp) != 1 {
+		t.Errorf("Expected only the config to be retrieved, got %d requests", len(pano.rp))
+	}
+}
+
+func TestFindReferencesKind(t *testing.T) {
+	cfg, err := cfgtree.Parse([]byte(`<config>
+<shared>
+<address><entry name="web"><ip-netmask>10.1.1.1</ip-netmask></entry></address>
+<service><entry name="web"><protocol><tcp><port>80</port></tcp></protocol></entry></service>
+</shared>
+<devices><entry name="localhost.localdomain"><device-group><entry name="dg1">
+<pre-rulebase><security><rules><entry name="r1">
+<destination><member>web</member></destination>
+<service><member>web</member></service>
+<description>web</description>
+</entry></rules></security></pre-rulebase>
+<service-group><entry name="sg"><members><member>web</member></members></entry></service-group>
+</entry></device-group></entry></devices>
+</config>`))
+	if err != nil {
+		t.Fatalf("Parse failed: %s", err)
+	}
+
+	dg := "/config/devices/entry[@name='localhost.localdomain']/device-group/entry[@name='dg1']"
+	testCases := []struct {
+		kind  string
+		paths []string
+	}{
+		{ObjectAddress, []string{
+			dg + "/pre-rulebase/security/rules/entry[@name='r1']/destination/member[text()='web']",
+		}},
+		{ObjectService, []string{
+			dg + "/pre-rulebase/security/rules/entry[@name='r1']/service/member[text()='web']",
+			dg + "/service-group/entry[@name='sg']/members/member[text()='web']",
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.kind, func(t *testing.T) {
+			list := FindReferences(cfg, tc.kind, "web")
+			if len(list) != len(tc.paths) {
+				t.Fatalf("Expected %d references, got %#v", len(tc.paths), list)
+			}
+			for i := range tc.paths {
+				if list[i].Path != tc.paths[i] {
+					t.Errorf("Reference %d is %s, not %s", i, list[i].Path, tc.paths[i])
+				}
+			}
+		})
+	}
+}
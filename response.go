@@ -0,0 +1,102 @@
+package pango
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// Response is the envelope of a PAN-OS API response.
+//
+// This is made available for advanced users that need to handle edge cases
+// such as warnings returned alongside a successful result.  Refer to
+// Client.OnResponse to receive the envelope of every API call, or use
+// ParseResponse on the bytes returned from any of the API methods.
+type Response struct {
+	Status   string
+	Code     int
+	Message  string
+	Warnings []string
+	Raw      []byte
+}
+
+// Failed returns true if this response represents an error.
+func (o Response) Failed() bool {
+	return panosStatus{o.Status, o.Code}.Failed()
+}
+
+// ParseResponse parses the envelope of the given PAN-OS response.
+//
+// If the body is not a valid response, then only the Raw field is set.
+func ParseResponse(body []byte) Response {
+	ans := Response{Raw: body}
+
+	var env responseEnvelope
+	if err := xml.Unmarshal(body, &env); err != nil {
+		return ans
+	}
+
+	ans.Status = env.ResponseStatus
+	ans.Code = env.ResponseCode
+	ans.Message = env.message()
+	ans.Warnings = env.warnings()
+
+	return ans
+}
+
+/** Internal structs **/
+
+type responseEnvelope struct {
+	XMLName xml.Name `xml:"response"`
+	panosStatus
+	Msg            *responseMsg `xml:"msg"`
+	ResultMsg      *responseMsg `xml:"result>msg"`
+	Warnings       []string     `xml:"warnings>line"`
+	ResultWarnings []string     `xml:"result>warnings>line"`
+}
+
+func (o responseEnvelope) message() string {
+	if o.ResultMsg != nil {
+		if s := o.ResultMsg.text(); s != "" {
+			return s
+		}
+	}
+	if o.Msg != nil {
+		return o.Msg.text()
+	}
+
+	return ""
+}
+
+func (o responseEnvelope) warnings() []string {
+	var ans []string
+
+	for _, list := range [][]string{o.Warnings, o.ResultWarnings} {
+		for _, w := range list {
+			if w = strings.TrimSpace(w); w != "" {
+				ans = append(ans, w)
+			}
+		}
+	}
+
+	return ans
+}
+
+type responseMsg struct {
+	Text  string   `xml:",chardata"`
+	Lines []string `xml:"line"`
+}
+
+func (o responseMsg) text() string {
+	if len(o.Lines) == 0 {
+		return strings.TrimSpace(o.Text)
+	}
+
+	lines := make([]string, 0, len(o.Lines))
+	for _, line := range o.Lines {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
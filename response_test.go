@@ -0,0 +1,73 @@
+package pango
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseResponse(t *testing.T) {
+	testCases := []struct {
+		desc    string
+		body    string
+		failed  bool
+		code    int
+		msg     string
+		warning []string
+	}{
+		{"success", `<response status="success" code="20"><msg>command succeeded</msg></response>`, false, 20, "command succeeded", nil},
+		{"error with lines", `<response status="error" code="12"><msg><line>bad</line><line>value</line></msg></response>`, true, 12, "bad\nvalue", nil},
+		{"result msg", `<response status="error"><result><msg>nope</msg></result></response>`, true, 0, "nope", nil},
+		{"warnings", `<response status="success"><result><warnings><line>w1</line><line> </line></warnings></result></response>`, false, 0, "", []string{"w1"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			r := ParseResponse([]byte(tc.body))
+			if r.Failed() != tc.failed {
+				t.Errorf("Failed is %t, not %t", r.Failed(), tc.failed)
+			}
+			if r.Code != tc.code {
+				t.Errorf("Code is %d, not %d", r.Code, tc.code)
+			}
+			if r.Message != tc.msg {
+				t.Errorf("Message is %q, not %q", r.Message, tc.msg)
+			}
+			if !reflect.DeepEqual(r.Warnings, tc.warning) {
+				t.Errorf("Warnings is %#v, not %#v", r.Warnings, tc.warning)
+			}
+			if string(r.Raw) != tc.body {
+				t.Errorf("Raw mismatch: %s", r.Raw)
+			}
+		})
+	}
+}
+
+func TestOnResponse(t *testing.T) {
+	var seen []Response
+
+	c := &Client{OnResponse: func(r Response) { seen = append(seen, r) }}
+	c.rb = [][]byte{
+		[]byte(`<response status="success"><result><warnings><line>careful</line></warnings></result></response>`),
+		[]byte(`<response status="error" code="7"><msg>Object not found</msg></response>`),
+	}
+	if err := c.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	if _, err := c.Op("<show><system><info /></system></show>", "", nil, nil); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if _, err := c.Show("/config/shared/address", nil, nil); err == nil {
+		t.Fatalf("Expected an error")
+	}
+
+	if len(seen) != 2 {
+		t.Fatalf("Expected 2 responses, got %d", len(seen))
+	}
+	if len(seen[0].Warnings) != 1 || seen[0].Warnings[0] != "careful" {
+		t.Errorf("Bad warnings: %#v", seen[0].Warnings)
+	}
+	if !seen[1].Failed() || seen[1].Code != 7 {
+		t.Errorf("Bad failed response: %#v", seen[1])
+	}
+}
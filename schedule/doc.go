@@ -0,0 +1,33 @@
+/*
+Package schedule runs queued operations at a specified time.
+
+This is meant for change window only operations, such as reboots or pushes
+to devices, that tooling wants to arm in advance.  Operations are registered
+by name, and tasks refer to an operation by that name along with string
+arguments, so that tasks can be persisted and reloaded across restarts using
+a Store:
+
+	s := &schedule.Scheduler{Store: schedule.FileStore{Path: "tasks.json"}}
+	s.Register("reboot", func(ctx context.Context, args map[string]string) error {
+	    return pano.Panorama.Upgrade.Reboot(args["serial"])
+	})
+	if err := s.Load(); err != nil {
+	    return err
+	}
+	_, err := s.Add(schedule.Task{
+	    Operation: "reboot",
+	    Args:      map[string]string{"serial": "0123456789"},
+	    At:        window,
+	})
+	if err != nil {
+	    return err
+	}
+	err = s.Run(ctx)
+
+Tasks that were running when the scheduler was stopped are not run again
+when loaded, but are instead marked as failed, since operations such as a
+reboot may not be safe to repeat.  Likewise, set MaxLateness so that tasks
+whose change window has passed while the scheduler was stopped are marked as
+missed instead of being run late.
+*/
+package schedule
@@ -0,0 +1,298 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// DefaultInterval is how often the scheduler checks for due tasks if the
+// scheduler does not specify an Interval.
+const DefaultInterval = 10 * time.Second
+
+// Valid values for Task.Status.
+const (
+	StatusPending  = "pending"
+	StatusRunning  = "running"
+	StatusDone     = "done"
+	StatusFailed   = "failed"
+	StatusCanceled = "canceled"
+	StatusMissed   = "missed"
+)
+
+// Operation is a named operation that tasks can run.
+type Operation func(ctx context.Context, args map[string]string) error
+
+// Task is a single operation to run at a specific time.
+//
+// The Id, Status, Started, Finished, and Error fields are managed by the
+// scheduler.
+type Task struct {
+	Id        string            `json:"id"`
+	Operation string            `json:"operation"`
+	Args      map[string]string `json:"args,omitempty"`
+	At        time.Time         `json:"at"`
+	Status    string            `json:"status"`
+	Started   time.Time         `json:"started,omitempty"`
+	Finished  time.Time         `json:"finished,omitempty"`
+	Error     string            `json:"error,omitempty"`
+}
+
+// Scheduler runs tasks once their time has come.
+//
+// If Store is nil, then tasks are only kept in memory.  Interval is how often
+// Run checks for tasks that are due.  If MaxLateness is positive, then tasks
+// that are found to be due more than MaxLateness after their time, such as
+// after the scheduler was stopped through a change window, are not run but
+// are instead marked as missed.
+type Scheduler struct {
+	Store       Store
+	Interval    time.Duration
+	MaxLateness time.Duration
+
+	mu    sync.Mutex
+	ops   map[string]Operation
+	tasks []Task
+	seq   int
+	now   func() time.Time
+}
+
+// Register makes the given operation available to tasks as name.
+func (s *Scheduler) Register(name string, op Operation) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.ops == nil {
+		s.ops = make(map[string]Operation)
+	}
+	s.ops[name] = op
+}
+
+// Load replaces the scheduler's tasks with those in the Store.
+//
+// Any task that was running when last saved is marked as failed.
+func (s *Scheduler) Load() error {
+	if s.Store == nil {
+		return nil
+	}
+
+	list, err := s.Store.Load()
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tasks = list
+	for i := range s.tasks {
+		if s.tasks[i].Status == StatusRunning {
+			s.tasks[i].Status = StatusFailed
+			s.tasks[i].Error = "interrupted"
+		}
+		if n, err := strconv.Atoi(s.tasks[i].Id); err == nil && n > s.seq {
+			s.seq = n
+		}
+	}
+
+	return s.save()
+}
+
+// Add queues the given task, returning the task as it was saved.
+//
+// The task's operation must already be registered.  If the task has no Id,
+// then one is assigned.
+func (s *Scheduler) Add(t Task) (Task, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.ops[t.Operation]; !ok {
+		return Task{}, fmt.Errorf("Unknown operation %q", t.Operation)
+	} else if t.At.IsZero() {
+		return Task{}, fmt.Errorf("Task has no time specified")
+	}
+
+	if t.Id == "" {
+		for t.Id == "" || s.find(t.Id) != -1 {
+			s.seq++
+			t.Id = strconv.Itoa(s.seq)
+		}
+	} else if s.find(t.Id) != -1 {
+		return Task{}, fmt.Errorf("Task %q already exists", t.Id)
+	}
+	t.Status = StatusPending
+	t.Started = time.Time{}
+	t.Finished = time.Time{}
+	t.Error = ""
+
+	s.tasks = append(s.tasks, t)
+	return t, s.save()
+}
+
+// Cancel cancels the given pending task.
+func (s *Scheduler) Cancel(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i := s.find(id)
+	if i == -1 {
+		return fmt.Errorf("Task %q not found", id)
+	} else if s.tasks[i].Status != StatusPending {
+		return fmt.Errorf("Task %q is %s", id, s.tasks[i].Status)
+	}
+
+	s.tasks[i].Status = StatusCanceled
+	return s.save()
+}
+
+// Tasks returns all tasks, ordered by when they are to run.
+func (s *Scheduler) Tasks() []Task {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ans := make([]Task, len(s.tasks))
+	copy(ans, s.tasks)
+	sort.SliceStable(ans, func(i, j int) bool {
+		return ans[i].At.Before(ans[j].At)
+	})
+
+	return ans
+}
+
+// RunDue runs all pending tasks whose time has come, in order, returning the
+// tasks that were run or missed.
+//
+// A task failing does not stop the other tasks from running; check each
+// task's Status and Error.  The error returned is only for failing to save
+// the tasks to the Store.
+func (s *Scheduler) RunDue(ctx context.Context) ([]Task, error) {
+	var ans []Task
+
+	for _, t := range s.Tasks() {
+		if t.Status != StatusPending || t.At.After(s.currentTime()) {
+			continue
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		done, ok, err := s.run(ctx, t.Id)
+		if ok {
+			ans = append(ans, done)
+		}
+		if err != nil {
+			return ans, err
+		}
+	}
+
+	return ans, nil
+}
+
+// Run checks for and runs due tasks until the context is canceled, which is
+// the error returned unless saving the tasks to the Store fails.
+func (s *Scheduler) Run(ctx context.Context) error {
+	d := s.Interval
+	if d <= 0 {
+		d = DefaultInterval
+	}
+
+	ticker := time.NewTicker(d)
+	defer ticker.Stop()
+
+	for {
+		if _, err := s.RunDue(ctx); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+/** Internal functions for the Scheduler struct **/
+
+// run runs the given task, returning false if the task was no longer pending
+// by the time it was to be run.
+func (s *Scheduler) run(ctx context.Context, id string) (Task, bool, error) {
+	s.mu.Lock()
+	i := s.find(id)
+	if i == -1 || s.tasks[i].Status != StatusPending {
+		s.mu.Unlock()
+		return Task{}, false, nil
+	}
+	now := s.currentTime()
+	if s.MaxLateness > 0 && now.Sub(s.tasks[i].At) > s.MaxLateness {
+		s.tasks[i].Status = StatusMissed
+		s.tasks[i].Error = fmt.Sprintf("Due since %s", s.tasks[i].At.Format(time.RFC3339))
+		t := s.tasks[i]
+		err := s.save()
+		s.mu.Unlock()
+		return t, true, err
+	}
+	op := s.ops[s.tasks[i].Operation]
+	s.tasks[i].Status = StatusRunning
+	s.tasks[i].Started = now
+	t := s.tasks[i]
+	err := s.save()
+	s.mu.Unlock()
+	if err != nil {
+		return t, true, err
+	}
+
+	if op == nil {
+		err = fmt.Errorf("Unknown operation %q", t.Operation)
+	} else {
+		err = op(ctx, t.Args)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	i = s.find(id)
+	if i == -1 {
+		return t, true, nil
+	}
+	s.tasks[i].Finished = s.currentTime()
+	if err != nil {
+		s.tasks[i].Status = StatusFailed
+		s.tasks[i].Error = err.Error()
+	} else {
+		s.tasks[i].Status = StatusDone
+	}
+
+	return s.tasks[i], true, s.save()
+}
+
+func (s *Scheduler) find(id string) int {
+	for i := range s.tasks {
+		if s.tasks[i].Id == id {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (s *Scheduler) currentTime() time.Time {
+	if s.now != nil {
+		return s.now()
+	}
+
+	return time.Now()
+}
+
+func (s *Scheduler) save() error {
+	if s.Store == nil {
+		return nil
+	}
+
+	list := make([]Task, len(s.tasks))
+	copy(list, s.tasks)
+	return s.Store.Save(list)
+}
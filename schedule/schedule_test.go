@@ -0,0 +1,194 @@
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestRunDue(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	var ran []string
+
+	s := &Scheduler{now: func() time.Time { return now }}
+	s.Register("ok", func(ctx context.Context, args map[string]string) error {
+		ran = append(ran, args["name"])
+		return nil
+	})
+	s.Register("fail", func(ctx context.Context, args map[string]string) error {
+		return fmt.Errorf("oops")
+	})
+
+	if _, err := s.Add(Task{Operation: "missing", At: now}); err == nil {
+		t.Errorf("Unregistered operation was added")
+	}
+	if _, err := s.Add(Task{Operation: "ok"}); err == nil {
+		t.Errorf("Task without a time was added")
+	}
+
+	later, _ := s.Add(Task{Operation: "ok", Args: map[string]string{"name": "later"}, At: now.Add(time.Hour)})
+	s.Add(Task{Operation: "ok", Args: map[string]string{"name": "second"}, At: now.Add(-time.Minute)})
+	s.Add(Task{Operation: "ok", Args: map[string]string{"name": "first"}, At: now.Add(-time.Hour)})
+	bad, _ := s.Add(Task{Operation: "fail", At: now})
+	canceled, _ := s.Add(Task{Operation: "ok", Args: map[string]string{"name": "canceled"}, At: now})
+	if err := s.Cancel(canceled.Id); err != nil {
+		t.Fatalf("Cancel failed: %s", err)
+	}
+
+	done, err := s.RunDue(context.Background())
+	if err != nil {
+		t.Fatalf("RunDue failed: %s", err)
+	}
+	if len(done) != 3 {
+		t.Errorf("Expected 3 tasks run, got %d", len(done))
+	}
+	if len(ran) != 2 || ran[0] != "first" || ran[1] != "second" {
+		t.Errorf("Wrong tasks run: %v", ran)
+	}
+
+	for _, v := range s.Tasks() {
+		var status string
+		switch v.Id {
+		case later.Id:
+			status = StatusPending
+		case bad.Id:
+			status = StatusFailed
+			if v.Error != "oops" {
+				t.Errorf("Wrong error: %q", v.Error)
+			}
+		case canceled.Id:
+			status = StatusCanceled
+		default:
+			status = StatusDone
+		}
+		if v.Status != status {
+			t.Errorf("Task %s: status %q, not %q", v.Id, v.Status, status)
+		}
+	}
+
+	if err = s.Cancel(bad.Id); err == nil {
+		t.Errorf("Canceled a finished task")
+	}
+}
+
+func TestRunDueMaxLateness(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	var ran []string
+
+	s := &Scheduler{MaxLateness: 30 * time.Minute, now: func() time.Time { return now }}
+	s.Register("ok", func(ctx context.Context, args map[string]string) error {
+		ran = append(ran, args["name"])
+		return nil
+	})
+
+	stale, _ := s.Add(Task{Operation: "ok", Args: map[string]string{"name": "stale"}, At: now.Add(-time.Hour)})
+	s.Add(Task{Operation: "ok", Args: map[string]string{"name": "recent"}, At: now.Add(-time.Minute)})
+
+	done, err := s.RunDue(context.Background())
+	if err != nil {
+		t.Fatalf("RunDue failed: %s", err)
+	}
+	if len(ran) != 1 || ran[0] != "recent" {
+		t.Errorf("Wrong tasks run: %v", ran)
+	}
+	if len(done) != 2 || done[0].Id != stale.Id || done[0].Status != StatusMissed || done[0].Error == "" {
+		t.Errorf("Stale task not missed: %#v", done)
+	}
+}
+
+func TestRunDueSkipsCanceled(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	var second Task
+
+	s := &Scheduler{now: func() time.Time { return now }}
+	s.Register("cancel", func(ctx context.Context, args map[string]string) error {
+		return s.Cancel(second.Id)
+	})
+
+	s.Add(Task{Operation: "cancel", At: now.Add(-time.Hour)})
+	second, _ = s.Add(Task{Operation: "cancel", At: now})
+
+	done, err := s.RunDue(context.Background())
+	if err != nil {
+		t.Fatalf("RunDue failed: %s", err)
+	}
+	if len(done) != 1 || done[0].Status != StatusDone {
+		t.Errorf("Expected only the first task run, got %#v", done)
+	}
+}
+
+func TestAddIdCollision(t *testing.T) {
+	at := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	s := &Scheduler{}
+	s.Register("ok", func(ctx context.Context, args map[string]string) error { return nil })
+
+	if _, err := s.Add(Task{Id: "2", Operation: "ok", At: at}); err != nil {
+		t.Fatalf("Add with id failed: %s", err)
+	}
+	if _, err := s.Add(Task{Id: "2", Operation: "ok", At: at}); err == nil {
+		t.Errorf("Duplicate id was added")
+	}
+
+	var ids []string
+	for i := 0; i < 2; i++ {
+		v, err := s.Add(Task{Operation: "ok", At: at})
+		if err != nil {
+			t.Fatalf("Add failed: %s", err)
+		}
+		ids = append(ids, v.Id)
+	}
+	if ids[0] != "1" || ids[1] != "3" {
+		t.Errorf("Expected ids 1 and 3, got %v", ids)
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "schedule")
+	if err != nil {
+		t.Fatalf("TempDir failed: %s", err)
+	}
+	defer os.RemoveAll(dir)
+
+	store := FileStore{Path: filepath.Join(dir, "tasks.json")}
+	at := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	op := func(ctx context.Context, args map[string]string) error { return nil }
+
+	s := &Scheduler{Store: store}
+	s.Register("reboot", op)
+	if err = s.Load(); err != nil {
+		t.Fatalf("Load of missing file failed: %s", err)
+	}
+	s.Add(Task{Operation: "reboot", Args: map[string]string{"serial": "0001"}, At: at})
+	s.Add(Task{Operation: "reboot", Args: map[string]string{"serial": "0002"}, At: at})
+
+	// Simulate being stopped while the second task was running.
+	list, _ := store.Load()
+	list[1].Status = StatusRunning
+	store.Save(list)
+
+	s2 := &Scheduler{Store: store}
+	s2.Register("reboot", op)
+	if err = s2.Load(); err != nil {
+		t.Fatalf("Load failed: %s", err)
+	}
+	tasks := s2.Tasks()
+	if len(tasks) != 2 {
+		t.Fatalf("Expected 2 tasks, got %d", len(tasks))
+	}
+	if tasks[0].Args["serial"] != "0001" || tasks[0].Status != StatusPending || !tasks[0].At.Equal(at) {
+		t.Errorf("Task not restored: %#v", tasks[0])
+	}
+	if tasks[1].Status != StatusFailed {
+		t.Errorf("Interrupted task is %q, not failed", tasks[1].Status)
+	}
+
+	next, _ := s2.Add(Task{Operation: "reboot", At: at})
+	if next.Id != "3" {
+		t.Errorf("Expected id 3, got %q", next.Id)
+	}
+}
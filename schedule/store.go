@@ -0,0 +1,58 @@
+package schedule
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+)
+
+// Store persists the tasks of a scheduler.
+//
+// Save is given the full list of tasks each time any task changes.
+type Store interface {
+	Load() ([]Task, error)
+	Save([]Task) error
+}
+
+// FileStore is a Store that saves tasks as JSON to the given file.
+//
+// Loading from a file that does not exist returns no tasks.
+type FileStore struct {
+	Path string
+}
+
+// Load reads the tasks from the file.
+func (o FileStore) Load() ([]Task, error) {
+	b, err := ioutil.ReadFile(o.Path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var ans []Task
+	if err = json.Unmarshal(b, &ans); err != nil {
+		return nil, err
+	}
+
+	return ans, nil
+}
+
+// Save writes the tasks to the file.
+//
+// The tasks are first written to a temp file which is then renamed, so that
+// the file is never left partially written.
+func (o FileStore) Save(list []Task) error {
+	b, err := json.MarshalIndent(list, "", "    ")
+	if err != nil {
+		return err
+	}
+
+	tmp := o.Path + ".tmp"
+	if err = ioutil.WriteFile(tmp, b, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, o.Path)
+}
@@ -0,0 +1,105 @@
+package pango
+
+import (
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/util"
+)
+
+// SdwanEvent is a single SD-WAN path monitoring event, such as a path
+// failing its SLA and traffic being moved to another path.
+//
+// Time is the event time as returned by PAN-OS, while At is the parsed form
+// of it.  PAN-OS does not include the timezone, so At is in UTC; use
+// util.ParseTime with the location of the firewall's Clock() if needed.
+type SdwanEvent struct {
+	Time        string
+	At          time.Time
+	Vif         string
+	Tunnel      string
+	Type        string
+	Description string
+}
+
+// SdwanPathHealth returns the path monitoring metrics for each path of the
+// given SD-WAN virtual interface (such as "sdwan.901").
+//
+// If vif is an empty string, then the paths of all SD-WAN virtual interfaces
+// are returned.
+func (c *Firewall) SdwanPathHealth(vif string) ([]util.SdwanPath, error) {
+	type req struct {
+		XMLName xml.Name `xml:"show"`
+		Vif     string   `xml:"sdwan>path-monitor>stats>vif,omitempty"`
+		All     *string  `xml:"sdwan>path-monitor>stats>all"`
+	}
+
+	r := req{Vif: vif}
+	if vif == "" {
+		s := ""
+		r.All = &s
+	}
+	ans := util.SdwanPathResponse{}
+
+	c.LogOp("(op) show sdwan path-monitor stats: %q", vif)
+	if _, err := c.Op(r, "", nil, &ans); err != nil {
+		return nil, err
+	}
+
+	return ans.Normalize(vif), nil
+}
+
+// SdwanEvents returns the SD-WAN path monitoring event history for the given
+// SD-WAN virtual interface, or for all of them if vif is an empty string.
+func (c *Firewall) SdwanEvents(vif string) ([]SdwanEvent, error) {
+	type req struct {
+		XMLName xml.Name `xml:"show"`
+		Vif     string   `xml:"sdwan>event>vif,omitempty"`
+		All     *string  `xml:"sdwan>event>all"`
+	}
+
+	r := req{Vif: vif}
+	if vif == "" {
+		s := ""
+		r.All = &s
+	}
+	ans := sdwanEventResp{}
+
+	c.LogOp("(op) show sdwan event: %q", vif)
+	if _, err := c.Op(r, "", nil, &ans); err != nil {
+		return nil, err
+	}
+
+	list := make([]SdwanEvent, 0, len(ans.Entries))
+	for _, e := range ans.Entries {
+		at, err := util.ParseTime(util.TimeFormat, e.Time, nil)
+		if err != nil {
+			return nil, fmt.Errorf("Bad sdwan event time: %s", err)
+		}
+		list = append(list, SdwanEvent{
+			Time:        e.Time,
+			At:          at,
+			Vif:         e.Vif,
+			Tunnel:      e.Tunnel,
+			Type:        e.Type,
+			Description: e.Description,
+		})
+	}
+
+	return list, nil
+}
+
+/** Internal structs **/
+
+type sdwanEventResp struct {
+	Entries []sdwanEventEntry `xml:"result>entry"`
+}
+
+type sdwanEventEntry struct {
+	Time        string `xml:"time"`
+	Vif         string `xml:"vif"`
+	Tunnel      string `xml:"tunnel"`
+	Type        string `xml:"type"`
+	Description string `xml:"description"`
+}
@@ -0,0 +1,61 @@
+package pango
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSdwanPathHealth(t *testing.T) {
+	fw := &Firewall{Client: Client{
+		rb: [][]byte{
+			[]byte(`<response status="success"><result>
+<entry><vif>sdwan.901</vif><tunnel>tunnel.901</tunnel><interface>ethernet1/1</interface><status>up</status><latency>12</latency><jitter>1.5 ms</jitter><pkt-loss>0.25%</pkt-loss></entry>
+<entry><vif>sdwan.901</vif><tunnel>tunnel.902</tunnel><interface>ethernet1/2</interface><status>down</status><latency>0</latency><jitter>0</jitter><pkt-loss>100</pkt-loss></entry>
+</result></response>`),
+		},
+	}}
+	if err := fw.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	ans, err := fw.SdwanPathHealth("sdwan.901")
+	if err != nil {
+		t.Fatalf("SdwanPathHealth failed: %s", err)
+	}
+	if len(ans) != 2 {
+		t.Fatalf("Expected 2 paths, got %#v", ans)
+	}
+	if ans[0].Tunnel != "tunnel.901" || ans[0].Latency != 12 || ans[0].Jitter != 1.5 || ans[0].PacketLoss != 0.25 {
+		t.Errorf("Bad first path: %#v", ans[0])
+	}
+	if ans[1].Status != "down" || ans[1].PacketLoss != 100 {
+		t.Errorf("Bad second path: %#v", ans[1])
+	}
+	if cmd := fw.rp[0].Get("cmd"); cmd != "<show><sdwan><path-monitor><stats><vif>sdwan.901</vif></stats></path-monitor></sdwan></show>" {
+		t.Errorf("Bad cmd: %s", cmd)
+	}
+}
+
+func TestSdwanEventsAll(t *testing.T) {
+	fw := &Firewall{Client: Client{
+		rb: [][]byte{
+			[]byte(`<response status="success"><result><entry><time>2020/01/01 10:00:00</time><vif>sdwan.901</vif><tunnel>tunnel.901</tunnel><type>path-down</type><description>latency exceeded SLA</description></entry></result></response>`),
+		},
+	}}
+	if err := fw.Initialize(); err != nil {
+		t.Fatalf("Initialize failed: %s", err)
+	}
+
+	ans, err := fw.SdwanEvents("")
+	if err != nil {
+		t.Fatalf("SdwanEvents failed: %s", err)
+	}
+	if len(ans) != 1 || ans[0].Type != "path-down" || ans[0].Tunnel != "tunnel.901" {
+		t.Errorf("Bad events: %#v", ans)
+	} else if !ans[0].At.Equal(time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("Bad event time: %s", ans[0].At)
+	}
+	if cmd := fw.rp[0].Get("cmd"); cmd != "<show><sdwan><event><all></all></event></sdwan></show>" {
+		t.Errorf("Bad cmd: %s", cmd)
+	}
+}
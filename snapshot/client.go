@@ -0,0 +1,375 @@
+package snapshot
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/PaloAltoNetworks/pango/cfgtree"
+	"github.com/PaloAltoNetworks/pango/util"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+// ErrReadOnly is returned for any operation that is not a config retrieval.
+var ErrReadOnly = errors.New("Snapshot client is read-only")
+
+// errNoSuchNode mirrors the PAN-OS error for a SHOW of missing config.
+var errNoSuchNode = errors.New("No such node")
+
+// Client is a read-only util.XapiClient that answers GET and SHOW requests
+// from an in-memory copy of the config.
+//
+// Scopes are the absolute xpaths to copy, and must start with "/config".  If
+// no scopes are specified, then the entire config is copied.  If Running is
+// true, then the running config is copied, otherwise the candidate config
+// is copied.  Both GET and SHOW are served from the same copy.
+type Client struct {
+	Scopes  []string
+	Running bool
+
+	con   util.XapiClient
+	mu    sync.RWMutex
+	root  *cfgtree.Node
+	ver   version.Number
+	taken time.Time
+}
+
+// Initialize sets the client that snapshots are taken from.
+func (c *Client) Initialize(con util.XapiClient) {
+	c.con = con
+}
+
+// Refresh takes a new snapshot of all scopes, replacing the current one.
+func (c *Client) Refresh() error {
+	list := c.Scopes
+	if len(list) == 0 {
+		list = []string{"/config"}
+	}
+
+	type result struct {
+		Result util.RawXml `xml:"result"`
+	}
+
+	root := &cfgtree.Node{XMLName: xml.Name{Local: "config"}}
+	for _, path := range list {
+		if path != "/config" && !strings.HasPrefix(path, "/config/") {
+			return fmt.Errorf("Scope %q is not under /config", path)
+		}
+
+		resp := result{}
+		var err error
+		if c.Running {
+			c.con.LogQuery("(show) snapshot of %s", path)
+			_, err = c.con.Show(path, nil, &resp)
+		} else {
+			c.con.LogQuery("(get) snapshot of %s", path)
+			_, err = c.con.Get(path, nil, &resp)
+		}
+		if err != nil {
+			if isNoSuchNode(err) {
+				continue
+			}
+			return err
+		}
+
+		n, err := cfgtree.ParseInner("result", []byte(strings.TrimSpace(resp.Result.Text)))
+		if err != nil {
+			return err
+		}
+		if len(n.Nodes) == 0 {
+			continue
+		}
+
+		if path == "/config" {
+			root = n.Nodes[0]
+		} else if err = graft(root, path, n.Nodes[0]); err != nil {
+			return err
+		}
+	}
+
+	c.mu.Lock()
+	c.root = root
+	c.ver = c.con.Versioning()
+	c.taken = time.Now()
+	c.mu.Unlock()
+
+	return nil
+}
+
+// Config returns the snapshot's config, rooted at the "config" node.
+//
+// The node returned must not be modified.
+func (c *Client) Config() *cfgtree.Node {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.root
+}
+
+// Taken returns when the current snapshot was taken.
+func (c *Client) Taken() time.Time {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.taken
+}
+
+// String is the string representation of the client.
+func (c *Client) String() string {
+	return fmt.Sprintf("snapshot of %s", c.con)
+}
+
+// Versioning returns the PAN-OS version at the time of the snapshot.
+func (c *Client) Versioning() version.Number {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.ver
+}
+
+// LogAction does nothing, as the snapshot is read-only.
+func (c *Client) LogAction(string, ...interface{}) {}
+
+// LogQuery does nothing, as queries do not contact the device.
+func (c *Client) LogQuery(string, ...interface{}) {}
+
+// LogOp does nothing, as op commands are not supported.
+func (c *Client) LogOp(string, ...interface{}) {}
+
+// LogUid does nothing, as User-ID commands are not supported.
+func (c *Client) LogUid(string, ...interface{}) {}
+
+// Show retrieves the given xpath from the snapshot.
+//
+// A missing xpath is an error, as it is from PAN-OS.
+func (c *Client) Show(path, extras, ans interface{}) ([]byte, error) {
+	return c.retrieve(path, true, ans)
+}
+
+// Get retrieves the given xpath from the snapshot.
+//
+// A missing xpath returns an empty result, as it does from PAN-OS.
+func (c *Client) Get(path, extras, ans interface{}) ([]byte, error) {
+	return c.retrieve(path, false, ans)
+}
+
+// EntryListUsing returns the names of the entries at the given path.
+func (c *Client) EntryListUsing(fn util.Retriever, path []string) ([]string, error) {
+	type resp struct {
+		Entries []struct {
+			Name string `xml:"name,attr"`
+		} `xml:"result>entry"`
+	}
+
+	if path == nil {
+		return nil, fmt.Errorf("xpath is empty")
+	}
+
+	full := make([]string, 0, len(path)+2)
+	full = append(full, path...)
+	full = append(full, "entry", "@name")
+
+	ans := resp{}
+	if _, err := fn(full, nil, &ans); err != nil {
+		if isNoSuchNode(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	list := make([]string, 0, len(ans.Entries))
+	for _, e := range ans.Entries {
+		list = append(list, e.Name)
+	}
+
+	return list, nil
+}
+
+// MemberListUsing returns the members at the given path.
+func (c *Client) MemberListUsing(fn util.Retriever, path []string) ([]string, error) {
+	type resp struct {
+		Members []string `xml:"result>member"`
+	}
+
+	if path == nil {
+		return nil, fmt.Errorf("xpath is empty")
+	}
+
+	full := make([]string, 0, len(path)+1)
+	full = append(full, path...)
+	full = append(full, "member")
+
+	ans := resp{}
+	if _, err := fn(full, nil, &ans); err != nil {
+		if isNoSuchNode(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return ans.Members, nil
+}
+
+// Op returns ErrReadOnly.
+func (c *Client) Op(req interface{}, vsys string, extras, ans interface{}) ([]byte, error) {
+	return nil, ErrReadOnly
+}
+
+// Delete returns ErrReadOnly.
+func (c *Client) Delete(path, extras, ans interface{}) ([]byte, error) {
+	return nil, ErrReadOnly
+}
+
+// Set returns ErrReadOnly.
+func (c *Client) Set(path, element, extras, ans interface{}) ([]byte, error) {
+	return nil, ErrReadOnly
+}
+
+// Edit returns ErrReadOnly.
+func (c *Client) Edit(path, element, extras, ans interface{}) ([]byte, error) {
+	return nil, ErrReadOnly
+}
+
+// Move returns ErrReadOnly.
+func (c *Client) Move(path interface{}, where, dst string, extras, ans interface{}) ([]byte, error) {
+	return nil, ErrReadOnly
+}
+
+// Uid returns ErrReadOnly.
+func (c *Client) Uid(cmd interface{}, vsys string, extras, ans interface{}) ([]byte, error) {
+	return nil, ErrReadOnly
+}
+
+// RequestPasswordHash returns ErrReadOnly.
+func (c *Client) RequestPasswordHash(val string) (string, error) {
+	return "", ErrReadOnly
+}
+
+// VsysImport returns ErrReadOnly.
+func (c *Client) VsysImport(loc, tmpl, ts, vsys string, names []string) error {
+	return ErrReadOnly
+}
+
+// VsysUnimport returns ErrReadOnly.
+func (c *Client) VsysUnimport(loc, tmpl, ts string, names []string) error {
+	return ErrReadOnly
+}
+
+// WaitForJob returns ErrReadOnly.
+func (c *Client) WaitForJob(id uint, sleep time.Duration, resp interface{}) error {
+	return ErrReadOnly
+}
+
+// Commit returns ErrReadOnly.
+func (c *Client) Commit(cmd interface{}, action string, extras interface{}) (uint, []byte, error) {
+	return 0, nil, ErrReadOnly
+}
+
+// PositionFirstEntity returns ErrReadOnly.
+func (c *Client) PositionFirstEntity(mvt int, rel, ent string, path, elms []string) error {
+	return ErrReadOnly
+}
+
+/** Internal functions for the Client struct **/
+
+func (c *Client) retrieve(path interface{}, show bool, ans interface{}) ([]byte, error) {
+	xpath := util.AsXpath(path)
+
+	c.mu.RLock()
+	root := c.root
+	c.mu.RUnlock()
+	if root == nil {
+		return nil, fmt.Errorf("No snapshot has been taken")
+	}
+
+	namesOnly := strings.HasSuffix(xpath, "/@name")
+	if namesOnly {
+		xpath = strings.TrimSuffix(xpath, "/@name")
+	}
+
+	list := root.FindAll(xpath)
+	if len(list) == 0 && show {
+		return nil, errNoSuchNode
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(`<response status="success"><result>`)
+	for _, n := range list {
+		if namesOnly {
+			buf.Write((&cfgtree.Node{
+				XMLName: n.XMLName,
+				Attrs:   []xml.Attr{{Name: xml.Name{Local: "name"}, Value: n.Attr("name")}},
+			}).Bytes())
+		} else {
+			buf.Write(n.Bytes())
+		}
+	}
+	buf.WriteString(`</result></response>`)
+	b := buf.Bytes()
+
+	if ans == nil {
+		return b, nil
+	}
+
+	if err := xml.Unmarshal(b, ans); err != nil {
+		return b, fmt.Errorf("Error unmarshaling into provided interface: %s", err)
+	}
+
+	return b, nil
+}
+
+// graft places n at the given absolute xpath under root, creating any
+// missing parent nodes and replacing any existing node at that location.
+func graft(root *cfgtree.Node, xpath string, n *cfgtree.Node) error {
+	steps := strings.Split(strings.TrimPrefix(xpath, "/config/"), "/")
+	cur := root
+
+	for i, v := range steps {
+		probe := &cfgtree.Node{}
+		if idx := strings.Index(v, "[@name="); idx != -1 && strings.HasSuffix(v, "]") {
+			name := strings.Trim(v[idx+len("[@name="):len(v)-1], `'"`)
+			probe.XMLName = xml.Name{Local: v[:idx]}
+			probe.Attrs = []xml.Attr{{Name: xml.Name{Local: "name"}, Value: name}}
+		} else if strings.ContainsAny(v, "[]*") {
+			return fmt.Errorf("Unsupported scope step %q", v)
+		} else {
+			probe.XMLName = xml.Name{Local: v}
+		}
+		key := probe.Key()
+
+		if i == len(steps)-1 {
+			for j, child := range cur.Nodes {
+				if child.Key() == key {
+					cur.Nodes[j] = n
+					return nil
+				}
+			}
+			cur.Nodes = append(cur.Nodes, n)
+			return nil
+		}
+
+		child := cur.Child(key)
+		if child == nil {
+			child = probe
+			cur.Nodes = append(cur.Nodes, child)
+		}
+		cur = child
+	}
+
+	return nil
+}
+
+// isNoSuchNode returns true if err is errNoSuchNode, or is a PAN-OS object
+// not found error from the client that snapshots are taken from.
+func isNoSuchNode(err error) bool {
+	if err == errNoSuchNode {
+		return true
+	}
+
+	e2, ok := err.(interface{ ObjectNotFound() bool })
+	return ok && e2.ObjectNotFound()
+}
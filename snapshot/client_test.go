@@ -0,0 +1,121 @@
+package snapshot
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/objs/addr"
+	"github.com/PaloAltoNetworks/pango/testdata"
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+const vsysXml = `<entry name="vsys1" admin="admin" dirtyId="3">
+    <address>
+        <entry name="a"><ip-netmask>10.1.1.1</ip-netmask><description>first</description></entry>
+        <entry name="b"><fqdn>example.com</fqdn></entry>
+    </address>
+</entry>`
+
+func newClient(t *testing.T) (*Client, *testdata.MockClient) {
+	mc := &testdata.MockClient{Version: version.Number{9, 1, 0, ""}}
+	mc.AddResp(vsysXml)
+
+	s := &Client{
+		Scopes: []string{"/config/devices/entry[@name='localhost.localdomain']/vsys/entry[@name='vsys1']"},
+	}
+	s.Initialize(mc)
+	if err := s.Refresh(); err != nil {
+		t.Fatalf("Refresh failed: %s", err)
+	}
+
+	return s, mc
+}
+
+func TestNamespaceRetrieval(t *testing.T) {
+	s, mc := newClient(t)
+	if mc.Called != 1 {
+		t.Fatalf("Expected 1 call to the device, got %d", mc.Called)
+	}
+
+	ns := &addr.FwAddr{}
+	ns.Initialize(s)
+
+	names, err := ns.GetList("vsys1")
+	if err != nil {
+		t.Fatalf("GetList failed: %s", err)
+	} else if !reflect.DeepEqual(names, []string{"a", "b"}) {
+		t.Errorf("Bad list: %v", names)
+	}
+
+	o, err := ns.Get("vsys1", "a")
+	if err != nil {
+		t.Fatalf("Get failed: %s", err)
+	}
+	expected := addr.Entry{Name: "a", Value: "10.1.1.1", Type: addr.IpNetmask, Description: "first"}
+	if !reflect.DeepEqual(o, expected) {
+		t.Errorf("%#v != %#v", o, expected)
+	}
+
+	all, err := ns.ShowAll("vsys1")
+	if err != nil {
+		t.Fatalf("ShowAll failed: %s", err)
+	} else if len(all) != 2 || all[1].Type != addr.Fqdn {
+		t.Errorf("Bad objects: %#v", all)
+	}
+
+	if _, err = ns.Show("vsys1", "missing"); err == nil {
+		t.Errorf("No error for missing object")
+	}
+
+	if names, err = ns.GetList("vsys2"); err != nil || len(names) != 0 {
+		t.Errorf("Out of scope list: %v, %v", names, err)
+	}
+
+	if err = ns.Set("vsys1", expected); err != ErrReadOnly {
+		t.Errorf("Set returned %v", err)
+	}
+
+	if mc.Called != 1 {
+		t.Errorf("Retrievals hit the device: %d calls", mc.Called)
+	}
+}
+
+func TestConfig(t *testing.T) {
+	s, _ := newClient(t)
+
+	n := s.Config().Find("/config/devices/entry[@name='localhost.localdomain']/vsys/entry[@name='vsys1']")
+	if n == nil {
+		t.Fatalf("Scope not grafted into the config")
+	}
+	if n.Attr("dirtyId") != "" {
+		t.Errorf("Candidate config attributes not removed")
+	}
+	if s.Versioning().String() != "9.1.0" {
+		t.Errorf("Bad version: %s", s.Versioning())
+	}
+}
+
+type notFoundError struct{}
+
+func (e notFoundError) Error() string        { return "No such node" }
+func (e notFoundError) ObjectNotFound() bool { return true }
+
+func TestRefreshMissingScope(t *testing.T) {
+	mc := &testdata.MockClient{Version: version.Number{9, 1, 0, ""}}
+	mc.AddResp(vsysXml)
+	mc.Resp = append(mc.Resp, testdata.Response{Raw: []byte("<response><result /></response>"), Error: notFoundError{}})
+
+	s := &Client{
+		Scopes: []string{
+			"/config/devices/entry[@name='localhost.localdomain']/vsys/entry[@name='vsys1']",
+			"/config/shared",
+		},
+	}
+	s.Initialize(mc)
+	if err := s.Refresh(); err != nil {
+		t.Fatalf("Refresh failed for missing scope: %s", err)
+	}
+	if mc.Called != 2 {
+		t.Errorf("Expected 2 calls to the device, got %d", mc.Called)
+	}
+}
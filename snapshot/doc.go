@@ -0,0 +1,27 @@
+/*
+Package snapshot is a read-only util.XapiClient that serves config
+retrievals from a point-in-time copy of a device's config.
+
+Analysis tools often make many passes over the same config, each pass
+invoking dozens of Get / GetList calls.  Instead, take one snapshot of the
+scope being analyzed, then initialize the namespaces with the snapshot
+client, so every subsequent retrieval is answered from memory:
+
+	s := &snapshot.Client{
+	    Scopes: []string{
+	        "/config/devices/entry[@name='localhost.localdomain']/vsys/entry[@name='vsys1']",
+	    },
+	}
+	s.Initialize(fw)
+	if err := s.Refresh(); err != nil {
+	    return err
+	}
+
+	objects := &objs.FwObjs{}
+	objects.Initialize(s)
+	names, err := objects.Address.GetList("vsys1")
+
+Retrievals outside of the snapshot's scopes behave as if the config is not
+present.  All config changes, op commands, and commits return ErrReadOnly.
+*/
+package snapshot
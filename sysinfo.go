@@ -0,0 +1,96 @@
+package pango
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// SystemInfo is the typed form of "show system info".
+//
+// Fields not covered by this struct are still available in the client's
+// SystemInfo map, as well as in the Other map here.  Plugins is keyed by
+// plugin name, with the plugin version as the value.
+type SystemInfo struct {
+	Hostname            string
+	IpAddress           string
+	Serial              string
+	Model               string
+	Family              string
+	SwVersion           string
+	AppVersion          string
+	ThreatVersion       string
+	AvVersion           string
+	WildfireVersion     string
+	UrlFilteringVersion string
+	MultiVsys           bool
+	OperationalMode     string
+	Uptime              string
+	VmMode              string
+	Plugins             map[string]string
+	Other               map[string]string
+}
+
+// UptimeDuration parses Uptime, which is formatted as "4 days, 2:03:17".
+func (o SystemInfo) UptimeDuration() (time.Duration, error) {
+	m := uptimeRe.FindStringSubmatch(o.Uptime)
+	if m == nil {
+		return 0, fmt.Errorf("Unknown uptime format: %q", o.Uptime)
+	}
+
+	var ans time.Duration
+	units := []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second}
+	for i, u := range units {
+		if m[i+1] == "" {
+			continue
+		}
+		v, err := strconv.Atoi(m[i+1])
+		if err != nil {
+			return 0, err
+		}
+		ans += time.Duration(v) * u
+	}
+
+	return ans, nil
+}
+
+/** Private functions **/
+
+var uptimeRe = regexp.MustCompile(`^(?:(\d+) days?, )?(\d+):(\d+):(\d+)$`)
+
+func newSystemInfo(m map[string]string, plugins map[string]string) SystemInfo {
+	ans := SystemInfo{
+		Plugins: plugins,
+		Other:   make(map[string]string),
+	}
+
+	fields := map[string]*string{
+		"hostname":              &ans.Hostname,
+		"ip-address":            &ans.IpAddress,
+		"serial":                &ans.Serial,
+		"model":                 &ans.Model,
+		"family":                &ans.Family,
+		"sw-version":            &ans.SwVersion,
+		"app-version":           &ans.AppVersion,
+		"threat-version":        &ans.ThreatVersion,
+		"av-version":            &ans.AvVersion,
+		"wildfire-version":      &ans.WildfireVersion,
+		"url-filtering-version": &ans.UrlFilteringVersion,
+		"operational-mode":      &ans.OperationalMode,
+		"uptime":                &ans.Uptime,
+		"vm-mode":               &ans.VmMode,
+	}
+
+	for key, val := range m {
+		if f, ok := fields[key]; ok {
+			*f = val
+		} else if key == "multi-vsys" {
+			ans.MultiVsys = val == "on" || val == "yes"
+		} else {
+			ans.Other[key] = val
+		}
+	}
+
+	return ans
+}
@@ -0,0 +1,51 @@
+package pango
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSystemInfo(t *testing.T) {
+	c := &Client{rb: [][]byte{[]byte(`<response status="success"><result><system>
+<hostname>fw1</hostname>
+<serial>0011</serial>
+<model>PA-VM</model>
+<sw-version>10.1.6</sw-version>
+<multi-vsys>on</multi-vsys>
+<operational-mode>normal</operational-mode>
+<uptime>4 days, 2:03:17</uptime>
+<vm-cpuid>AZR:1234</vm-cpuid>
+<plugin_versions>
+<entry name="vm_series" version="2.1.6"><pkginfo>vm_series-2.1.6</pkginfo></entry>
+</plugin_versions>
+</system></result></response>`)}}
+
+	if err := c.initSystemInfo(); err != nil {
+		t.Fatalf("initSystemInfo failed: %s", err)
+	}
+
+	o := c.Info
+	if o.Hostname != "fw1" || o.Serial != "0011" || o.Model != "PA-VM" || o.SwVersion != "10.1.6" {
+		t.Errorf("Bad info: %#v", o)
+	}
+	if !o.MultiVsys || o.OperationalMode != "normal" {
+		t.Errorf("Bad mode: %#v", o)
+	}
+	if o.Plugins["vm_series"] != "2.1.6" {
+		t.Errorf("Bad plugins: %#v", o.Plugins)
+	}
+	if o.Other["vm-cpuid"] != "AZR:1234" {
+		t.Errorf("Unknown key not kept: %#v", o.Other)
+	}
+	if c.SystemInfo["serial"] != "0011" {
+		t.Errorf("SystemInfo map not populated: %#v", c.SystemInfo)
+	}
+
+	d, err := o.UptimeDuration()
+	if err != nil {
+		t.Fatalf("UptimeDuration failed: %s", err)
+	}
+	if d != 4*24*time.Hour+2*time.Hour+3*time.Minute+17*time.Second {
+		t.Errorf("Bad uptime: %s", d)
+	}
+}
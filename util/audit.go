@@ -0,0 +1,102 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+// UnsupportedField is a field that was specified but which is not supported
+// by the PAN-OS version being configured.
+//
+// Min is the first version that supports the field, while Max is the first
+// version that no longer supports it.  Either may be the zero value if there
+// is no such bound.
+type UnsupportedField struct {
+	Field string
+	Min   version.Number
+	Max   version.Number
+}
+
+// String returns a human readable explanation of the version requirement.
+func (o UnsupportedField) String() string {
+	var zero version.Number
+
+	switch {
+	case o.Min != zero && o.Max != zero:
+		return fmt.Sprintf("%s (requires PAN-OS %s up to but not including %s)", o.Field, o.Min, o.Max)
+	case o.Min != zero:
+		return fmt.Sprintf("%s (requires PAN-OS %s+)", o.Field, o.Min)
+	case o.Max != zero:
+		return fmt.Sprintf("%s (removed in PAN-OS %s)", o.Field, o.Max)
+	}
+
+	return o.Field
+}
+
+// AuditError is returned when an object specifies fields that the target
+// PAN-OS version does not support.
+//
+// Without this check, such fields would be silently omitted from the config
+// sent to PAN-OS.
+type AuditError struct {
+	Name    string
+	Version version.Number
+	Fields  []UnsupportedField
+}
+
+func (e AuditError) Error() string {
+	list := make([]string, 0, len(e.Fields))
+	for _, f := range e.Fields {
+		list = append(list, f.String())
+	}
+
+	return fmt.Sprintf("%q uses fields unsupported by PAN-OS %s: %s", e.Name, e.Version, strings.Join(list, ", "))
+}
+
+// Auditor collects the fields of an object that are unsupported by the
+// target PAN-OS version.
+type Auditor struct {
+	Name    string
+	Version version.Number
+	Fields  []UnsupportedField
+}
+
+// NewAuditor returns a new auditor for the named object.
+func NewAuditor(name string, v version.Number) *Auditor {
+	return &Auditor{
+		Name:    name,
+		Version: v,
+	}
+}
+
+// Since flags the field if it is set and the version is less than min.
+//
+// Nothing is flagged if the version is zero, as then the PAN-OS version is
+// unknown, such as when the client has not been initialized.
+func (o *Auditor) Since(field string, set bool, min version.Number) {
+	if set && o.Version != (version.Number{}) && !o.Version.Gte(min) {
+		o.Fields = append(o.Fields, UnsupportedField{Field: field, Min: min})
+	}
+}
+
+// Until flags the field if it is set and the version is max or greater.
+func (o *Auditor) Until(field string, set bool, max version.Number) {
+	if set && o.Version.Gte(max) {
+		o.Fields = append(o.Fields, UnsupportedField{Field: field, Max: max})
+	}
+}
+
+// Err returns an AuditError if any fields were flagged, otherwise nil.
+func (o *Auditor) Err() error {
+	if len(o.Fields) == 0 {
+		return nil
+	}
+
+	return AuditError{
+		Name:    o.Name,
+		Version: o.Version,
+		Fields:  o.Fields,
+	}
+}
@@ -0,0 +1,45 @@
+package util
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/PaloAltoNetworks/pango/version"
+)
+
+func TestAuditor(t *testing.T) {
+	v81 := version.Number{8, 1, 0, ""}
+	v90 := version.Number{9, 0, 0, ""}
+
+	a := NewAuditor("obj", v81)
+	a.Since("Old", true, v81)
+	a.Since("Unset", false, v90)
+	a.Until("Current", true, v90)
+	if err := a.Err(); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	a.Since("New", true, v90)
+	a.Until("Removed", true, v81)
+	err := a.Err()
+	if err == nil {
+		t.Fatalf("Expected an error")
+	}
+
+	e2, ok := err.(AuditError)
+	if !ok {
+		t.Fatalf("Expected AuditError, got %T", err)
+	} else if len(e2.Fields) != 2 {
+		t.Errorf("Expected 2 fields, got %#v", e2.Fields)
+	} else if !strings.Contains(err.Error(), "New (requires PAN-OS 9.0.0+)") {
+		t.Errorf("Unexpected message: %s", err)
+	}
+}
+
+func TestAuditorUnknownVersion(t *testing.T) {
+	a := NewAuditor("obj", version.Number{})
+	a.Since("New", true, version.Number{9, 0, 0, ""})
+	if err := a.Err(); err != nil {
+		t.Errorf("Unexpected error for unknown version: %s", err)
+	}
+}
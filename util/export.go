@@ -0,0 +1,31 @@
+package util
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+)
+
+// WriteCsv writes the given header row followed by the given rows as CSV.
+func WriteCsv(w io.Writer, header []string, rows [][]string) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJson writes the given value as indented JSON.
+func WriteJson(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
@@ -2,7 +2,9 @@ package util
 
 import (
 	"encoding/xml"
+	"fmt"
 	"strings"
+	"time"
 )
 
 // JobResponse parses a XML response that includes a job ID.
@@ -41,11 +43,125 @@ func (o *BasicJobDetails) String() string {
 	return strings.Join(ans, " | ")
 }
 
+// Strings returns each line, with surrounding whitespace removed.  If there
+// are no lines, then nil is returned.
+func (o *BasicJobDetails) Strings() []string {
+	if len(o.Lines) == 0 {
+		return nil
+	}
+
+	ans := make([]string, 0, len(o.Lines))
+	for _, line := range o.Lines {
+		if line.Cdata != nil {
+			ans = append(ans, strings.TrimSpace(*line.Cdata))
+		} else if line.Text != nil {
+			ans = append(ans, strings.TrimSpace(*line.Text))
+		}
+	}
+
+	return ans
+}
+
 type LineOrCdata struct {
 	Cdata *string `xml:",cdata"`
 	Text  *string `xml:",chardata"`
 }
 
+// Job is a single job, as returned by "show jobs".
+//
+// The Progress field is usually the percent complete, but for some finished
+// jobs PAN-OS reports the completion time here instead.
+//
+// The Enqueued, Dequeued, and Finished fields are the strings returned from
+// PAN-OS, while EnqueuedAt, DequeuedAt, and FinishedAt are set from them by
+// ParseTimes.
+type Job struct {
+	XMLName         xml.Name        `xml:"job"`
+	Id              uint            `xml:"id"`
+	Type            string          `xml:"type"`
+	User            string          `xml:"user"`
+	Status          string          `xml:"status"`
+	Result          string          `xml:"result"`
+	Progress        string          `xml:"progress"`
+	Queued          string          `xml:"queued"`
+	PositionInQueue int             `xml:"positionInQ"`
+	Stoppable       string          `xml:"stoppable"`
+	Description     string          `xml:"description"`
+	Enqueued        string          `xml:"tenq"`
+	Dequeued        string          `xml:"tdeq"`
+	Finished        string          `xml:"tfin"`
+	EnqueuedAt      time.Time       `xml:"-"`
+	DequeuedAt      time.Time       `xml:"-"`
+	FinishedAt      time.Time       `xml:"-"`
+	Details         BasicJobDetails `xml:"details"`
+	Warnings        BasicJobDetails `xml:"warnings"`
+	Devices         []JobDevice     `xml:"devices>entry"`
+}
+
+// JobDevice is the result of a job for a single device, such as a Panorama
+// commit all (push) to a device group.
+type JobDevice struct {
+	Serial   string          `xml:"serial-no"`
+	Name     string          `xml:"devicename"`
+	Vsys     string          `xml:"vsys"`
+	Status   string          `xml:"status"`
+	Result   string          `xml:"result"`
+	Progress string          `xml:"progress"`
+	Errors   BasicJobDetails `xml:"details>msg>errors"`
+	Warnings BasicJobDetails `xml:"details>msg>warnings"`
+}
+
+// JobsResponse parses the XML response of a "show jobs" op command.
+type JobsResponse struct {
+	XMLName xml.Name `xml:"response"`
+	Jobs    []Job    `xml:"result>job"`
+}
+
+// IsCommit returns if this job is a commit job.
+func (o *Job) IsCommit() bool {
+	return strings.HasPrefix(o.Type, "Commit")
+}
+
+// IsFinished returns if this job has finished running.
+func (o *Job) IsFinished() bool {
+	return o.Status == "FIN"
+}
+
+// ParseTimes sets EnqueuedAt, DequeuedAt, and FinishedAt from the Enqueued,
+// Dequeued, and Finished fields.
+//
+// Since PAN-OS does not output timezone information with job timestamps, they
+// are interpreted as being in location loc, with a nil loc being UTC.  PAN-OS
+// only reports the time of day that a job was dequeued, so DequeuedAt is the
+// first such time at or after EnqueuedAt.  FinishedAt is only set once the
+// job IsFinished().
+func (o *Job) ParseTimes(loc *time.Location) error {
+	var err error
+
+	if o.EnqueuedAt, err = ParseTime(TimeFormat, o.Enqueued, loc); err != nil {
+		return fmt.Errorf("Bad enqueue time for job %d: %s", o.Id, err)
+	}
+
+	o.DequeuedAt = time.Time{}
+	if v := strings.TrimSpace(o.Dequeued); v != "" && !o.EnqueuedAt.IsZero() {
+		if o.DequeuedAt, err = ParseTime(TimeFormat, o.EnqueuedAt.Format("2006/01/02 ")+v, loc); err != nil {
+			return fmt.Errorf("Bad dequeue time for job %d: %s", o.Id, err)
+		}
+		if o.DequeuedAt.Before(o.EnqueuedAt) {
+			o.DequeuedAt = o.DequeuedAt.AddDate(0, 0, 1)
+		}
+	}
+
+	o.FinishedAt = time.Time{}
+	if o.IsFinished() {
+		if o.FinishedAt, err = ParseTime(TimeFormat, o.Finished, loc); err != nil {
+			return fmt.Errorf("Bad finish time for job %d: %s", o.Id, err)
+		}
+	}
+
+	return nil
+}
+
 type devJob struct {
 	Serial string `xml:"serial-no"`
 	Result string `xml:"result"`
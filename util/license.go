@@ -2,6 +2,8 @@ package util
 
 import (
 	"encoding/xml"
+	"strings"
+	"time"
 )
 
 // License defines a license entry.
@@ -15,3 +17,18 @@ type License struct {
 	Expired     string   `xml:"expired"`
 	AuthCode    string   `xml:"authcode"`
 }
+
+// IssuedAt returns the parsed Issued date.
+func (o License) IssuedAt() (time.Time, error) {
+	return ParseTime(LicenseDateFormat, o.Issued, nil)
+}
+
+// ExpiresAt returns the parsed Expires date.  Licenses that never expire
+// have a zero ExpiresAt.
+func (o License) ExpiresAt() (time.Time, error) {
+	if strings.EqualFold(strings.TrimSpace(o.Expires), "never") {
+		return time.Time{}, nil
+	}
+
+	return ParseTime(LicenseDateFormat, o.Expires, nil)
+}
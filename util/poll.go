@@ -0,0 +1,49 @@
+package util
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrTimeout is returned by Poll if the timeout elapses before the check
+// succeeds.
+var ErrTimeout = errors.New("timeout")
+
+// MinPollSleep is the shortest time that Poll waits between checks, so that
+// a zero or small sleep does not flood PAN-OS with requests.
+const MinPollSleep = time.Second
+
+// Poll invokes check until it returns true or an error, sleeping between each
+// invocation.
+//
+// The sleep param is the length of time to wait between checks, and is at
+// least MinPollSleep, while the timeout param is the total length of time to
+// wait.  A timeout of zero waits forever.  If the timeout elapses first,
+// ErrTimeout is returned.
+func Poll(sleep, timeout time.Duration, check func() (bool, error)) error {
+	if sleep < MinPollSleep {
+		sleep = MinPollSleep
+	}
+
+	var deadline time.Time
+	if timeout > 0 {
+		deadline = time.Now().Add(timeout)
+	}
+
+	for {
+		done, err := check()
+		if err != nil {
+			return err
+		} else if done {
+			return nil
+		}
+
+		if !deadline.IsZero() && time.Now().Add(sleep).After(deadline) {
+			return ErrTimeout
+		}
+
+		if sleep > 0 {
+			time.Sleep(sleep)
+		}
+	}
+}
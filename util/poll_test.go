@@ -0,0 +1,42 @@
+package util
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestPollDone(t *testing.T) {
+	var count int
+	start := time.Now()
+	err := Poll(0, 0, func() (bool, error) {
+		count++
+		return count == 2, nil
+	})
+	if err != nil {
+		t.Errorf("Error: %s", err)
+	} else if count != 2 {
+		t.Errorf("Check invoked %d times, not 2", count)
+	}
+	if d := time.Since(start); d < MinPollSleep {
+		t.Errorf("Zero sleep did not wait the minimum: %s", d)
+	}
+}
+
+func TestPollError(t *testing.T) {
+	err := Poll(0, 0, func() (bool, error) {
+		return false, fmt.Errorf("failed")
+	})
+	if err == nil || err.Error() != "failed" {
+		t.Errorf("Expected check error, got %v", err)
+	}
+}
+
+func TestPollTimeout(t *testing.T) {
+	err := Poll(time.Millisecond, 5*time.Millisecond, func() (bool, error) {
+		return false, nil
+	})
+	if err != ErrTimeout {
+		t.Errorf("Expected ErrTimeout, got %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+package util
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+)
+
+// SdwanPath is the health of a single SD-WAN path (tunnel) of an SD-WAN
+// virtual interface, as measured by path monitoring.
+//
+// Latency and Jitter are in milliseconds, while PacketLoss is a percentage.
+type SdwanPath struct {
+	Vif        string
+	Tunnel     string
+	Interface  string
+	Status     string
+	Latency    float64
+	Jitter     float64
+	PacketLoss float64
+}
+
+// SdwanPathResponse parses the XML response of the
+// "show sdwan path-monitor stats" op command.
+type SdwanPathResponse struct {
+	XMLName xml.Name         `xml:"response"`
+	Entries []sdwanPathEntry `xml:"result>entry"`
+}
+
+// Normalize returns the paths in the response.  Paths that do not specify
+// their SD-WAN virtual interface are assigned the given vif.
+func (o *SdwanPathResponse) Normalize(vif string) []SdwanPath {
+	ans := make([]SdwanPath, 0, len(o.Entries))
+
+	for _, e := range o.Entries {
+		p := SdwanPath{
+			Vif:        e.Vif,
+			Tunnel:     e.Tunnel,
+			Interface:  e.Interface,
+			Status:     e.Status,
+			Latency:    AsMetric(e.Latency),
+			Jitter:     AsMetric(e.Jitter),
+			PacketLoss: AsMetric(e.PacketLoss),
+		}
+		if p.Vif == "" {
+			p.Vif = vif
+		}
+		ans = append(ans, p)
+	}
+
+	return ans
+}
+
+type sdwanPathEntry struct {
+	Vif        string `xml:"vif"`
+	Tunnel     string `xml:"tunnel"`
+	Interface  string `xml:"interface"`
+	Status     string `xml:"status"`
+	Latency    string `xml:"latency"`
+	Jitter     string `xml:"jitter"`
+	PacketLoss string `xml:"pkt-loss"`
+}
+
+// AsMetric parses the leading number of a metric, ignoring any units
+// (such as "12 ms" or "0.5%").  Zero is returned if there is no number.
+func AsMetric(s string) float64 {
+	s = strings.TrimSpace(s)
+	end := 0
+	for end < len(s) && (s[end] == '.' || s[end] == '-' || (s[end] >= '0' && s[end] <= '9')) {
+		end++
+	}
+
+	v, _ := strconv.ParseFloat(s[:end], 64)
+	return v
+}
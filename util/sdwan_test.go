@@ -0,0 +1,27 @@
+package util
+
+import (
+	"testing"
+)
+
+func TestAsMetric(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected float64
+	}{
+		{"12", 12},
+		{"1.5 ms", 1.5},
+		{"0.25%", 0.25},
+		{" 3ms ", 3},
+		{"", 0},
+		{"n/a", 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			if v := AsMetric(tc.input); v != tc.expected {
+				t.Errorf("Expected %v, got %v", tc.expected, v)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package util
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TimeFormat is the format of most PAN-OS timestamps, such as those in job
+// and VM auth key output.  Note that it does not include timezone info.
+const TimeFormat = "2006/01/02 15:04:05"
+
+// ZonedTimeFormat is TimeFormat followed by the timezone abbreviation, as
+// used in device certificate expiry and content update release dates.
+const ZonedTimeFormat = TimeFormat + " MST"
+
+// LicenseDateFormat is the format of license issue and expiration dates.
+const LicenseDateFormat = "January 02, 2006"
+
+// ParseTime parses the PAN-OS timestamp value using the given layout.
+//
+// Most PAN-OS timestamps do not include timezone information and are in
+// the device's local time, so they are interpreted as being in location loc.
+// The device's location can be found from the time returned by the client's
+// Clock().  A nil loc is treated as UTC.
+//
+// If the layout ends in a timezone abbreviation (such as ZonedTimeFormat),
+// then the abbreviation must be UTC, GMT, or the abbreviation loc uses at
+// that time.  Abbreviations are ambiguous, so any other abbreviation is an
+// error instead of a guess at its offset.
+//
+// The zero time is returned if value is empty.
+func ParseTime(layout, value string, loc *time.Location) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	zone := ""
+	if strings.HasSuffix(layout, " MST") {
+		layout = strings.TrimSuffix(layout, " MST")
+		if i := strings.LastIndex(value, " "); i != -1 {
+			value, zone = strings.TrimSpace(value[:i]), value[i+1:]
+		}
+		if zone == "UTC" || zone == "GMT" {
+			loc, zone = time.UTC, ""
+		}
+	}
+
+	t, err := time.ParseInLocation(layout, value, loc)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if zone != "" {
+		if name, _ := t.Zone(); name != zone {
+			return time.Time{}, fmt.Errorf("Unknown timezone %q in %q, expected %q", zone, value, name)
+		}
+	}
+
+	return t, nil
+}
@@ -0,0 +1,88 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTime(t *testing.T) {
+	loc := time.FixedZone("PDT", -7*60*60)
+
+	v, err := ParseTime(TimeFormat, " 2020/05/01 10:11:12\n", loc)
+	if err != nil || !v.Equal(time.Date(2020, 5, 1, 17, 11, 12, 0, time.UTC)) {
+		t.Errorf("Bad time in location: %s (%v)", v, err)
+	}
+
+	v, err = ParseTime(TimeFormat, "2020/05/01 10:11:12", nil)
+	if err != nil || !v.Equal(time.Date(2020, 5, 1, 10, 11, 12, 0, time.UTC)) {
+		t.Errorf("Bad time in UTC: %s (%v)", v, err)
+	}
+
+	if v, err = ParseTime(TimeFormat, "", loc); err != nil || !v.IsZero() {
+		t.Errorf("Expected zero time for empty value, got %s (%v)", v, err)
+	}
+
+	for _, s := range []string{"Never", "2020-05-01"} {
+		if _, err = ParseTime(TimeFormat, s, loc); err == nil {
+			t.Errorf("No error for %q", s)
+		}
+	}
+}
+
+func TestParseTimeZoned(t *testing.T) {
+	loc := time.FixedZone("PDT", -7*60*60)
+
+	v, err := ParseTime(ZonedTimeFormat, "2020/05/01 10:11:12 PDT", loc)
+	if err != nil || !v.Equal(time.Date(2020, 5, 1, 17, 11, 12, 0, time.UTC)) {
+		t.Errorf("Bad time in device zone: %s (%v)", v, err)
+	}
+
+	v, err = ParseTime(ZonedTimeFormat, "2020/05/01 10:11:12 GMT", loc)
+	if err != nil || !v.Equal(time.Date(2020, 5, 1, 10, 11, 12, 0, time.UTC)) {
+		t.Errorf("Bad time in GMT: %s (%v)", v, err)
+	}
+
+	if v, err = ParseTime(ZonedTimeFormat, "2020/05/01 10:11:12 PDT", nil); err == nil {
+		t.Errorf("No error for a zone other than loc's, got %s", v)
+	}
+}
+
+func TestLicenseDates(t *testing.T) {
+	o := License{Issued: "January 01, 2020", Expires: "Never"}
+
+	if v, err := o.IssuedAt(); err != nil || !v.Equal(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Bad issued date: %s (%v)", v, err)
+	}
+	if v, err := o.ExpiresAt(); err != nil || !v.IsZero() {
+		t.Errorf("Expected zero expiry, got %s (%v)", v, err)
+	}
+
+	o.Expires = "sometime"
+	if _, err := o.ExpiresAt(); err == nil {
+		t.Errorf("No error for a bad expiry")
+	}
+}
+
+func TestJobParseTimes(t *testing.T) {
+	o := Job{Id: 3, Status: "FIN", Enqueued: "2020/03/01 23:59:59", Dequeued: "00:00:01", Finished: "2020/03/02 00:01:00"}
+
+	if err := o.ParseTimes(nil); err != nil {
+		t.Fatalf("ParseTimes failed: %s", err)
+	}
+	if !o.DequeuedAt.Equal(time.Date(2020, 3, 2, 0, 0, 1, 0, time.UTC)) {
+		t.Errorf("Bad dequeue time: %s", o.DequeuedAt)
+	}
+	if !o.FinishedAt.Equal(time.Date(2020, 3, 2, 0, 1, 0, 0, time.UTC)) {
+		t.Errorf("Bad finish time: %s", o.FinishedAt)
+	}
+
+	o = Job{Id: 4, Status: "ACT", Enqueued: "2020/03/01 10:00:00", Finished: "Still Active"}
+	if err := o.ParseTimes(nil); err != nil || !o.FinishedAt.IsZero() {
+		t.Errorf("Bad finish time for a running job: %s (%v)", o.FinishedAt, err)
+	}
+
+	o.Enqueued = "yesterday"
+	if err := o.ParseTimes(nil); err == nil {
+		t.Errorf("No error for a bad enqueue time")
+	}
+}
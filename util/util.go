@@ -256,3 +256,12 @@ func RelativeMovement(v int) bool {
 
 	return false
 }
+
+// ValidSubinterfaceName returns if name is a subinterface name of the given
+// parent interface, such as "ethernet1/1.5" for a parent of "ethernet1/1".
+//
+// This only checks the name, not that the parent interface exists.
+func ValidSubinterfaceName(parent, name string) bool {
+	prefix := parent + "."
+	return strings.HasPrefix(name, prefix) && len(name) > len(prefix)
+}
@@ -2,6 +2,7 @@ package util
 
 import (
 	"fmt"
+	"net/url"
 	"testing"
 )
 
@@ -190,3 +191,34 @@ func TestCleanRawXml(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func TestValidSubinterfaceName(t *testing.T) {
+	testCases := []struct {
+		parent string
+		name   string
+		valid  bool
+	}{
+		{"ethernet1/1", "ethernet1/1.5", true},
+		{"ae1", "ae1.100", true},
+		{"ethernet1/1", "ethernet1/12.5", false},
+		{"ethernet1/1", "ethernet1/1.", false},
+		{"ethernet1/1", "ethernet1/1", false},
+	}
+
+	for _, tc := range testCases {
+		if v := ValidSubinterfaceName(tc.parent, tc.name); v != tc.valid {
+			t.Errorf("%q of %q: got %t, expected %t", tc.name, tc.parent, v, tc.valid)
+		}
+	}
+}
+
+func TestTargetExtras(t *testing.T) {
+	if v := TargetExtras(""); v != nil {
+		t.Errorf("Expected nil extras, got %#v", v)
+	}
+
+	v, ok := TargetExtras("0123").(url.Values)
+	if !ok || len(v) != 1 || v.Get("target") != "0123" {
+		t.Errorf("Bad extras: %#v", v)
+	}
+}
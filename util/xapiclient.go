@@ -1,6 +1,7 @@
 package util
 
 import (
+	"net/url"
 	"time"
 
 	"github.com/PaloAltoNetworks/pango/version"
@@ -31,3 +32,14 @@ type XapiClient interface {
 	Commit(interface{}, string, interface{}) (uint, []byte, error)
 	PositionFirstEntity(int, string, string, []string, []string) error
 }
+
+// TargetExtras returns the extras param that sends a request through Panorama
+// to the managed device with the given serial number.  If serial is empty,
+// then nil is returned, sending the request to the client itself.
+func TargetExtras(serial string) interface{} {
+	if serial == "" {
+		return nil
+	}
+
+	return url.Values{"target": []string{serial}}
+}